@@ -1,6 +1,4 @@
 db = db.getSiblingDB('challenge');
 
+// Indexes, validators and backfills are applied by the application migrations (`migrate up`)
 users = db.createCollection('users');
-db.users.createIndex( { "document.type": 1 }, { unique: true } )
-db.users.createIndex( { "email": 1 }, { unique: true } )
-
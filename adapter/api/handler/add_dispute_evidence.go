@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type (
+	// Request data
+	AddDisputeEvidenceRequest struct {
+		SubmittedBy string            `json:"submitted_by" openapi:"required"`
+		Metadata    map[string]string `json:"metadata"`
+	}
+
+	// AddDisputeEvidenceHandler defines the dependencies of the HTTP handler for the use case
+	AddDisputeEvidenceHandler struct {
+		uc     usecase.AddDisputeEvidenceUseCase
+		log    logger.Logger
+		logKey string
+	}
+)
+
+// NewAddDisputeEvidenceHandler creates new AddDisputeEvidenceHandler with its dependencies
+func NewAddDisputeEvidenceHandler(uc usecase.AddDisputeEvidenceUseCase, log logger.Logger) AddDisputeEvidenceHandler {
+	return AddDisputeEvidenceHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "add_dispute_evidence",
+	}
+}
+
+// Handle handles http request
+func (a AddDisputeEvidenceHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	a.log = logger.FromContext(r.Context(), a.log)
+
+	var reqData AddDisputeEvidenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+		a.log.WithFields(logger.Fields{
+			"key":         a.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to marshal message")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+	defer r.Body.Close()
+
+	input, errs := a.validate(mux.Vars(r)["dispute_id"], reqData)
+	if len(errs) > 0 {
+		a.log.WithFields(logger.Fields{
+			"key":         a.logKey,
+			"error":       "invalid input",
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to data")
+
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := a.uc.Execute(r.Context(), input)
+	if err != nil {
+		status := response.StatusCode(err)
+		a.log.WithFields(logger.Fields{
+			"key":         a.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when adding dispute evidence")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	a.log.WithFields(logger.Fields{
+		"key":         a.logKey,
+		"http_status": http.StatusCreated,
+	}).Infof("success adding dispute evidence")
+
+	response.NewSuccess(output, http.StatusCreated).Send(w)
+}
+
+func (a AddDisputeEvidenceHandler) validate(disputeID string, i AddDisputeEvidenceRequest) (usecase.AddDisputeEvidenceInput, []error) {
+	var errs []error
+	ID, err := vo.NewUuid(disputeID)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("dispute_id", errors.New("invalid uuid")))
+	}
+
+	submittedBy, err := vo.NewUuid(i.SubmittedBy)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("submitted_by", err))
+	}
+
+	evidence, err := entity.NewEvidence(submittedBy, i.Metadata, time.Now())
+	if err != nil {
+		errs = append(errs, response.NewFieldError("metadata", err))
+	}
+
+	return usecase.AddDisputeEvidenceInput{
+		ID:       ID,
+		Evidence: evidence,
+	}, errs
+}
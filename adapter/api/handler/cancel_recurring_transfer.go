@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+// CancelRecurringTransferHandler defines the dependencies of the HTTP handler for the use case
+type CancelRecurringTransferHandler struct {
+	uc     usecase.CancelRecurringTransferUseCase
+	log    logger.Logger
+	logKey string
+}
+
+// NewCancelRecurringTransferHandler creates new CancelRecurringTransferHandler with its dependencies
+func NewCancelRecurringTransferHandler(uc usecase.CancelRecurringTransferUseCase, log logger.Logger) CancelRecurringTransferHandler {
+	return CancelRecurringTransferHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "cancel_recurring_transfer",
+	}
+}
+
+// Handle handles http request
+func (c CancelRecurringTransferHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	c.log = logger.FromContext(r.Context(), c.log)
+
+	ID, err := vo.NewUuid(mux.Vars(r)["recurring_transfer_id"])
+	if err != nil {
+		err := response.NewFieldError("recurring_transfer_id", errors.New("invalid uuid"))
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("invalid uuid")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := c.uc.Execute(r.Context(), usecase.CancelRecurringTransferInput{
+		ID:         ID,
+		CanceledAt: time.Now(),
+	})
+	if err != nil {
+		status := response.StatusCode(err)
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when canceling recurring transfer")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	c.log.WithFields(logger.Fields{
+		"key":         c.logKey,
+		"http_status": http.StatusOK,
+	}).Infof("success canceling recurring transfer")
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
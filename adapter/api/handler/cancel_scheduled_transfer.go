@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+// CancelScheduledTransferHandler defines the dependencies of the HTTP handler for the use case
+type CancelScheduledTransferHandler struct {
+	uc     usecase.CancelScheduledTransferUseCase
+	log    logger.Logger
+	logKey string
+}
+
+// NewCancelScheduledTransferHandler creates new CancelScheduledTransferHandler with its dependencies
+func NewCancelScheduledTransferHandler(uc usecase.CancelScheduledTransferUseCase, log logger.Logger) CancelScheduledTransferHandler {
+	return CancelScheduledTransferHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "cancel_scheduled_transfer",
+	}
+}
+
+// Handle handles http request
+func (c CancelScheduledTransferHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	c.log = logger.FromContext(r.Context(), c.log)
+
+	ID, err := vo.NewUuid(mux.Vars(r)["scheduled_transfer_id"])
+	if err != nil {
+		err := response.NewFieldError("scheduled_transfer_id", errors.New("invalid uuid"))
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("invalid uuid")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := c.uc.Execute(r.Context(), usecase.CancelScheduledTransferInput{
+		ID:         ID,
+		CanceledAt: time.Now(),
+	})
+	if err != nil {
+		status := response.StatusCode(err)
+
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when canceling scheduled transfer")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	c.log.WithFields(logger.Fields{
+		"key":         c.logKey,
+		"http_status": http.StatusOK,
+	}).Infof("success canceling scheduled transfer")
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type stubCancelScheduledTransferUseCase struct {
+	result usecase.ScheduledTransferOutput
+	err    error
+}
+
+func (s stubCancelScheduledTransferUseCase) Execute(_ context.Context, _ usecase.CancelScheduledTransferInput) (usecase.ScheduledTransferOutput, error) {
+	return s.result, s.err
+}
+
+func TestCancelScheduledTransferHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name               string
+		uc                 usecase.CancelScheduledTransferUseCase
+		ID                 string
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success cancel scheduled transfer",
+			uc: stubCancelScheduledTransferUseCase{
+				result: usecase.ScheduledTransferOutput{
+					ID:        "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+					PayerID:   "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+					PayeeID:   "0db298eb-c8e7-4829-84b7-c1036b4f0790",
+					Value:     100,
+					ExecuteAt: "2020-11-10T09:00:00Z",
+					Status:    "CANCELED",
+					CreatedAt: "2020-11-09T00:00:00Z",
+				},
+			},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0792","payer":"0db298eb-c8e7-4829-84b7-c1036b4f0791","payee":"0db298eb-c8e7-4829-84b7-c1036b4f0790","value":100,"execute_at":"2020-11-10T09:00:00Z","status":"CANCELED","created_at":"2020-11-09T00:00:00Z"}`,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Error cancel scheduled transfer invalid uuid",
+			uc:                 stubCancelScheduledTransferUseCase{},
+			ID:                 "0db298eb",
+			expectedBody:       `{"errors":["invalid uuid"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error cancel scheduled transfer not found",
+			uc:                 stubCancelScheduledTransferUseCase{err: entity.ErrNotFoundScheduledTransfer},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+			expectedBody:       `{"errors":["not found scheduled transfer"]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Error cancel scheduled transfer already executed",
+			uc:                 stubCancelScheduledTransferUseCase{err: entity.ErrScheduledTransferNotCancelable},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+			expectedBody:       `{"errors":["scheduled transfer can no longer be canceled"]}`,
+			expectedStatusCode: http.StatusConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uri := fmt.Sprintf("/scheduled-transfers/%s/cancel", tt.ID)
+			req, _ := http.NewRequest(http.MethodPost, uri, nil)
+
+			req = mux.SetURLVars(req, map[string]string{"scheduled_transfer_id": tt.ID})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewCancelScheduledTransferHandler(tt.uc, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
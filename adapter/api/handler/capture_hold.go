@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type (
+	// Request data
+	CaptureHoldRequest struct {
+		Value *int64 `json:"value"`
+	}
+
+	// CaptureHoldHandler defines the dependencies of the HTTP handler for the use case
+	CaptureHoldHandler struct {
+		uc     usecase.CaptureHoldUseCase
+		log    logger.Logger
+		logKey string
+	}
+)
+
+// NewCaptureHoldHandler creates new CaptureHoldHandler with its dependencies
+func NewCaptureHoldHandler(uc usecase.CaptureHoldUseCase, log logger.Logger) CaptureHoldHandler {
+	return CaptureHoldHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "capture_hold",
+	}
+}
+
+// Handle handles http request, without a value in the body the whole hold is captured
+func (c CaptureHoldHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	c.log = logger.FromContext(r.Context(), c.log)
+
+	var reqData CaptureHoldRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil && err != io.EOF {
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to marshal message")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+	defer r.Body.Close()
+
+	input, errs := c.validate(mux.Vars(r)["hold_id"], reqData)
+	if len(errs) > 0 {
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       "invalid input",
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to data")
+
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := c.uc.Execute(r.Context(), input)
+	if err != nil {
+		status := response.StatusCode(err)
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when capturing hold")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	c.log.WithFields(logger.Fields{
+		"key":         c.logKey,
+		"http_status": http.StatusOK,
+	}).Infof("success capturing hold")
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
+
+func (c CaptureHoldHandler) validate(holdID string, i CaptureHoldRequest) (usecase.CaptureHoldInput, []error) {
+	var errs []error
+	ID, err := vo.NewUuid(holdID)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("hold_id", errors.New("invalid uuid")))
+	}
+
+	var value *vo.Money
+	if i.Value != nil {
+		amount, err := vo.NewAmount(*i.Value)
+		if err != nil {
+			errs = append(errs, response.NewFieldError("value", err))
+		}
+
+		money := vo.NewMoneyBRL(amount)
+		value = &money
+	}
+
+	return usecase.CaptureHoldInput{
+		ID:         ID,
+		Value:      value,
+		CapturedAt: time.Now(),
+	}, errs
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type spyCaptureHoldUseCase struct {
+	result usecase.HoldOutput
+	err    error
+	input  usecase.CaptureHoldInput
+}
+
+func (s *spyCaptureHoldUseCase) Execute(_ context.Context, i usecase.CaptureHoldInput) (usecase.HoldOutput, error) {
+	s.input = i
+	return s.result, s.err
+}
+
+func TestCaptureHoldHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name               string
+		uc                 *spyCaptureHoldUseCase
+		ID                 string
+		rawPayload         string
+		expectedValue      int64
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success capture part of the hold",
+			uc: &spyCaptureHoldUseCase{
+				result: usecase.HoldOutput{
+					ID:       "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+					Value:    100,
+					Captured: 60,
+					Status:   "CAPTURED",
+					Transfer: &usecase.CreateTransferOutput{ID: "0db298eb-c8e7-4829-84b7-c1036b4f0793", Value: 60},
+				},
+			},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+			rawPayload:         `{"value": 60}`,
+			expectedValue:      60,
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0793","payer":"","payee":"","value":100,"captured":60,"status":"CAPTURED","transfer":{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0793","payer":"","payee":"","value":60,"created_at":""},"expires_at":"","created_at":"","updated_at":""}`,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Success capture the whole hold without body",
+			uc:                 &spyCaptureHoldUseCase{},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+			rawPayload:         ``,
+			expectedBody:       `{"id":"","payer":"","payee":"","value":0,"captured":0,"status":"","expires_at":"","created_at":"","updated_at":""}`,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Error capture hold invalid input",
+			uc:                 &spyCaptureHoldUseCase{},
+			ID:                 "0db298eb",
+			rawPayload:         `{"value": -1}`,
+			expectedBody:       `{"errors":["invalid uuid","invalid amount"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error capture hold not found",
+			uc:                 &spyCaptureHoldUseCase{err: entity.ErrNotFoundHold},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+			expectedBody:       `{"errors":["not found hold"]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Error capture hold no longer active",
+			uc:                 &spyCaptureHoldUseCase{err: entity.ErrHoldNotActive},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+			expectedBody:       `{"errors":["hold was already captured, voided or expired"]}`,
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name:               "Error capture more than the hold",
+			uc:                 &spyCaptureHoldUseCase{err: entity.ErrInvalidCaptureValue},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+			rawPayload:         `{"value": 1000}`,
+			expectedValue:      1000,
+			expectedBody:       `{"errors":["capture value must be positive and up to the held value"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uri := fmt.Sprintf("/holds/%s/capture", tt.ID)
+			req, _ := http.NewRequest(http.MethodPost, uri, bytes.NewReader([]byte(tt.rawPayload)))
+
+			req = mux.SetURLVars(req, map[string]string{"hold_id": tt.ID})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewCaptureHoldHandler(tt.uc, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+
+			var value int64
+			if tt.uc.input.Value != nil {
+				value = tt.uc.input.Value.Amount().Value()
+			}
+			if value != tt.expectedValue {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, value, tt.expectedValue)
+			}
+		})
+	}
+}
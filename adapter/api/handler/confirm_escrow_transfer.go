@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+// ConfirmEscrowTransferHandler defines the dependencies of the HTTP handler for the use case
+type ConfirmEscrowTransferHandler struct {
+	uc     usecase.ConfirmEscrowTransferUseCase
+	log    logger.Logger
+	logKey string
+}
+
+// NewConfirmEscrowTransferHandler creates new ConfirmEscrowTransferHandler with its dependencies
+func NewConfirmEscrowTransferHandler(uc usecase.ConfirmEscrowTransferUseCase, log logger.Logger) ConfirmEscrowTransferHandler {
+	return ConfirmEscrowTransferHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "confirm_escrow_transfer",
+	}
+}
+
+// Handle handles http request
+func (c ConfirmEscrowTransferHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	c.log = logger.FromContext(r.Context(), c.log)
+
+	ID, err := vo.NewUuid(mux.Vars(r)["escrow_transfer_id"])
+	if err != nil {
+		err := response.NewFieldError("escrow_transfer_id", errors.New("invalid uuid"))
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("invalid uuid")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := c.uc.Execute(r.Context(), usecase.ConfirmEscrowTransferInput{
+		ID:          ID,
+		ConfirmedAt: time.Now(),
+	})
+	if err != nil {
+		status := response.StatusCode(err)
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when confirming escrow transfer")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	c.log.WithFields(logger.Fields{
+		"key":         c.logKey,
+		"http_status": http.StatusOK,
+	}).Infof("success confirming escrow transfer")
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type stubCreateEscrowTransferUseCase struct {
+	result usecase.EscrowTransferOutput
+	err    error
+}
+
+func (s stubCreateEscrowTransferUseCase) Execute(_ context.Context, _ usecase.CreateEscrowTransferInput) (usecase.EscrowTransferOutput, error) {
+	return s.result, s.err
+}
+
+func TestCreateEscrowTransferHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name               string
+		uc                 usecase.CreateEscrowTransferUseCase
+		rawPayload         string
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success create escrow transfer",
+			uc: stubCreateEscrowTransferUseCase{
+				result: usecase.EscrowTransferOutput{
+					ID:        "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+					PayerID:   "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+					PayeeID:   "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+					Value:     100,
+					Status:    "IN_ESCROW",
+					ReleaseAt: "2020-11-23T00:00:00Z",
+					CreatedAt: "2020-11-09T00:00:00Z",
+					UpdatedAt: "2020-11-09T00:00:00Z",
+				},
+			},
+			rawPayload:         `{"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791", "payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0792", "value": 100}`,
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0793","payer":"0db298eb-c8e7-4829-84b7-c1036b4f0791","payee":"0db298eb-c8e7-4829-84b7-c1036b4f0792","value":100,"status":"IN_ESCROW","release_at":"2020-11-23T00:00:00Z","created_at":"2020-11-09T00:00:00Z","updated_at":"2020-11-09T00:00:00Z"}`,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Error create escrow transfer invalid input",
+			uc:                 stubCreateEscrowTransferUseCase{},
+			rawPayload:         `{"payer_id": "0db298eb", "payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0792", "value": -1}`,
+			expectedBody:       `{"errors":["invalid uuid","invalid amount"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error create escrow transfer release date in the past",
+			uc:                 stubCreateEscrowTransferUseCase{err: entity.ErrInvalidEscrowRelease},
+			rawPayload:         `{"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791", "payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0792", "value": 100, "release_at": "2020-11-09T00:00:00Z"}`,
+			expectedBody:       `{"errors":["escrow release date must be in the future"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error create escrow transfer insufficient balance",
+			uc:                 stubCreateEscrowTransferUseCase{err: entity.ErrUserInsufficientBalance},
+			rawPayload:         `{"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791", "payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0792", "value": 100}`,
+			expectedBody:       `{"errors":["user does not have sufficient balance"]}`,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:               "Error create escrow transfer without escrow account",
+			uc:                 stubCreateEscrowTransferUseCase{err: entity.ErrEscrowAccountRequired},
+			rawPayload:         `{"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791", "payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0792", "value": 100}`,
+			expectedBody:       `{"errors":["escrow account is required to create escrow transfers"]}`,
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "/escrow-transfers", bytes.NewReader([]byte(tt.rawPayload)))
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewCreateEscrowTransferHandler(tt.uc, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
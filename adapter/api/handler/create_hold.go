@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/google/uuid"
+)
+
+type (
+	// Request data
+	CreateHoldRequest struct {
+		PayerID   string     `json:"payer_id" openapi:"required"`
+		PayeeID   string     `json:"payee_id" openapi:"required"`
+		Value     int64      `json:"value" openapi:"required"`
+		ExpiresAt *time.Time `json:"expires_at"`
+	}
+
+	// CreateHoldHandler defines the dependencies of the HTTP handler for the use case
+	CreateHoldHandler struct {
+		uc     usecase.CreateHoldUseCase
+		log    logger.Logger
+		logKey string
+	}
+)
+
+// NewCreateHoldHandler creates new CreateHoldHandler with its dependencies
+func NewCreateHoldHandler(uc usecase.CreateHoldUseCase, log logger.Logger) CreateHoldHandler {
+	return CreateHoldHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "create_hold",
+	}
+}
+
+// Handle handles http request
+func (c CreateHoldHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	c.log = logger.FromContext(r.Context(), c.log)
+
+	var reqData CreateHoldRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to marshal message")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+	defer r.Body.Close()
+
+	input, errs := c.validate(reqData)
+	if len(errs) > 0 {
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       "invalid input",
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to data")
+
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := c.uc.Execute(r.Context(), input)
+	if err != nil {
+		status := response.StatusCode(err)
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when creating a new hold")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	c.log.WithFields(logger.Fields{
+		"key":         c.logKey,
+		"http_status": http.StatusCreated,
+	}).Infof("success creating hold")
+
+	response.NewSuccess(output, http.StatusCreated).Send(w)
+}
+
+func (c CreateHoldHandler) validate(i CreateHoldRequest) (usecase.CreateHoldInput, []error) {
+	var errs []error
+	id, err := vo.NewUuid(uuid.New().String())
+	if err != nil {
+		errs = append(errs, err)
+	}
+	payerID, err := vo.NewUuid(i.PayerID)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("payer_id", err))
+	}
+	payeeID, err := vo.NewUuid(i.PayeeID)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("payee_id", err))
+	}
+	amount, err := vo.NewAmount(i.Value)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("value", err))
+	}
+
+	var (
+		now       = time.Now()
+		expiresAt = now.Add(entity.DefaultHoldDuration)
+	)
+	if i.ExpiresAt != nil {
+		expiresAt = *i.ExpiresAt
+	}
+
+	return usecase.CreateHoldInput{
+		ID:        id,
+		PayerID:   payerID,
+		PayeeID:   payeeID,
+		Value:     vo.NewMoneyBRL(amount),
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}, errs
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/pkg/errors"
+)
+
+type stubCreateHoldUseCase struct {
+	result usecase.HoldOutput
+	err    error
+}
+
+func (s stubCreateHoldUseCase) Execute(_ context.Context, _ usecase.CreateHoldInput) (usecase.HoldOutput, error) {
+	return s.result, s.err
+}
+
+func TestCreateHoldHandler_Handle(t *testing.T) {
+	const payload = `{"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791", "payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0792", "value": 100}`
+
+	tests := []struct {
+		name               string
+		uc                 usecase.CreateHoldUseCase
+		rawPayload         string
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success create hold",
+			uc: stubCreateHoldUseCase{
+				result: usecase.HoldOutput{
+					ID:        "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+					PayerID:   "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+					PayeeID:   "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+					Value:     100,
+					Status:    "ACTIVE",
+					ExpiresAt: "2020-11-16T00:00:00Z",
+					CreatedAt: "2020-11-09T00:00:00Z",
+					UpdatedAt: "2020-11-09T00:00:00Z",
+				},
+			},
+			rawPayload:         payload,
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0793","payer":"0db298eb-c8e7-4829-84b7-c1036b4f0791","payee":"0db298eb-c8e7-4829-84b7-c1036b4f0792","value":100,"captured":0,"status":"ACTIVE","expires_at":"2020-11-16T00:00:00Z","created_at":"2020-11-09T00:00:00Z","updated_at":"2020-11-09T00:00:00Z"}`,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Error create hold invalid input",
+			uc:                 stubCreateHoldUseCase{},
+			rawPayload:         `{"payer_id": "0db298eb", "payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0792", "value": -100}`,
+			expectedBody:       `{"errors":["invalid uuid","invalid amount"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error create hold expired",
+			uc:                 stubCreateHoldUseCase{err: entity.ErrInvalidHoldExpiration},
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["hold expiration date must be in the future"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error create hold from merchant",
+			uc:                 stubCreateHoldUseCase{err: errors.Wrap(vo.ErrNotAllowedTypeUser, entity.ErrUnauthorizedTransfer.Error())},
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["unauthorized transfer: not allowed user type"]}`,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "Error create hold insufficient available balance",
+			uc:                 stubCreateHoldUseCase{err: entity.ErrUserInsufficientBalance},
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["user does not have sufficient balance"]}`,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "/holds", bytes.NewReader([]byte(tt.rawPayload)))
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewCreateHoldHandler(tt.uc, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/google/uuid"
+)
+
+type (
+	// Request data
+	CreateRecurringTransferRequest struct {
+		PayerID     string     `json:"payer_id" openapi:"required"`
+		PayeeID     string     `json:"payee_id" openapi:"required"`
+		Value       int64      `json:"value" openapi:"required"`
+		Frequency   string     `json:"frequency" openapi:"required,enum=DAILY|WEEKLY|MONTHLY"`
+		DayOfMonth  int        `json:"day_of_month"`
+		StartAt     *time.Time `json:"start_at"`
+		EndAt       *time.Time `json:"end_at"`
+		Count       int        `json:"count"`
+		MaxFailures int        `json:"max_failures"`
+	}
+
+	// CreateRecurringTransferHandler defines the dependencies of the HTTP handler for the use case
+	CreateRecurringTransferHandler struct {
+		uc     usecase.CreateRecurringTransferUseCase
+		log    logger.Logger
+		logKey string
+	}
+)
+
+// NewCreateRecurringTransferHandler creates new CreateRecurringTransferHandler with its dependencies
+func NewCreateRecurringTransferHandler(uc usecase.CreateRecurringTransferUseCase, log logger.Logger) CreateRecurringTransferHandler {
+	return CreateRecurringTransferHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "create_recurring_transfer",
+	}
+}
+
+// Handle handles http request
+func (c CreateRecurringTransferHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	c.log = logger.FromContext(r.Context(), c.log)
+
+	var reqData CreateRecurringTransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to marshal message")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+	defer r.Body.Close()
+
+	input, errs := c.validate(reqData)
+	if len(errs) > 0 {
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       "invalid input",
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to data")
+
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := c.uc.Execute(r.Context(), input)
+	if err != nil {
+		status := response.StatusCode(err)
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when creating a new recurring transfer")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	c.log.WithFields(logger.Fields{
+		"key":         c.logKey,
+		"http_status": http.StatusCreated,
+	}).Infof("success creating recurring transfer")
+
+	response.NewSuccess(output, http.StatusCreated).Send(w)
+}
+
+func (c CreateRecurringTransferHandler) validate(i CreateRecurringTransferRequest) (usecase.CreateRecurringTransferInput, []error) {
+	var errs []error
+	id, err := vo.NewUuid(uuid.New().String())
+	if err != nil {
+		errs = append(errs, err)
+	}
+	payerID, err := vo.NewUuid(i.PayerID)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("payer_id", err))
+	}
+	payeeID, err := vo.NewUuid(i.PayeeID)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("payee_id", err))
+	}
+	amount, err := vo.NewAmount(i.Value)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("value", err))
+	}
+
+	var (
+		now         = time.Now()
+		startAt     = now
+		endAt       time.Time
+		maxFailures = i.MaxFailures
+	)
+	if i.StartAt != nil {
+		startAt = *i.StartAt
+	}
+	if i.EndAt != nil {
+		endAt = *i.EndAt
+	}
+	if maxFailures == 0 {
+		maxFailures = entity.DefaultMaxConsecutiveFailures
+	}
+
+	return usecase.CreateRecurringTransferInput{
+		ID:          id,
+		PayerID:     payerID,
+		PayeeID:     payeeID,
+		Value:       vo.NewMoneyBRL(amount),
+		Frequency:   entity.Frequency(i.Frequency),
+		DayOfMonth:  i.DayOfMonth,
+		StartAt:     startAt,
+		EndAt:       endAt,
+		Count:       i.Count,
+		MaxFailures: maxFailures,
+		CreatedAt:   now,
+	}, errs
+}
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/pkg/errors"
+)
+
+type stubCreateRecurringTransferUseCase struct {
+	result usecase.RecurringTransferOutput
+	err    error
+}
+
+func (s stubCreateRecurringTransferUseCase) Execute(_ context.Context, _ usecase.CreateRecurringTransferInput) (usecase.RecurringTransferOutput, error) {
+	return s.result, s.err
+}
+
+func TestCreateRecurringTransferHandler_Handle(t *testing.T) {
+	const payload = `{"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791", "payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0792", "value": 100, "frequency": "WEEKLY", "start_at": "2020-11-09T09:00:00Z", "count": 4}`
+
+	tests := []struct {
+		name               string
+		uc                 usecase.CreateRecurringTransferUseCase
+		rawPayload         string
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success create recurring transfer",
+			uc: stubCreateRecurringTransferUseCase{
+				result: usecase.RecurringTransferOutput{
+					ID:          "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+					PayerID:     "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+					PayeeID:     "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+					Value:       100,
+					Frequency:   "WEEKLY",
+					StartAt:     "2020-11-09T09:00:00Z",
+					Count:       4,
+					NextRunAt:   "2020-11-09T09:00:00Z",
+					MaxFailures: 3,
+					Status:      "ACTIVE",
+					CreatedAt:   "2020-11-09T00:00:00Z",
+					UpdatedAt:   "2020-11-09T00:00:00Z",
+				},
+			},
+			rawPayload:         payload,
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0793","payer":"0db298eb-c8e7-4829-84b7-c1036b4f0791","payee":"0db298eb-c8e7-4829-84b7-c1036b4f0792","value":100,"frequency":"WEEKLY","start_at":"2020-11-09T09:00:00Z","count":4,"occurrences":0,"next_run_at":"2020-11-09T09:00:00Z","consecutive_failures":0,"max_failures":3,"status":"ACTIVE","created_at":"2020-11-09T00:00:00Z","updated_at":"2020-11-09T00:00:00Z"}`,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Error create recurring transfer invalid input",
+			uc:                 stubCreateRecurringTransferUseCase{},
+			rawPayload:         `{"payer_id": "0db298eb", "payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0792", "value": -100}`,
+			expectedBody:       `{"errors":["invalid uuid","invalid amount"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error create recurring transfer invalid frequency",
+			uc:                 stubCreateRecurringTransferUseCase{err: entity.ErrInvalidFrequency},
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["invalid frequency"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error create recurring transfer from merchant",
+			uc:                 stubCreateRecurringTransferUseCase{err: errors.Wrap(vo.ErrNotAllowedTypeUser, entity.ErrUnauthorizedTransfer.Error())},
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["unauthorized transfer: not allowed user type"]}`,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "Error create recurring transfer payee not found",
+			uc:                 stubCreateRecurringTransferUseCase{err: entity.ErrNotFoundUser},
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["not found user"]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "/recurring-transfers", bytes.NewReader([]byte(tt.rawPayload)))
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewCreateRecurringTransferHandler(tt.uc, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/google/uuid"
+)
+
+type (
+	// Request data
+	CreateSplitTransferRequest struct {
+		PayerID string                            `json:"payer_id" openapi:"required"`
+		Value   int64                             `json:"value" openapi:"required"`
+		Splits  []CreateSplitTransferShareRequest `json:"splits" openapi:"required"`
+	}
+
+	// Request data, a share has either a fixed value or a percentage of what remains after the fixed values
+	CreateSplitTransferShareRequest struct {
+		PayeeID    string  `json:"payee_id" openapi:"required"`
+		Value      int64   `json:"value"`
+		Percentage float64 `json:"percentage"`
+	}
+
+	// CreateSplitTransferHandler defines the dependencies of the HTTP handler for the use case
+	CreateSplitTransferHandler struct {
+		uc     usecase.CreateSplitTransferUseCase
+		log    logger.Logger
+		logKey string
+	}
+)
+
+// NewCreateSplitTransferHandler creates new CreateSplitTransferHandler with its dependencies
+func NewCreateSplitTransferHandler(uc usecase.CreateSplitTransferUseCase, log logger.Logger) CreateSplitTransferHandler {
+	return CreateSplitTransferHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "create_split_transfer",
+	}
+}
+
+// Handle handles http request
+func (c CreateSplitTransferHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	c.log = logger.FromContext(r.Context(), c.log)
+
+	var reqData CreateSplitTransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to marshal message")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+	defer r.Body.Close()
+
+	input, errs := c.validate(reqData)
+	if len(errs) > 0 {
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       "invalid input",
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to data")
+
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := c.uc.Execute(r.Context(), input)
+	if err != nil {
+		status := response.StatusCode(err)
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when creating a new split transfer")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	c.log.WithFields(logger.Fields{
+		"key":         c.logKey,
+		"http_status": http.StatusCreated,
+	}).Infof("success creating split transfer")
+
+	response.NewSuccess(output, http.StatusCreated).Send(w)
+}
+
+func (c CreateSplitTransferHandler) validate(i CreateSplitTransferRequest) (usecase.CreateSplitTransferInput, []error) {
+	var errs []error
+	id, err := vo.NewUuid(uuid.New().String())
+	if err != nil {
+		errs = append(errs, err)
+	}
+	payerID, err := vo.NewUuid(i.PayerID)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("payer_id", err))
+	}
+	amount, err := vo.NewAmount(i.Value)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("value", err))
+	}
+
+	var shares = make([]entity.SplitShare, 0, len(i.Splits))
+	for n, split := range i.Splits {
+		payeeID, err := vo.NewUuid(split.PayeeID)
+		if err != nil {
+			errs = append(errs, response.NewFieldError(fmt.Sprintf("splits[%d].payee_id", n), fmt.Errorf("split %d: %w", n+1, err)))
+			continue
+		}
+
+		share, err := newSplitShare(payeeID, split)
+		if err != nil {
+			errs = append(errs, response.NewFieldError(fmt.Sprintf("splits[%d]", n), fmt.Errorf("split %d: %w", n+1, err)))
+			continue
+		}
+
+		shares = append(shares, share)
+	}
+
+	return usecase.CreateSplitTransferInput{
+		ID:        id,
+		PayerID:   payerID,
+		Value:     vo.NewMoneyBRL(amount),
+		Shares:    shares,
+		CreatedAt: time.Now(),
+	}, errs
+}
+
+// newSplitShare returns the share of a fixed value or of a percentage, given with up to two decimal places
+func newSplitShare(payeeID vo.Uuid, i CreateSplitTransferShareRequest) (entity.SplitShare, error) {
+	switch {
+	case i.Value != 0 && i.Percentage == 0:
+		amount, err := vo.NewAmount(i.Value)
+		if err != nil {
+			return entity.SplitShare{}, err
+		}
+
+		return entity.NewFixedSplitShare(payeeID, vo.NewMoneyBRL(amount))
+	case i.Percentage != 0 && i.Value == 0:
+		return entity.NewPercentageSplitShare(payeeID, int64(math.Round(i.Percentage*100)))
+	}
+
+	return entity.SplitShare{}, entity.ErrInvalidSplitShare
+}
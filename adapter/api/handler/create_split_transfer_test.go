@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type stubCreateSplitTransferUseCase struct {
+	result usecase.CreateSplitTransferOutput
+	err    error
+}
+
+func (s stubCreateSplitTransferUseCase) Execute(_ context.Context, _ usecase.CreateSplitTransferInput) (usecase.CreateSplitTransferOutput, error) {
+	return s.result, s.err
+}
+
+func TestCreateSplitTransferHandler_Handle(t *testing.T) {
+	const payload = `{"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791", "value": 1000, "splits": [{"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0792", "percentage": 90}, {"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0793", "percentage": 10}]}`
+
+	tests := []struct {
+		name               string
+		uc                 usecase.CreateSplitTransferUseCase
+		rawPayload         string
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success create split transfer",
+			uc: stubCreateSplitTransferUseCase{
+				result: usecase.CreateSplitTransferOutput{
+					ID:      "0db298eb-c8e7-4829-84b7-c1036b4f0794",
+					PayerID: "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+					Value:   1000,
+					Transfers: []usecase.CreateTransferOutput{
+						{
+							ID:        "0db298eb-c8e7-4829-84b7-c1036b4f0795",
+							PayerID:   "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+							PayeeID:   "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+							Value:     900,
+							CreatedAt: "2020-11-09T00:00:00Z",
+						},
+						{
+							ID:        "0db298eb-c8e7-4829-84b7-c1036b4f0796",
+							PayerID:   "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+							PayeeID:   "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+							Value:     100,
+							CreatedAt: "2020-11-09T00:00:00Z",
+						},
+					},
+					CreatedAt: "2020-11-09T00:00:00Z",
+				},
+			},
+			rawPayload:         payload,
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0794","payer":"0db298eb-c8e7-4829-84b7-c1036b4f0791","value":1000,"transfers":[{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0795","payer":"0db298eb-c8e7-4829-84b7-c1036b4f0791","payee":"0db298eb-c8e7-4829-84b7-c1036b4f0792","value":900,"created_at":"2020-11-09T00:00:00Z"},{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0796","payer":"0db298eb-c8e7-4829-84b7-c1036b4f0791","payee":"0db298eb-c8e7-4829-84b7-c1036b4f0793","value":100,"created_at":"2020-11-09T00:00:00Z"}],"created_at":"2020-11-09T00:00:00Z"}`,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Error create split transfer invalid shares",
+			uc:                 stubCreateSplitTransferUseCase{},
+			rawPayload:         `{"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791", "value": 1000, "splits": [{"payee_id": "0db298eb", "percentage": 90}, {"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0793", "value": 100, "percentage": 10}]}`,
+			expectedBody:       `{"errors":["split 1: invalid uuid","split 2: split share must have either a positive value or a percentage up to 100"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error create split transfer not adding up",
+			uc:                 stubCreateSplitTransferUseCase{err: entity.ErrInvalidSplit},
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["split shares must add up to the transfer value"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error create split transfer insufficient balance",
+			uc:                 stubCreateSplitTransferUseCase{err: entity.ErrUserInsufficientBalance},
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["user does not have sufficient balance"]}`,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:               "Error create split transfer denied",
+			uc:                 stubCreateSplitTransferUseCase{err: entity.ErrUnauthorizedTransfer},
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["unauthorized transfer"]}`,
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "/transfers/split", bytes.NewReader([]byte(tt.rawPayload)))
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewCreateSplitTransferHandler(tt.uc, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
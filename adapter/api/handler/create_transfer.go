@@ -7,6 +7,7 @@ import (
 
 	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
 	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
 	"github.com/GSabadini/golang-clean-architecture/domain/vo"
 	"github.com/GSabadini/golang-clean-architecture/usecase"
 	"github.com/google/uuid"
@@ -15,33 +16,41 @@ import (
 type (
 	// Request data
 	CreateTransferRequest struct {
-		PayerID string `json:"payer_id"`
-		PayeeID string `json:"payee_id"`
-		Value   int64  `json:"value"`
+		PayerID           string            `json:"payer_id" openapi:"required"`
+		PayeeID           string            `json:"payee_id" openapi:"required"`
+		Value             int64             `json:"value" openapi:"required"`
+		Description       string            `json:"description"`
+		ExternalReference string            `json:"external_reference"`
+		Metadata          map[string]string `json:"metadata"`
+		ExecuteAt         *time.Time        `json:"execute_at"`
 	}
 
 	// CreateTransferHandler defines the dependencies of the HTTP handler for the use case
 	CreateTransferHandler struct {
-		uc     usecase.CreateTransferUseCase
-		log    logger.Logger
-		logKey string
+		uc         usecase.CreateTransferUseCase
+		ucSchedule usecase.ScheduleTransferUseCase
+		log        logger.Logger
+		logKey     string
 	}
 )
 
 // NewCreateTransferHandler creates new CreateTransferHandler with its dependencies
-func NewCreateTransferHandler(uc usecase.CreateTransferUseCase, log logger.Logger) CreateTransferHandler {
+func NewCreateTransferHandler(
+	uc usecase.CreateTransferUseCase,
+	ucSchedule usecase.ScheduleTransferUseCase,
+	log logger.Logger,
+) CreateTransferHandler {
 	return CreateTransferHandler{
-		uc:     uc,
-		log:    log,
-		logKey: "create_transfer",
+		uc:         uc,
+		ucSchedule: ucSchedule,
+		log:        log,
+		logKey:     "create_transfer",
 	}
 }
 
 // Handle handles http request
 func (c CreateTransferHandler) Handle(w http.ResponseWriter, r *http.Request) {
-	c.log = c.log.WithFields(logger.Fields{
-		"correlation_id": r.Context().Value("correlation_id"),
-	})
+	c.log = logger.FromContext(r.Context(), c.log)
 
 	var reqData CreateTransferRequest
 	if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
@@ -51,7 +60,7 @@ func (c CreateTransferHandler) Handle(w http.ResponseWriter, r *http.Request) {
 			"http_status": http.StatusBadRequest,
 		}).Errorf("failed to marshal message")
 
-		response.NewError(err, http.StatusBadRequest).Send(w)
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
 		return
 	}
 	defer r.Body.Close()
@@ -64,19 +73,26 @@ func (c CreateTransferHandler) Handle(w http.ResponseWriter, r *http.Request) {
 			"http_status": http.StatusBadRequest,
 		}).Errorf("failed to data")
 
-		response.NewErrors(errs, http.StatusBadRequest).Send(w)
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	if reqData.ExecuteAt != nil {
+		c.schedule(w, r, input, *reqData.ExecuteAt)
 		return
 	}
 
 	output, err := c.uc.Execute(r.Context(), input)
 	if err != nil {
+		status := response.StatusCode(err)
+
 		c.log.WithFields(logger.Fields{
 			"key":         c.logKey,
 			"error":       err.Error(),
-			"http_status": http.StatusInternalServerError,
+			"http_status": status,
 		}).Errorf("error when creating a new transfer")
 
-		response.NewError(err, http.StatusInternalServerError).Send(w)
+		response.NewError(err, status).Send(w, r)
 		return
 	}
 
@@ -88,6 +104,38 @@ func (c CreateTransferHandler) Handle(w http.ResponseWriter, r *http.Request) {
 	response.NewSuccess(output, http.StatusCreated).Send(w)
 }
 
+// schedule creates a transfer executed by the scheduler worker at executeAt
+func (c CreateTransferHandler) schedule(w http.ResponseWriter, r *http.Request, i usecase.CreateTransferInput, executeAt time.Time) {
+	output, err := c.ucSchedule.Execute(r.Context(), usecase.ScheduleTransferInput{
+		ID:        i.ID,
+		PayerID:   i.PayerID,
+		PayeeID:   i.PayeeID,
+		Value:     i.Value,
+		Details:   i.Details,
+		ExecuteAt: executeAt,
+		CreatedAt: i.CreatedAt,
+	})
+	if err != nil {
+		status := response.StatusCode(err)
+
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when scheduling a new transfer")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	c.log.WithFields(logger.Fields{
+		"key":         c.logKey,
+		"http_status": http.StatusAccepted,
+	}).Infof("success scheduling transfer")
+
+	response.NewSuccess(output, http.StatusAccepted).Send(w)
+}
+
 func (c CreateTransferHandler) validate(i CreateTransferRequest) (usecase.CreateTransferInput, []error) {
 	var errs []error
 	id, err := vo.NewUuid(uuid.New().String())
@@ -96,15 +144,19 @@ func (c CreateTransferHandler) validate(i CreateTransferRequest) (usecase.Create
 	}
 	payerID, err := vo.NewUuid(i.PayerID)
 	if err != nil {
-		errs = append(errs, err)
+		errs = append(errs, response.NewFieldError("payer_id", err))
 	}
 	payeeID, err := vo.NewUuid(i.PayeeID)
 	if err != nil {
-		errs = append(errs, err)
+		errs = append(errs, response.NewFieldError("payee_id", err))
 	}
 	amount, err := vo.NewAmount(i.Value)
 	if err != nil {
-		errs = append(errs, err)
+		errs = append(errs, response.NewFieldError("value", err))
+	}
+	details, err := entity.NewTransferDetails(i.Description, i.ExternalReference, i.Metadata)
+	if err != nil {
+		errs = append(errs, response.NewFieldError(transferDetailsField(err), err))
 	}
 
 	return usecase.CreateTransferInput{
@@ -112,6 +164,19 @@ func (c CreateTransferHandler) validate(i CreateTransferRequest) (usecase.Create
 		PayerID:   payerID,
 		PayeeID:   payeeID,
 		Value:     vo.NewMoneyBRL(amount),
+		Details:   details,
 		CreatedAt: time.Now(),
 	}, errs
 }
+
+// transferDetailsField returns the field of the request refused by the validation of the transfer details
+func transferDetailsField(err error) string {
+	switch err {
+	case entity.ErrInvalidTransferDescription:
+		return "description"
+	case entity.ErrInvalidTransferExternalReference:
+		return "external_reference"
+	default:
+		return "metadata"
+	}
+}
@@ -0,0 +1,240 @@
+package handler
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/google/uuid"
+)
+
+const csvContentType = "text/csv"
+
+type (
+	// Request data
+	CreateTransferBatchRequest struct {
+		PayerID string                           `json:"payer_id" openapi:"required"`
+		Mode    string                           `json:"mode" openapi:"enum=ALL_OR_NOTHING|BEST_EFFORT"`
+		Items   []CreateTransferBatchItemRequest `json:"items" openapi:"required"`
+	}
+
+	// Request data
+	CreateTransferBatchItemRequest struct {
+		PayeeID string `json:"payee_id" openapi:"required"`
+		Value   int64  `json:"value" openapi:"required"`
+	}
+
+	// BackgroundRunner defines how the work outliving the request is run, so it can be waited for on shutdown.
+	// The context given to the work is canceled when the shutdown gives up waiting
+	BackgroundRunner interface {
+		Go(func(context.Context))
+	}
+
+	// CreateTransferBatchHandler defines the dependencies of the HTTP handler for the use case
+	CreateTransferBatchHandler struct {
+		uc         usecase.CreateTransferBatchUseCase
+		ucProcess  usecase.ProcessTransferBatchUseCase
+		background BackgroundRunner
+		log        logger.Logger
+		logKey     string
+	}
+)
+
+// NewCreateTransferBatchHandler creates new CreateTransferBatchHandler with its dependencies
+func NewCreateTransferBatchHandler(
+	uc usecase.CreateTransferBatchUseCase,
+	ucProcess usecase.ProcessTransferBatchUseCase,
+	background BackgroundRunner,
+	log logger.Logger,
+) CreateTransferBatchHandler {
+	return CreateTransferBatchHandler{
+		uc:         uc,
+		ucProcess:  ucProcess,
+		background: background,
+		log:        log,
+		logKey:     "create_transfer_batch",
+	}
+}
+
+// Handle handles http request, the batch is accepted once validated and processed in background.
+// The body is either JSON or, with the text/csv content type, lines of payee_id,value with the
+// payer_id and mode in the query string
+func (c CreateTransferBatchHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	c.log = logger.FromContext(r.Context(), c.log)
+
+	reqData, err := c.decode(r)
+	if err != nil {
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to marshal message")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+	defer r.Body.Close()
+
+	input, errs := c.validate(reqData)
+	if len(errs) > 0 {
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       "invalid input",
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to data")
+
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := c.uc.Execute(r.Context(), input)
+	if err != nil {
+		status := response.StatusCode(err)
+		c.log.WithFields(logger.Fields{
+			"key":         c.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when creating a new transfer batch")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	c.background.Go(func(ctx context.Context) { c.process(ctx, input.ID) })
+
+	c.log.WithFields(logger.Fields{
+		"key":         c.logKey,
+		"http_status": http.StatusAccepted,
+	}).Infof("success creating transfer batch")
+
+	response.NewSuccess(output, http.StatusAccepted).Send(w)
+}
+
+// process runs the batch outside the request with the logger of the request, a batch interrupted by the shutdown
+// is resumed by the scheduler
+func (c CreateTransferBatchHandler) process(ctx context.Context, ID vo.Uuid) {
+	output, err := c.ucProcess.Execute(logger.NewContext(ctx, c.log), usecase.ProcessTransferBatchInput{ID: ID})
+	if err != nil {
+		c.log.WithFields(logger.Fields{
+			"key":               c.logKey,
+			"transfer_batch_id": ID.Value(),
+			"error":             err.Error(),
+		}).Errorf("error processing transfer batch")
+		return
+	}
+
+	c.log.WithFields(logger.Fields{
+		"key":               c.logKey,
+		"transfer_batch_id": ID.Value(),
+		"status":            output.Status,
+		"succeeded_items":   output.SucceededItems,
+		"failed_items":      output.FailedItems,
+	}).Infof("success processing transfer batch")
+}
+
+func (c CreateTransferBatchHandler) decode(r *http.Request) (CreateTransferBatchRequest, error) {
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == csvContentType {
+		return decodeTransferBatchCSV(r)
+	}
+
+	var reqData CreateTransferBatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+		return CreateTransferBatchRequest{}, err
+	}
+
+	return reqData, nil
+}
+
+// decodeTransferBatchCSV reads the items of the batch from lines of payee_id,value, the header line is optional
+func decodeTransferBatchCSV(r *http.Request) (CreateTransferBatchRequest, error) {
+	var (
+		reqData = CreateTransferBatchRequest{
+			PayerID: r.URL.Query().Get("payer_id"),
+			Mode:    r.URL.Query().Get("mode"),
+		}
+		reader = csv.NewReader(r.Body)
+	)
+
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return CreateTransferBatchRequest{}, err
+		}
+
+		if line == 1 && strings.EqualFold(record[0], "payee_id") {
+			continue
+		}
+
+		value, err := strconv.ParseInt(strings.TrimSpace(record[1]), 10, 64)
+		if err != nil {
+			return CreateTransferBatchRequest{}, fmt.Errorf("line %d: invalid value %q", line, record[1])
+		}
+
+		reqData.Items = append(reqData.Items, CreateTransferBatchItemRequest{
+			PayeeID: strings.TrimSpace(record[0]),
+			Value:   value,
+		})
+	}
+
+	return reqData, nil
+}
+
+func (c CreateTransferBatchHandler) validate(i CreateTransferBatchRequest) (usecase.CreateTransferBatchInput, []error) {
+	var errs []error
+	id, err := vo.NewUuid(uuid.New().String())
+	if err != nil {
+		errs = append(errs, err)
+	}
+	payerID, err := vo.NewUuid(i.PayerID)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("payer_id", err))
+	}
+
+	var items = make([]usecase.CreateTransferBatchItemInput, 0, len(i.Items))
+	for n, item := range i.Items {
+		itemID, err := vo.NewUuid(uuid.New().String())
+		if err != nil {
+			errs = append(errs, err)
+		}
+		payeeID, err := vo.NewUuid(item.PayeeID)
+		if err != nil {
+			errs = append(errs, response.NewFieldError(fmt.Sprintf("items[%d].payee_id", n), fmt.Errorf("item %d: %w", n+1, err)))
+		}
+		amount, err := vo.NewAmount(item.Value)
+		if err != nil {
+			errs = append(errs, response.NewFieldError(fmt.Sprintf("items[%d].value", n), fmt.Errorf("item %d: %w", n+1, err)))
+		}
+
+		items = append(items, usecase.CreateTransferBatchItemInput{
+			ID:      itemID,
+			PayeeID: payeeID,
+			Value:   vo.NewMoneyBRL(amount),
+		})
+	}
+
+	return usecase.CreateTransferBatchInput{
+		ID:        id,
+		PayerID:   payerID,
+		Mode:      entity.BatchMode(strings.ToUpper(i.Mode)),
+		Items:     items,
+		CreatedAt: time.Now(),
+	}, errs
+}
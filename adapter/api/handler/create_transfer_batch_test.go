@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/pkg/errors"
+)
+
+type spyCreateTransferBatchUseCase struct {
+	result usecase.TransferBatchOutput
+	err    error
+	input  usecase.CreateTransferBatchInput
+}
+
+func (s *spyCreateTransferBatchUseCase) Execute(_ context.Context, i usecase.CreateTransferBatchInput) (usecase.TransferBatchOutput, error) {
+	s.input = i
+	return s.result, s.err
+}
+
+type spyProcessTransferBatchUseCase struct {
+	processed chan vo.Uuid
+}
+
+func (s spyProcessTransferBatchUseCase) Execute(_ context.Context, i usecase.ProcessTransferBatchInput) (usecase.TransferBatchOutput, error) {
+	s.processed <- i.ID
+	return usecase.TransferBatchOutput{}, nil
+}
+
+type goroutineRunner struct{}
+
+func (goroutineRunner) Go(f func(context.Context)) {
+	go f(context.Background())
+}
+
+func TestCreateTransferBatchHandler_Handle(t *testing.T) {
+	const (
+		payer   = "0db298eb-c8e7-4829-84b7-c1036b4f0791"
+		payload = `{"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791", "mode": "BEST_EFFORT", "items": [{"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0792", "value": 100}, {"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0793", "value": 250}]}`
+	)
+
+	tests := []struct {
+		name               string
+		uc                 *spyCreateTransferBatchUseCase
+		uri                string
+		contentType        string
+		rawPayload         string
+		expectedItems      int
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success create transfer batch from JSON",
+			uc: &spyCreateTransferBatchUseCase{
+				result: usecase.TransferBatchOutput{
+					ID:         "0db298eb-c8e7-4829-84b7-c1036b4f0794",
+					PayerID:    payer,
+					Mode:       "BEST_EFFORT",
+					Status:     "PENDING",
+					TotalItems: 2,
+					Items:      []usecase.TransferBatchItemOutput{},
+					CreatedAt:  "2020-11-09T00:00:00Z",
+					UpdatedAt:  "2020-11-09T00:00:00Z",
+				},
+			},
+			uri:                "/transfer-batches",
+			rawPayload:         payload,
+			expectedItems:      2,
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0794","payer":"0db298eb-c8e7-4829-84b7-c1036b4f0791","mode":"BEST_EFFORT","status":"PENDING","total_items":2,"processed_items":0,"succeeded_items":0,"failed_items":0,"items":[],"created_at":"2020-11-09T00:00:00Z","updated_at":"2020-11-09T00:00:00Z"}`,
+			expectedStatusCode: http.StatusAccepted,
+		},
+		{
+			name: "Success create transfer batch from CSV",
+			uc: &spyCreateTransferBatchUseCase{
+				result: usecase.TransferBatchOutput{
+					ID:         "0db298eb-c8e7-4829-84b7-c1036b4f0794",
+					PayerID:    payer,
+					Mode:       "ALL_OR_NOTHING",
+					Status:     "PENDING",
+					TotalItems: 3,
+					Items:      []usecase.TransferBatchItemOutput{},
+					CreatedAt:  "2020-11-09T00:00:00Z",
+					UpdatedAt:  "2020-11-09T00:00:00Z",
+				},
+			},
+			uri:                "/transfer-batches?payer_id=" + payer + "&mode=all_or_nothing",
+			contentType:        "text/csv; charset=utf-8",
+			rawPayload:         "payee_id,value\n0db298eb-c8e7-4829-84b7-c1036b4f0792,100\n0db298eb-c8e7-4829-84b7-c1036b4f0793, 250\n0db298eb-c8e7-4829-84b7-c1036b4f0792,50\n",
+			expectedItems:      3,
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0794","payer":"0db298eb-c8e7-4829-84b7-c1036b4f0791","mode":"ALL_OR_NOTHING","status":"PENDING","total_items":3,"processed_items":0,"succeeded_items":0,"failed_items":0,"items":[],"created_at":"2020-11-09T00:00:00Z","updated_at":"2020-11-09T00:00:00Z"}`,
+			expectedStatusCode: http.StatusAccepted,
+		},
+		{
+			name:               "Error create transfer batch invalid CSV value",
+			uc:                 &spyCreateTransferBatchUseCase{},
+			uri:                "/transfer-batches?payer_id=" + payer + "&mode=BEST_EFFORT",
+			contentType:        "text/csv",
+			rawPayload:         "0db298eb-c8e7-4829-84b7-c1036b4f0792,ten\n",
+			expectedBody:       `{"errors":["line 1: invalid value \"ten\""]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error create transfer batch invalid items",
+			uc:                 &spyCreateTransferBatchUseCase{},
+			uri:                "/transfer-batches",
+			rawPayload:         `{"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791", "mode": "BEST_EFFORT", "items": [{"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0792", "value": 100}, {"payee_id": "0db298eb", "value": -1}]}`,
+			expectedBody:       `{"errors":["item 2: invalid uuid","item 2: invalid amount"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error create transfer batch too large",
+			uc:                 &spyCreateTransferBatchUseCase{err: entity.ErrTransferBatchTooLarge},
+			uri:                "/transfer-batches",
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["transfer batch has too many items"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error create transfer batch from merchant",
+			uc:                 &spyCreateTransferBatchUseCase{err: errors.Wrap(vo.ErrNotAllowedTypeUser, entity.ErrUnauthorizedTransfer.Error())},
+			uri:                "/transfer-batches",
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["unauthorized transfer: not allowed user type"]}`,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "Error create transfer batch payee not found",
+			uc:                 &spyCreateTransferBatchUseCase{err: errors.Wrap(entity.ErrNotFoundUser, "payee 0db298eb-c8e7-4829-84b7-c1036b4f0792")},
+			uri:                "/transfer-batches",
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["payee 0db298eb-c8e7-4829-84b7-c1036b4f0792: not found user"]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Error create transfer batch insufficient balance",
+			uc:                 &spyCreateTransferBatchUseCase{err: entity.ErrUserInsufficientBalance},
+			uri:                "/transfer-batches",
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["user does not have sufficient balance"]}`,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, tt.uri, bytes.NewReader([]byte(tt.rawPayload)))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
+			var (
+				w         = httptest.NewRecorder()
+				ucProcess = spyProcessTransferBatchUseCase{processed: make(chan vo.Uuid, 1)}
+				handler   = NewCreateTransferBatchHandler(tt.uc, ucProcess, goroutineRunner{}, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+
+			if tt.expectedStatusCode != http.StatusAccepted {
+				return
+			}
+
+			if len(tt.uc.input.Items) != tt.expectedItems {
+				t.Errorf("[TestCase '%s'] Items: '%v' | Expected: '%v'", tt.name, len(tt.uc.input.Items), tt.expectedItems)
+			}
+
+			select {
+			case ID := <-ucProcess.processed:
+				if ID != tt.uc.input.ID {
+					t.Errorf("[TestCase '%s'] Processed: '%v' | Expected: '%v'", tt.name, ID, tt.uc.input.ID)
+				}
+			case <-time.After(time.Second):
+				t.Errorf("[TestCase '%s'] the batch was not processed", tt.name)
+			}
+		})
+	}
+}
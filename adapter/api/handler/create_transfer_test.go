@@ -27,10 +27,20 @@ func (s stubCreateTransferUseCase) Execute(_ context.Context, _ usecase.CreateTr
 	return s.result, s.err
 }
 
+type stubScheduleTransferUseCase struct {
+	result usecase.ScheduledTransferOutput
+	err    error
+}
+
+func (s stubScheduleTransferUseCase) Execute(_ context.Context, _ usecase.ScheduleTransferInput) (usecase.ScheduledTransferOutput, error) {
+	return s.result, s.err
+}
+
 func TestCreateTransferHandler_Handle(t *testing.T) {
 	type fields struct {
-		uc  usecase.CreateTransferUseCase
-		log logger.Logger
+		uc         usecase.CreateTransferUseCase
+		ucSchedule usecase.ScheduleTransferUseCase
+		log        logger.Logger
 	}
 	type args struct {
 		rawPayload []byte
@@ -67,7 +77,7 @@ func TestCreateTransferHandler_Handle(t *testing.T) {
 					}`,
 				),
 			},
-			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","payer":"0db298eb-c8e7-4829-84b7-c1036b4f0791","payee":"0db298eb-c8e7-4829-84b7-c1036b4f0791","value":100,"created_at":"0001-01-01T00:00:00Z"}`,
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","payer":"0db298eb-c8e7-4829-84b7-c1036b4f0791","payee":"0db298eb-c8e7-4829-84b7-c1036b4f0791","value":100,"fee":{"payer_fee":0,"payee_fee":0,"total":0,"payer_debit":100,"payee_credit":100},"created_at":"0001-01-01T00:00:00Z"}`,
 			expectedStatusCode: http.StatusCreated,
 		},
 		{
@@ -109,6 +119,226 @@ func TestCreateTransferHandler_Handle(t *testing.T) {
 			expectedBody:       `{"errors":["db_error"]}`,
 			expectedStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name: "Error create transfer insufficient balance",
+			fields: fields{
+				uc: stubCreateTransferUseCase{
+					result: usecase.CreateTransferOutput{},
+					err:    entity.ErrUserInsufficientBalance,
+				},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				rawPayload: []byte(`
+					{
+						"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"value": 100
+					}`,
+				),
+			},
+			expectedBody:       `{"errors":["user does not have sufficient balance"]}`,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error create transfer by a merchant",
+			fields: fields{
+				uc: stubCreateTransferUseCase{
+					result: usecase.CreateTransferOutput{},
+					err:    entity.WrapError(entity.ErrUnauthorizedTransfer, vo.ErrNotAllowedTypeUser),
+				},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				rawPayload: []byte(`
+					{
+						"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"value": 100
+					}`,
+				),
+			},
+			expectedBody:       `{"errors":["unauthorized transfer: not allowed user type"]}`,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "Error create transfer payer not found",
+			fields: fields{
+				uc: stubCreateTransferUseCase{
+					result: usecase.CreateTransferOutput{},
+					err:    entity.ErrNotFoundUser,
+				},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				rawPayload: []byte(`
+					{
+						"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"value": 100
+					}`,
+				),
+			},
+			expectedBody:       `{"errors":["not found user"]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Error create transfer denied by the authorizer",
+			fields: fields{
+				uc: stubCreateTransferUseCase{
+					result: usecase.CreateTransferOutput{},
+					err:    entity.ErrUnauthorizedTransfer,
+				},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				rawPayload: []byte(`
+					{
+						"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"value": 100
+					}`,
+				),
+			},
+			expectedBody:       `{"errors":["unauthorized transfer"]}`,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "Error create transfer authorizer unavailable",
+			fields: fields{
+				uc: stubCreateTransferUseCase{
+					result: usecase.CreateTransferOutput{},
+					err:    entity.WrapError(entity.ErrAuthorizerUnavailable, errors.New("timeout")),
+				},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				rawPayload: []byte(`
+					{
+						"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"value": 100
+					}`,
+				),
+			},
+			expectedBody:       `{"errors":["authorizer is unavailable: timeout"]}`,
+			expectedStatusCode: http.StatusServiceUnavailable,
+		},
+		{
+			name: "Error create transfer invalid metadata",
+			fields: fields{
+				uc:  stubCreateTransferUseCase{},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				rawPayload: []byte(`
+					{
+						"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"value": 100,
+						"metadata": {"order.id": "1234"}
+					}`,
+				),
+			},
+			expectedBody:       `{"errors":["transfer metadata must have up to 20 keys of letters, digits, '_' or '-' up to 40 characters and values up to 500 characters"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error create transfer duplicated external reference",
+			fields: fields{
+				uc: stubCreateTransferUseCase{
+					result: usecase.CreateTransferOutput{},
+					err:    entity.ErrDuplicateTransferReference,
+				},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				rawPayload: []byte(`
+					{
+						"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"value": 100,
+						"description": "Order #1234",
+						"external_reference": "order-1234"
+					}`,
+				),
+			},
+			expectedBody:       `{"errors":["transfer with the external reference already exists for the payee"]}`,
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name: "Error create transfer above the daily outgoing limit",
+			fields: fields{
+				uc: stubCreateTransferUseCase{
+					result: usecase.CreateTransferOutput{},
+					err:    entity.NewKYCLimitError(entity.KYCBasic, entity.DailyOutgoingLimit, 50000),
+				},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				rawPayload: []byte(`
+					{
+						"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"value": 60000
+					}`,
+				),
+			},
+			expectedBody:       `{"errors":["daily outgoing limit of the KYC level BASIC exceeded, remaining allowance of 50000"]}`,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Success schedule transfer",
+			fields: fields{
+				uc: stubCreateTransferUseCase{},
+				ucSchedule: stubScheduleTransferUseCase{
+					result: usecase.ScheduledTransferOutput{
+						ID:        "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						PayerID:   "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						PayeeID:   "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+						Value:     100,
+						ExecuteAt: "2030-01-01T00:00:00Z",
+						Status:    "SCHEDULED",
+						CreatedAt: "0001-01-01T00:00:00Z",
+					},
+				},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				rawPayload: []byte(`
+					{
+						"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+						"value": 100,
+						"execute_at": "2030-01-01T00:00:00Z"
+					}`,
+				),
+			},
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","payer":"0db298eb-c8e7-4829-84b7-c1036b4f0791","payee":"0db298eb-c8e7-4829-84b7-c1036b4f0792","value":100,"execute_at":"2030-01-01T00:00:00Z","status":"SCHEDULED","created_at":"0001-01-01T00:00:00Z"}`,
+			expectedStatusCode: http.StatusAccepted,
+		},
+		{
+			name: "Error schedule transfer in the past",
+			fields: fields{
+				uc: stubCreateTransferUseCase{},
+				ucSchedule: stubScheduleTransferUseCase{
+					err: entity.ErrInvalidExecutionDate,
+				},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				rawPayload: []byte(`
+					{
+						"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+						"value": 100,
+						"execute_at": "2000-01-01T00:00:00Z"
+					}`,
+				),
+			},
+			expectedBody:       `{"errors":["execution date must be in the future"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -120,7 +350,7 @@ func TestCreateTransferHandler_Handle(t *testing.T) {
 
 			var (
 				w       = httptest.NewRecorder()
-				handler = NewCreateTransferHandler(tt.fields.uc, tt.fields.log)
+				handler = NewCreateTransferHandler(tt.fields.uc, tt.fields.ucSchedule, tt.fields.log)
 			)
 
 			handler.Handle(w, req)
@@ -146,3 +376,157 @@ func TestCreateTransferHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateTransferHandler_HandleProblem(t *testing.T) {
+	type args struct {
+		accept     string
+		rawPayload []byte
+	}
+	tests := []struct {
+		name                string
+		uc                  usecase.CreateTransferUseCase
+		args                args
+		expectedBody        string
+		expectedContentType string
+		expectedStatusCode  int
+	}{
+		{
+			name: "Problem create transfer invalid input",
+			uc:   stubCreateTransferUseCase{},
+			args: args{
+				accept: "application/problem+json",
+				rawPayload: []byte(`
+					{
+						"payer_id": "0db298eb-c8e7-4829-84b7",
+						"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"value": -100
+					}`,
+				),
+			},
+			expectedBody:        `{"type":"/problems/bad_request","title":"Bad Request","status":400,"detail":"2 errors found in the request: invalid uuid; invalid amount","instance":"f9882930-1914-47d7-8b58-18bff092e081","code":"bad_request","invalid_params":[{"name":"payer_id","reason":"invalid uuid"},{"name":"value","reason":"invalid amount"}]}`,
+			expectedContentType: "application/problem+json",
+			expectedStatusCode:  http.StatusBadRequest,
+		},
+		{
+			name: "Problem create transfer invalid description",
+			uc:   stubCreateTransferUseCase{},
+			args: args{
+				accept: "application/json, application/problem+json;q=0.9",
+				rawPayload: []byte(`
+					{
+						"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"value": 100,
+						"external_reference": "` + strings.Repeat("a", 65) + `"
+					}`,
+				),
+			},
+			expectedBody:        `{"type":"/problems/invalid_transfer_external_reference","title":"Bad Request","status":400,"detail":"transfer external reference must have up to 64 characters","instance":"f9882930-1914-47d7-8b58-18bff092e081","code":"invalid_transfer_external_reference","invalid_params":[{"name":"external_reference","reason":"transfer external reference must have up to 64 characters"}]}`,
+			expectedContentType: "application/problem+json",
+			expectedStatusCode:  http.StatusBadRequest,
+		},
+		{
+			name: "Problem create transfer insufficient balance",
+			uc: stubCreateTransferUseCase{
+				err: entity.ErrUserInsufficientBalance,
+			},
+			args: args{
+				accept: "application/problem+json",
+				rawPayload: []byte(`
+					{
+						"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+						"value": 100
+					}`,
+				),
+			},
+			expectedBody:        `{"type":"/problems/user_insufficient_balance","title":"Unprocessable Entity","status":422,"detail":"user does not have sufficient balance","instance":"f9882930-1914-47d7-8b58-18bff092e081","code":"user_insufficient_balance"}`,
+			expectedContentType: "application/problem+json",
+			expectedStatusCode:  http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Problem create transfer database failed",
+			uc: stubCreateTransferUseCase{
+				err: errors.New("db_error"),
+			},
+			args: args{
+				accept: "application/problem+json",
+				rawPayload: []byte(`
+					{
+						"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+						"value": 100
+					}`,
+				),
+			},
+			expectedBody:        `{"type":"/problems/internal_error","title":"Internal Server Error","status":500,"detail":"db_error","instance":"f9882930-1914-47d7-8b58-18bff092e081","code":"internal_error"}`,
+			expectedContentType: "application/problem+json",
+			expectedStatusCode:  http.StatusInternalServerError,
+		},
+		{
+			name: "Legacy create transfer insufficient balance",
+			uc: stubCreateTransferUseCase{
+				err: entity.ErrUserInsufficientBalance,
+			},
+			args: args{
+				accept: "application/json",
+				rawPayload: []byte(`
+					{
+						"payer_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						"payee_id": "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+						"value": 100
+					}`,
+				),
+			},
+			expectedBody:        `{"errors":["user does not have sufficient balance"]}`,
+			expectedContentType: "application/json",
+			expectedStatusCode:  http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(
+				http.MethodPost,
+				"/transfers",
+				bytes.NewReader(tt.args.rawPayload),
+			)
+			req.Header.Set("Accept", tt.args.accept)
+			req = req.WithContext(logger.WithCorrelationID(req.Context(), "f9882930-1914-47d7-8b58-18bff092e081"))
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewCreateTransferHandler(tt.uc, nil, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			if contentType := w.Header().Get("Content-Type"); contentType != tt.expectedContentType {
+				t.Errorf(
+					"[TestCase '%s'] Content-Type: '%v' | Expected: '%v'",
+					tt.name,
+					contentType,
+					tt.expectedContentType,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if result != tt.expectedBody {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
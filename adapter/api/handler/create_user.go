@@ -15,24 +15,23 @@ import (
 type (
 	// Request data
 	CreateUserRequest struct {
-		FullName string
-		Email    string
-		Password string
-		Document CreateUserDocumentRequest
-		Wallet   CreateUserWalletRequest
-		Type     string
+		FullName string                    `openapi:"required"`
+		Email    string                    `openapi:"required"`
+		Password string                    `openapi:"required"`
+		Document CreateUserDocumentRequest `openapi:"required"`
+		Wallet   CreateUserWalletRequest   `openapi:"required"`
+		Type     string                    `openapi:"required,enum=COMMON|MERCHANT"`
 	}
 
 	// Request data
 	CreateUserDocumentRequest struct {
-		Type  string
-		Value string
+		Type  string `openapi:"required,enum=CPF|CNPJ"`
+		Value string `openapi:"required"`
 	}
 
-	// Request data
+	// Request data, the wallet always starts with a zero balance and is funded by deposits
 	CreateUserWalletRequest struct {
-		Currency string
-		Amount   int64
+		Currency string `openapi:"required,enum=BRL|USD"`
 	}
 
 	// CreateUserHandler defines the dependencies of the HTTP handler for the use case
@@ -54,9 +53,7 @@ func NewCreateUserHandler(uc usecase.CreateUserUseCase, l logger.Logger) CreateU
 
 // Handle handles http request
 func (c CreateUserHandler) Handle(w http.ResponseWriter, r *http.Request) {
-	c.log = c.log.WithFields(logger.Fields{
-		"correlation_id": r.Context().Value("correlation_id"),
-	})
+	c.log = logger.FromContext(r.Context(), c.log)
 
 	var reqData CreateUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
@@ -66,7 +63,7 @@ func (c CreateUserHandler) Handle(w http.ResponseWriter, r *http.Request) {
 			"http_status": http.StatusBadRequest,
 		}).Errorf("failed to marshal message")
 
-		response.NewError(err, http.StatusBadRequest).Send(w)
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
 		return
 	}
 	defer r.Body.Close()
@@ -79,19 +76,20 @@ func (c CreateUserHandler) Handle(w http.ResponseWriter, r *http.Request) {
 			"http_status": http.StatusBadRequest,
 		}).Errorf("failed to data")
 
-		response.NewErrors(errs, http.StatusBadRequest).Send(w)
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
 		return
 	}
 
 	output, err := c.uc.Execute(r.Context(), input)
 	if err != nil {
+		status := response.StatusCode(err)
 		c.log.WithFields(logger.Fields{
 			"key":         c.logKey,
 			"error":       err.Error(),
-			"http_status": http.StatusInternalServerError,
+			"http_status": status,
 		}).Errorf("error when creating a new user")
 
-		response.NewError(err, http.StatusInternalServerError).Send(w)
+		response.NewError(err, status).Send(w, r)
 		return
 	}
 
@@ -111,17 +109,17 @@ func (c CreateUserHandler) validate(i CreateUserRequest) (usecase.CreateUserInpu
 	}
 	doc, err := vo.NewDocument(vo.TypeDocument(i.Document.Type), i.Document.Value)
 	if err != nil {
-		errs = append(errs, err)
+		errs = append(errs, response.NewFieldError("document", err))
 	}
 	email, err := vo.NewEmail(i.Email)
 	if err != nil {
-		errs = append(errs, err)
+		errs = append(errs, response.NewFieldError("email", err))
 	}
 	currency, err := vo.NewCurrency(i.Wallet.Currency)
 	if err != nil {
-		errs = append(errs, err)
+		errs = append(errs, response.NewFieldError("wallet.currency", err))
 	}
-	amount, err := vo.NewAmount(i.Wallet.Amount)
+	amount, err := vo.NewAmount(0)
 	if err != nil {
 		errs = append(errs, err)
 	}
@@ -135,7 +133,7 @@ func (c CreateUserHandler) validate(i CreateUserRequest) (usecase.CreateUserInpu
 	}
 	typeUser, err := vo.NewTypeUser(i.Type)
 	if err != nil {
-		errs = append(errs, err)
+		errs = append(errs, response.NewFieldError("type", err))
 	}
 
 	return usecase.CreateUserInput{
@@ -54,7 +54,7 @@ func TestCreateUserHandler_Handle(t *testing.T) {
 							vo.NewEmailTest("test@testing.com"),
 							vo.NewPassword("passw"),
 							vo.NewDocumentTest(vo.CPF, "07091054954"),
-							vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(100))),
+							vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(0))),
 							time.Time{},
 						)),
 					err: nil,
@@ -72,14 +72,13 @@ func TestCreateUserHandler_Handle(t *testing.T) {
 							"value": "070.910.549-54"
 						},
 						"wallet": {
-							"currency": "BRL",
-							"amount": 100
+							"currency": "BRL"
 						},
 						"type": "common"
 					}`,
 				),
 			},
-			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","full_name":"Common user","email":"test@testing.com","password":"passw","document":{"type":"CPF","value":"07091054954"},"wallet":{"currency":"BRL","amount":100},"Roles":{"can_transfer":true},"type":"COMMON","created_at":"0001-01-01T00:00:00Z"}`,
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","full_name":"Common user","email":"test@testing.com","password":"passw","document":{"type":"CPF","value":"07091054954"},"wallet":{"currency":"BRL","amount":0},"Roles":{"can_transfer":true},"type":"COMMON","created_at":"0001-01-01T00:00:00Z"}`,
 			expectedStatusCode: http.StatusCreated,
 		},
 		{
@@ -93,7 +92,7 @@ func TestCreateUserHandler_Handle(t *testing.T) {
 							vo.NewEmailTest("test@testing.com"),
 							vo.NewPassword("passw"),
 							vo.NewDocumentTest(vo.CPF, "07091054954"),
-							vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(100))),
+							vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(0))),
 							time.Time{},
 						)),
 					err: nil,
@@ -111,14 +110,13 @@ func TestCreateUserHandler_Handle(t *testing.T) {
 							"value": "070.910.549-54"
 						},
 						"wallet": {
-							"currency": "BRL",
-							"amount": 100
+							"currency": "BRL"
 						},
 						"type": "merchant"
 					}`,
 				),
 			},
-			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","full_name":"Common user","email":"test@testing.com","password":"passw","document":{"type":"CPF","value":"07091054954"},"wallet":{"currency":"BRL","amount":100},"Roles":{"can_transfer":false},"type":"MERCHANT","created_at":"0001-01-01T00:00:00Z"}`,
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","full_name":"Common user","email":"test@testing.com","password":"passw","document":{"type":"CPF","value":"07091054954"},"wallet":{"currency":"BRL","amount":0},"Roles":{"can_transfer":false},"type":"MERCHANT","created_at":"0001-01-01T00:00:00Z"}`,
 			expectedStatusCode: http.StatusCreated,
 		},
 		{
@@ -138,8 +136,7 @@ func TestCreateUserHandler_Handle(t *testing.T) {
 							"value": "070.910.549-54"
 						},
 						"wallet": {
-							"currency": "BRL",
-							"amount": 100
+							"currency": "BRL"
 						},
 						"type": "not exists"
 					}`,
@@ -168,8 +165,7 @@ func TestCreateUserHandler_Handle(t *testing.T) {
 							"value": "070.910.549-54"
 						},
 						"wallet": {
-							"currency": "BRL",
-							"amount": 100
+							"currency": "BRL"
 						},
 						"type": "merchant"
 					}`,
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/google/uuid"
+)
+
+type (
+	// Request data
+	CreateMovementRequest struct {
+		UserID            string `json:"user_id" openapi:"required"`
+		Value             int64  `json:"value" openapi:"required"`
+		ExternalReference string `json:"external_reference"`
+	}
+
+	// DepositHandler defines the dependencies of the HTTP handler for the use case
+	DepositHandler struct {
+		uc     usecase.DepositUseCase
+		log    logger.Logger
+		logKey string
+	}
+)
+
+// NewDepositHandler creates new DepositHandler with its dependencies
+func NewDepositHandler(uc usecase.DepositUseCase, log logger.Logger) DepositHandler {
+	return DepositHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "deposit",
+	}
+}
+
+// Handle handles http request
+func (d DepositHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	d.log = logger.FromContext(r.Context(), d.log)
+
+	var reqData CreateMovementRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+		d.log.WithFields(logger.Fields{
+			"key":         d.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to marshal message")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+	defer r.Body.Close()
+
+	ID, userID, value, errs := validateMovement(reqData)
+	if len(errs) > 0 {
+		d.log.WithFields(logger.Fields{
+			"key":         d.logKey,
+			"error":       "invalid input",
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to data")
+
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := d.uc.Execute(r.Context(), usecase.DepositInput{
+		ID:                ID,
+		UserID:            userID,
+		Value:             value,
+		ExternalReference: reqData.ExternalReference,
+		CreatedAt:         time.Now(),
+	})
+	if err != nil {
+		status := response.StatusCode(err)
+		d.log.WithFields(logger.Fields{
+			"key":         d.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when creating a new deposit")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	d.log.WithFields(logger.Fields{
+		"key":         d.logKey,
+		"http_status": http.StatusCreated,
+	}).Infof("success creating deposit")
+
+	response.NewSuccess(output, http.StatusCreated).Send(w)
+}
+
+func validateMovement(i CreateMovementRequest) (vo.Uuid, vo.Uuid, vo.Money, []error) {
+	var errs []error
+	id, err := vo.NewUuid(uuid.New().String())
+	if err != nil {
+		errs = append(errs, err)
+	}
+	userID, err := vo.NewUuid(i.UserID)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("user_id", err))
+	}
+	amount, err := vo.NewAmount(i.Value)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("value", err))
+	}
+	if i.ExternalReference == "" {
+		errs = append(errs, response.NewFieldError("external_reference", entity.ErrInvalidExternalReference))
+	}
+
+	return id, userID, vo.NewMoneyBRL(amount), errs
+}
@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/pkg/errors"
+)
+
+type stubDepositUseCase struct {
+	result usecase.MovementOutput
+	err    error
+}
+
+func (s stubDepositUseCase) Execute(_ context.Context, _ usecase.DepositInput) (usecase.MovementOutput, error) {
+	return s.result, s.err
+}
+
+func TestDepositHandler_Handle(t *testing.T) {
+	const payload = `{"user_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791", "value": 100, "external_reference": "bank-slip-0001"}`
+
+	tests := []struct {
+		name               string
+		uc                 usecase.DepositUseCase
+		rawPayload         string
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success deposit",
+			uc: stubDepositUseCase{
+				result: usecase.MovementOutput{
+					ID:                "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+					UserID:            "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+					Type:              "DEPOSIT",
+					Currency:          "BRL",
+					Value:             100,
+					ExternalReference: "bank-slip-0001",
+					Status:            "COMPLETED",
+					CreatedAt:         "0001-01-01T00:00:00Z",
+				},
+			},
+			rawPayload:         payload,
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0792","user_id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","type":"DEPOSIT","currency":"BRL","value":100,"external_reference":"bank-slip-0001","status":"COMPLETED","created_at":"0001-01-01T00:00:00Z"}`,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Error deposit invalid input",
+			uc:                 stubDepositUseCase{},
+			rawPayload:         `{"user_id": "0db298eb", "value": -100}`,
+			expectedBody:       `{"errors":["invalid uuid","invalid amount","invalid external reference"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error deposit duplicated external reference",
+			uc: stubDepositUseCase{
+				err: errors.Wrap(entity.ErrDuplicateExternalReference, entity.ErrCreateMovement.Error()),
+			},
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["error creating movement: movement with the external reference already exists"]}`,
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name:               "Error deposit user not found",
+			uc:                 stubDepositUseCase{err: entity.ErrNotFoundUser},
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["not found user"]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Error deposit not authorized",
+			uc:                 stubDepositUseCase{err: entity.ErrUnauthorizedMovement},
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["unauthorized movement"]}`,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Error deposit above the wallet balance limit",
+			uc: stubDepositUseCase{
+				err: entity.NewKYCLimitError(entity.KYCBasic, entity.WalletBalanceLimit, 2500),
+			},
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["wallet balance limit of the KYC level BASIC exceeded, remaining allowance of 2500"]}`,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:               "Error deposit database failed",
+			uc:                 stubDepositUseCase{err: errors.New("db_error")},
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["db_error"]}`,
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "/deposits", bytes.NewReader([]byte(tt.rawPayload)))
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewDepositHandler(tt.uc, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type (
+	// Request data
+	DisputeEscrowTransferRequest struct {
+		Reason string `json:"reason" openapi:"required"`
+	}
+
+	// DisputeEscrowTransferHandler defines the dependencies of the HTTP handler for the use case
+	DisputeEscrowTransferHandler struct {
+		uc     usecase.DisputeEscrowTransferUseCase
+		log    logger.Logger
+		logKey string
+	}
+)
+
+// NewDisputeEscrowTransferHandler creates new DisputeEscrowTransferHandler with its dependencies
+func NewDisputeEscrowTransferHandler(uc usecase.DisputeEscrowTransferUseCase, log logger.Logger) DisputeEscrowTransferHandler {
+	return DisputeEscrowTransferHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "dispute_escrow_transfer",
+	}
+}
+
+// Handle handles http request
+func (d DisputeEscrowTransferHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	d.log = logger.FromContext(r.Context(), d.log)
+
+	var reqData DisputeEscrowTransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+		d.log.WithFields(logger.Fields{
+			"key":         d.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to marshal message")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+	defer r.Body.Close()
+
+	input, errs := d.validate(mux.Vars(r)["escrow_transfer_id"], reqData)
+	if len(errs) > 0 {
+		d.log.WithFields(logger.Fields{
+			"key":         d.logKey,
+			"error":       "invalid input",
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to data")
+
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := d.uc.Execute(r.Context(), input)
+	if err != nil {
+		status := response.StatusCode(err)
+		d.log.WithFields(logger.Fields{
+			"key":         d.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when disputing escrow transfer")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	d.log.WithFields(logger.Fields{
+		"key":         d.logKey,
+		"http_status": http.StatusOK,
+	}).Infof("success disputing escrow transfer")
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
+
+func (d DisputeEscrowTransferHandler) validate(escrowID string, i DisputeEscrowTransferRequest) (usecase.DisputeEscrowTransferInput, []error) {
+	var errs []error
+	ID, err := vo.NewUuid(escrowID)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("escrow_transfer_id", errors.New("invalid uuid")))
+	}
+
+	var reason = strings.TrimSpace(i.Reason)
+	if reason == "" {
+		errs = append(errs, response.NewFieldError("reason", errors.New("dispute reason is required")))
+	}
+
+	return usecase.DisputeEscrowTransferInput{
+		ID:         ID,
+		Reason:     reason,
+		DisputedAt: time.Now(),
+	}, errs
+}
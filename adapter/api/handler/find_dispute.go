@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+// FindDisputeHandler defines the dependencies of the HTTP handler for the use case
+type FindDisputeHandler struct {
+	uc     usecase.FindDisputeUseCase
+	log    logger.Logger
+	logKey string
+}
+
+// NewFindDisputeHandler creates new FindDisputeHandler with its dependencies
+func NewFindDisputeHandler(uc usecase.FindDisputeUseCase, log logger.Logger) FindDisputeHandler {
+	return FindDisputeHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "find_dispute",
+	}
+}
+
+// Handle handles http request
+func (f FindDisputeHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	f.log = logger.FromContext(r.Context(), f.log)
+
+	ID, err := vo.NewUuid(mux.Vars(r)["dispute_id"])
+	if err != nil {
+		err := response.NewFieldError("dispute_id", errors.New("invalid uuid"))
+		f.log.WithFields(logger.Fields{
+			"key":         f.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("invalid uuid")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := f.uc.Execute(r.Context(), usecase.FindDisputeInput{ID: ID})
+	if err != nil {
+		status := response.StatusCode(err)
+		f.log.WithFields(logger.Fields{
+			"key":         f.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error fetching dispute")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	f.log.WithFields(logger.Fields{
+		"key":         f.logKey,
+		"http_status": http.StatusOK,
+	}).Infof("success when returning dispute")
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
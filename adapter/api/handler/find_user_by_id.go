@@ -6,7 +6,6 @@ import (
 
 	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
 	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
-	"github.com/GSabadini/golang-clean-architecture/domain/entity"
 	"github.com/GSabadini/golang-clean-architecture/domain/vo"
 	"github.com/GSabadini/golang-clean-architecture/usecase"
 	"github.com/gorilla/mux"
@@ -30,9 +29,7 @@ func NewFindUserByIDHandler(uc usecase.FindUserByIDUseCase, l logger.Logger) Fin
 
 // Handle handles http request
 func (f FindUserByIDHandler) Handle(w http.ResponseWriter, r *http.Request) {
-	f.log = f.log.WithFields(logger.Fields{
-		"correlation_id": r.Context().Value("correlation_id"),
-	})
+	f.log = logger.FromContext(r.Context(), f.log)
 
 	reqID := mux.Vars(r)["user_id"]
 	if reqID == "" {
@@ -43,44 +40,33 @@ func (f FindUserByIDHandler) Handle(w http.ResponseWriter, r *http.Request) {
 			"http_status": http.StatusBadRequest,
 		}).Errorf("invalid parameter")
 
-		response.NewError(err, http.StatusBadRequest).Send(w)
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
 		return
 	}
 
 	ID, err := vo.NewUuid(reqID)
 	if err != nil {
-		err := errors.New("invalid uuid")
+		err := response.NewFieldError("user_id", errors.New("invalid uuid"))
 		f.log.WithFields(logger.Fields{
 			"key":         f.logKey,
 			"error":       err.Error(),
 			"http_status": http.StatusBadRequest,
 		}).Errorf("invalid uuid")
 
-		response.NewError(err, http.StatusBadRequest).Send(w)
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
 		return
 	}
 
 	output, err := f.uc.Execute(r.Context(), usecase.FindUserByIDInput{ID: ID})
 	if err != nil {
-		switch err {
-		case entity.ErrNotFoundUser:
-			f.log.WithFields(logger.Fields{
-				"key":         f.logKey,
-				"error":       err.Error(),
-				"http_status": http.StatusNotFound,
-			}).Errorf("error fetching user by id")
-
-			response.NewError(err, http.StatusNotFound).Send(w)
-		default:
-			f.log.WithFields(logger.Fields{
-				"key":         f.logKey,
-				"error":       err.Error(),
-				"http_status": http.StatusInternalServerError,
-			}).Errorf("error fetching user by id")
-
-			response.NewError(err, http.StatusInternalServerError).Send(w)
-		}
+		status := response.StatusCode(err)
+		f.log.WithFields(logger.Fields{
+			"key":         f.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error fetching user by id")
 
+		response.NewError(err, status).Send(w, r)
 		return
 	}
 
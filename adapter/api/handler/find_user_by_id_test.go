@@ -64,7 +64,7 @@ func TestFindUserByIDHandler_Execute(t *testing.T) {
 			args: args{
 				ID: vo.NewUuidStaticTest().Value(),
 			},
-			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","fullname":"Common user","email":"test@testing.com","document":{"type":"CPF","value":"07091054954"},"wallet":{"currency":"BRL","amount":100},"roles":{"can_transfer":true},"type":"COMMON","created_at":"0001-01-01T00:00:00Z"}`,
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","fullname":"Common user","email":"test@testing.com","document":{"type":"CPF","value":"07091054954"},"wallet":{"currency":"BRL","amount":100,"held":0,"available":100},"roles":{"can_transfer":true},"type":"COMMON","kyc_level":"BASIC","created_at":"0001-01-01T00:00:00Z"}`,
 			expectedStatusCode: http.StatusOK,
 		},
 		{
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+// FindUserEventsHandler defines the dependencies of the HTTP handler for the use case
+type FindUserEventsHandler struct {
+	uc     usecase.FindUserEventsUseCase
+	log    logger.Logger
+	logKey string
+}
+
+// NewFindUserEventsHandler creates new FindUserEventsHandler with its dependencies
+func NewFindUserEventsHandler(uc usecase.FindUserEventsUseCase, l logger.Logger) FindUserEventsHandler {
+	return FindUserEventsHandler{
+		uc:     uc,
+		log:    l,
+		logKey: "find_user_events",
+	}
+}
+
+// Handle handles http request
+func (f FindUserEventsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	f.log = logger.FromContext(r.Context(), f.log)
+
+	ID, err := vo.NewUuid(mux.Vars(r)["user_id"])
+	if err != nil {
+		err := response.NewFieldError("user_id", errors.New("invalid uuid"))
+		f.log.WithFields(logger.Fields{
+			"key":         f.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("invalid uuid")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := f.uc.Execute(r.Context(), usecase.FindUserEventsInput{ID: ID})
+	if err != nil {
+		status := response.StatusCode(err)
+		f.log.WithFields(logger.Fields{
+			"key":         f.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error fetching user events")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	f.log.WithFields(logger.Fields{
+		"key":         f.logKey,
+		"http_status": http.StatusOK,
+	}).Infof("success when returning user events")
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type stubFindUserEventsUseCase struct {
+	result usecase.FindUserEventsOutput
+	err    error
+}
+
+func (s stubFindUserEventsUseCase) Execute(_ context.Context, _ usecase.FindUserEventsInput) (usecase.FindUserEventsOutput, error) {
+	return s.result, s.err
+}
+
+func TestFindUserEventsHandler_Handle(t *testing.T) {
+	type fields struct {
+		uc  usecase.FindUserEventsUseCase
+		log logger.Logger
+	}
+	type args struct {
+		ID string
+	}
+	tests := []struct {
+		name               string
+		fields             fields
+		args               args
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success find user events",
+			fields: fields{
+				uc: stubFindUserEventsUseCase{
+					result: usecase.FindUserEventsOutput{
+						ID: vo.NewUuidStaticTest().Value(),
+						Events: []usecase.FindUserEventsItemOutput{
+							{
+								Sequence:   1,
+								Type:       "WalletCredited",
+								Data:       []byte(`{"amount":100}`),
+								OccurredAt: "0001-01-01T00:00:00Z",
+							},
+						},
+					},
+				},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				ID: vo.NewUuidStaticTest().Value(),
+			},
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","events":[{"sequence":1,"type":"WalletCredited","data":{"amount":100},"occurred_at":"0001-01-01T00:00:00Z"}]}`,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Error find user events invalid uuid",
+			fields: fields{
+				uc:  stubFindUserEventsUseCase{},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				ID: "invalid",
+			},
+			expectedBody:       `{"errors":["invalid uuid"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error find user events not found",
+			fields: fields{
+				uc: stubFindUserEventsUseCase{
+					err: entity.ErrNotFoundUser,
+				},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				ID: vo.NewUuidStaticTest().Value(),
+			},
+			expectedBody:       `{"errors":["not found user"]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Error find user events database failed",
+			fields: fields{
+				uc: stubFindUserEventsUseCase{
+					err: errors.New("db_error"),
+				},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				ID: vo.NewUuidStaticTest().Value(),
+			},
+			expectedBody:       `{"errors":["db_error"]}`,
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uri := fmt.Sprintf("/users/%s/events", tt.args.ID)
+			req, _ := http.NewRequest(http.MethodGet, uri, nil)
+
+			req = mux.SetURLVars(req, map[string]string{"user_id": tt.args.ID})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewFindUserEventsHandler(tt.fields.uc, tt.fields.log)
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
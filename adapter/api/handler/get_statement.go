@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+const (
+	statementFormatJSON = "json"
+	statementFormatCSV  = "csv"
+	statementFormatText = "txt"
+
+	defaultStatementPeriod = 30 * 24 * time.Hour
+)
+
+var (
+	errInvalidStatementDate = errors.New("invalid date, expected RFC3339 or YYYY-MM-DD")
+
+	errInvalidStatementFormat = errors.New("invalid format, expected json, csv or txt")
+)
+
+// GetStatementHandler defines the dependencies of the HTTP handler for the use case
+type GetStatementHandler struct {
+	uc     usecase.GetStatementUseCase
+	log    logger.Logger
+	logKey string
+}
+
+// NewGetStatementHandler creates new GetStatementHandler with its dependencies
+func NewGetStatementHandler(uc usecase.GetStatementUseCase, l logger.Logger) GetStatementHandler {
+	return GetStatementHandler{
+		uc:     uc,
+		log:    l,
+		logKey: "get_statement",
+	}
+}
+
+// Handle handles http request
+func (g GetStatementHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	g.log = logger.FromContext(r.Context(), g.log)
+
+	input, format, errs := g.validate(r)
+	if len(errs) > 0 {
+		g.log.WithFields(logger.Fields{
+			"key":         g.logKey,
+			"error":       "invalid input",
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to data")
+
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := g.uc.Execute(r.Context(), input)
+	if err != nil {
+		status := response.StatusCode(err)
+		g.log.WithFields(logger.Fields{
+			"key":         g.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error fetching statement")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	g.log.WithFields(logger.Fields{
+		"key":         g.logKey,
+		"http_status": http.StatusOK,
+	}).Infof("success when returning statement")
+
+	switch format {
+	case statementFormatCSV:
+		body, err := statementCSV(output)
+		if err != nil {
+			response.NewError(err, http.StatusInternalServerError).Send(w, r)
+			return
+		}
+
+		response.NewFile(body, "text/csv; charset=utf-8", http.StatusOK).Send(w)
+	case statementFormatText:
+		response.NewFile(statementText(output), "text/plain; charset=utf-8", http.StatusOK).Send(w)
+	default:
+		response.NewSuccess(output, http.StatusOK).Send(w)
+	}
+}
+
+func (g GetStatementHandler) validate(r *http.Request) (usecase.GetStatementInput, string, []error) {
+	var errs []error
+	ID, err := vo.NewUuid(mux.Vars(r)["user_id"])
+	if err != nil {
+		errs = append(errs, response.NewFieldError("user_id", err))
+	}
+
+	to := time.Now()
+	if value := r.URL.Query().Get("to"); value != "" {
+		if to, err = parseStatementDate(value, true); err != nil {
+			errs = append(errs, response.NewFieldError("to", err))
+		}
+	}
+
+	from := to.Add(-defaultStatementPeriod)
+	if value := r.URL.Query().Get("from"); value != "" {
+		if from, err = parseStatementDate(value, false); err != nil {
+			errs = append(errs, response.NewFieldError("from", err))
+		}
+	}
+
+	format := r.URL.Query().Get("format")
+	switch format {
+	case "":
+		format = statementFormatJSON
+	case statementFormatJSON, statementFormatCSV, statementFormatText:
+	default:
+		errs = append(errs, response.NewFieldError("format", errInvalidStatementFormat))
+	}
+
+	return usecase.GetStatementInput{
+		UserID: ID,
+		From:   from,
+		To:     to,
+	}, format, errs
+}
+
+// parseStatementDate accepts RFC3339 timestamps or dates, a date as the end of the period includes the whole day
+func parseStatementDate(value string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, errInvalidStatementDate
+	}
+
+	if endOfDay {
+		return t.Add(24*time.Hour - time.Nanosecond), nil
+	}
+
+	return t, nil
+}
+
+func statementCSV(o usecase.GetStatementOutput) ([]byte, error) {
+	var (
+		buf bytes.Buffer
+		w   = csv.NewWriter(&buf)
+	)
+
+	records := [][]string{
+		{"created_at", "type", "reference_id", "counterparty", "amount", "balance", "currency"},
+		{o.From, "OPENING_BALANCE", "", "", "", strconv.FormatInt(o.OpeningBalance, 10), o.Currency},
+	}
+	for _, entry := range o.Entries {
+		records = append(records, []string{
+			entry.CreatedAt,
+			entry.Type,
+			entry.ReferenceID,
+			entry.Counterparty,
+			strconv.FormatInt(entry.Amount, 10),
+			strconv.FormatInt(entry.Balance, 10),
+			o.Currency,
+		})
+	}
+	records = append(records, []string{o.To, "CLOSING_BALANCE", "", "", "", strconv.FormatInt(o.ClosingBalance, 10), o.Currency})
+
+	if err := w.WriteAll(records); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func statementText(o usecase.GetStatementOutput) []byte {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "Statement of %s\n", o.UserID)
+	fmt.Fprintf(&buf, "Period: %s to %s\n", o.From, o.To)
+	fmt.Fprintf(&buf, "Currency: %s\n\n", o.Currency)
+
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "DATE\tTYPE\tREFERENCE\tCOUNTERPARTY\tAMOUNT\tBALANCE\t")
+	fmt.Fprintf(w, "%s\tOPENING BALANCE\t\t\t\t%d\t\n", o.From, o.OpeningBalance)
+	for _, entry := range o.Entries {
+		amount := entry.Amount
+		if entry.Type == string(entity.Debit) {
+			amount = -amount
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t\n",
+			entry.CreatedAt,
+			entry.Type,
+			entry.ReferenceID,
+			entry.Counterparty,
+			amount,
+			entry.Balance,
+		)
+	}
+	fmt.Fprintf(w, "%s\tCLOSING BALANCE\t\t\t\t%d\t\n", o.To, o.ClosingBalance)
+	w.Flush()
+
+	return buf.Bytes()
+}
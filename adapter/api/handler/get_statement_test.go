@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type stubGetStatementUseCase struct {
+	result usecase.GetStatementOutput
+	err    error
+}
+
+func (s stubGetStatementUseCase) Execute(_ context.Context, _ usecase.GetStatementInput) (usecase.GetStatementOutput, error) {
+	return s.result, s.err
+}
+
+func TestGetStatementHandler_Handle(t *testing.T) {
+	var output = usecase.GetStatementOutput{
+		UserID:         "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+		Currency:       "BRL",
+		From:           "2020-11-01T00:00:00Z",
+		To:             "2020-11-30T23:59:59Z",
+		OpeningBalance: 100,
+		ClosingBalance: 70,
+		Entries: []usecase.GetStatementEntryOutput{
+			{
+				ReferenceID:  "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Type:         "DEBIT",
+				Counterparty: "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+				Amount:       30,
+				Balance:      70,
+				CreatedAt:    "2020-11-09T00:00:00Z",
+			},
+		},
+	}
+
+	type fields struct {
+		uc  usecase.GetStatementUseCase
+		log logger.Logger
+	}
+	type args struct {
+		ID    string
+		query string
+	}
+	tests := []struct {
+		name                string
+		fields              fields
+		args                args
+		expectedBody        string
+		expectedContentType string
+		expectedStatusCode  int
+	}{
+		{
+			name: "Success get statement json",
+			fields: fields{
+				uc:  stubGetStatementUseCase{result: output},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				ID:    vo.NewUuidStaticTest().Value(),
+				query: "from=2020-11-01&to=2020-11-30",
+			},
+			expectedBody:        `{"user_id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","currency":"BRL","from":"2020-11-01T00:00:00Z","to":"2020-11-30T23:59:59Z","opening_balance":100,"closing_balance":70,"entries":[{"reference_id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","type":"DEBIT","counterparty":"0db298eb-c8e7-4829-84b7-c1036b4f0792","amount":30,"balance":70,"created_at":"2020-11-09T00:00:00Z"}]}`,
+			expectedContentType: "application/json",
+			expectedStatusCode:  http.StatusOK,
+		},
+		{
+			name: "Success get statement csv",
+			fields: fields{
+				uc:  stubGetStatementUseCase{result: output},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				ID:    vo.NewUuidStaticTest().Value(),
+				query: "format=csv",
+			},
+			expectedBody: "created_at,type,reference_id,counterparty,amount,balance,currency\n" +
+				"2020-11-01T00:00:00Z,OPENING_BALANCE,,,,100,BRL\n" +
+				"2020-11-09T00:00:00Z,DEBIT,0db298eb-c8e7-4829-84b7-c1036b4f0791,0db298eb-c8e7-4829-84b7-c1036b4f0792,30,70,BRL\n" +
+				"2020-11-30T23:59:59Z,CLOSING_BALANCE,,,,70,BRL",
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedStatusCode:  http.StatusOK,
+		},
+		{
+			name: "Error get statement invalid input",
+			fields: fields{
+				uc:  stubGetStatementUseCase{},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				ID:    "invalid",
+				query: "from=yesterday&format=pdf",
+			},
+			expectedBody:        `{"errors":["invalid uuid","invalid date, expected RFC3339 or YYYY-MM-DD","invalid format, expected json, csv or txt"]}`,
+			expectedContentType: "application/json",
+			expectedStatusCode:  http.StatusBadRequest,
+		},
+		{
+			name: "Error get statement not found",
+			fields: fields{
+				uc:  stubGetStatementUseCase{err: entity.ErrNotFoundUser},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				ID: vo.NewUuidStaticTest().Value(),
+			},
+			expectedBody:        `{"errors":["not found user"]}`,
+			expectedContentType: "application/json",
+			expectedStatusCode:  http.StatusNotFound,
+		},
+		{
+			name: "Error get statement database failed",
+			fields: fields{
+				uc:  stubGetStatementUseCase{err: errors.New("db_error")},
+				log: infralogger.Dummy{},
+			},
+			args: args{
+				ID: vo.NewUuidStaticTest().Value(),
+			},
+			expectedBody:        `{"errors":["db_error"]}`,
+			expectedContentType: "application/json",
+			expectedStatusCode:  http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uri := fmt.Sprintf("/users/%s/statement?%s", tt.args.ID, tt.args.query)
+			req, _ := http.NewRequest(http.MethodGet, uri, nil)
+
+			req = mux.SetURLVars(req, map[string]string{"user_id": tt.args.ID})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewGetStatementHandler(tt.fields.uc, tt.fields.log)
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			if contentType := w.Header().Get("Content-Type"); contentType != tt.expectedContentType {
+				t.Errorf("[TestCase '%s'] Content-Type: '%v' | Expected: '%v'", tt.name, contentType, tt.expectedContentType)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
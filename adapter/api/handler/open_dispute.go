@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type (
+	// Request data
+	OpenDisputeRequest struct {
+		Reason string `json:"reason" openapi:"required"`
+	}
+
+	// OpenDisputeHandler defines the dependencies of the HTTP handler for the use case
+	OpenDisputeHandler struct {
+		uc     usecase.OpenDisputeUseCase
+		log    logger.Logger
+		logKey string
+	}
+)
+
+// NewOpenDisputeHandler creates new OpenDisputeHandler with its dependencies
+func NewOpenDisputeHandler(uc usecase.OpenDisputeUseCase, log logger.Logger) OpenDisputeHandler {
+	return OpenDisputeHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "open_dispute",
+	}
+}
+
+// Handle handles http request
+func (o OpenDisputeHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	o.log = logger.FromContext(r.Context(), o.log)
+
+	var reqData OpenDisputeRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+		o.log.WithFields(logger.Fields{
+			"key":         o.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to marshal message")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+	defer r.Body.Close()
+
+	input, errs := o.validate(mux.Vars(r)["transfer_id"], reqData)
+	if len(errs) > 0 {
+		o.log.WithFields(logger.Fields{
+			"key":         o.logKey,
+			"error":       "invalid input",
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to data")
+
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := o.uc.Execute(r.Context(), input)
+	if err != nil {
+		status := response.StatusCode(err)
+		o.log.WithFields(logger.Fields{
+			"key":         o.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when opening dispute")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	o.log.WithFields(logger.Fields{
+		"key":         o.logKey,
+		"http_status": http.StatusCreated,
+	}).Infof("success opening dispute")
+
+	response.NewSuccess(output, http.StatusCreated).Send(w)
+}
+
+func (o OpenDisputeHandler) validate(transferID string, i OpenDisputeRequest) (usecase.OpenDisputeInput, []error) {
+	var errs []error
+	id, err := vo.NewUuid(uuid.New().String())
+	if err != nil {
+		errs = append(errs, err)
+	}
+	transfer, err := vo.NewUuid(transferID)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("transfer_id", errors.New("invalid uuid")))
+	}
+
+	var reason = strings.TrimSpace(i.Reason)
+	if reason == "" {
+		errs = append(errs, response.NewFieldError("reason", errors.New("dispute reason is required")))
+	}
+
+	return usecase.OpenDisputeInput{
+		ID:         id,
+		TransferID: transfer,
+		Reason:     reason,
+		CreatedAt:  time.Now(),
+	}, errs
+}
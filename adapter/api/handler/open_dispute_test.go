@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type stubOpenDisputeUseCase struct {
+	result usecase.DisputeOutput
+	err    error
+}
+
+func (s stubOpenDisputeUseCase) Execute(_ context.Context, _ usecase.OpenDisputeInput) (usecase.DisputeOutput, error) {
+	return s.result, s.err
+}
+
+func TestOpenDisputeHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name               string
+		uc                 usecase.OpenDisputeUseCase
+		transferID         string
+		rawPayload         string
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success open dispute",
+			uc: stubOpenDisputeUseCase{
+				result: usecase.DisputeOutput{
+					ID:         "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+					TransferID: "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+					Value:      100,
+					Reason:     "item not delivered",
+					Status:     "OPEN",
+					Evidence:   []usecase.DisputeEvidenceOutput{},
+				},
+			},
+			transferID:         "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+			rawPayload:         `{"reason": "item not delivered"}`,
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0793","transfer_id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","payer":"","payee":"","value":100,"reason":"item not delivered","status":"OPEN","evidence":[],"created_at":"","updated_at":""}`,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Error open dispute invalid input",
+			uc:                 stubOpenDisputeUseCase{},
+			transferID:         "0db298eb",
+			rawPayload:         `{"reason": " "}`,
+			expectedBody:       `{"errors":["invalid uuid","dispute reason is required"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error open dispute transfer not found",
+			uc:                 stubOpenDisputeUseCase{err: entity.ErrNotFoundTransfer},
+			transferID:         "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+			rawPayload:         `{"reason": "item not delivered"}`,
+			expectedBody:       `{"errors":["not found transfer"]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Error open dispute transfer already disputed",
+			uc:                 stubOpenDisputeUseCase{err: entity.ErrTransferAlreadyDisputed},
+			transferID:         "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+			rawPayload:         `{"reason": "item not delivered"}`,
+			expectedBody:       `{"errors":["transfer already has a dispute"]}`,
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name:               "Error open dispute payee without available balance",
+			uc:                 stubOpenDisputeUseCase{err: entity.ErrUserInsufficientBalance},
+			transferID:         "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+			rawPayload:         `{"reason": "item not delivered"}`,
+			expectedBody:       fmt.Sprintf(`{"errors":["%s"]}`, entity.ErrUserInsufficientBalance),
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uri := fmt.Sprintf("/transfers/%s/disputes", tt.transferID)
+			req, _ := http.NewRequest(http.MethodPost, uri, bytes.NewReader([]byte(tt.rawPayload)))
+
+			req = mux.SetURLVars(req, map[string]string{"transfer_id": tt.transferID})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewOpenDisputeHandler(tt.uc, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+// QuoteTransferHandler defines the dependencies of the HTTP handler for the use case
+type QuoteTransferHandler struct {
+	uc     usecase.QuoteTransferUseCase
+	log    logger.Logger
+	logKey string
+}
+
+// NewQuoteTransferHandler creates new QuoteTransferHandler with its dependencies
+func NewQuoteTransferHandler(uc usecase.QuoteTransferUseCase, log logger.Logger) QuoteTransferHandler {
+	return QuoteTransferHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "quote_transfer",
+	}
+}
+
+// Handle handles http request
+func (q QuoteTransferHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	q.log = logger.FromContext(r.Context(), q.log)
+
+	input, errs := q.validate(r)
+	if len(errs) > 0 {
+		q.log.WithFields(logger.Fields{
+			"key":         q.logKey,
+			"error":       "invalid input",
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to data")
+
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := q.uc.Execute(r.Context(), input)
+	if err != nil {
+		status := response.StatusCode(err)
+
+		q.log.WithFields(logger.Fields{
+			"key":         q.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error quoting transfer")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	q.log.WithFields(logger.Fields{
+		"key":         q.logKey,
+		"http_status": http.StatusOK,
+	}).Infof("success when returning transfer quote")
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
+
+func (q QuoteTransferHandler) validate(r *http.Request) (usecase.QuoteTransferInput, []error) {
+	var (
+		errs  []error
+		query = r.URL.Query()
+	)
+
+	payerID, err := vo.NewUuid(query.Get("payer_id"))
+	if err != nil {
+		errs = append(errs, response.NewFieldError("payer_id", err))
+	}
+	payeeID, err := vo.NewUuid(query.Get("payee_id"))
+	if err != nil {
+		errs = append(errs, response.NewFieldError("payee_id", err))
+	}
+
+	value, err := strconv.ParseInt(query.Get("value"), 10, 64)
+	if err != nil {
+		value = -1
+	}
+	amount, err := vo.NewAmount(value)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("value", err))
+	}
+
+	return usecase.QuoteTransferInput{
+		PayerID: payerID,
+		PayeeID: payeeID,
+		Value:   vo.NewMoneyBRL(amount),
+	}, errs
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type stubQuoteTransferUseCase struct {
+	result usecase.QuoteTransferOutput
+	err    error
+}
+
+func (s stubQuoteTransferUseCase) Execute(_ context.Context, _ usecase.QuoteTransferInput) (usecase.QuoteTransferOutput, error) {
+	return s.result, s.err
+}
+
+func TestQuoteTransferHandler_Handle(t *testing.T) {
+	const (
+		payer = "0db298eb-c8e7-4829-84b7-c1036b4f0791"
+		payee = "0db298eb-c8e7-4829-84b7-c1036b4f0790"
+	)
+
+	tests := []struct {
+		name               string
+		uc                 usecase.QuoteTransferUseCase
+		query              string
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success quote transfer",
+			uc: stubQuoteTransferUseCase{
+				result: usecase.QuoteTransferOutput{
+					PayerID: payer,
+					PayeeID: payee,
+					Value:   1000,
+					Fee: usecase.TransferFeeOutput{
+						PayerFee:    10,
+						PayeeFee:    25,
+						Total:       35,
+						PayerDebit:  1010,
+						PayeeCredit: 975,
+					},
+				},
+			},
+			query:              "payer_id=" + payer + "&payee_id=" + payee + "&value=1000",
+			expectedBody:       `{"payer":"0db298eb-c8e7-4829-84b7-c1036b4f0791","payee":"0db298eb-c8e7-4829-84b7-c1036b4f0790","value":1000,"fee":{"payer_fee":10,"payee_fee":25,"total":35,"payer_debit":1010,"payee_credit":975}}`,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Error quote transfer invalid query",
+			uc:                 stubQuoteTransferUseCase{},
+			query:              "payer_id=0db298eb&payee_id=" + payee + "&value=abc",
+			expectedBody:       `{"errors":["invalid uuid","invalid amount"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error quote transfer user not found",
+			uc:                 stubQuoteTransferUseCase{err: entity.ErrNotFoundUser},
+			query:              "payer_id=" + payer + "&payee_id=" + payee + "&value=1000",
+			expectedBody:       `{"errors":["not found user"]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Error quote transfer fee exceeds value",
+			uc:                 stubQuoteTransferUseCase{err: entity.ErrFeeExceedsValue},
+			query:              "payer_id=" + payer + "&payee_id=" + payee + "&value=1",
+			expectedBody:       `{"errors":["fee exceeds the transfer value"]}`,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/transfers/quote?"+tt.query, nil)
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewQuoteTransferHandler(tt.uc, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
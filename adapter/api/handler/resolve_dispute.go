@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type (
+	// Request data
+	ResolveDisputeRequest struct {
+		Outcome string `json:"outcome" openapi:"required,enum=WON|LOST"`
+	}
+
+	// ResolveDisputeHandler defines the dependencies of the HTTP handler for the use case
+	ResolveDisputeHandler struct {
+		uc     usecase.ResolveDisputeUseCase
+		log    logger.Logger
+		logKey string
+	}
+)
+
+// NewResolveDisputeHandler creates new ResolveDisputeHandler with its dependencies
+func NewResolveDisputeHandler(uc usecase.ResolveDisputeUseCase, log logger.Logger) ResolveDisputeHandler {
+	return ResolveDisputeHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "resolve_dispute",
+	}
+}
+
+// Handle handles http request
+func (re ResolveDisputeHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	re.log = logger.FromContext(r.Context(), re.log)
+
+	var reqData ResolveDisputeRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+		re.log.WithFields(logger.Fields{
+			"key":         re.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to marshal message")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+	defer r.Body.Close()
+
+	input, errs := re.validate(mux.Vars(r)["dispute_id"], reqData)
+	if len(errs) > 0 {
+		re.log.WithFields(logger.Fields{
+			"key":         re.logKey,
+			"error":       "invalid input",
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to data")
+
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := re.uc.Execute(r.Context(), input)
+	if err != nil {
+		status := response.StatusCode(err)
+		re.log.WithFields(logger.Fields{
+			"key":         re.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when resolving dispute")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	re.log.WithFields(logger.Fields{
+		"key":         re.logKey,
+		"http_status": http.StatusOK,
+	}).Infof("success resolving dispute")
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
+
+func (re ResolveDisputeHandler) validate(disputeID string, i ResolveDisputeRequest) (usecase.ResolveDisputeInput, []error) {
+	var errs []error
+	ID, err := vo.NewUuid(disputeID)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("dispute_id", errors.New("invalid uuid")))
+	}
+
+	var outcome = entity.DisputeStatus(strings.ToUpper(i.Outcome))
+	if outcome != entity.DisputeWon && outcome != entity.DisputeLost {
+		errs = append(errs, response.NewFieldError("outcome", entity.ErrInvalidDisputeOutcome))
+	}
+
+	return usecase.ResolveDisputeInput{
+		ID:         ID,
+		Outcome:    outcome,
+		ResolvedAt: time.Now(),
+	}, errs
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type spyResolveDisputeUseCase struct {
+	result usecase.DisputeOutput
+	err    error
+	input  usecase.ResolveDisputeInput
+}
+
+func (s *spyResolveDisputeUseCase) Execute(_ context.Context, i usecase.ResolveDisputeInput) (usecase.DisputeOutput, error) {
+	s.input = i
+	return s.result, s.err
+}
+
+func TestResolveDisputeHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name               string
+		uc                 *spyResolveDisputeUseCase
+		ID                 string
+		rawPayload         string
+		expectedOutcome    entity.DisputeStatus
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success resolve lost by the payee",
+			uc: &spyResolveDisputeUseCase{
+				result: usecase.DisputeOutput{
+					ID:       "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+					Value:    100,
+					Reason:   "item not delivered",
+					Status:   "LOST",
+					Evidence: []usecase.DisputeEvidenceOutput{},
+				},
+			},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+			rawPayload:         `{"outcome": "lost"}`,
+			expectedOutcome:    entity.DisputeLost,
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0793","transfer_id":"","payer":"","payee":"","value":100,"reason":"item not delivered","status":"LOST","evidence":[],"created_at":"","updated_at":""}`,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Error resolve invalid input",
+			uc:                 &spyResolveDisputeUseCase{},
+			ID:                 "0db298eb",
+			rawPayload:         `{"outcome": "UNDER_REVIEW"}`,
+			expectedBody:       `{"errors":["invalid uuid","dispute outcome must be WON or LOST"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error resolve dispute not found",
+			uc:                 &spyResolveDisputeUseCase{err: entity.ErrNotFoundDispute},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+			rawPayload:         `{"outcome": "WON"}`,
+			expectedOutcome:    entity.DisputeWon,
+			expectedBody:       `{"errors":["not found dispute"]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Error resolve dispute already resolved",
+			uc:                 &spyResolveDisputeUseCase{err: entity.ErrDisputeResolved},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+			rawPayload:         `{"outcome": "WON"}`,
+			expectedOutcome:    entity.DisputeWon,
+			expectedBody:       `{"errors":["dispute was already resolved"]}`,
+			expectedStatusCode: http.StatusConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uri := fmt.Sprintf("/disputes/%s/resolve", tt.ID)
+			req, _ := http.NewRequest(http.MethodPost, uri, bytes.NewReader([]byte(tt.rawPayload)))
+
+			req = mux.SetURLVars(req, map[string]string{"dispute_id": tt.ID})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewResolveDisputeHandler(tt.uc, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+
+			if tt.uc.input.Outcome != tt.expectedOutcome {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, tt.uc.input.Outcome, tt.expectedOutcome)
+			}
+		})
+	}
+}
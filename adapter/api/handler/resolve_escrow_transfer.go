@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type (
+	// Request data
+	ResolveEscrowTransferRequest struct {
+		Resolution string `json:"resolution" openapi:"required,enum=RELEASE|REFUND"`
+	}
+
+	// ResolveEscrowTransferHandler defines the dependencies of the HTTP handler for the use case
+	ResolveEscrowTransferHandler struct {
+		uc     usecase.ResolveEscrowTransferUseCase
+		log    logger.Logger
+		logKey string
+	}
+)
+
+// NewResolveEscrowTransferHandler creates new ResolveEscrowTransferHandler with its dependencies
+func NewResolveEscrowTransferHandler(uc usecase.ResolveEscrowTransferUseCase, log logger.Logger) ResolveEscrowTransferHandler {
+	return ResolveEscrowTransferHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "resolve_escrow_transfer",
+	}
+}
+
+// Handle handles http request
+func (re ResolveEscrowTransferHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	re.log = logger.FromContext(r.Context(), re.log)
+
+	var reqData ResolveEscrowTransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+		re.log.WithFields(logger.Fields{
+			"key":         re.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to marshal message")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+	defer r.Body.Close()
+
+	input, errs := re.validate(mux.Vars(r)["escrow_transfer_id"], reqData)
+	if len(errs) > 0 {
+		re.log.WithFields(logger.Fields{
+			"key":         re.logKey,
+			"error":       "invalid input",
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to data")
+
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := re.uc.Execute(r.Context(), input)
+	if err != nil {
+		status := response.StatusCode(err)
+		re.log.WithFields(logger.Fields{
+			"key":         re.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when resolving escrow transfer")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	re.log.WithFields(logger.Fields{
+		"key":         re.logKey,
+		"http_status": http.StatusOK,
+	}).Infof("success resolving escrow transfer")
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
+
+func (re ResolveEscrowTransferHandler) validate(escrowID string, i ResolveEscrowTransferRequest) (usecase.ResolveEscrowTransferInput, []error) {
+	var errs []error
+	ID, err := vo.NewUuid(escrowID)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("escrow_transfer_id", errors.New("invalid uuid")))
+	}
+
+	var resolution = entity.EscrowResolution(strings.ToUpper(i.Resolution))
+	if resolution != entity.EscrowReleaseToPayee && resolution != entity.EscrowRefundToPayer {
+		errs = append(errs, response.NewFieldError("resolution", entity.ErrInvalidEscrowResolution))
+	}
+
+	return usecase.ResolveEscrowTransferInput{
+		ID:         ID,
+		Resolution: resolution,
+		ResolvedAt: time.Now(),
+	}, errs
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type spyResolveEscrowTransferUseCase struct {
+	result usecase.EscrowTransferOutput
+	err    error
+	input  usecase.ResolveEscrowTransferInput
+}
+
+func (s *spyResolveEscrowTransferUseCase) Execute(_ context.Context, i usecase.ResolveEscrowTransferInput) (usecase.EscrowTransferOutput, error) {
+	s.input = i
+	return s.result, s.err
+}
+
+func TestResolveEscrowTransferHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name               string
+		uc                 *spyResolveEscrowTransferUseCase
+		ID                 string
+		rawPayload         string
+		expectedResolution entity.EscrowResolution
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success resolve refunding the payer",
+			uc: &spyResolveEscrowTransferUseCase{
+				result: usecase.EscrowTransferOutput{
+					ID:            "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+					Value:         100,
+					Status:        "REFUNDED",
+					DisputeReason: "item not delivered",
+				},
+			},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+			rawPayload:         `{"resolution": "refund"}`,
+			expectedResolution: entity.EscrowRefundToPayer,
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0793","payer":"","payee":"","value":100,"status":"REFUNDED","dispute_reason":"item not delivered","release_at":"","created_at":"","updated_at":""}`,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Error resolve invalid input",
+			uc:                 &spyResolveEscrowTransferUseCase{},
+			ID:                 "0db298eb",
+			rawPayload:         `{"resolution": "split"}`,
+			expectedBody:       `{"errors":["invalid uuid","escrow resolution must be RELEASE or REFUND"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error resolve escrow transfer not found",
+			uc:                 &spyResolveEscrowTransferUseCase{err: entity.ErrNotFoundEscrowTransfer},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+			rawPayload:         `{"resolution": "RELEASE"}`,
+			expectedResolution: entity.EscrowReleaseToPayee,
+			expectedBody:       `{"errors":["not found escrow transfer"]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Error resolve escrow transfer not disputed",
+			uc:                 &spyResolveEscrowTransferUseCase{err: entity.ErrEscrowTransferNotDisputed},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+			rawPayload:         `{"resolution": "RELEASE"}`,
+			expectedResolution: entity.EscrowReleaseToPayee,
+			expectedBody:       `{"errors":["escrow transfer is not disputed"]}`,
+			expectedStatusCode: http.StatusConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uri := fmt.Sprintf("/escrow-transfers/%s/resolve", tt.ID)
+			req, _ := http.NewRequest(http.MethodPost, uri, bytes.NewReader([]byte(tt.rawPayload)))
+
+			req = mux.SetURLVars(req, map[string]string{"escrow_transfer_id": tt.ID})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewResolveEscrowTransferHandler(tt.uc, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+
+			if tt.uc.input.Resolution != tt.expectedResolution {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, tt.uc.input.Resolution, tt.expectedResolution)
+			}
+		})
+	}
+}
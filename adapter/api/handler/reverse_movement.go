@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+// ReverseMovementHandler defines the dependencies of the HTTP handler for the use case
+type ReverseMovementHandler struct {
+	uc     usecase.ReverseMovementUseCase
+	log    logger.Logger
+	logKey string
+}
+
+// NewReverseMovementHandler creates new ReverseMovementHandler with its dependencies
+func NewReverseMovementHandler(uc usecase.ReverseMovementUseCase, log logger.Logger) ReverseMovementHandler {
+	return ReverseMovementHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "reverse_movement",
+	}
+}
+
+// Handle handles http request
+func (rh ReverseMovementHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	rh.log = logger.FromContext(r.Context(), rh.log)
+
+	ID, err := vo.NewUuid(mux.Vars(r)["movement_id"])
+	if err != nil {
+		err := response.NewFieldError("movement_id", errors.New("invalid uuid"))
+		rh.log.WithFields(logger.Fields{
+			"key":         rh.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("invalid uuid")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := rh.uc.Execute(r.Context(), usecase.ReverseMovementInput{
+		ID:         ID,
+		ReversedAt: time.Now(),
+	})
+	if err != nil {
+		status := response.StatusCode(err)
+		rh.log.WithFields(logger.Fields{
+			"key":         rh.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when reversing movement")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	rh.log.WithFields(logger.Fields{
+		"key":         rh.logKey,
+		"http_status": http.StatusOK,
+	}).Infof("success reversing movement")
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type stubReverseMovementUseCase struct {
+	result usecase.MovementOutput
+	err    error
+}
+
+func (s stubReverseMovementUseCase) Execute(_ context.Context, _ usecase.ReverseMovementInput) (usecase.MovementOutput, error) {
+	return s.result, s.err
+}
+
+func TestReverseMovementHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name               string
+		uc                 usecase.ReverseMovementUseCase
+		ID                 string
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success reverse movement",
+			uc: stubReverseMovementUseCase{
+				result: usecase.MovementOutput{
+					ID:                "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+					UserID:            "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+					Type:              "DEPOSIT",
+					Currency:          "BRL",
+					Value:             100,
+					ExternalReference: "bank-slip-0001",
+					Status:            "REVERSED",
+					CreatedAt:         "0001-01-01T00:00:00Z",
+					ReversedAt:        "0001-01-01T00:00:00Z",
+				},
+			},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0792","user_id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","type":"DEPOSIT","currency":"BRL","value":100,"external_reference":"bank-slip-0001","status":"REVERSED","created_at":"0001-01-01T00:00:00Z","reversed_at":"0001-01-01T00:00:00Z"}`,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Error reverse movement invalid uuid",
+			uc:                 stubReverseMovementUseCase{},
+			ID:                 "0db298eb",
+			expectedBody:       `{"errors":["invalid uuid"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error reverse movement not found",
+			uc:                 stubReverseMovementUseCase{err: entity.ErrNotFoundMovement},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+			expectedBody:       `{"errors":["not found movement"]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Error reverse movement already reversed",
+			uc:                 stubReverseMovementUseCase{err: entity.ErrMovementAlreadyReversed},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+			expectedBody:       `{"errors":["movement already reversed"]}`,
+			expectedStatusCode: http.StatusConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uri := fmt.Sprintf("/movements/%s/reverse", tt.ID)
+			req, _ := http.NewRequest(http.MethodPost, uri, nil)
+
+			req = mux.SetURLVars(req, map[string]string{"movement_id": tt.ID})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewReverseMovementHandler(tt.uc, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
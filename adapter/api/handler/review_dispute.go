@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+// ReviewDisputeHandler defines the dependencies of the HTTP handler for the use case
+type ReviewDisputeHandler struct {
+	uc     usecase.ReviewDisputeUseCase
+	log    logger.Logger
+	logKey string
+}
+
+// NewReviewDisputeHandler creates new ReviewDisputeHandler with its dependencies
+func NewReviewDisputeHandler(uc usecase.ReviewDisputeUseCase, log logger.Logger) ReviewDisputeHandler {
+	return ReviewDisputeHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "review_dispute",
+	}
+}
+
+// Handle handles http request
+func (rd ReviewDisputeHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	rd.log = logger.FromContext(r.Context(), rd.log)
+
+	ID, err := vo.NewUuid(mux.Vars(r)["dispute_id"])
+	if err != nil {
+		err := response.NewFieldError("dispute_id", errors.New("invalid uuid"))
+		rd.log.WithFields(logger.Fields{
+			"key":         rd.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("invalid uuid")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := rd.uc.Execute(r.Context(), usecase.ReviewDisputeInput{
+		ID:         ID,
+		ReviewedAt: time.Now(),
+	})
+	if err != nil {
+		status := response.StatusCode(err)
+		rd.log.WithFields(logger.Fields{
+			"key":         rd.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when reviewing dispute")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	rd.log.WithFields(logger.Fields{
+		"key":         rd.logKey,
+		"http_status": http.StatusOK,
+	}).Infof("success reviewing dispute")
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+// searchTransfersMetadataPrefix prefixes the query parameters matching the metadata of the transfers
+const searchTransfersMetadataPrefix = "metadata."
+
+var errInvalidSearchLimit = errors.New("invalid limit, expected a number from 1 to 100")
+
+// SearchTransfersHandler defines the dependencies of the HTTP handler for the use case
+type SearchTransfersHandler struct {
+	uc     usecase.SearchTransfersUseCase
+	log    logger.Logger
+	logKey string
+}
+
+// NewSearchTransfersHandler creates new SearchTransfersHandler with its dependencies
+func NewSearchTransfersHandler(uc usecase.SearchTransfersUseCase, log logger.Logger) SearchTransfersHandler {
+	return SearchTransfersHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "search_transfers",
+	}
+}
+
+// Handle handles http request
+func (s SearchTransfersHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	s.log = logger.FromContext(r.Context(), s.log)
+
+	input, errs := s.validate(r)
+	if len(errs) > 0 {
+		s.log.WithFields(logger.Fields{
+			"key":         s.logKey,
+			"error":       "invalid input",
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to data")
+
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := s.uc.Execute(r.Context(), input)
+	if err != nil {
+		status := response.StatusCode(err)
+
+		s.log.WithFields(logger.Fields{
+			"key":         s.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error searching transfers")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	s.log.WithFields(logger.Fields{
+		"key":         s.logKey,
+		"http_status": http.StatusOK,
+	}).Infof("success when returning transfers")
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
+
+// validate reads the search from the query, the parameters prefixed by "metadata." match the metadata key
+// after the prefix, e.g. metadata.order_id=1234
+func (s SearchTransfersHandler) validate(r *http.Request) (usecase.SearchTransfersInput, []error) {
+	var (
+		errs  []error
+		query = r.URL.Query()
+	)
+
+	userID, err := vo.NewUuid(query.Get("user_id"))
+	if err != nil {
+		errs = append(errs, response.NewFieldError("user_id", err))
+	}
+
+	var limit = entity.DefaultTransferSearchLimit
+	if value := query.Get("limit"); value != "" {
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > entity.MaxTransferSearchLimit {
+			errs = append(errs, response.NewFieldError("limit", errInvalidSearchLimit))
+		}
+	}
+
+	var metadata = make(map[string]string)
+	for key, values := range query {
+		if strings.HasPrefix(key, searchTransfersMetadataPrefix) {
+			metadata[strings.TrimPrefix(key, searchTransfersMetadataPrefix)] = values[0]
+		}
+	}
+
+	return usecase.SearchTransfersInput{
+		UserID:            userID,
+		ExternalReference: query.Get("external_reference"),
+		Text:              query.Get("q"),
+		Metadata:          metadata,
+		Limit:             limit,
+	}, errs
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type spySearchTransfersUseCase struct {
+	result usecase.SearchTransfersOutput
+	err    error
+	input  usecase.SearchTransfersInput
+}
+
+func (s *spySearchTransfersUseCase) Execute(_ context.Context, i usecase.SearchTransfersInput) (usecase.SearchTransfersOutput, error) {
+	s.input = i
+	return s.result, s.err
+}
+
+func TestSearchTransfersHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name               string
+		uc                 *spySearchTransfersUseCase
+		query              string
+		expectedMetadata   map[string]string
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success search transfers by metadata",
+			uc: &spySearchTransfersUseCase{
+				result: usecase.SearchTransfersOutput{Transfers: []usecase.CreateTransferOutput{
+					{
+						ID:                "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+						Value:             100,
+						Description:       "Order #1234",
+						ExternalReference: "order-1234",
+						Metadata:          map[string]string{"order_id": "1234"},
+					},
+				}},
+			},
+			query:              "user_id=0db298eb-c8e7-4829-84b7-c1036b4f0791&metadata.order_id=1234",
+			expectedMetadata:   map[string]string{"order_id": "1234"},
+			expectedBody:       `{"transfers":[{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0793","payer":"","payee":"","value":100,"description":"Order #1234","external_reference":"order-1234","metadata":{"order_id":"1234"},"created_at":""}]}`,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Error search transfers invalid input",
+			uc:                 &spySearchTransfersUseCase{},
+			query:              "user_id=0db298eb&limit=1000",
+			expectedMetadata:   map[string]string{},
+			expectedBody:       `{"errors":["invalid uuid","invalid limit, expected a number from 1 to 100"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error search transfers invalid metadata",
+			uc:                 &spySearchTransfersUseCase{err: entity.ErrInvalidTransferMetadata},
+			query:              "user_id=0db298eb-c8e7-4829-84b7-c1036b4f0791&metadata.order.id=1234",
+			expectedMetadata:   map[string]string{"order.id": "1234"},
+			expectedBody:       `{"errors":["transfer metadata must have up to 20 keys of letters, digits, '_' or '-' up to 40 characters and values up to 500 characters"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error search transfers of an unknown user",
+			uc:                 &spySearchTransfersUseCase{err: entity.ErrNotFoundUser},
+			query:              "user_id=0db298eb-c8e7-4829-84b7-c1036b4f0791",
+			expectedMetadata:   map[string]string{},
+			expectedBody:       `{"errors":["not found user"]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/transfers?"+tt.query, nil)
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewSearchTransfersHandler(tt.uc, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+
+			if tt.expectedStatusCode != http.StatusBadRequest || tt.uc.err != nil {
+				if !reflect.DeepEqual(tt.uc.input.Metadata, tt.expectedMetadata) {
+					t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, tt.uc.input.Metadata, tt.expectedMetadata)
+				}
+			}
+		})
+	}
+}
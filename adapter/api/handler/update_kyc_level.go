@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type (
+	// Request data
+	UpdateKYCLevelRequest struct {
+		Level string `json:"level" openapi:"required,enum=BASIC|VERIFIED|FULL"`
+	}
+
+	// UpdateKYCLevelHandler defines the dependencies of the HTTP handler for the use case
+	UpdateKYCLevelHandler struct {
+		uc     usecase.UpdateKYCLevelUseCase
+		log    logger.Logger
+		logKey string
+	}
+)
+
+// NewUpdateKYCLevelHandler creates new UpdateKYCLevelHandler with its dependencies
+func NewUpdateKYCLevelHandler(uc usecase.UpdateKYCLevelUseCase, log logger.Logger) UpdateKYCLevelHandler {
+	return UpdateKYCLevelHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "update_kyc_level",
+	}
+}
+
+// Handle handles http request
+func (u UpdateKYCLevelHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	u.log = logger.FromContext(r.Context(), u.log)
+
+	var reqData UpdateKYCLevelRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+		u.log.WithFields(logger.Fields{
+			"key":         u.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to marshal message")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+	defer r.Body.Close()
+
+	input, errs := u.validate(mux.Vars(r)["user_id"], reqData)
+	if len(errs) > 0 {
+		u.log.WithFields(logger.Fields{
+			"key":         u.logKey,
+			"error":       "invalid input",
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to data")
+
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := u.uc.Execute(r.Context(), input)
+	if err != nil {
+		status := response.StatusCode(err)
+
+		u.log.WithFields(logger.Fields{
+			"key":         u.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when updating KYC level")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	u.log.WithFields(logger.Fields{
+		"key":         u.logKey,
+		"http_status": http.StatusOK,
+	}).Infof("success updating KYC level")
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
+
+func (u UpdateKYCLevelHandler) validate(userID string, i UpdateKYCLevelRequest) (usecase.UpdateKYCLevelInput, []error) {
+	var errs []error
+	ID, err := vo.NewUuid(userID)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("user_id", errors.New("invalid uuid")))
+	}
+
+	level, err := entity.NewKYCLevel(i.Level)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("level", err))
+	}
+
+	return usecase.UpdateKYCLevelInput{
+		UserID: ID,
+		Level:  level,
+	}, errs
+}
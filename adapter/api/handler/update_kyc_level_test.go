@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type spyUpdateKYCLevelUseCase struct {
+	result usecase.KYCLevelOutput
+	err    error
+	input  usecase.UpdateKYCLevelInput
+}
+
+func (s *spyUpdateKYCLevelUseCase) Execute(_ context.Context, i usecase.UpdateKYCLevelInput) (usecase.KYCLevelOutput, error) {
+	s.input = i
+	return s.result, s.err
+}
+
+func TestUpdateKYCLevelHandler_Handle(t *testing.T) {
+	tests := []struct {
+		name               string
+		uc                 *spyUpdateKYCLevelUseCase
+		ID                 string
+		rawPayload         string
+		expectedLevel      entity.KYCLevel
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success upgrade KYC level",
+			uc: &spyUpdateKYCLevelUseCase{
+				result: usecase.KYCLevelOutput{
+					UserID:        "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+					PreviousLevel: "BASIC",
+					Level:         "VERIFIED",
+					Limits: usecase.KYCLimitsOutput{
+						SingleTransfer: 1000000,
+						DailyOutgoing:  2000000,
+						WalletBalance:  5000000,
+					},
+				},
+			},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+			rawPayload:         `{"level": "verified"}`,
+			expectedLevel:      entity.KYCVerified,
+			expectedBody:       `{"user_id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","previous_level":"BASIC","level":"VERIFIED","limits":{"single_transfer":1000000,"daily_outgoing":2000000,"wallet_balance":5000000}}`,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Error update KYC level invalid input",
+			uc:                 &spyUpdateKYCLevelUseCase{},
+			ID:                 "0db298eb",
+			rawPayload:         `{"level": "PREMIUM"}`,
+			expectedBody:       `{"errors":["invalid uuid","invalid KYC level, expected BASIC, VERIFIED or FULL"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error update KYC level of an unknown user",
+			uc:                 &spyUpdateKYCLevelUseCase{err: entity.ErrNotFoundUser},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+			rawPayload:         `{"level": "BASIC"}`,
+			expectedLevel:      entity.KYCBasic,
+			expectedBody:       `{"errors":["not found user"]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uri := fmt.Sprintf("/admin/users/%s/kyc-level", tt.ID)
+			req, _ := http.NewRequest(http.MethodPut, uri, bytes.NewReader([]byte(tt.rawPayload)))
+
+			req = mux.SetURLVars(req, map[string]string{"user_id": tt.ID})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewUpdateKYCLevelHandler(tt.uc, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+
+			if tt.uc.input.Level != tt.expectedLevel {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, tt.uc.input.Level, tt.expectedLevel)
+			}
+		})
+	}
+}
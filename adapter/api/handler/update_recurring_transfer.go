@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type (
+	// Request data
+	UpdateRecurringTransferRequest struct {
+		Value  int64      `json:"value"`
+		EndAt  *time.Time `json:"end_at"`
+		Count  int        `json:"count"`
+		Status string     `json:"status" openapi:"enum=ACTIVE|PAUSED"`
+	}
+
+	// UpdateRecurringTransferHandler defines the dependencies of the HTTP handler for the use case
+	UpdateRecurringTransferHandler struct {
+		uc     usecase.UpdateRecurringTransferUseCase
+		log    logger.Logger
+		logKey string
+	}
+)
+
+// NewUpdateRecurringTransferHandler creates new UpdateRecurringTransferHandler with its dependencies
+func NewUpdateRecurringTransferHandler(uc usecase.UpdateRecurringTransferUseCase, log logger.Logger) UpdateRecurringTransferHandler {
+	return UpdateRecurringTransferHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "update_recurring_transfer",
+	}
+}
+
+// Handle handles http request
+func (u UpdateRecurringTransferHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	u.log = logger.FromContext(r.Context(), u.log)
+
+	var reqData UpdateRecurringTransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+		u.log.WithFields(logger.Fields{
+			"key":         u.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to marshal message")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+	defer r.Body.Close()
+
+	input, errs := u.validate(mux.Vars(r)["recurring_transfer_id"], reqData)
+	if len(errs) > 0 {
+		u.log.WithFields(logger.Fields{
+			"key":         u.logKey,
+			"error":       "invalid input",
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to data")
+
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := u.uc.Execute(r.Context(), input)
+	if err != nil {
+		status := response.StatusCode(err)
+		u.log.WithFields(logger.Fields{
+			"key":         u.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when updating recurring transfer")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	u.log.WithFields(logger.Fields{
+		"key":         u.logKey,
+		"http_status": http.StatusOK,
+	}).Infof("success updating recurring transfer")
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
+
+func (u UpdateRecurringTransferHandler) validate(ID string, i UpdateRecurringTransferRequest) (usecase.UpdateRecurringTransferInput, []error) {
+	var errs []error
+	id, err := vo.NewUuid(ID)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("recurring_transfer_id", errors.New("invalid uuid")))
+	}
+	amount, err := vo.NewAmount(i.Value)
+	if err != nil {
+		errs = append(errs, response.NewFieldError("value", err))
+	}
+
+	var endAt time.Time
+	if i.EndAt != nil {
+		endAt = *i.EndAt
+	}
+
+	return usecase.UpdateRecurringTransferInput{
+		ID:        id,
+		Value:     vo.NewMoneyBRL(amount),
+		EndAt:     endAt,
+		Count:     i.Count,
+		Status:    entity.RecurrenceStatus(i.Status),
+		UpdatedAt: time.Now(),
+	}, errs
+}
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type stubUpdateRecurringTransferUseCase struct {
+	result usecase.RecurringTransferOutput
+	err    error
+}
+
+func (s stubUpdateRecurringTransferUseCase) Execute(_ context.Context, _ usecase.UpdateRecurringTransferInput) (usecase.RecurringTransferOutput, error) {
+	return s.result, s.err
+}
+
+func TestUpdateRecurringTransferHandler_Handle(t *testing.T) {
+	const payload = `{"value": 250, "status": "PAUSED"}`
+
+	tests := []struct {
+		name               string
+		uc                 usecase.UpdateRecurringTransferUseCase
+		ID                 string
+		rawPayload         string
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success pause recurring transfer",
+			uc: stubUpdateRecurringTransferUseCase{
+				result: usecase.RecurringTransferOutput{
+					ID:          "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+					PayerID:     "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+					PayeeID:     "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+					Value:       250,
+					Frequency:   "DAILY",
+					StartAt:     "2020-11-09T09:00:00Z",
+					Occurrences: 2,
+					MaxFailures: 3,
+					Status:      "PAUSED",
+					CreatedAt:   "2020-11-09T00:00:00Z",
+					UpdatedAt:   "2020-11-11T00:00:00Z",
+				},
+			},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+			rawPayload:         payload,
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0793","payer":"0db298eb-c8e7-4829-84b7-c1036b4f0791","payee":"0db298eb-c8e7-4829-84b7-c1036b4f0792","value":250,"frequency":"DAILY","start_at":"2020-11-09T09:00:00Z","occurrences":2,"consecutive_failures":0,"max_failures":3,"status":"PAUSED","created_at":"2020-11-09T00:00:00Z","updated_at":"2020-11-11T00:00:00Z"}`,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Error update recurring transfer invalid input",
+			uc:                 stubUpdateRecurringTransferUseCase{},
+			ID:                 "0db298eb",
+			rawPayload:         `{"value": -250, "status": "PAUSED"}`,
+			expectedBody:       `{"errors":["invalid uuid","invalid amount"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Error update recurring transfer not found",
+			uc:                 stubUpdateRecurringTransferUseCase{err: entity.ErrNotFoundRecurringTransfer},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["not found recurring transfer"]}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Error update recurring transfer being executed",
+			uc:                 stubUpdateRecurringTransferUseCase{err: entity.ErrRecurringTransferBusy},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+			rawPayload:         payload,
+			expectedBody:       `{"errors":["recurring transfer is being executed"]}`,
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name:               "Error update recurring transfer invalid status",
+			uc:                 stubUpdateRecurringTransferUseCase{err: entity.ErrInvalidRecurrenceStatus},
+			ID:                 "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+			rawPayload:         `{"value": 250, "status": "FINISHED"}`,
+			expectedBody:       `{"errors":["status must be ACTIVE or PAUSED"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uri := fmt.Sprintf("/recurring-transfers/%s", tt.ID)
+			req, _ := http.NewRequest(http.MethodPut, uri, bytes.NewReader([]byte(tt.rawPayload)))
+
+			req = mux.SetURLVars(req, map[string]string{"recurring_transfer_id": tt.ID})
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewUpdateRecurringTransferHandler(tt.uc, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+// VoidHoldHandler defines the dependencies of the HTTP handler for the use case
+type VoidHoldHandler struct {
+	uc     usecase.VoidHoldUseCase
+	log    logger.Logger
+	logKey string
+}
+
+// NewVoidHoldHandler creates new VoidHoldHandler with its dependencies
+func NewVoidHoldHandler(uc usecase.VoidHoldUseCase, log logger.Logger) VoidHoldHandler {
+	return VoidHoldHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "void_hold",
+	}
+}
+
+// Handle handles http request
+func (v VoidHoldHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	v.log = logger.FromContext(r.Context(), v.log)
+
+	ID, err := vo.NewUuid(mux.Vars(r)["hold_id"])
+	if err != nil {
+		err := response.NewFieldError("hold_id", errors.New("invalid uuid"))
+		v.log.WithFields(logger.Fields{
+			"key":         v.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("invalid uuid")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := v.uc.Execute(r.Context(), usecase.VoidHoldInput{
+		ID:       ID,
+		VoidedAt: time.Now(),
+	})
+	if err != nil {
+		status := response.StatusCode(err)
+		v.log.WithFields(logger.Fields{
+			"key":         v.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when voiding hold")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	v.log.WithFields(logger.Fields{
+		"key":         v.logKey,
+		"http_status": http.StatusOK,
+	}).Infof("success voiding hold")
+
+	response.NewSuccess(output, http.StatusOK).Send(w)
+}
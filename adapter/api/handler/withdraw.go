@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+// WithdrawHandler defines the dependencies of the HTTP handler for the use case
+type WithdrawHandler struct {
+	uc     usecase.WithdrawUseCase
+	log    logger.Logger
+	logKey string
+}
+
+// NewWithdrawHandler creates new WithdrawHandler with its dependencies
+func NewWithdrawHandler(uc usecase.WithdrawUseCase, log logger.Logger) WithdrawHandler {
+	return WithdrawHandler{
+		uc:     uc,
+		log:    log,
+		logKey: "withdraw",
+	}
+}
+
+// Handle handles http request
+func (wh WithdrawHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	wh.log = logger.FromContext(r.Context(), wh.log)
+
+	var reqData CreateMovementRequest
+	if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+		wh.log.WithFields(logger.Fields{
+			"key":         wh.logKey,
+			"error":       err.Error(),
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to marshal message")
+
+		response.NewError(err, http.StatusBadRequest).Send(w, r)
+		return
+	}
+	defer r.Body.Close()
+
+	ID, userID, value, errs := validateMovement(reqData)
+	if len(errs) > 0 {
+		wh.log.WithFields(logger.Fields{
+			"key":         wh.logKey,
+			"error":       "invalid input",
+			"http_status": http.StatusBadRequest,
+		}).Errorf("failed to data")
+
+		response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+		return
+	}
+
+	output, err := wh.uc.Execute(r.Context(), usecase.WithdrawInput{
+		ID:                ID,
+		UserID:            userID,
+		Value:             value,
+		ExternalReference: reqData.ExternalReference,
+		CreatedAt:         time.Now(),
+	})
+	if err != nil {
+		status := response.StatusCode(err)
+		wh.log.WithFields(logger.Fields{
+			"key":         wh.logKey,
+			"error":       err.Error(),
+			"http_status": status,
+		}).Errorf("error when creating a new withdrawal")
+
+		response.NewError(err, status).Send(w, r)
+		return
+	}
+
+	wh.log.WithFields(logger.Fields{
+		"key":         wh.logKey,
+		"http_status": http.StatusCreated,
+	}).Infof("success creating withdrawal")
+
+	response.NewSuccess(output, http.StatusCreated).Send(w)
+}
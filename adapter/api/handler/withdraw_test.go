@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type stubWithdrawUseCase struct {
+	result usecase.MovementOutput
+	err    error
+}
+
+func (s stubWithdrawUseCase) Execute(_ context.Context, _ usecase.WithdrawInput) (usecase.MovementOutput, error) {
+	return s.result, s.err
+}
+
+func TestWithdrawHandler_Handle(t *testing.T) {
+	const payload = `{"user_id": "0db298eb-c8e7-4829-84b7-c1036b4f0791", "value": 100, "external_reference": "bank-transfer-0001"}`
+
+	tests := []struct {
+		name               string
+		uc                 usecase.WithdrawUseCase
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name: "Success withdraw",
+			uc: stubWithdrawUseCase{
+				result: usecase.MovementOutput{
+					ID:                "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+					UserID:            "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+					Type:              "WITHDRAWAL",
+					Currency:          "BRL",
+					Value:             100,
+					ExternalReference: "bank-transfer-0001",
+					Status:            "COMPLETED",
+					CreatedAt:         "0001-01-01T00:00:00Z",
+				},
+			},
+			expectedBody:       `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0792","user_id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","type":"WITHDRAWAL","currency":"BRL","value":100,"external_reference":"bank-transfer-0001","status":"COMPLETED","created_at":"0001-01-01T00:00:00Z"}`,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Error withdraw insufficient balance",
+			uc:                 stubWithdrawUseCase{err: entity.ErrUserInsufficientBalance},
+			expectedBody:       `{"errors":["user does not have sufficient balance"]}`,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "/withdrawals", bytes.NewReader([]byte(payload)))
+
+			var (
+				w       = httptest.NewRecorder()
+				handler = NewWithdrawHandler(tt.uc, infralogger.Dummy{})
+			)
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					w.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(w.Body.String())
+			if !strings.EqualFold(result, tt.expectedBody) {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
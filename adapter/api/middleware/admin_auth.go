@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+)
+
+var errInvalidAdminToken = errors.New("missing or invalid admin token")
+
+// AdminAuth restricts a route to the administrators, who send the admin token as a bearer token
+type AdminAuth struct {
+	token  string
+	log    logger.Logger
+	logKey string
+}
+
+// NewAdminAuth creates new AdminAuth with its dependencies
+func NewAdminAuth(token string, log logger.Logger) *AdminAuth {
+	return &AdminAuth{
+		token:  token,
+		log:    log,
+		logKey: "admin_auth",
+	}
+}
+
+// Execute refuses with 401 Unauthorized the requests without the admin token, every request is refused while
+// no admin token is configured
+func (a AdminAuth) Execute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !a.authorized(r) {
+			logger.FromContext(r.Context(), a.log).WithFields(logger.Fields{
+				"key":         a.logKey,
+				"path":        routeTemplate(r),
+				"http_status": http.StatusUnauthorized,
+			}).Errorf("admin token refused")
+
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			response.NewError(errInvalidAdminToken, http.StatusUnauthorized).Send(w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (a AdminAuth) authorized(r *http.Request) bool {
+	const prefix = "Bearer "
+
+	var header = r.Header.Get("Authorization")
+	if a.token == "" || !strings.HasPrefix(header, prefix) {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(header, prefix)), []byte(a.token)) == 1
+}
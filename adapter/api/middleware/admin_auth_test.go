@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+)
+
+func TestAdminAuth_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		token          string
+		authorization  string
+		expectedStatus int
+		expectedBody   string
+		expectedLogged bool
+	}{
+		{
+			name:           "Admin token accepted",
+			token:          "s3cr3t",
+			authorization:  "Bearer s3cr3t",
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "Admin token wrong",
+			token:          "s3cr3t",
+			authorization:  "Bearer guess",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `"code":"unauthorized"`,
+			expectedLogged: true,
+		},
+		{
+			name:           "Admin token missing",
+			token:          "s3cr3t",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `"code":"unauthorized"`,
+			expectedLogged: true,
+		},
+		{
+			name:           "Admin token not a bearer token",
+			token:          "s3cr3t",
+			authorization:  "s3cr3t",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `"code":"unauthorized"`,
+			expectedLogged: true,
+		},
+		{
+			name:           "Admin token not configured",
+			authorization:  "Bearer ",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `"code":"unauthorized"`,
+			expectedLogged: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var spy = newSpyLogger()
+
+			req, err := http.NewRequest(http.MethodPut, "/admin", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Accept", response.ProblemContentType)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+
+			rr := httptest.NewRecorder()
+			NewAdminAuth(tt.token, spy).Execute(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			})).ServeHTTP(rr, req)
+
+			if rr.Code != tt.expectedStatus {
+				t.Errorf("[TestCase '%s'] Got status: '%v' | Want status: '%v'", tt.name, rr.Code, tt.expectedStatus)
+			}
+
+			if !strings.Contains(rr.Body.String(), tt.expectedBody) {
+				t.Errorf("[TestCase '%s'] Got body: '%v' | Want body: '%v'", tt.name, rr.Body.String(), tt.expectedBody)
+			}
+
+			if logged := len(*spy.entries) > 0; logged != tt.expectedLogged {
+				t.Errorf("[TestCase '%s'] Got logged: '%v' | Want logged: '%v'", tt.name, logged, tt.expectedLogged)
+			}
+		})
+	}
+}
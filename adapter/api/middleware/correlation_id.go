@@ -1,9 +1,9 @@
 package middleware
 
 import (
-	"context"
 	"net/http"
 
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
 	"github.com/google/uuid"
 )
 
@@ -21,7 +21,7 @@ func (c CorrelationID) Execute(next http.Handler) http.Handler {
 			id = uuid.New().String()
 		}
 
-		ctx = context.WithValue(ctx, "correlation_id", id)
+		ctx = logger.WithCorrelationID(ctx, id)
 		r = r.WithContext(ctx)
 
 		w.Header().Set("X-Correlation-Id", id)
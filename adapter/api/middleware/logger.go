@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+)
+
+type (
+	// RequestLogger defines the logger of the requests to the routes matched
+	RequestLogger struct {
+		log logger.Logger
+	}
+
+	// AccessLog logs the requests to the routes matched
+	AccessLog struct {
+		log    logger.Logger
+		logKey string
+	}
+)
+
+// NewRequestLogger creates new RequestLogger with its dependencies
+func NewRequestLogger(log logger.Logger) *RequestLogger {
+	return &RequestLogger{log: log}
+}
+
+// Execute keeps the logger of the request, with its correlation id and trace id, in the context for the handlers
+// and the use cases, retrieved with logger.FromContext and usecase.LoggerFromContext. It runs after CorrelationID
+// and Tracing
+func (l RequestLogger) Execute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log := l.log.WithFields(logger.Fields{
+			"correlation_id": logger.CorrelationID(r.Context()),
+			"trace_id":       logger.TraceID(r.Context()),
+		})
+
+		next.ServeHTTP(w, r.WithContext(logger.NewContext(r.Context(), log)))
+	})
+}
+
+// NewAccessLog creates new AccessLog with its dependencies
+func NewAccessLog(log logger.Logger) *AccessLog {
+	return &AccessLog{
+		log:    log,
+		logKey: "access_log",
+	}
+}
+
+// Execute logs the request once handled with its method, route template, status code, bytes written and duration,
+// the server errors are logged as errors. It runs after RequestLogger so that the correlation id is logged
+func (a AccessLog) Execute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var (
+			start    = time.Now()
+			recorder = &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		)
+
+		next.ServeHTTP(recorder, r)
+
+		log := logger.FromContext(r.Context(), a.log).WithFields(logger.Fields{
+			"key":         a.logKey,
+			"method":      r.Method,
+			"path":        routeTemplate(r),
+			"http_status": recorder.status,
+			"bytes":       recorder.bytes,
+			"duration":    time.Since(start).String(),
+		})
+
+		if recorder.status >= http.StatusInternalServerError {
+			log.Errorf("request handled")
+			return
+		}
+
+		log.Infof("request handled")
+	})
+}
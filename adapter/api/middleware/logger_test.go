@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/gorilla/mux"
+)
+
+type logEntry struct {
+	level   string
+	message string
+	fields  logger.Fields
+}
+
+// spyLogger keeps the entries logged with their fields
+type spyLogger struct {
+	entries *[]logEntry
+	fields  logger.Fields
+}
+
+func newSpyLogger() spyLogger {
+	return spyLogger{entries: &[]logEntry{}, fields: logger.Fields{}}
+}
+
+func (s spyLogger) log(level string, format string, args ...interface{}) {
+	*s.entries = append(*s.entries, logEntry{level: level, message: fmt.Sprintf(format, args...), fields: s.fields})
+}
+
+func (s spyLogger) Infof(format string, args ...interface{})  { s.log("info", format, args...) }
+func (s spyLogger) Warnf(format string, args ...interface{})  { s.log("warn", format, args...) }
+func (s spyLogger) Errorf(format string, args ...interface{}) { s.log("error", format, args...) }
+
+func (s spyLogger) WithFields(fields logger.Fields) logger.Logger {
+	var merged = logger.Fields{}
+	for k, v := range s.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+
+	return spyLogger{entries: s.entries, fields: merged}
+}
+
+func (s spyLogger) WithError(err error) logger.Logger {
+	return s.WithFields(logger.Fields{"error": err.Error()})
+}
+
+func TestAccessLog_Execute(t *testing.T) {
+	tests := []struct {
+		name          string
+		handler       http.HandlerFunc
+		expectedLevel string
+		expected      logger.Fields
+	}{
+		{
+			name:          "Log request handled",
+			handler:       func(w http.ResponseWriter, _ *http.Request) { w.Write([]byte(`{}`)) },
+			expectedLevel: "info",
+			expected: logger.Fields{
+				"correlation_id": "f9882930-1914-47d7-8b58-18bff092e081",
+				"method":         http.MethodGet,
+				"path":           "/users/{user_id}",
+				"http_status":    http.StatusOK,
+				"bytes":          2,
+			},
+		},
+		{
+			name:          "Log server error as error",
+			handler:       func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusServiceUnavailable) },
+			expectedLevel: "error",
+			expected: logger.Fields{
+				"http_status": http.StatusServiceUnavailable,
+				"bytes":       0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				spy    = newSpyLogger()
+				router = mux.NewRouter()
+			)
+			router.Use(NewRequestLogger(spy).Execute)
+			router.Use(NewAccessLog(spy).Execute)
+			router.HandleFunc("/users/{user_id}", tt.handler).Methods(http.MethodGet)
+
+			req, err := http.NewRequest(http.MethodGet, "/users/0db298eb-c8e7-4829-84b7-c1036b4f0791", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req = req.WithContext(logger.WithCorrelationID(req.Context(), "f9882930-1914-47d7-8b58-18bff092e081"))
+
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			if len(*spy.entries) != 1 {
+				t.Fatalf("[TestCase '%s'] Got entries: '%v' | Want entries: '%v'", tt.name, len(*spy.entries), 1)
+			}
+
+			entry := (*spy.entries)[0]
+			if entry.level != tt.expectedLevel {
+				t.Errorf("[TestCase '%s'] Got level: '%v' | Want level: '%v'", tt.name, entry.level, tt.expectedLevel)
+			}
+
+			for key, want := range tt.expected {
+				if entry.fields[key] != want {
+					t.Errorf("[TestCase '%s'] Got %s: '%v' | Want %s: '%v'", tt.name, key, entry.fields[key], key, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRequestLogger_Execute(t *testing.T) {
+	var (
+		spy        = newSpyLogger()
+		got        logger.Logger
+		gotUseCase usecase.Logger
+	)
+
+	req, err := http.NewRequest(http.MethodGet, "/middleware", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req = req.WithContext(logger.WithTraceID(req.Context(), "4bf92f3577b34da6a3ce929d0e0e4736"))
+
+	handler := NewRequestLogger(spy).Execute(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = logger.FromContext(r.Context(), nil)
+		gotUseCase = usecase.LoggerFromContext(r.Context())
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if got == nil {
+		t.Fatalf("Got logger: '%v' | Want logger of the request", got)
+	}
+
+	got.Infof("success")
+	gotUseCase.Infof("success")
+	for _, entry := range *spy.entries {
+		if entry.fields["trace_id"] != "4bf92f3577b34da6a3ce929d0e0e4736" {
+			t.Errorf("Got trace_id: '%v' | Want trace_id: '%v'", entry.fields["trace_id"], "4bf92f3577b34da6a3ce929d0e0e4736")
+		}
+	}
+
+	if len(*spy.entries) != 2 {
+		t.Errorf("Got entries: '%v' | Want entries of the handler and of the use case: '%v'", len(*spy.entries), 2)
+	}
+}
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+type (
+	// HTTPMetrics port records the requests by route template, method and status code with their latency
+	HTTPMetrics interface {
+		RequestCompleted(route string, method string, status int, latency time.Duration)
+	}
+
+	// Metrics records the requests to the routes matched
+	Metrics struct {
+		metrics HTTPMetrics
+	}
+
+	// statusRecorder keeps the status code and the number of bytes of the body written by the handler, and
+	// whether the handler wrote anything at all
+	statusRecorder struct {
+		http.ResponseWriter
+		status  int
+		bytes   int
+		written bool
+	}
+)
+
+// NewMetrics creates new Metrics with its dependencies
+func NewMetrics(metrics HTTPMetrics) *Metrics {
+	return &Metrics{metrics: metrics}
+}
+
+// Execute records the request once handled, by the template of the route so the paths with ids share a series
+func (m Metrics) Execute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var (
+			start    = time.Now()
+			recorder = &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		)
+
+		next.ServeHTTP(recorder, r)
+
+		m.metrics.RequestCompleted(routeTemplate(r), r.Method, recorder.status, time.Since(start))
+	})
+}
+
+// unmatchedRoute labels the requests without a route matched, whose paths would create a series each
+const unmatchedRoute = "unmatched"
+
+// routeTemplate returns the template of the route matched, or unmatchedRoute when none matched
+func routeTemplate(r *http.Request) string {
+	if current := mux.CurrentRoute(r); current != nil {
+		if template, err := current.GetPathTemplate(); err == nil {
+			return template
+		}
+	}
+
+	return unmatchedRoute
+}
+
+// WriteHeader keeps the status code before writing it
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.written = true
+	s.ResponseWriter.WriteHeader(status)
+}
+
+// Write counts the bytes of the body written
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	n, err := s.ResponseWriter.Write(b)
+	s.bytes += n
+	s.written = true
+	return n, err
+}
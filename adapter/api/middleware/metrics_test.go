@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+type spyHTTPMetrics struct {
+	route  string
+	method string
+	status int
+	calls  int
+}
+
+func (s *spyHTTPMetrics) RequestCompleted(route string, method string, status int, _ time.Duration) {
+	s.route, s.method, s.status = route, method, status
+	s.calls++
+}
+
+func TestMetrics_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		uri            string
+		handler        http.HandlerFunc
+		expectedRoute  string
+		expectedStatus int
+	}{
+		{
+			name:           "Record request by route template",
+			uri:            "/users/0db298eb-c8e7-4829-84b7-c1036b4f0791",
+			handler:        func(w http.ResponseWriter, _ *http.Request) { w.Write([]byte(`{}`)) },
+			expectedRoute:  "/users/{user_id}",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Record status code written",
+			uri:            "/users/0db298eb-c8e7-4829-84b7",
+			handler:        func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusBadRequest) },
+			expectedRoute:  "/users/{user_id}",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Record request without a route matched",
+			uri:            "/users/0db298eb-c8e7-4829-84b7-c1036b4f0791/unknown",
+			handler:        func(w http.ResponseWriter, _ *http.Request) { w.Write([]byte(`{}`)) },
+			expectedRoute:  "unmatched",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				spy    = &spyHTTPMetrics{}
+				router = mux.NewRouter()
+			)
+			router.Use(NewMetrics(spy).Execute)
+			router.NotFoundHandler = NewMetrics(spy).Execute(http.NotFoundHandler())
+			router.HandleFunc("/users/{user_id}", tt.handler).Methods(http.MethodGet)
+
+			req, err := http.NewRequest(http.MethodGet, tt.uri, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+
+			if spy.calls != 1 {
+				t.Fatalf("[TestCase '%s'] Got requests recorded: '%v' | Want requests recorded: '%v'", tt.name, spy.calls, 1)
+			}
+
+			if spy.route != tt.expectedRoute || spy.method != http.MethodGet {
+				t.Errorf("[TestCase '%s'] Got route: '%v %v' | Want route: '%v %v'", tt.name, spy.method, spy.route, http.MethodGet, tt.expectedRoute)
+			}
+
+			if spy.status != tt.expectedStatus || rr.Code != tt.expectedStatus {
+				t.Errorf("[TestCase '%s'] Got status: '%v' | Want status: '%v'", tt.name, spy.status, tt.expectedStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+)
+
+// Recovery recovers the panics of the handlers of the routes matched
+type Recovery struct {
+	log    logger.Logger
+	logKey string
+}
+
+// NewRecovery creates new Recovery with its dependencies
+func NewRecovery(log logger.Logger) *Recovery {
+	return &Recovery{
+		log:    log,
+		logKey: "recovery",
+	}
+}
+
+// Execute answers 500 Internal Server Error when the handler panics, logging the panic with its stack. A handler
+// that already wrote part of its response keeps it, since the status can't be changed anymore. The
+// http.ErrAbortHandler panic aborting a response on purpose is left to the server
+func (rc Recovery) Execute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var recorder = &statusRecorder{ResponseWriter: w, status: http.StatusInternalServerError}
+
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			if recovered == http.ErrAbortHandler {
+				panic(recovered)
+			}
+
+			logger.FromContext(r.Context(), rc.log).WithFields(logger.Fields{
+				"key":         rc.logKey,
+				"path":        routeTemplate(r),
+				"panic":       fmt.Sprint(recovered),
+				"stack":       string(debug.Stack()),
+				"http_status": recorder.status,
+				"written":     recorder.written,
+			}).Errorf("panic recovered")
+
+			if !recorder.written {
+				response.NewError(entity.ErrInternal, http.StatusInternalServerError).Send(w, r)
+			}
+		}()
+
+		next.ServeHTTP(recorder, r)
+	})
+}
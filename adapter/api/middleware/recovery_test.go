@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+)
+
+func TestRecovery_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		handler        http.HandlerFunc
+		expectedStatus int
+		expectedBody   string
+		expectedLogged bool
+	}{
+		{
+			name: "Recover panic of the handler",
+			handler: func(_ http.ResponseWriter, _ *http.Request) {
+				var m map[string]int
+				m["panic"]++
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `"code":"internal_error"`,
+			expectedLogged: true,
+		},
+		{
+			name: "Recover panic after the handler wrote the response",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusAccepted)
+				w.Write([]byte(`{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0791"}`))
+				panic("after writing")
+			},
+			expectedStatus: http.StatusAccepted,
+			expectedBody:   `{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0791"}`,
+			expectedLogged: true,
+		},
+		{
+			name: "Recover panic after the handler wrote the body only",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Write([]byte(`partial`))
+				panic("after writing")
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `partial`,
+			expectedLogged: true,
+		},
+		{
+			name:           "Handler without panic",
+			handler:        func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) },
+			expectedStatus: http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var spy = newSpyLogger()
+
+			req, err := http.NewRequest(http.MethodGet, "/middleware", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Accept", response.ProblemContentType)
+
+			rr := httptest.NewRecorder()
+			NewRecovery(spy).Execute(tt.handler).ServeHTTP(rr, req)
+
+			if rr.Code != tt.expectedStatus {
+				t.Errorf("[TestCase '%s'] Got status: '%v' | Want status: '%v'", tt.name, rr.Code, tt.expectedStatus)
+			}
+
+			if !strings.Contains(rr.Body.String(), tt.expectedBody) {
+				t.Errorf("[TestCase '%s'] Got body: '%v' | Want body: '%v'", tt.name, rr.Body.String(), tt.expectedBody)
+			}
+
+			if tt.expectedStatus != http.StatusInternalServerError && strings.Contains(rr.Body.String(), "internal_error") {
+				t.Errorf("[TestCase '%s'] Got body: '%v' | Want body without the problem response", tt.name, rr.Body.String())
+			}
+
+			if logged := len(*spy.entries) > 0; logged != tt.expectedLogged {
+				t.Fatalf("[TestCase '%s'] Got logged: '%v' | Want logged: '%v'", tt.name, logged, tt.expectedLogged)
+			}
+
+			if tt.expectedLogged {
+				fields := (*spy.entries)[0].fields
+				if stack, _ := fields["stack"].(string); !strings.Contains(stack, "runtime/debug.Stack") {
+					t.Errorf("[TestCase '%s'] Got stack: '%v' | Want stack of the panic", tt.name, stack)
+				}
+			}
+		})
+	}
+}
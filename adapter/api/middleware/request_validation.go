@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/openapi"
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/gorilla/mux"
+)
+
+// RequestValidation validates the JSON bodies of the requests against the OpenAPI document of the API
+type RequestValidation struct {
+	doc    openapi.Document
+	log    logger.Logger
+	logKey string
+}
+
+// NewRequestValidation creates new RequestValidation with its dependencies
+func NewRequestValidation(doc openapi.Document, log logger.Logger) *RequestValidation {
+	return &RequestValidation{
+		doc:    doc,
+		log:    log,
+		logKey: "request_validation",
+	}
+}
+
+// Execute refuses with 400 Bad Request the bodies not matching the schema of the route, bodies that are not
+// JSON are left for the handlers to refuse
+func (v RequestValidation) Execute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := mux.CurrentRoute(r)
+		if route == nil || !isJSON(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		path, err := route.GetPathTemplate()
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		schema, ok := v.doc.RequestSchema(r.Method, path)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		var value interface{}
+		if err := json.Unmarshal(body, &value); err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if errs := v.doc.Validate(schema, value); len(errs) > 0 {
+			logger.FromContext(r.Context(), v.log).WithFields(logger.Fields{
+				"key":         v.logKey,
+				"path":        path,
+				"http_status": http.StatusBadRequest,
+			}).Errorf("request body does not match the schema")
+
+			response.NewErrors(errs, http.StatusBadRequest).Send(w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isJSON reports whether the body of the request is JSON, the content type defaults to it
+func isJSON(r *http.Request) bool {
+	contentType := r.Header.Get("Content-Type")
+	if contentType == "" {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == "application/json"
+}
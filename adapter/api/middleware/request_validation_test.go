@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/openapi"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/gorilla/mux"
+)
+
+type testTransferRequest struct {
+	PayerID string `json:"payer_id" openapi:"required"`
+	Value   int64  `json:"value" openapi:"required"`
+}
+
+func TestRequestValidation_Execute(t *testing.T) {
+	tests := []struct {
+		name               string
+		contentType        string
+		body               string
+		expectedBody       string
+		expectedStatusCode int
+	}{
+		{
+			name:               "Valid body",
+			body:               `{"payer_id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","value":100}`,
+			expectedBody:       `{"payer_id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","value":100}`,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Invalid body",
+			contentType:        "application/json; charset=utf-8",
+			body:               `{"value":"100"}`,
+			expectedBody:       `{"errors":["payer_id is required","value must be an integer"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "Malformed body left to the handler",
+			body:               `{"value":`,
+			expectedBody:       `{"value":`,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Body of another content type left to the handler",
+			contentType:        "text/csv",
+			body:               `0db298eb-c8e7-4829-84b7-c1036b4f0791,100`,
+			expectedBody:       `0db298eb-c8e7-4829-84b7-c1036b4f0791,100`,
+			expectedStatusCode: http.StatusCreated,
+		},
+	}
+
+	var doc = openapi.NewDocument(
+		openapi.Info{Title: "test", Version: "1.0.0"},
+		[]openapi.Route{{
+			Method:      http.MethodPost,
+			Path:        "/transfers",
+			OperationID: "createTransfer",
+			Request:     testTransferRequest{},
+			Status:      http.StatusCreated,
+		}},
+		struct{}{},
+		struct{}{},
+	)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, "/transfers", bytes.NewReader([]byte(tt.body)))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
+			router := mux.NewRouter()
+			router.Use(NewRequestValidation(doc, logger.Dummy{}).Execute)
+			router.HandleFunc("/transfers", func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				w.WriteHeader(http.StatusCreated)
+				w.Write(body)
+			}).Methods(http.MethodPost)
+
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+
+			if rr.Code != tt.expectedStatusCode {
+				t.Errorf(
+					"[TestCase '%s'] O handler retornou um HTTP status code inesperado: retornado '%v' esperado '%v'",
+					tt.name,
+					rr.Code,
+					tt.expectedStatusCode,
+				)
+			}
+
+			var result = strings.TrimSpace(rr.Body.String())
+			if result != tt.expectedBody {
+				t.Errorf(
+					"[TestCase '%s'] Result: '%v' | Expected: '%v'",
+					tt.name,
+					result,
+					tt.expectedBody,
+				)
+			}
+		})
+	}
+}
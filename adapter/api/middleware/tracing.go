@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing starts the span of the requests to the routes matched
+type Tracing struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+// NewTracing creates new Tracing with its dependencies, the span continues the trace of the W3C traceparent header
+func NewTracing(tracer trace.Tracer) *Tracing {
+	return &Tracing{
+		tracer:     tracer,
+		propagator: propagation.TraceContext{},
+	}
+}
+
+// Execute runs the request within its span, named by the template of the route, and keeps the trace id in the
+// context so that it is logged alongside the correlation id. It runs after CorrelationID
+func (t Tracing) Execute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var (
+			route = routeTemplate(r)
+			ctx   = t.propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		)
+
+		ctx, span := t.tracer.Start(
+			ctx,
+			fmt.Sprintf("%s %s", r.Method, route),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.method", r.Method),
+				attribute.String("http.route", route),
+				attribute.String("http.target", r.URL.Path),
+			),
+		)
+		defer span.End()
+
+		if id := logger.CorrelationID(ctx); id != "" {
+			span.SetAttributes(attribute.String("correlation_id", id))
+		}
+
+		ctx = logger.WithTraceID(ctx, span.SpanContext().TraceID().String())
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		span.SetAttributes(attribute.Int("http.status_code", recorder.status))
+		if recorder.status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(recorder.status))
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracing_Execute(t *testing.T) {
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+	tests := []struct {
+		name            string
+		traceparent     string
+		handler         http.HandlerFunc
+		expectedName    string
+		expectedStatus  codes.Code
+		expectedTraceID string
+	}{
+		{
+			name:         "Span named by route template",
+			handler:      func(w http.ResponseWriter, _ *http.Request) { w.Write([]byte(`{}`)) },
+			expectedName: "GET /users/{user_id}",
+		},
+		{
+			name:            "Span continuing the trace of the traceparent header",
+			traceparent:     "00-" + traceID + "-00f067aa0ba902b7-01",
+			handler:         func(w http.ResponseWriter, _ *http.Request) { w.Write([]byte(`{}`)) },
+			expectedName:    "GET /users/{user_id}",
+			expectedTraceID: traceID,
+		},
+		{
+			name:           "Span failed by a server error",
+			handler:        func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusInternalServerError) },
+			expectedName:   "GET /users/{user_id}",
+			expectedStatus: codes.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				exporter = tracetest.NewInMemoryExporter()
+				provider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+				router   = mux.NewRouter()
+				loggedID string
+			)
+			router.Use(NewTracing(provider.Tracer("test")).Execute)
+			router.HandleFunc("/users/{user_id}", func(w http.ResponseWriter, r *http.Request) {
+				loggedID = logger.TraceID(r.Context())
+				tt.handler(w, r)
+			}).Methods(http.MethodGet)
+
+			req, err := http.NewRequest(http.MethodGet, "/users/0db298eb-c8e7-4829-84b7-c1036b4f0791", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.traceparent != "" {
+				req.Header.Set("traceparent", tt.traceparent)
+			}
+
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("[TestCase '%s'] Got spans: '%v' | Want spans: '%v'", tt.name, len(spans), 1)
+			}
+
+			if spans[0].Name != tt.expectedName {
+				t.Errorf("[TestCase '%s'] Got name: '%v' | Want name: '%v'", tt.name, spans[0].Name, tt.expectedName)
+			}
+
+			if spans[0].StatusCode != tt.expectedStatus {
+				t.Errorf("[TestCase '%s'] Got status: '%v' | Want status: '%v'", tt.name, spans[0].StatusCode, tt.expectedStatus)
+			}
+
+			gotID := spans[0].SpanContext.TraceID().String()
+			if tt.expectedTraceID != "" && gotID != tt.expectedTraceID {
+				t.Errorf("[TestCase '%s'] Got trace id: '%v' | Want trace id: '%v'", tt.name, gotID, tt.expectedTraceID)
+			}
+
+			if loggedID != gotID {
+				t.Errorf("[TestCase '%s'] Got trace id in the context: '%v' | Want trace id in the context: '%v'", tt.name, loggedID, gotID)
+			}
+		})
+	}
+}
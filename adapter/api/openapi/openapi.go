@@ -0,0 +1,353 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	// Version is the version of the OpenAPI specification of the documents
+	Version = "3.0.3"
+
+	jsonContentType    = "application/json"
+	problemContentType = "application/problem+json"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+
+	pathParamPattern = regexp.MustCompile(`{([a-z_]+)}`)
+)
+
+type (
+	// Document defines the structure of an OpenAPI 3 document
+	Document struct {
+		OpenAPI    string              `json:"openapi"`
+		Info       Info                `json:"info"`
+		Paths      map[string]PathItem `json:"paths"`
+		Components Components          `json:"components"`
+	}
+
+	// Info defines the metadata of the API
+	Info struct {
+		Title       string `json:"title"`
+		Description string `json:"description,omitempty"`
+		Version     string `json:"version"`
+	}
+
+	// PathItem defines the operations of a path by their lowercase HTTP method
+	PathItem map[string]*Operation
+
+	// Operation defines an operation of a path
+	Operation struct {
+		OperationID string              `json:"operationId"`
+		Summary     string              `json:"summary,omitempty"`
+		Tags        []string            `json:"tags,omitempty"`
+		Parameters  []Parameter         `json:"parameters,omitempty"`
+		RequestBody *RequestBody        `json:"requestBody,omitempty"`
+		Responses   map[string]Response `json:"responses"`
+	}
+
+	// Parameter defines a path or query parameter of an operation
+	Parameter struct {
+		Name     string  `json:"name"`
+		In       string  `json:"in"`
+		Required bool    `json:"required,omitempty"`
+		Schema   *Schema `json:"schema"`
+	}
+
+	// RequestBody defines the body of the request of an operation by its content type
+	RequestBody struct {
+		Required bool                 `json:"required"`
+		Content  map[string]MediaType `json:"content"`
+	}
+
+	// Response defines a response of an operation by its content type
+	Response struct {
+		Description string               `json:"description"`
+		Content     map[string]MediaType `json:"content,omitempty"`
+	}
+
+	// MediaType defines the schema of a content type
+	MediaType struct {
+		Schema *Schema `json:"schema"`
+	}
+
+	// Components defines the schemas referenced by the operations
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	}
+
+	// Schema defines the subset of the JSON schema of OpenAPI 3 derived from the Go types
+	Schema struct {
+		Ref                  string             `json:"$ref,omitempty"`
+		Type                 string             `json:"type,omitempty"`
+		Format               string             `json:"format,omitempty"`
+		Nullable             bool               `json:"nullable,omitempty"`
+		Enum                 []string           `json:"enum,omitempty"`
+		Properties           map[string]*Schema `json:"properties,omitempty"`
+		Required             []string           `json:"required,omitempty"`
+		Items                *Schema            `json:"items,omitempty"`
+		AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	}
+
+	// Route defines an operation of the API with the Go types of its request and response, the request is
+	// sent as JSON and, when Consumes is set, also with those content types
+	Route struct {
+		Method         string
+		Path           string
+		OperationID    string
+		Summary        string
+		Tag            string
+		Query          []Parameter
+		Request        interface{}
+		Consumes       []string
+		Status         int
+		Response       interface{}
+		Produces       []string
+		OtherResponses map[int]interface{}
+	}
+
+	// generator defines the components found while deriving the schemas of the Go types
+	generator struct {
+		schemas map[string]*Schema
+	}
+)
+
+// NewDocument creates new Document with the operations of the routes, the errors of every operation are
+// described by the types of the legacy and the problem details formats
+func NewDocument(info Info, routes []Route, legacyError interface{}, problem interface{}) Document {
+	var (
+		g   = generator{schemas: map[string]*Schema{}}
+		doc = Document{
+			OpenAPI: Version,
+			Info:    info,
+			Paths:   map[string]PathItem{},
+		}
+		errorResponse = Response{
+			Description: "Error",
+			Content: map[string]MediaType{
+				jsonContentType:    {Schema: g.schemaOf(reflect.TypeOf(legacyError))},
+				problemContentType: {Schema: g.schemaOf(reflect.TypeOf(problem))},
+			},
+		}
+	)
+
+	for _, route := range routes {
+		item, ok := doc.Paths[route.Path]
+		if !ok {
+			item = PathItem{}
+			doc.Paths[route.Path] = item
+		}
+
+		item[strings.ToLower(route.Method)] = g.operation(route, errorResponse)
+	}
+
+	doc.Components = Components{Schemas: g.schemas}
+
+	return doc
+}
+
+// Operation returns the operation of the method and path template, such as /users/{user_id}
+func (d Document) Operation(method string, path string) (*Operation, bool) {
+	op, ok := d.Paths[path][strings.ToLower(method)]
+	return op, ok
+}
+
+// RequestSchema returns the schema of the JSON body of the operation of the method and path template
+func (d Document) RequestSchema(method string, path string) (*Schema, bool) {
+	op, ok := d.Operation(method, path)
+	if !ok || op.RequestBody == nil {
+		return nil, false
+	}
+
+	media, ok := op.RequestBody.Content[jsonContentType]
+	if !ok {
+		return nil, false
+	}
+
+	return d.resolve(media.Schema), true
+}
+
+// resolve returns the component referenced by the schema
+func (d Document) resolve(s *Schema) *Schema {
+	if s == nil || s.Ref == "" {
+		return s
+	}
+
+	if component, ok := d.Components.Schemas[strings.TrimPrefix(s.Ref, "#/components/schemas/")]; ok {
+		return component
+	}
+
+	return s
+}
+
+func (g *generator) operation(route Route, errorResponse Response) *Operation {
+	var op = &Operation{
+		OperationID: route.OperationID,
+		Summary:     route.Summary,
+		Responses:   map[string]Response{},
+	}
+
+	if route.Tag != "" {
+		op.Tags = []string{route.Tag}
+	}
+
+	for _, match := range pathParamPattern.FindAllStringSubmatch(route.Path, -1) {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string", Format: "uuid"},
+		})
+	}
+
+	for _, param := range route.Query {
+		param.In = "query"
+		op.Parameters = append(op.Parameters, param)
+	}
+
+	if route.Request != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{jsonContentType: {Schema: g.schemaOf(reflect.TypeOf(route.Request))}},
+		}
+
+		for _, contentType := range route.Consumes {
+			op.RequestBody.Content[contentType] = MediaType{Schema: &Schema{Type: "string"}}
+		}
+	}
+
+	var success = Response{Description: http.StatusText(route.Status)}
+	if route.Response != nil {
+		success.Content = map[string]MediaType{jsonContentType: {Schema: g.schemaOf(reflect.TypeOf(route.Response))}}
+	}
+
+	for _, contentType := range route.Produces {
+		if success.Content == nil {
+			success.Content = map[string]MediaType{}
+		}
+
+		success.Content[contentType] = MediaType{Schema: &Schema{Type: "string"}}
+	}
+
+	op.Responses[strconv.Itoa(route.Status)] = success
+	for status, body := range route.OtherResponses {
+		op.Responses[strconv.Itoa(status)] = Response{
+			Description: http.StatusText(status),
+			Content:     map[string]MediaType{jsonContentType: {Schema: g.schemaOf(reflect.TypeOf(body))}},
+		}
+	}
+	op.Responses["default"] = errorResponse
+
+	return op
+}
+
+// schemaOf returns the schema of a Go type, exported structs are components referenced by their name
+func (g *generator) schemaOf(t reflect.Type) *Schema {
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case rawMessageType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		s := g.schemaOf(t.Elem())
+		if s.Ref != "" {
+			return s
+		}
+
+		nullable := *s
+		nullable.Nullable = true
+		return &nullable
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+
+		return &Schema{Type: "array", Items: g.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaOf(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" || !unicode.IsUpper([]rune(t.Name())[0]) {
+			return g.structSchema(t)
+		}
+
+		if _, ok := g.schemas[t.Name()]; !ok {
+			g.schemas[t.Name()] = &Schema{}
+			*g.schemas[t.Name()] = *g.structSchema(t)
+		}
+
+		return &Schema{Ref: "#/components/schemas/" + t.Name()}
+	default:
+		return &Schema{}
+	}
+}
+
+// structSchema returns the schema of the exported fields of a struct by their JSON names. The openapi tag
+// marks the required fields and the accepted values, such as `openapi:"required,enum=BASIC|VERIFIED|FULL"`
+func (g *generator) structSchema(t reflect.Type) *Schema {
+	var s = &Schema{Type: "object", Properties: map[string]*Schema{}}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name, ok := fieldName(field)
+		if !ok {
+			continue
+		}
+
+		prop := g.schemaOf(field.Type)
+		for _, option := range strings.Split(field.Tag.Get("openapi"), ",") {
+			switch {
+			case option == "required":
+				s.Required = append(s.Required, name)
+			case strings.HasPrefix(option, "enum="):
+				enum := *prop
+				enum.Enum = strings.Split(strings.TrimPrefix(option, "enum="), "|")
+				prop = &enum
+			}
+		}
+
+		s.Properties[name] = prop
+	}
+
+	return s
+}
+
+// fieldName returns the JSON name of a field, fields without a json tag are decoded case-insensitively and
+// documented in lowercase
+func fieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+
+	if name := strings.Split(tag, ",")[0]; name != "" {
+		return name, true
+	}
+
+	return strings.ToLower(field.Name), true
+}
@@ -0,0 +1,173 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type (
+	testErrorResponse struct {
+		Errors []string `json:"errors"`
+	}
+
+	testProblem struct {
+		Code string `json:"code"`
+	}
+
+	testTransferRequest struct {
+		PayerID   string            `json:"payer_id" openapi:"required"`
+		Value     int64             `json:"value" openapi:"required"`
+		Mode      string            `json:"mode" openapi:"enum=ONE|TWO"`
+		ExecuteAt *time.Time        `json:"execute_at"`
+		Metadata  map[string]string `json:"metadata"`
+		Items     []testItemRequest `json:"items"`
+		Ignored   string            `json:"-"`
+		Untagged  string
+		internal  string
+	}
+
+	testItemRequest struct {
+		PayeeID string `json:"payee_id" openapi:"required"`
+	}
+
+	testTransferOutput struct {
+		ID        string `json:"id"`
+		CreatedAt string `json:"created_at"`
+	}
+)
+
+func testDocument() Document {
+	return NewDocument(
+		Info{Title: "test", Version: "1.0.0"},
+		[]Route{
+			{
+				Method:      http.MethodPost,
+				Path:        "/transfers",
+				OperationID: "createTransfer",
+				Request:     testTransferRequest{},
+				Status:      http.StatusCreated,
+				Response:    testTransferOutput{},
+			},
+			{
+				Method:      http.MethodGet,
+				Path:        "/transfers/{transfer_id}",
+				OperationID: "findTransfer",
+				Status:      http.StatusOK,
+				Response:    testTransferOutput{},
+			},
+		},
+		testErrorResponse{},
+		testProblem{},
+	)
+}
+
+func TestNewDocument(t *testing.T) {
+	var doc = testDocument()
+
+	if _, ok := doc.Operation(http.MethodPost, "/transfers"); !ok {
+		t.Fatalf("[TestCase '%s'] Operation not found", "POST /transfers")
+	}
+
+	op, ok := doc.Operation(http.MethodGet, "/transfers/{transfer_id}")
+	if !ok {
+		t.Fatalf("[TestCase '%s'] Operation not found", "GET /transfers/{transfer_id}")
+	}
+
+	wantParams := []Parameter{{Name: "transfer_id", In: "path", Required: true, Schema: &Schema{Type: "string", Format: "uuid"}}}
+	if !reflect.DeepEqual(op.Parameters, wantParams) {
+		t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", "path parameters", op.Parameters, wantParams)
+	}
+
+	if _, ok := op.Responses["default"].Content[problemContentType]; !ok {
+		t.Errorf("[TestCase '%s'] Problem details response not documented", "default response")
+	}
+
+	want := `{"type":"object","properties":{"execute_at":{"type":"string","format":"date-time","nullable":true},` +
+		`"items":{"type":"array","items":{"type":"object","properties":{"payee_id":{"type":"string"}},"required":["payee_id"]}},` +
+		`"metadata":{"type":"object","additionalProperties":{"type":"string"}},"mode":{"type":"string","enum":["ONE","TWO"]},` +
+		`"payer_id":{"type":"string"},"untagged":{"type":"string"},"value":{"type":"integer","format":"int64"}},` +
+		`"required":["payer_id","value"]}`
+
+	schema, ok := doc.RequestSchema(http.MethodPost, "/transfers")
+	if !ok {
+		t.Fatalf("[TestCase '%s'] Request schema not found", "POST /transfers")
+	}
+
+	got, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != want {
+		t.Errorf("[TestCase '%s'] Got: '%s' | Want: '%s'", "resolved request schema", got, want)
+	}
+}
+
+func TestDocument_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{
+			name: "Valid body",
+			body: `{"payer_id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","value":100,"mode":"one","items":[{"payee_id":"x"}]}`,
+		},
+		{
+			name: "Valid body with properties in another case",
+			body: `{"PAYER_ID":"0db298eb-c8e7-4829-84b7-c1036b4f0791","Value":100}`,
+		},
+		{
+			name: "Required properties missing",
+			body: `{"payer_id":null}`,
+			want: []string{"payer_id: payer_id is required", "value: value is required"},
+		},
+		{
+			name: "Invalid types",
+			body: `{"payer_id":1,"value":1.5,"execute_at":"tomorrow","metadata":{"key":1},"items":[{}],"mode":"THREE"}`,
+			want: []string{
+				"execute_at: execute_at must be a date-time in RFC 3339",
+				"items[0].payee_id: items[0].payee_id is required",
+				"metadata.key: metadata.key must be a string",
+				"mode: mode must be one of ONE, TWO",
+				"payer_id: payer_id must be a string",
+				"value: value must be an integer",
+			},
+		},
+		{
+			name: "Body is not an object",
+			body: `[]`,
+			want: []string{"body: body must be an object"},
+		},
+	}
+
+	var doc = testDocument()
+	schema, _ := doc.RequestSchema(http.MethodPost, "/transfers")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var value interface{}
+			if err := json.Unmarshal([]byte(tt.body), &value); err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, err := range doc.Validate(schema, value) {
+				f, ok := err.(interface{ Name() string })
+				if !ok {
+					t.Fatalf("[TestCase '%s'] Error without the name of the field: '%v'", tt.name, err)
+				}
+
+				got = append(got, f.Name()+": "+err.Error())
+			}
+
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
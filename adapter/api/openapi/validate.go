@@ -0,0 +1,163 @@
+package openapi
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+)
+
+// Validate returns the errors of a decoded JSON value against the schema, each one a response.FieldError named
+// by the path of the field, such as splits[0].payee_id. Like encoding/json the properties are matched
+// case-insensitively and null values are taken as absent
+func (d Document) Validate(schema *Schema, value interface{}) []error {
+	return d.validate(schema, "", value)
+}
+
+func (d Document) validate(schema *Schema, name string, value interface{}) []error {
+	schema = d.resolve(schema)
+	if schema == nil || value == nil {
+		return nil
+	}
+
+	var field = name
+	if field == "" {
+		field = "body"
+	}
+
+	switch schema.Type {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return []error{fieldError(field, "%s must be a string", field)}
+		}
+
+		if schema.Format == "date-time" {
+			if _, err := time.Parse(time.RFC3339, s); err != nil {
+				return []error{fieldError(field, "%s must be a date-time in RFC 3339", field)}
+			}
+		}
+
+		if len(schema.Enum) > 0 && s != "" && !inEnum(schema.Enum, s) {
+			return []error{fieldError(field, "%s must be one of %s", field, strings.Join(schema.Enum, ", "))}
+		}
+	case "integer":
+		n, ok := value.(float64)
+		if !ok || n != math.Trunc(n) {
+			return []error{fieldError(field, "%s must be an integer", field)}
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return []error{fieldError(field, "%s must be a number", field)}
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return []error{fieldError(field, "%s must be a boolean", field)}
+		}
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			return []error{fieldError(field, "%s must be an array", field)}
+		}
+
+		var errs []error
+		for i, item := range items {
+			errs = append(errs, d.validate(schema.Items, fmt.Sprintf("%s[%d]", name, i), item)...)
+		}
+
+		return errs
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return []error{fieldError(field, "%s must be an object", field)}
+		}
+
+		return d.validateObject(schema, name, object)
+	}
+
+	return nil
+}
+
+func (d Document) validateObject(schema *Schema, name string, object map[string]interface{}) []error {
+	var errs []error
+
+	for _, required := range schema.Required {
+		if value, ok := lookup(object, required); !ok || value == nil {
+			field := join(name, required)
+			errs = append(errs, fieldError(field, "%s is required", field))
+		}
+	}
+
+	for _, key := range sortedKeys(schema.Properties) {
+		if value, ok := lookup(object, key); ok {
+			errs = append(errs, d.validate(schema.Properties[key], join(name, key), value)...)
+		}
+	}
+
+	if schema.AdditionalProperties != nil {
+		for _, key := range sortedKeys(object) {
+			errs = append(errs, d.validate(schema.AdditionalProperties, join(name, key), object[key])...)
+		}
+	}
+
+	return errs
+}
+
+// lookup returns the value of the key of an object, preferring an exact match like encoding/json
+func lookup(object map[string]interface{}, key string) (interface{}, bool) {
+	if value, ok := object[key]; ok {
+		return value, true
+	}
+
+	for k, value := range object {
+		if strings.EqualFold(k, key) {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
+// inEnum reports whether the value is one of the enum, ignoring the case as the handlers do
+func inEnum(enum []string, value string) bool {
+	for _, v := range enum {
+		if strings.EqualFold(v, strings.TrimSpace(value)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sortedKeys returns the keys of a map of properties or of an object in order, so are the errors
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch t := m.(type) {
+	case map[string]*Schema:
+		for key := range t {
+			keys = append(keys, key)
+		}
+	case map[string]interface{}:
+		for key := range t {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+func join(name string, key string) string {
+	if name == "" {
+		return key
+	}
+
+	return name + "." + key
+}
+
+func fieldError(name string, format string, args ...interface{}) error {
+	return response.NewFieldError(name, fmt.Errorf(format, args...))
+}
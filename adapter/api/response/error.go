@@ -2,21 +2,76 @@ package response
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+)
+
+const (
+	// ProblemContentType is the media type of the RFC 7807 problem details, negotiated by the Accept header
+	ProblemContentType = "application/problem+json"
+
+	problemTypePrefix = "/problems/"
+)
+
+type (
+	// Error defines the structure of error for http responses
+	Error struct {
+		statusCode int
+		errs       []error
+		Errors     []string `json:"errors"`
+	}
+
+	// Problem defines the structure of the RFC 7807 problem details for http responses
+	Problem struct {
+		Type          string         `json:"type"`
+		Title         string         `json:"title"`
+		Status        int            `json:"status"`
+		Detail        string         `json:"detail"`
+		Instance      string         `json:"instance,omitempty"`
+		Code          string         `json:"code"`
+		InvalidParams []InvalidParam `json:"invalid_params,omitempty"`
+	}
+
+	// InvalidParam defines a field of the request refused by the validation and the reason
+	InvalidParam struct {
+		Name   string `json:"name"`
+		Reason string `json:"reason"`
+	}
+
+	// FieldError defines a validation error of a field of the request
+	FieldError struct {
+		name string
+		err  error
+	}
 )
 
-// Error defines the structure of success for http responses
-type Error struct {
-	statusCode int
-	Errors     []string `json:"errors"`
+// NewFieldError creates new FieldError, its message is the one of err so that the legacy format is unchanged
+func NewFieldError(name string, err error) error {
+	return FieldError{name: name, err: err}
+}
+
+// Error returns the message of the error
+func (f FieldError) Error() string {
+	return f.err.Error()
+}
+
+// Unwrap returns the error of the field
+func (f FieldError) Unwrap() error {
+	return f.err
+}
+
+// Name returns the name property
+func (f FieldError) Name() string {
+	return f.name
 }
 
 // NewError creates new Error
 func NewError(err error, status int) *Error {
-	return &Error{
-		statusCode: status,
-		Errors:     []string{err.Error()},
-	}
+	return NewErrors([]error{err}, status)
 }
 
 // NewErrors creates new Error
@@ -28,13 +83,108 @@ func NewErrors(errs []error, status int) *Error {
 
 	return &Error{
 		statusCode: status,
+		errs:       errs,
 		Errors:     msgs,
 	}
 }
 
-// Send returns a response with JSON format
-func (e Error) Send(w http.ResponseWriter) error {
+// Send returns a response with the problem details format when the request accepts it, otherwise with JSON format
+func (e Error) Send(w http.ResponseWriter, r *http.Request) error {
+	w.Header().Add("Vary", "Accept")
+
+	if acceptsProblem(r) {
+		w.Header().Set("Content-Type", ProblemContentType)
+		w.WriteHeader(e.statusCode)
+		return json.NewEncoder(w).Encode(e.problem(r))
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(e.statusCode)
 	return json.NewEncoder(w).Encode(e)
 }
+
+// problem returns the problem details of the error, the code of a single error comes from its domain error and
+// the one of several errors, or of an error outside of the domain, from the status code
+func (e Error) problem(r *http.Request) Problem {
+	var (
+		code   = statusCode(e.statusCode)
+		detail = strings.Join(e.Errors, "; ")
+	)
+
+	if len(e.errs) == 1 {
+		if domainErr := entity.ErrorOf(e.errs[0]); domainErr != entity.ErrInternal || e.statusCode >= http.StatusInternalServerError {
+			code = domainErr.Code()
+		}
+	}
+
+	if len(e.errs) > 1 {
+		detail = fmt.Sprintf("%d errors found in the request: %s", len(e.errs), detail)
+	}
+
+	return Problem{
+		Type:          problemTypePrefix + code,
+		Title:         http.StatusText(e.statusCode),
+		Status:        e.statusCode,
+		Detail:        detail,
+		Instance:      correlationID(r),
+		Code:          code,
+		InvalidParams: invalidParams(e.errs),
+	}
+}
+
+// invalidParams returns the fields refused by the validation
+func invalidParams(errs []error) []InvalidParam {
+	var params []InvalidParam
+	for _, err := range errs {
+		if f, ok := err.(FieldError); ok {
+			params = append(params, InvalidParam{Name: f.name, Reason: f.Error()})
+		}
+	}
+
+	return params
+}
+
+// acceptsProblem reports whether the Accept header of the request lists the problem details media type
+func acceptsProblem(r *http.Request) bool {
+	if r == nil {
+		return false
+	}
+
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		if strings.TrimSpace(strings.Split(accept, ";")[0]) == ProblemContentType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// correlationID returns the correlation id of the request defined by middleware.CorrelationID
+func correlationID(r *http.Request) string {
+	if r == nil {
+		return ""
+	}
+
+	return logger.CorrelationID(r.Context())
+}
+
+// statusCode returns the code of a status code, such as bad_request
+func statusCode(status int) string {
+	return strings.ToLower(strings.ReplaceAll(http.StatusText(status), " ", "_"))
+}
+
+// statusCodes maps the kinds of the domain errors to the HTTP status codes
+var statusCodes = map[entity.ErrorKind]int{
+	entity.KindValidation:    http.StatusBadRequest,
+	entity.KindNotFound:      http.StatusNotFound,
+	entity.KindConflict:      http.StatusConflict,
+	entity.KindRuleViolation: http.StatusUnprocessableEntity,
+	entity.KindForbidden:     http.StatusForbidden,
+	entity.KindUnavailable:   http.StatusServiceUnavailable,
+	entity.KindInternal:      http.StatusInternalServerError,
+}
+
+// StatusCode returns the HTTP status code of an error of the use cases by the kind of its domain error
+func StatusCode(err error) int {
+	return statusCodes[entity.ErrorOf(err).Kind()]
+}
@@ -0,0 +1,239 @@
+package response
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	pkgerrors "github.com/pkg/errors"
+)
+
+func TestStatusCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{
+			name: "Validation error",
+			err:  entity.NewError(entity.KindValidation, "invalid", "invalid"),
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "Not found error",
+			err:  entity.NewError(entity.KindNotFound, "not_found", "not found"),
+			want: http.StatusNotFound,
+		},
+		{
+			name: "Conflict error",
+			err:  entity.NewError(entity.KindConflict, "conflict", "conflict"),
+			want: http.StatusConflict,
+		},
+		{
+			name: "Rule violation error",
+			err:  entity.NewError(entity.KindRuleViolation, "rule_violation", "rule violation"),
+			want: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Forbidden error",
+			err:  entity.NewError(entity.KindForbidden, "forbidden", "forbidden"),
+			want: http.StatusForbidden,
+		},
+		{
+			name: "Unavailable error",
+			err:  entity.NewError(entity.KindUnavailable, "unavailable", "unavailable"),
+			want: http.StatusServiceUnavailable,
+		},
+		{
+			name: "Internal error",
+			err:  entity.NewError(entity.KindInternal, "internal", "internal"),
+			want: http.StatusInternalServerError,
+		},
+		{
+			name: "Error of the value objects",
+			err:  vo.ErrNotAllowedTypeUser,
+			want: http.StatusForbidden,
+		},
+		{
+			name: "Error outside of the domain",
+			err:  errors.New("connection refused"),
+			want: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StatusCode(tt.err); got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusCode_WrapError(t *testing.T) {
+	var (
+		conflict = entity.NewError(entity.KindConflict, "conflict", "conflict")
+		internal = entity.NewError(entity.KindInternal, "internal", "internal")
+		cause    = errors.New("connection refused")
+	)
+
+	tests := []struct {
+		name     string
+		err      error
+		want     int
+		wantCode string
+		wantIs   []error
+	}{
+		{
+			name:     "Domain error wrapping a cause outside of the domain",
+			err:      entity.WrapError(entity.ErrAuthorizerUnavailable, cause),
+			want:     http.StatusServiceUnavailable,
+			wantCode: "authorizer_unavailable",
+			wantIs:   []error{entity.ErrAuthorizerUnavailable, cause},
+		},
+		{
+			name:     "Internal error wrapping a domain error",
+			err:      entity.WrapError(internal, conflict),
+			want:     http.StatusConflict,
+			wantCode: "conflict",
+			wantIs:   []error{internal, conflict},
+		},
+		{
+			name:     "Domain error wrapped by fmt.Errorf",
+			err:      fmt.Errorf("create transfer: %w", entity.WrapError(entity.ErrAuthorizerUnavailable, cause)),
+			want:     http.StatusServiceUnavailable,
+			wantCode: "authorizer_unavailable",
+			wantIs:   []error{entity.ErrAuthorizerUnavailable, cause},
+		},
+		{
+			name:     "Domain error wrapped by pkg/errors",
+			err:      pkgerrors.Wrap(conflict, "payee"),
+			want:     http.StatusConflict,
+			wantCode: "conflict",
+			wantIs:   []error{conflict},
+		},
+		{
+			name:     "Cause without a domain error",
+			err:      entity.WrapError(cause, errors.New("timeout")),
+			want:     http.StatusInternalServerError,
+			wantCode: "internal_error",
+			wantIs:   []error{cause},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StatusCode(tt.err); got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+
+			if got := entity.ErrorOf(tt.err).Code(); got != tt.wantCode {
+				t.Errorf("[TestCase '%s'] Got code: '%v' | Want code: '%v'", tt.name, got, tt.wantCode)
+			}
+
+			for _, target := range tt.wantIs {
+				if !errors.Is(tt.err, target) {
+					t.Errorf("[TestCase '%s'] Got: '%v' | Want errors.Is: '%v'", tt.name, tt.err, target)
+				}
+			}
+		})
+	}
+}
+
+func TestError_Send(t *testing.T) {
+	var res = NewError(entity.ErrAuthorizerUnavailable, http.StatusServiceUnavailable)
+
+	tests := []struct {
+		name            string
+		accept          string
+		wantContentType string
+		wantBody        interface{}
+	}{
+		{
+			name:            "Without Accept header",
+			wantContentType: "application/json",
+			wantBody:        map[string]interface{}{"errors": []interface{}{"authorizer is unavailable"}},
+		},
+		{
+			name:            "Accept application/json",
+			accept:          "application/json",
+			wantContentType: "application/json",
+			wantBody:        map[string]interface{}{"errors": []interface{}{"authorizer is unavailable"}},
+		},
+		{
+			name:            "Accept any media type",
+			accept:          "*/*",
+			wantContentType: "application/json",
+			wantBody:        map[string]interface{}{"errors": []interface{}{"authorizer is unavailable"}},
+		},
+		{
+			name:            "Accept application/problem+json",
+			accept:          "application/problem+json",
+			wantContentType: ProblemContentType,
+			wantBody:        problemBody(),
+		},
+		{
+			name:            "Accept application/problem+json with parameters",
+			accept:          "application/problem+json; q=0.9",
+			wantContentType: ProblemContentType,
+			wantBody:        problemBody(),
+		},
+		{
+			name:            "Accept application/problem+json among other media types",
+			accept:          "application/json, application/problem+json",
+			wantContentType: ProblemContentType,
+			wantBody:        problemBody(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/transfers", nil)
+			req = req.WithContext(logger.WithCorrelationID(req.Context(), "7f4c2a1e-correlation"))
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+
+			rr := httptest.NewRecorder()
+			if err := res.Send(rr, req); err != nil {
+				t.Fatal(err)
+			}
+
+			if rr.Code != http.StatusServiceUnavailable {
+				t.Errorf("[TestCase '%s'] Got status: '%v' | Want status: '%v'", tt.name, rr.Code, http.StatusServiceUnavailable)
+			}
+
+			if got := rr.Header().Get("Content-Type"); got != tt.wantContentType {
+				t.Errorf("[TestCase '%s'] Got content type: '%v' | Want content type: '%v'", tt.name, got, tt.wantContentType)
+			}
+
+			if got := rr.Header().Get("Vary"); got != "Accept" {
+				t.Errorf("[TestCase '%s'] Got vary: '%v' | Want vary: '%v'", tt.name, got, "Accept")
+			}
+
+			var got interface{}
+			if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, tt.wantBody) {
+				t.Errorf("[TestCase '%s'] Got body: '%v' | Want body: '%v'", tt.name, got, tt.wantBody)
+			}
+		})
+	}
+}
+
+func problemBody() map[string]interface{} {
+	return map[string]interface{}{
+		"type":     "/problems/authorizer_unavailable",
+		"title":    "Service Unavailable",
+		"status":   float64(http.StatusServiceUnavailable),
+		"detail":   "authorizer is unavailable",
+		"instance": "7f4c2a1e-correlation",
+		"code":     "authorizer_unavailable",
+	}
+}
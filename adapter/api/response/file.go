@@ -0,0 +1,29 @@
+package response
+
+import (
+	"net/http"
+)
+
+// File defines the structure of non JSON http responses, such as CSV and plain text exports
+type File struct {
+	statusCode  int
+	contentType string
+	body        []byte
+}
+
+// NewFile creates new File
+func NewFile(body []byte, contentType string, status int) File {
+	return File{
+		statusCode:  status,
+		contentType: contentType,
+		body:        body,
+	}
+}
+
+// Send returns a response with the given content type
+func (f File) Send(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", f.contentType)
+	w.WriteHeader(f.statusCode)
+	_, err := w.Write(f.body)
+	return err
+}
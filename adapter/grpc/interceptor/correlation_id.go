@@ -0,0 +1,45 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// correlationIDKey is the metadata key of the correlation id, the gRPC counterpart of the X-Correlation-Id header
+const correlationIDKey = "x-correlation-id"
+
+// CorrelationID defines the correlation id of the calls, like middleware.CorrelationID does for the HTTP requests
+type CorrelationID struct{}
+
+// NewCorrelationID creates new CorrelationID
+func NewCorrelationID() *CorrelationID {
+	return &CorrelationID{}
+}
+
+// Execute reads the correlation id from the metadata of the call, or generates one, and sends it back in the header
+func (c CorrelationID) Execute(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(correlationIDKey); len(values) > 0 {
+			id = values[0]
+		}
+	}
+
+	if id == "" {
+		id = uuid.New().String()
+	}
+
+	ctx = logger.WithCorrelationID(ctx, id)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(correlationIDKey, id))
+
+	return handler(ctx, req)
+}
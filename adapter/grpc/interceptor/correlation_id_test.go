@@ -0,0 +1,53 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCorrelationID_Execute(t *testing.T) {
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want string
+	}{
+		{
+			name: "Define correlation id",
+			md:   metadata.Pairs("x-correlation-id", "f9882930-1914-47d7-8b58-18bff092e081"),
+			want: "f9882930-1914-47d7-8b58-18bff092e081",
+		},
+		{
+			name: "Auto generated correlation id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ctx = context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			var got string
+			_, err := NewCorrelationID().Execute(
+				ctx,
+				nil,
+				&grpc.UnaryServerInfo{FullMethod: "/transactions.v1.UserService/FindUserByID"},
+				func(ctx context.Context, _ interface{}) (interface{}, error) {
+					got = logger.CorrelationID(ctx)
+					return nil, nil
+				},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got == "" || (tt.want != "" && got != tt.want) {
+				t.Errorf("[TestCase '%s'] Got correlation id: '%v' | Want correlation id: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
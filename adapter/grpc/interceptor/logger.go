@@ -0,0 +1,46 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Logger defines the logger of the calls, like middleware.RequestLogger and middleware.AccessLog do for the
+// HTTP requests
+type Logger struct {
+	log logger.Logger
+}
+
+// NewLogger creates new Logger with its dependencies
+func NewLogger(log logger.Logger) *Logger {
+	return &Logger{log: log}
+}
+
+// Execute keeps the logger of the call, with its correlation id and trace id, in the context for the services
+// and logs the call once handled with its method, status code and duration. It runs after CorrelationID and Tracing
+func (l Logger) Execute(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	log := l.log.WithFields(logger.Fields{
+		"correlation_id": logger.CorrelationID(ctx),
+		"trace_id":       logger.TraceID(ctx),
+	})
+
+	start := time.Now()
+	resp, err := handler(logger.NewContext(ctx, log), req)
+
+	log.WithFields(logger.Fields{
+		"method":    info.FullMethod,
+		"grpc_code": status.Code(err).String(),
+		"duration":  time.Since(start).String(),
+	}).Infof("gRPC call handled")
+
+	return resp, err
+}
@@ -0,0 +1,50 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Recovery recovers the panics of the services, like middleware.Recovery does for the HTTP handlers
+type Recovery struct {
+	log    logger.Logger
+	logKey string
+}
+
+// NewRecovery creates new Recovery with its dependencies
+func NewRecovery(log logger.Logger) *Recovery {
+	return &Recovery{
+		log:    log,
+		logKey: "recovery",
+	}
+}
+
+// Execute fails the call with codes.Internal when the service panics, logging the panic with its stack. It runs
+// after Logger so that the call is logged with the status code returned
+func (r Recovery) Execute(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp interface{}, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			logger.FromContext(ctx, r.log).WithFields(logger.Fields{
+				"key":    r.logKey,
+				"method": info.FullMethod,
+				"panic":  fmt.Sprint(recovered),
+				"stack":  string(debug.Stack()),
+			}).Errorf("panic recovered")
+
+			resp, err = nil, status.Error(codes.Internal, "internal error")
+		}
+	}()
+
+	return handler(ctx, req)
+}
@@ -0,0 +1,54 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecovery_Execute(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  grpc.UnaryHandler
+		wantResp interface{}
+		wantCode codes.Code
+	}{
+		{
+			name: "Recover panic of the service",
+			handler: func(_ context.Context, _ interface{}) (interface{}, error) {
+				panic("nil wallet")
+			},
+			wantCode: codes.Internal,
+		},
+		{
+			name: "Service without panic",
+			handler: func(_ context.Context, _ interface{}) (interface{}, error) {
+				return "user", nil
+			},
+			wantResp: "user",
+			wantCode: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := NewRecovery(infralogger.Dummy{}).Execute(
+				context.Background(),
+				nil,
+				&grpc.UnaryServerInfo{FullMethod: "/transactions.v1.UserService/FindUserByID"},
+				tt.handler,
+			)
+
+			if resp != tt.wantResp {
+				t.Errorf("[TestCase '%s'] Got response: '%v' | Want response: '%v'", tt.name, resp, tt.wantResp)
+			}
+
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("[TestCase '%s'] Got code: '%v' | Want code: '%v'", tt.name, status.Code(err), tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	// Tracing starts the span of the calls, like middleware.Tracing does for the HTTP requests
+	Tracing struct {
+		tracer     trace.Tracer
+		propagator propagation.TextMapPropagator
+	}
+
+	// metadataCarrier carries the trace context in the metadata of a call
+	metadataCarrier metadata.MD
+)
+
+// NewTracing creates new Tracing with its dependencies, the span continues the trace of the traceparent metadata
+func NewTracing(tracer trace.Tracer) *Tracing {
+	return &Tracing{
+		tracer:     tracer,
+		propagator: propagation.TraceContext{},
+	}
+}
+
+// Execute runs the call within its span, named by the method, and keeps the trace id in the context so that it
+// is logged alongside the correlation id. It runs after CorrelationID
+func (t Tracing) Execute(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = t.propagator.Extract(ctx, metadataCarrier(md))
+	}
+
+	ctx, span := t.tracer.Start(
+		ctx,
+		info.FullMethod,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attribute.String("rpc.system", "grpc")),
+	)
+	defer span.End()
+
+	if id := logger.CorrelationID(ctx); id != "" {
+		span.SetAttributes(attribute.String("correlation_id", id))
+	}
+
+	ctx = logger.WithTraceID(ctx, span.SpanContext().TraceID().String())
+
+	resp, err := handler(ctx, req)
+
+	span.SetAttributes(attribute.String("rpc.grpc.status_code", status.Code(err).String()))
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return resp, err
+}
+
+// Get returns the first value of the key
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// Set sets the value of the key
+func (c metadataCarrier) Set(key string, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+// Keys returns the keys of the metadata
+func (c metadataCarrier) Keys() []string {
+	var keys = make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
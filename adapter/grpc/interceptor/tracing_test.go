@@ -0,0 +1,82 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTracing_Execute(t *testing.T) {
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+	tests := []struct {
+		name            string
+		md              metadata.MD
+		err             error
+		expectedStatus  codes.Code
+		expectedTraceID string
+	}{
+		{
+			name: "Span of the call",
+		},
+		{
+			name:            "Span continuing the trace of the traceparent metadata",
+			md:              metadata.Pairs("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01"),
+			expectedTraceID: traceID,
+		},
+		{
+			name:           "Span failed by an error",
+			err:            status.Error(grpccodes.Unavailable, "authorizer unavailable"),
+			expectedStatus: codes.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				exporter = tracetest.NewInMemoryExporter()
+				provider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+				ctx      = context.Background()
+				loggedID string
+			)
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			_, _ = NewTracing(provider.Tracer("test")).Execute(
+				ctx,
+				nil,
+				&grpc.UnaryServerInfo{FullMethod: "/transactions.v1.TransferService/CreateTransfer"},
+				func(ctx context.Context, _ interface{}) (interface{}, error) {
+					loggedID = logger.TraceID(ctx)
+					return nil, tt.err
+				},
+			)
+
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("[TestCase '%s'] Got spans: '%v' | Want spans: '%v'", tt.name, len(spans), 1)
+			}
+
+			if spans[0].StatusCode != tt.expectedStatus {
+				t.Errorf("[TestCase '%s'] Got status: '%v' | Want status: '%v'", tt.name, spans[0].StatusCode, tt.expectedStatus)
+			}
+
+			gotID := spans[0].SpanContext.TraceID().String()
+			if tt.expectedTraceID != "" && gotID != tt.expectedTraceID {
+				t.Errorf("[TestCase '%s'] Got trace id: '%v' | Want trace id: '%v'", tt.name, gotID, tt.expectedTraceID)
+			}
+
+			if loggedID != gotID {
+				t.Errorf("[TestCase '%s'] Got trace id in the context: '%v' | Want trace id in the context: '%v'", tt.name, loggedID, gotID)
+			}
+		})
+	}
+}
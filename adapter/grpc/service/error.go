@@ -0,0 +1,64 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain identifies the service in the details of the errors
+const errorDomain = "golang-clean-architecture"
+
+// statusCodes maps the kinds of the domain errors to the gRPC status codes
+var statusCodes = map[entity.ErrorKind]codes.Code{
+	entity.KindValidation:    codes.InvalidArgument,
+	entity.KindNotFound:      codes.NotFound,
+	entity.KindConflict:      codes.AlreadyExists,
+	entity.KindRuleViolation: codes.FailedPrecondition,
+	entity.KindForbidden:     codes.PermissionDenied,
+	entity.KindUnavailable:   codes.Unavailable,
+	entity.KindInternal:      codes.Internal,
+}
+
+// Code returns the gRPC status code of an error of the use cases by the kind of its domain error
+func Code(err error) codes.Code {
+	return statusCodes[entity.ErrorOf(err).Kind()]
+}
+
+// statusError returns the gRPC status of an error of the use cases, with the code of its domain error as reason
+func statusError(err error) error {
+	st, detailsErr := status.New(Code(err), err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: entity.ErrorOf(err).Code(),
+		Domain: errorDomain,
+	})
+	if detailsErr != nil {
+		return status.Error(Code(err), err.Error())
+	}
+
+	return st.Err()
+}
+
+// invalidArgument returns the gRPC status of the fields refused by the validation of a request
+func invalidArgument(violations []*errdetails.BadRequest_FieldViolation) error {
+	var msgs []string
+	for _, v := range violations {
+		msgs = append(msgs, v.Description)
+	}
+
+	st, err := status.New(codes.InvalidArgument, strings.Join(msgs, "; ")).WithDetails(&errdetails.BadRequest{
+		FieldViolations: violations,
+	})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, strings.Join(msgs, "; "))
+	}
+
+	return st.Err()
+}
+
+// fieldViolation returns the violation of a field refused by the validation
+func fieldViolation(field string, err error) *errdetails.BadRequest_FieldViolation {
+	return &errdetails.BadRequest_FieldViolation{Field: field, Description: err.Error()}
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/grpc/pb"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// TransferService defines the dependencies of the gRPC service of the transfers
+type TransferService struct {
+	pb.UnimplementedTransferServiceServer
+
+	uc  usecase.CreateTransferUseCase
+	log logger.Logger
+}
+
+// NewTransferService creates new TransferService with its dependencies
+func NewTransferService(uc usecase.CreateTransferUseCase, log logger.Logger) TransferService {
+	return TransferService{
+		uc:  uc,
+		log: log,
+	}
+}
+
+// CreateTransfer transfers money from the wallet of the payer to the wallet of the payee
+func (t TransferService) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.Transfer, error) {
+	var log = logger.FromContext(ctx, t.log).WithFields(logger.Fields{"key": "create_transfer"})
+
+	input, violations := t.validate(req)
+	if len(violations) > 0 {
+		err := invalidArgument(violations)
+		log.WithFields(logger.Fields{"error": err.Error()}).Errorf("invalid input")
+
+		return nil, err
+	}
+
+	output, err := t.uc.Execute(ctx, input)
+	if err != nil {
+		log.WithFields(logger.Fields{
+			"error":     err.Error(),
+			"grpc_code": Code(err).String(),
+		}).Errorf("failed to create transfer")
+
+		return nil, statusError(err)
+	}
+
+	log.Infof("success create transfer")
+
+	var transfer = &pb.Transfer{
+		Id:                output.ID,
+		PayerId:           output.PayerID,
+		PayeeId:           output.PayeeID,
+		Value:             output.Value,
+		Description:       output.Description,
+		ExternalReference: output.ExternalReference,
+		Metadata:          output.Metadata,
+		CreatedAt:         output.CreatedAt,
+	}
+
+	if output.Fee != nil {
+		transfer.Fee = &pb.TransferFee{
+			PayerFee:    output.Fee.PayerFee,
+			PayeeFee:    output.Fee.PayeeFee,
+			Total:       output.Fee.Total,
+			PayerDebit:  output.Fee.PayerDebit,
+			PayeeCredit: output.Fee.PayeeCredit,
+		}
+	}
+
+	return transfer, nil
+}
+
+func (t TransferService) validate(req *pb.CreateTransferRequest) (usecase.CreateTransferInput, []*errdetails.BadRequest_FieldViolation) {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	id, err := vo.NewUuid(uuid.New().String())
+	if err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	payerID, err := vo.NewUuid(req.GetPayerId())
+	if err != nil {
+		violations = append(violations, fieldViolation("payer_id", err))
+	}
+	payeeID, err := vo.NewUuid(req.GetPayeeId())
+	if err != nil {
+		violations = append(violations, fieldViolation("payee_id", err))
+	}
+	amount, err := vo.NewAmount(req.GetValue())
+	if err != nil {
+		violations = append(violations, fieldViolation("value", err))
+	}
+	details, err := entity.NewTransferDetails(req.GetDescription(), req.GetExternalReference(), req.GetMetadata())
+	if err != nil {
+		violations = append(violations, fieldViolation(transferDetailsField(err), err))
+	}
+
+	return usecase.CreateTransferInput{
+		ID:        id,
+		PayerID:   payerID,
+		PayeeID:   payeeID,
+		Value:     vo.NewMoneyBRL(amount),
+		Details:   details,
+		CreatedAt: time.Now(),
+	}, violations
+}
+
+// transferDetailsField returns the field of the request refused by the validation of the transfer details
+func transferDetailsField(err error) string {
+	switch err {
+	case entity.ErrInvalidTransferDescription:
+		return "description"
+	case entity.ErrInvalidTransferExternalReference:
+		return "external_reference"
+	default:
+		return "metadata"
+	}
+}
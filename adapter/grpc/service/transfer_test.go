@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/grpc/pb"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type stubCreateTransferUseCase struct {
+	result usecase.CreateTransferOutput
+	err    error
+}
+
+func (s stubCreateTransferUseCase) Execute(_ context.Context, _ usecase.CreateTransferInput) (usecase.CreateTransferOutput, error) {
+	return s.result, s.err
+}
+
+func TestTransferService_CreateTransfer(t *testing.T) {
+	tests := []struct {
+		name           string
+		uc             usecase.CreateTransferUseCase
+		req            *pb.CreateTransferRequest
+		want           *pb.Transfer
+		expectedCode   codes.Code
+		expectedReason string
+		expectedFields []string
+	}{
+		{
+			name: "Success create transfer",
+			uc: stubCreateTransferUseCase{
+				result: usecase.CreateTransferOutput{
+					ID:      "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+					PayerID: "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+					PayeeID: "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+					Value:   100,
+					Fee: &usecase.TransferFeeOutput{
+						PayerFee:    2,
+						Total:       2,
+						PayerDebit:  102,
+						PayeeCredit: 100,
+					},
+					Metadata:  map[string]string{"order_id": "1234"},
+					CreatedAt: "0001-01-01T00:00:00Z",
+				},
+			},
+			req: &pb.CreateTransferRequest{
+				PayerId:  "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+				PayeeId:  "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+				Value:    100,
+				Metadata: map[string]string{"order_id": "1234"},
+			},
+			want: &pb.Transfer{
+				Id:      "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayerId: "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+				PayeeId: "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+				Value:   100,
+				Fee: &pb.TransferFee{
+					PayerFee:    2,
+					Total:       2,
+					PayerDebit:  102,
+					PayeeCredit: 100,
+				},
+				Metadata:  map[string]string{"order_id": "1234"},
+				CreatedAt: "0001-01-01T00:00:00Z",
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "Error create transfer invalid input",
+			uc:   stubCreateTransferUseCase{},
+			req: &pb.CreateTransferRequest{
+				PayerId:           "0db298eb-c8e7-4829-84b7",
+				PayeeId:           "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+				Value:             -100,
+				ExternalReference: strings.Repeat("a", 65),
+			},
+			expectedCode:   codes.InvalidArgument,
+			expectedFields: []string{"payer_id", "value", "external_reference"},
+		},
+		{
+			name: "Error create transfer insufficient balance",
+			uc: stubCreateTransferUseCase{
+				err: entity.ErrUserInsufficientBalance,
+			},
+			req: &pb.CreateTransferRequest{
+				PayerId: "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+				PayeeId: "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+				Value:   100,
+			},
+			expectedCode:   codes.FailedPrecondition,
+			expectedReason: "user_insufficient_balance",
+		},
+		{
+			name: "Error create transfer duplicated external reference",
+			uc: stubCreateTransferUseCase{
+				err: entity.ErrDuplicateTransferReference,
+			},
+			req: &pb.CreateTransferRequest{
+				PayerId:           "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+				PayeeId:           "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+				Value:             100,
+				ExternalReference: "order-1234",
+			},
+			expectedCode:   codes.AlreadyExists,
+			expectedReason: "duplicate_transfer_reference",
+		},
+		{
+			name: "Error create transfer authorizer unavailable",
+			uc: stubCreateTransferUseCase{
+				err: entity.WrapError(entity.ErrAuthorizerUnavailable, context.DeadlineExceeded),
+			},
+			req: &pb.CreateTransferRequest{
+				PayerId: "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+				PayeeId: "0db298eb-c8e7-4829-84b7-c1036b4f0793",
+				Value:   100,
+			},
+			expectedCode:   codes.Unavailable,
+			expectedReason: "authorizer_unavailable",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var svc = NewTransferService(tt.uc, infralogger.Dummy{})
+
+			got, err := svc.CreateTransfer(context.Background(), tt.req)
+
+			if code := status.Code(err); code != tt.expectedCode {
+				t.Errorf("[TestCase '%s'] Got code: '%v' | Want code: '%v'", tt.name, code, tt.expectedCode)
+			}
+
+			var (
+				reason string
+				fields []string
+			)
+			for _, detail := range status.Convert(err).Details() {
+				switch d := detail.(type) {
+				case *errdetails.ErrorInfo:
+					reason = d.GetReason()
+				case *errdetails.BadRequest:
+					for _, v := range d.GetFieldViolations() {
+						fields = append(fields, v.GetField())
+					}
+				}
+			}
+
+			if reason != tt.expectedReason {
+				t.Errorf("[TestCase '%s'] Got reason: '%s' | Want reason: '%s'", tt.name, reason, tt.expectedReason)
+			}
+
+			if strings.Join(fields, ",") != strings.Join(tt.expectedFields, ",") {
+				t.Errorf("[TestCase '%s'] Got fields: '%v' | Want fields: '%v'", tt.name, fields, tt.expectedFields)
+			}
+
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
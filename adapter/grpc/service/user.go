@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/grpc/pb"
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// UserService defines the dependencies of the gRPC service of the users
+type UserService struct {
+	pb.UnimplementedUserServiceServer
+
+	ucCreate usecase.CreateUserUseCase
+	ucFind   usecase.FindUserByIDUseCase
+	log      logger.Logger
+}
+
+// NewUserService creates new UserService with its dependencies
+func NewUserService(ucCreate usecase.CreateUserUseCase, ucFind usecase.FindUserByIDUseCase, log logger.Logger) UserService {
+	return UserService{
+		ucCreate: ucCreate,
+		ucFind:   ucFind,
+		log:      log,
+	}
+}
+
+// CreateUser creates a user with an empty wallet
+func (u UserService) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.User, error) {
+	var log = logger.FromContext(ctx, u.log).WithFields(logger.Fields{"key": "create_user"})
+
+	input, violations := u.validateCreateUser(req)
+	if len(violations) > 0 {
+		err := invalidArgument(violations)
+		log.WithFields(logger.Fields{"error": err.Error()}).Errorf("invalid input")
+
+		return nil, err
+	}
+
+	output, err := u.ucCreate.Execute(ctx, input)
+	if err != nil {
+		log.WithFields(logger.Fields{
+			"error":     err.Error(),
+			"grpc_code": Code(err).String(),
+		}).Errorf("error when creating a new user")
+
+		return nil, statusError(err)
+	}
+
+	log.Infof("success creating user")
+
+	return &pb.User{
+		Id:       output.ID,
+		FullName: output.FullName,
+		Email:    output.Email,
+		Document: &pb.Document{Type: output.Document.Type, Value: output.Document.Value},
+		Wallet: &pb.Wallet{
+			Currency:  output.Wallet.Currency,
+			Amount:    output.Wallet.Amount,
+			Available: output.Wallet.Amount,
+		},
+		Roles:     &pb.Roles{CanTransfer: output.Roles.CanTransfer},
+		Type:      output.Type,
+		CreatedAt: output.CreatedAt,
+	}, nil
+}
+
+// FindUserByID finds a user with the balance of the wallet
+func (u UserService) FindUserByID(ctx context.Context, req *pb.FindUserByIDRequest) (*pb.User, error) {
+	var log = logger.FromContext(ctx, u.log).WithFields(logger.Fields{"key": "find_user_by_id"})
+
+	ID, err := vo.NewUuid(req.GetUserId())
+	if err != nil {
+		err := invalidArgument([]*errdetails.BadRequest_FieldViolation{fieldViolation("user_id", errors.New("invalid uuid"))})
+		log.WithFields(logger.Fields{"error": err.Error()}).Errorf("invalid uuid")
+
+		return nil, err
+	}
+
+	output, err := u.ucFind.Execute(ctx, usecase.FindUserByIDInput{ID: ID})
+	if err != nil {
+		log.WithFields(logger.Fields{
+			"error":     err.Error(),
+			"grpc_code": Code(err).String(),
+		}).Errorf("error fetching user by id")
+
+		return nil, statusError(err)
+	}
+
+	log.Infof("success when returning user by id")
+
+	return &pb.User{
+		Id:       output.ID,
+		FullName: output.FullName,
+		Email:    output.Email,
+		Document: &pb.Document{Type: output.Document.Type, Value: output.Document.Value},
+		Wallet: &pb.Wallet{
+			Currency:  output.Wallet.Currency,
+			Amount:    output.Wallet.Amount,
+			Held:      output.Wallet.Held,
+			Available: output.Wallet.Available,
+		},
+		Roles:     &pb.Roles{CanTransfer: output.Roles.CanTransfer},
+		Type:      output.Type,
+		KycLevel:  output.KYCLevel,
+		CreatedAt: output.CreatedAt,
+	}, nil
+}
+
+func (u UserService) validateCreateUser(req *pb.CreateUserRequest) (usecase.CreateUserInput, []*errdetails.BadRequest_FieldViolation) {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	id, err := vo.NewUuid(uuid.New().String())
+	if err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+	doc, err := vo.NewDocument(vo.TypeDocument(req.GetDocument().GetType()), req.GetDocument().GetValue())
+	if err != nil {
+		violations = append(violations, fieldViolation("document", err))
+	}
+	email, err := vo.NewEmail(req.GetEmail())
+	if err != nil {
+		violations = append(violations, fieldViolation("email", err))
+	}
+	currency, err := vo.NewCurrency(req.GetWallet().GetCurrency())
+	if err != nil {
+		violations = append(violations, fieldViolation("wallet.currency", err))
+	}
+	amount, err := vo.NewAmount(0)
+	if err != nil {
+		violations = append(violations, fieldViolation("wallet.amount", err))
+	}
+	typeUser, err := vo.NewTypeUser(req.GetType())
+	if err != nil {
+		violations = append(violations, fieldViolation("type", err))
+	}
+
+	return usecase.CreateUserInput{
+		ID:        id,
+		FullName:  vo.NewFullName(req.GetFullName()),
+		Document:  doc,
+		Email:     email,
+		Password:  vo.NewPassword(req.GetPassword()),
+		Wallet:    vo.NewWallet(vo.NewMoney(currency, amount)),
+		Type:      typeUser,
+		CreatedAt: time.Now(),
+	}, violations
+}
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/grpc/pb"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	infralogger "github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type stubCreateUserUseCase struct {
+	result usecase.CreateUserOutput
+	err    error
+}
+
+func (s stubCreateUserUseCase) Execute(_ context.Context, _ usecase.CreateUserInput) (usecase.CreateUserOutput, error) {
+	return s.result, s.err
+}
+
+type stubFindUserByIDUseCase struct {
+	result usecase.FindUserByIDOutput
+	err    error
+}
+
+func (s stubFindUserByIDUseCase) Execute(_ context.Context, _ usecase.FindUserByIDInput) (usecase.FindUserByIDOutput, error) {
+	return s.result, s.err
+}
+
+func TestUserService_CreateUser(t *testing.T) {
+	tests := []struct {
+		name         string
+		uc           usecase.CreateUserUseCase
+		req          *pb.CreateUserRequest
+		want         *pb.User
+		expectedCode codes.Code
+		expectedErr  string
+	}{
+		{
+			name: "Success create user",
+			uc: stubCreateUserUseCase{
+				result: usecase.CreateUserOutput{
+					ID:        "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+					FullName:  "Test testing",
+					Email:     "test@testing.com",
+					Document:  usecase.CreateUserDocumentOutput{Type: "CPF", Value: "07091054965"},
+					Wallet:    usecase.CreateUserWalletOutput{Currency: "BRL", Amount: 0},
+					Roles:     usecase.CreateUserRolesOutput{CanTransfer: true},
+					Type:      "COMMON",
+					CreatedAt: "0001-01-01T00:00:00Z",
+				},
+			},
+			req: &pb.CreateUserRequest{
+				FullName: "Test testing",
+				Email:    "test@testing.com",
+				Password: "passw123",
+				Document: &pb.Document{Type: "CPF", Value: "07091054965"},
+				Wallet:   &pb.Wallet{Currency: "BRL"},
+				Type:     "COMMON",
+			},
+			want: &pb.User{
+				Id:        "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				FullName:  "Test testing",
+				Email:     "test@testing.com",
+				Document:  &pb.Document{Type: "CPF", Value: "07091054965"},
+				Wallet:    &pb.Wallet{Currency: "BRL"},
+				Roles:     &pb.Roles{CanTransfer: true},
+				Type:      "COMMON",
+				CreatedAt: "0001-01-01T00:00:00Z",
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "Error create user invalid input",
+			uc:   stubCreateUserUseCase{},
+			req: &pb.CreateUserRequest{
+				Email:    "test",
+				Document: &pb.Document{Type: "CPF", Value: "07091054965"},
+				Wallet:   &pb.Wallet{Currency: "BRL"},
+				Type:     "COMMON",
+			},
+			expectedCode: codes.InvalidArgument,
+			expectedErr:  "invalid email",
+		},
+		{
+			name: "Error create user database failed",
+			uc: stubCreateUserUseCase{
+				err: errors.New("db_error"),
+			},
+			req: &pb.CreateUserRequest{
+				Email:    "test@testing.com",
+				Document: &pb.Document{Type: "CPF", Value: "07091054965"},
+				Wallet:   &pb.Wallet{Currency: "BRL"},
+				Type:     "COMMON",
+			},
+			expectedCode: codes.Internal,
+			expectedErr:  "db_error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var svc = NewUserService(tt.uc, stubFindUserByIDUseCase{}, infralogger.Dummy{})
+
+			got, err := svc.CreateUser(context.Background(), tt.req)
+
+			if code := status.Code(err); code != tt.expectedCode {
+				t.Errorf("[TestCase '%s'] Got code: '%v' | Want code: '%v'", tt.name, code, tt.expectedCode)
+			}
+
+			if err != nil && status.Convert(err).Message() != tt.expectedErr {
+				t.Errorf("[TestCase '%s'] Got error: '%v' | Want error: '%v'", tt.name, status.Convert(err).Message(), tt.expectedErr)
+			}
+
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserService_FindUserByID(t *testing.T) {
+	tests := []struct {
+		name           string
+		uc             usecase.FindUserByIDUseCase
+		req            *pb.FindUserByIDRequest
+		want           *pb.User
+		expectedCode   codes.Code
+		expectedReason string
+		expectedField  string
+	}{
+		{
+			name: "Success find user by id",
+			uc: stubFindUserByIDUseCase{
+				result: usecase.FindUserByIDOutput{
+					ID:       "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+					FullName: "Test testing",
+					Email:    "test@testing.com",
+					Document: usecase.FindUserByIDDocumentOutput{Type: "CPF", Value: "07091054965"},
+					Wallet: usecase.FindUserByIDWalletOutput{
+						Currency:  "BRL",
+						Amount:    100,
+						Held:      40,
+						Available: 60,
+					},
+					Roles:     usecase.FindUserByIDRolesOutput{CanTransfer: true},
+					Type:      "COMMON",
+					KYCLevel:  "BASIC",
+					CreatedAt: "0001-01-01T00:00:00Z",
+				},
+			},
+			req: &pb.FindUserByIDRequest{UserId: "0db298eb-c8e7-4829-84b7-c1036b4f0791"},
+			want: &pb.User{
+				Id:        "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				FullName:  "Test testing",
+				Email:     "test@testing.com",
+				Document:  &pb.Document{Type: "CPF", Value: "07091054965"},
+				Wallet:    &pb.Wallet{Currency: "BRL", Amount: 100, Held: 40, Available: 60},
+				Roles:     &pb.Roles{CanTransfer: true},
+				Type:      "COMMON",
+				KycLevel:  "BASIC",
+				CreatedAt: "0001-01-01T00:00:00Z",
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:          "Error find user by id invalid uuid",
+			uc:            stubFindUserByIDUseCase{},
+			req:           &pb.FindUserByIDRequest{UserId: "0db298eb-c8e7-4829-84b7"},
+			expectedCode:  codes.InvalidArgument,
+			expectedField: "user_id",
+		},
+		{
+			name: "Error find user by id not found",
+			uc: stubFindUserByIDUseCase{
+				err: entity.ErrNotFoundUser,
+			},
+			req:            &pb.FindUserByIDRequest{UserId: "0db298eb-c8e7-4829-84b7-c1036b4f0791"},
+			expectedCode:   codes.NotFound,
+			expectedReason: "not_found_user",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var svc = NewUserService(stubCreateUserUseCase{}, tt.uc, infralogger.Dummy{})
+
+			got, err := svc.FindUserByID(context.Background(), tt.req)
+
+			if code := status.Code(err); code != tt.expectedCode {
+				t.Errorf("[TestCase '%s'] Got code: '%v' | Want code: '%v'", tt.name, code, tt.expectedCode)
+			}
+
+			var (
+				reason string
+				field  string
+			)
+			for _, detail := range status.Convert(err).Details() {
+				switch d := detail.(type) {
+				case *errdetails.ErrorInfo:
+					reason = d.GetReason()
+				case *errdetails.BadRequest:
+					field = d.GetFieldViolations()[0].GetField()
+				}
+			}
+
+			if reason != tt.expectedReason || field != tt.expectedField {
+				t.Errorf(
+					"[TestCase '%s'] Got details: '%s' '%s' | Want details: '%s' '%s'",
+					tt.name,
+					reason,
+					field,
+					tt.expectedReason,
+					tt.expectedField,
+				)
+			}
+
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -3,27 +3,26 @@ package http
 import (
 	"context"
 	"encoding/json"
-	"os"
+	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
 	"github.com/GSabadini/golang-clean-architecture/domain/entity"
 	"github.com/GSabadini/golang-clean-architecture/usecase"
-	"github.com/pkg/errors"
 )
 
 const (
 	autorizado = "Autorizado"
 )
 
-var (
-	errAuthorizationDenied = errors.New("authorization denied")
-)
-
 type (
 	authorizer struct {
-		client HTTPGetter
-		log    logger.Logger
-		logKey string
+		client  HTTPGetter
+		uri     string
+		metrics usecase.AuthorizerMetrics
+		log     logger.Logger
+		logKey  string
 	}
 
 	authorizerResponse struct {
@@ -31,46 +30,77 @@ type (
 	}
 )
 
-// NewAuthorizer creates new authorizer with its dependencies
-func NewAuthorizer(client HTTPGetter, l logger.Logger) usecase.Authorizer {
+// NewAuthorizer creates new authorizer of the service at uri with its dependencies
+func NewAuthorizer(client HTTPGetter, uri string, metrics usecase.AuthorizerMetrics, l logger.Logger) usecase.Authorizer {
 	return authorizer{
-		client: client,
-		log:    l,
-		logKey: "send_authorized",
+		client:  client,
+		uri:     uri,
+		metrics: metrics,
+		log:     l,
+		logKey:  "send_authorized",
 	}
 }
 
-// Authorized authorizes a transfer
-func (a authorizer) Authorized(_ context.Context, _ entity.Transfer) (bool, error) {
-	res, err := a.client.Get(os.Getenv("AUTHORIZER_URI"))
+// Authorized authorizes a transfer, deposit or withdrawal. A 2xx response with any message other than Autorizado
+// is a denial and not an error, while an authorizer that can't be reached, answers a status other than 2xx or a
+// body that is not JSON is unavailable
+func (a authorizer) Authorized(ctx context.Context, _ entity.Authorizable) (bool, error) {
+	var (
+		start = time.Now()
+		log   = logger.FromContext(ctx, a.log)
+	)
+
+	res, err := a.client.Get(ctx, a.uri)
+	if res != nil && res.Body != nil {
+		defer res.Body.Close()
+	}
 	if err != nil {
-		a.log.WithFields(logger.Fields{
+		a.metrics.AuthorizationCompleted(usecase.AuthorizationUnavailable, time.Since(start))
+		log.WithFields(logger.Fields{
 			"key":   a.logKey,
 			"error": err.Error(),
 		}).Errorf("failed to client")
 
-		return false, errAuthorizationDenied
+		return false, entity.WrapError(entity.ErrAuthorizerUnavailable, err)
+	}
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		log.WithFields(logger.Fields{
+			"key":         a.logKey,
+			"http_status": res.StatusCode,
+		}).Errorf("unexpected status")
+
+		a.metrics.AuthorizationCompleted(usecase.AuthorizationUnavailable, time.Since(start))
+		return false, entity.WrapError(entity.ErrAuthorizerUnavailable, fmt.Errorf("unexpected status %d", res.StatusCode))
 	}
 
 	b := &authorizerResponse{}
 	err = json.NewDecoder(res.Body).Decode(&b)
 	if err != nil {
-		a.log.WithFields(logger.Fields{
+		log.WithFields(logger.Fields{
 			"key":   a.logKey,
 			"error": err.Error(),
 		}).Errorf("failed to marshal message")
 
-		return false, errAuthorizationDenied
+		a.metrics.AuthorizationCompleted(usecase.AuthorizationUnavailable, time.Since(start))
+		return false, entity.WrapError(entity.ErrAuthorizerUnavailable, err)
 	}
 
 	if b.Message != autorizado {
-		return false, errAuthorizationDenied
+		log.WithFields(logger.Fields{
+			"key":         a.logKey,
+			"http_status": res.StatusCode,
+		}).Infof("authorization denied")
+
+		a.metrics.AuthorizationCompleted(usecase.AuthorizationDenied, time.Since(start))
+		return false, nil
 	}
 
-	a.log.WithFields(logger.Fields{
+	log.WithFields(logger.Fields{
 		"key":         a.logKey,
 		"http_status": res.StatusCode,
 	}).Infof("success to authorized")
 
+	a.metrics.AuthorizationCompleted(usecase.AuthorizationGranted, time.Since(start))
 	return true, nil
 }
@@ -4,7 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
-	"io/ioutil"
+	"io"
 	"net/http"
 	"testing"
 
@@ -12,9 +12,25 @@ import (
 	"github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
 )
 
+type spyBody struct {
+	io.Reader
+	closed bool
+}
+
+func (s *spyBody) Close() error {
+	s.closed = true
+	return nil
+}
+
+func newSpyBody(body string) *spyBody {
+	return &spyBody{Reader: bytes.NewReader([]byte(body))}
+}
+
 func TestAuthorizer_Authorized(t *testing.T) {
 	type fields struct {
-		client HTTPGetter
+		status int
+		body   *spyBody
+		err    error
 	}
 	type args struct {
 		transfer entity.Transfer
@@ -24,72 +40,118 @@ func TestAuthorizer_Authorized(t *testing.T) {
 		fields  fields
 		args    args
 		want    bool
-		wantErr bool
+		wantErr error
+		outcome string
 	}{
 		{
 			name: "Test authorized success",
 			fields: fields{
-				client: stubHTTPGetter{
-					res: &http.Response{
-						Body: ioutil.NopCloser(
-							bytes.NewReader([]byte(`{"message":"Autorizado"}`)),
-						),
-					},
-					err: nil,
-				},
+				status: http.StatusOK,
+				body:   newSpyBody(`{"message":"Autorizado"}`),
 			},
 			args: args{
 				transfer: entity.Transfer{},
 			},
 			want:    true,
-			wantErr: false,
+			outcome: "authorized",
 		},
 		{
-			name: "Test authorized error response",
+			name: "Test authorized denied",
 			fields: fields{
-				client: stubHTTPGetter{
-					res: &http.Response{
-						Body: ioutil.NopCloser(
-							bytes.NewReader([]byte(`{"message":"fail"}`)),
-						),
-					},
-					err: nil,
-				},
+				status: http.StatusOK,
+				body:   newSpyBody(`{"message":"fail"}`),
 			},
 			args: args{
 				transfer: entity.Transfer{},
 			},
 			want:    false,
-			wantErr: true,
+			outcome: "denied",
 		},
 		{
 			name: "Test authorized error",
 			fields: fields{
-				client: stubHTTPGetter{
-					res: &http.Response{},
-					err: errors.New("failure client"),
-				},
+				err: errors.New("failure client"),
+			},
+			args: args{
+				transfer: entity.Transfer{},
+			},
+			want:    false,
+			wantErr: entity.ErrAuthorizerUnavailable,
+			outcome: "unavailable",
+		},
+		{
+			name: "Test authorized server error",
+			fields: fields{
+				status: http.StatusServiceUnavailable,
+				body:   newSpyBody(`{"message":"Autorizado"}`),
+			},
+			args: args{
+				transfer: entity.Transfer{},
+			},
+			want:    false,
+			wantErr: entity.ErrAuthorizerUnavailable,
+			outcome: "unavailable",
+		},
+		{
+			name: "Test authorized client error",
+			fields: fields{
+				status: http.StatusNotFound,
+				body:   newSpyBody(`not found`),
+			},
+			args: args{
+				transfer: entity.Transfer{},
+			},
+			want:    false,
+			wantErr: entity.ErrAuthorizerUnavailable,
+			outcome: "unavailable",
+		},
+		{
+			name: "Test authorized body not JSON",
+			fields: fields{
+				status: http.StatusOK,
+				body:   newSpyBody(`Autorizado`),
 			},
 			args: args{
 				transfer: entity.Transfer{},
 			},
 			want:    false,
-			wantErr: true,
+			wantErr: entity.ErrAuthorizerUnavailable,
+			outcome: "unavailable",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			a := NewAuthorizer(tt.fields.client, logger.Dummy{})
+			var (
+				metrics = &spyMetrics{}
+				client  = stubHTTPGetter{res: &http.Response{StatusCode: tt.fields.status}, err: tt.fields.err}
+			)
+			if tt.fields.body != nil {
+				client.res.Body = tt.fields.body
+			}
+
+			a := NewAuthorizer(client, "https://authorizer.test/authorize", metrics, logger.Dummy{})
 			got, err := a.Authorized(context.TODO(), tt.args.transfer)
-			if (err != nil) != tt.wantErr {
+
+			if len(metrics.outcomes) != 1 || metrics.outcomes[0] != tt.outcome {
+				t.Errorf("[TestCase '%s'] Got outcomes: '%v' | Want outcome: '%v'", tt.name, metrics.outcomes, tt.outcome)
+			}
+
+			if tt.fields.body != nil && !tt.fields.body.closed {
+				t.Errorf("[TestCase '%s'] Got body closed: '%v' | Want body closed: '%v'", tt.name, false, true)
+			}
+
+			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
 				return
 			}
 
+			if err != nil && entity.ErrorOf(err).Kind() != entity.KindUnavailable {
+				t.Errorf("[TestCase '%s'] Got kind: '%v' | Want kind: '%v'", tt.name, entity.ErrorOf(err).Kind(), entity.KindUnavailable)
+			}
+
 			if got != tt.want {
 				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
 			}
-
 		})
 	}
 }
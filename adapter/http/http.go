@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"net/http"
 )
 
@@ -12,8 +13,8 @@ type (
 
 	// HTTPGetter holds fields and dependencies for executing an http GET request
 	HTTPGetter interface {
-		// Get executes a GET http request
-		Get(url string) (*http.Response, error)
+		// Get executes a GET http request within the context
+		Get(ctx context.Context, url string) (*http.Response, error)
 	}
 )
 
@@ -24,6 +25,6 @@ type (
 	}
 )
 
-func (h stubHTTPGetter) Get(_ string) (*http.Response, error) {
+func (h stubHTTPGetter) Get(_ context.Context, _ string) (*http.Response, error) {
 	return h.res, h.err
 }
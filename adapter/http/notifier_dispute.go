@@ -0,0 +1,39 @@
+package http
+
+import (
+	"context"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/adapter/queue"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+// NewDisputeNotifier creates new notifier of the changes of the disputes to the service at uri with its dependencies
+func NewDisputeNotifier(
+	c HTTPGetter,
+	uri string,
+	p queue.Producer,
+	metrics usecase.NotifierMetrics,
+	l logger.Logger,
+) usecase.DisputeNotifier {
+	return notifier{
+		client:    c,
+		uri:       uri,
+		publisher: p,
+		metrics:   metrics,
+		log:       l,
+		logKey:    "send_dispute_notify",
+	}
+}
+
+// NotifyDispute send a notification of the change of a dispute to one of its parties
+func (n notifier) NotifyDispute(ctx context.Context, notice entity.DisputeNotice) {
+	n.send(ctx, map[string]string{
+		"notice":      string(notice.Kind()),
+		"dispute_id":  notice.Dispute().Value(),
+		"transfer_id": notice.Transfer().Value(),
+		"recipient":   notice.Recipient().Value(),
+		"status":      string(notice.Status()),
+	})
+}
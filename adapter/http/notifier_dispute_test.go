@@ -0,0 +1,113 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+)
+
+func TestNotifier_NotifyDispute(t *testing.T) {
+	var (
+		transferID, _ = vo.NewUuid("5c8e2a1f-4b7d-4e9a-8c3f-6d1b2e7a9f04")
+		payerID, _    = vo.NewUuid("7e1b3c5d-9a2f-4d6e-8b0c-1f3a5c7e9b2d")
+		transfer      = entity.NewTransfer(
+			transferID,
+			payerID,
+			vo.NewUuidStaticTest(),
+			vo.NewMoneyBRL(vo.NewAmountTest(70)),
+			time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC),
+		)
+		dispute   = entity.NewDispute(vo.NewUuidStaticTest(), transfer, "item not delivered", time.Date(2020, 11, 10, 0, 0, 0, 0, time.UTC))
+		lost, _   = dispute.Resolve(entity.DisputeLost, time.Date(2020, 11, 11, 0, 0, 0, 0, time.UTC))
+		opened    = dispute.Notices(entity.DisputeOpenedNotice)[0]
+		resolved  = lost.Notices(entity.DisputeResolvedNotice)[1]
+		sentRes   = &http.Response{Body: ioutil.NopCloser(bytes.NewReader([]byte(`{"message":"Enviado"}`)))}
+		failedRes = &http.Response{Body: ioutil.NopCloser(bytes.NewReader([]byte(`{"message":"error"}`)))}
+	)
+
+	tests := []struct {
+		name             string
+		client           HTTPGetter
+		notice           entity.DisputeNotice
+		expectedOutcomes []string
+		expectedMessage  map[string]string
+	}{
+		{
+			name:             "Test notify dispute success",
+			client:           stubHTTPGetter{res: sentRes},
+			notice:           opened,
+			expectedOutcomes: []string{"sent"},
+		},
+		{
+			name:             "Test notify dispute opened queues the notice",
+			client:           stubHTTPGetter{res: &http.Response{}, err: errors.New("failure client")},
+			notice:           opened,
+			expectedOutcomes: []string{"failed", "queued"},
+			expectedMessage: map[string]string{
+				"uri":         "https://notifier.test/notify",
+				"error":       "failure client",
+				"notice":      "DISPUTE_OPENED",
+				"dispute_id":  "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				"transfer_id": "5c8e2a1f-4b7d-4e9a-8c3f-6d1b2e7a9f04",
+				"recipient":   "7e1b3c5d-9a2f-4d6e-8b0c-1f3a5c7e9b2d",
+				"status":      "OPEN",
+			},
+		},
+		{
+			name:             "Test notify dispute resolved queues the notice with the outcome",
+			client:           stubHTTPGetter{res: failedRes},
+			notice:           resolved,
+			expectedOutcomes: []string{"failed", "queued"},
+			expectedMessage: map[string]string{
+				"uri":         "https://notifier.test/notify",
+				"error":       errFailedToNotify.Error(),
+				"notice":      "DISPUTE_RESOLVED",
+				"dispute_id":  "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				"transfer_id": "5c8e2a1f-4b7d-4e9a-8c3f-6d1b2e7a9f04",
+				"recipient":   "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				"status":      "LOST",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				spyProducer = &spyProducer{}
+				metrics     = &spyMetrics{}
+			)
+
+			n := NewDisputeNotifier(tt.client, "https://notifier.test/notify", spyProducer, metrics, logger.Dummy{})
+			n.NotifyDispute(context.TODO(), tt.notice)
+
+			if !reflect.DeepEqual(metrics.outcomes, tt.expectedOutcomes) {
+				t.Errorf("[TestCase '%s'] Got outcomes: '%v' | Want outcomes: '%v'", tt.name, metrics.outcomes, tt.expectedOutcomes)
+			}
+
+			if tt.expectedMessage == nil {
+				if spyProducer.invoked {
+					t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, spyProducer.invoked, false)
+				}
+				return
+			}
+
+			var got map[string]string
+			if err := json.Unmarshal(spyProducer.message, &got); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, tt.expectedMessage) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.expectedMessage)
+			}
+		})
+	}
+}
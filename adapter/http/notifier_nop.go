@@ -0,0 +1,18 @@
+package http
+
+import (
+	"context"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type nopNotifier struct{}
+
+// NewNopNotifier creates new notifier that discards the notifications, used when the caller notifies by itself
+func NewNopNotifier() usecase.Notifier {
+	return nopNotifier{}
+}
+
+// Notify does nothing
+func (n nopNotifier) Notify(_ context.Context, _ entity.Transfer) {}
@@ -3,7 +3,6 @@ package http
 import (
 	"context"
 	"encoding/json"
-	"os"
 
 	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
 	"github.com/GSabadini/golang-clean-architecture/adapter/queue"
@@ -23,7 +22,9 @@ var (
 type (
 	notifier struct {
 		client    HTTPGetter
+		uri       string
 		publisher queue.Producer
+		metrics   usecase.NotifierMetrics
 		log       logger.Logger
 		logKey    string
 	}
@@ -33,74 +34,106 @@ type (
 	}
 )
 
-// NewNotifier creates new notifier with its dependencies
-func NewNotifier(c HTTPGetter, p queue.Producer, l logger.Logger) usecase.Notifier {
+// NewNotifier creates new notifier of the service at uri with its dependencies
+func NewNotifier(c HTTPGetter, uri string, p queue.Producer, metrics usecase.NotifierMetrics, l logger.Logger) usecase.Notifier {
 	return notifier{
 		client:    c,
+		uri:       uri,
 		publisher: p,
+		metrics:   metrics,
 		log:       l,
 		logKey:    "send_notify",
 	}
 }
 
 // Notify send a notification
-func (n notifier) Notify(_ context.Context, _ entity.Transfer) {
-	res, err := n.client.Get(os.Getenv("NOTIFY_URI"))
+func (n notifier) Notify(ctx context.Context, _ entity.Transfer) {
+	n.send(ctx, nil)
+}
+
+// send requests the notification service, the notification failed is queued with its fields
+func (n notifier) send(ctx context.Context, fields map[string]string) {
+	log := logger.FromContext(ctx, n.log).WithFields(notificationFields(fields))
+
+	res, err := n.client.Get(ctx, n.uri)
 	if err != nil {
-		n.log.WithFields(logger.Fields{
+		log.WithFields(logger.Fields{
 			"key":   n.logKey,
 			"error": err.Error(),
 		}).Errorf("failed to client")
 
-		n.publish(err)
+		n.publish(ctx, err, fields)
 		return
 	}
 
 	b := &notifierResponse{}
 	err = json.NewDecoder(res.Body).Decode(&b)
 	if err != nil {
-		n.log.WithFields(logger.Fields{
+		log.WithFields(logger.Fields{
 			"key":   n.logKey,
 			"error": err.Error(),
 		}).Errorf("failed to marshal message")
 
-		n.publish(err)
+		n.publish(ctx, err, fields)
 		return
 	}
 
 	if b.Message != enviado {
-		n.publish(errFailedToNotify)
+		n.publish(ctx, errFailedToNotify, fields)
 		return
 	}
 
-	n.log.WithFields(logger.Fields{
+	n.metrics.NotificationCompleted(usecase.NotificationSent)
+
+	log.WithFields(logger.Fields{
 		"key":         n.logKey,
 		"http_status": res.StatusCode,
 	}).Infof("success to notify")
 }
 
-func (n notifier) publish(err error) {
-	message, err := json.Marshal(map[string]string{
-		"uri":   os.Getenv("NOTIFY_URI"),
+// publish queues the notification failed to be sent again later
+func (n notifier) publish(ctx context.Context, err error, fields map[string]string) {
+	log := logger.FromContext(ctx, n.log).WithFields(notificationFields(fields))
+
+	n.metrics.NotificationCompleted(usecase.NotificationFailed)
+
+	var payload = map[string]string{
+		"uri":   n.uri,
 		"error": err.Error(),
-	})
+	}
+	for key, value := range fields {
+		payload[key] = value
+	}
+
+	message, err := json.Marshal(payload)
 	if err != nil {
-		n.log.WithFields(logger.Fields{
+		log.WithFields(logger.Fields{
 			"key":   n.logKey,
 			"error": err.Error(),
 		}).Errorf("failed to marshal message")
 		return
 	}
 
-	if err := n.publisher.Publish(message); err != nil {
-		n.log.WithFields(logger.Fields{
+	if err := n.publisher.Publish(ctx, message); err != nil {
+		log.WithFields(logger.Fields{
 			"key":   n.logKey,
 			"error": err.Error(),
 		}).Errorf("failed to publish to the queue")
 		return
 	}
 
-	n.log.WithFields(logger.Fields{
+	n.metrics.NotificationCompleted(usecase.NotificationQueued)
+
+	log.WithFields(logger.Fields{
 		"key": n.logKey,
 	}).Infof("success to publish to the queue")
 }
+
+func notificationFields(fields map[string]string) logger.Fields {
+	var logFields = make(logger.Fields, len(fields))
+	for key, value := range fields {
+		logFields[key] = value
+	}
+
+	return logFields
+}
@@ -6,7 +6,9 @@ import (
 	"errors"
 	"io/ioutil"
 	"net/http"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/GSabadini/golang-clean-architecture/adapter/queue"
 	"github.com/GSabadini/golang-clean-architecture/domain/entity"
@@ -15,15 +17,29 @@ import (
 
 type spyProducer struct {
 	invoked bool
+	message []byte
 	err     error
 }
 
-func (s *spyProducer) Publish(_ []byte) error {
+func (s *spyProducer) Publish(_ context.Context, message []byte) error {
 	s.invoked = true
+	s.message = message
 
 	return s.err
 }
 
+type spyMetrics struct {
+	outcomes []string
+}
+
+func (s *spyMetrics) AuthorizationCompleted(outcome string, _ time.Duration) {
+	s.outcomes = append(s.outcomes, outcome)
+}
+
+func (s *spyMetrics) NotificationCompleted(outcome string) {
+	s.outcomes = append(s.outcomes, outcome)
+}
+
 func TestNotifier_Notify(t *testing.T) {
 	type fields struct {
 		client   HTTPGetter
@@ -38,6 +54,7 @@ func TestNotifier_Notify(t *testing.T) {
 		args             args
 		publishIsInvoked bool
 		publishErr       error
+		expectedOutcomes []string
 	}{
 		{
 			name: "Test notify success",
@@ -56,6 +73,7 @@ func TestNotifier_Notify(t *testing.T) {
 			},
 			publishIsInvoked: false,
 			publishErr:       nil,
+			expectedOutcomes: []string{"sent"},
 		},
 		{
 			name: "Test notify error response",
@@ -74,6 +92,7 @@ func TestNotifier_Notify(t *testing.T) {
 			},
 			publishIsInvoked: true,
 			publishErr:       nil,
+			expectedOutcomes: []string{"failed", "queued"},
 		},
 		{
 			name: "Test notify client error",
@@ -88,6 +107,7 @@ func TestNotifier_Notify(t *testing.T) {
 			},
 			publishIsInvoked: true,
 			publishErr:       nil,
+			expectedOutcomes: []string{"failed", "queued"},
 		},
 		{
 			name: "Test notify publish error",
@@ -102,6 +122,7 @@ func TestNotifier_Notify(t *testing.T) {
 			},
 			publishIsInvoked: true,
 			publishErr:       errors.New("failed publish"),
+			expectedOutcomes: []string{"failed"},
 		},
 	}
 	for _, tt := range tests {
@@ -110,9 +131,15 @@ func TestNotifier_Notify(t *testing.T) {
 				err: tt.publishErr,
 			}
 
-			n := NewNotifier(tt.fields.client, spyProducer, logger.Dummy{})
+			metrics := &spyMetrics{}
+
+			n := NewNotifier(tt.fields.client, "https://notifier.test/notify", spyProducer, metrics, logger.Dummy{})
 			n.Notify(context.TODO(), tt.args.t)
 
+			if !reflect.DeepEqual(metrics.outcomes, tt.expectedOutcomes) {
+				t.Errorf("[TestCase '%s'] Got outcomes: '%v' | Want outcomes: '%v'", tt.name, metrics.outcomes, tt.expectedOutcomes)
+			}
+
 			if tt.publishIsInvoked != spyProducer.invoked {
 				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'",
 					tt.name,
@@ -0,0 +1,55 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+// contextKey defines the keys of the values of a request kept in its context, unexported so that they are only
+// read and written by the functions below
+type contextKey int
+
+const (
+	loggerKey contextKey = iota
+	correlationIDKey
+	traceIDKey
+)
+
+// NewContext returns a copy of ctx carrying the logger of the request, also retrieved by the use cases with
+// usecase.LoggerFromContext
+func NewContext(ctx context.Context, log Logger) context.Context {
+	return usecase.ContextWithLogger(context.WithValue(ctx, loggerKey, log), log)
+}
+
+// FromContext returns the logger of the request, with its correlation id and trace id, or fallback when ctx
+// doesn't carry one
+func FromContext(ctx context.Context, fallback Logger) Logger {
+	if log, ok := ctx.Value(loggerKey).(Logger); ok {
+		return log
+	}
+
+	return fallback
+}
+
+// WithCorrelationID returns a copy of ctx carrying the correlation id of the request
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey, id)
+}
+
+// CorrelationID returns the correlation id of the request, empty when ctx doesn't carry one
+func CorrelationID(ctx context.Context) string {
+	id, _ := ctx.Value(correlationIDKey).(string)
+	return id
+}
+
+// WithTraceID returns a copy of ctx carrying the trace id of the request
+func WithTraceID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, traceIDKey, id)
+}
+
+// TraceID returns the trace id of the request, empty when ctx doesn't carry one
+func TraceID(ctx context.Context) string {
+	id, _ := ctx.Value(traceIDKey).(string)
+	return id
+}
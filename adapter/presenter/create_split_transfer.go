@@ -0,0 +1,34 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type createSplitTransferPresenter struct{}
+
+// NewCreateSplitTransferPresenter creates new createSplitTransferPresenter
+func NewCreateSplitTransferPresenter() usecase.CreateSplitTransferPresenter {
+	return createSplitTransferPresenter{}
+}
+
+// Output returns the split transfer creation response with the transfer to each payee
+func (c createSplitTransferPresenter) Output(s entity.SplitTransfer) usecase.CreateSplitTransferOutput {
+	var (
+		pre       = NewCreateTransferPresenter()
+		transfers = make([]usecase.CreateTransferOutput, 0, len(s.Transfers()))
+	)
+	for _, t := range s.Transfers() {
+		transfers = append(transfers, pre.Output(t))
+	}
+
+	return usecase.CreateSplitTransferOutput{
+		ID:        s.ID().Value(),
+		PayerID:   s.Payer().Value(),
+		Value:     s.Value().Amount().Value(),
+		Transfers: transfers,
+		CreatedAt: s.CreatedAt().Format(time.RFC3339),
+	}
+}
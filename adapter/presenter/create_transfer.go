@@ -16,10 +16,25 @@ func NewCreateTransferPresenter() usecase.CreateTransferPresenter {
 // Output returns the transfer creation response
 func (c createTransferPresenter) Output(t entity.Transfer) usecase.CreateTransferOutput {
 	return usecase.CreateTransferOutput{
-		ID:        t.ID().Value(),
-		PayerID:   t.Payer().Value(),
-		PayeeID:   t.Payee().Value(),
-		Value:     t.Value().Amount().Value(),
-		CreatedAt: t.CreatedAt().Format(time.RFC3339),
+		ID:                t.ID().Value(),
+		PayerID:           t.Payer().Value(),
+		PayeeID:           t.Payee().Value(),
+		Value:             t.Value().Amount().Value(),
+		Fee:               newTransferFeeOutput(t),
+		Description:       t.Details().Description(),
+		ExternalReference: t.Details().ExternalReference(),
+		Metadata:          t.Details().Metadata(),
+		CreatedAt:         t.CreatedAt().Format(time.RFC3339),
+	}
+}
+
+// newTransferFeeOutput returns the fee breakdown of the transfer
+func newTransferFeeOutput(t entity.Transfer) *usecase.TransferFeeOutput {
+	return &usecase.TransferFeeOutput{
+		PayerFee:    t.Fee().Payer().Amount().Value(),
+		PayeeFee:    t.Fee().Payee().Amount().Value(),
+		Total:       t.Fee().Total().Amount().Value(),
+		PayerDebit:  t.Debited().Amount().Value(),
+		PayeeCredit: t.Credited().Amount().Value(),
 	}
 }
@@ -31,13 +31,73 @@ func Test_createTransferPresenter_Output(t *testing.T) {
 				),
 			},
 			want: usecase.CreateTransferOutput{
-				ID:        "0db298eb-c8e7-4829-84b7-c1036b4f0791",
-				PayerID:   "0db298eb-c8e7-4829-84b7-c1036b4f0791",
-				PayeeID:   "0db298eb-c8e7-4829-84b7-c1036b4f0791",
-				Value:     100,
+				ID:      "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayerID: "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayeeID: "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:   100,
+				Fee: &usecase.TransferFeeOutput{
+					PayerDebit:  100,
+					PayeeCredit: 100,
+				},
 				CreatedAt: time.Time{}.Format(time.RFC3339),
 			},
 		},
+		{
+			name: "Create transfer output with fees",
+			args: args{
+				t: entity.NewTransfer(
+					vo.NewUuidStaticTest(),
+					vo.NewUuidStaticTest(),
+					vo.NewUuidStaticTest(),
+					vo.NewMoneyBRL(vo.NewAmountTest(100)),
+					time.Time{},
+				).WithFee(entity.NewFee(
+					vo.NewUuidStaticTest(),
+					vo.NewMoneyBRL(vo.NewAmountTest(1)),
+					vo.NewMoneyBRL(vo.NewAmountTest(3)),
+				)),
+			},
+			want: usecase.CreateTransferOutput{
+				ID:      "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayerID: "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayeeID: "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:   100,
+				Fee: &usecase.TransferFeeOutput{
+					PayerFee:    1,
+					PayeeFee:    3,
+					Total:       4,
+					PayerDebit:  101,
+					PayeeCredit: 97,
+				},
+				CreatedAt: time.Time{}.Format(time.RFC3339),
+			},
+		},
+		{
+			name: "Create transfer output with details",
+			args: args{
+				t: entity.NewTransfer(
+					vo.NewUuidStaticTest(),
+					vo.NewUuidStaticTest(),
+					vo.NewUuidStaticTest(),
+					vo.NewMoneyBRL(vo.NewAmountTest(100)),
+					time.Time{},
+				).WithDetails(entity.RestoreTransferDetails("Order #1234", "order-1234", map[string]string{"channel": "web"})),
+			},
+			want: usecase.CreateTransferOutput{
+				ID:      "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayerID: "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayeeID: "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:   100,
+				Fee: &usecase.TransferFeeOutput{
+					PayerDebit:  100,
+					PayeeCredit: 100,
+				},
+				Description:       "Order #1234",
+				ExternalReference: "order-1234",
+				Metadata:          map[string]string{"channel": "web"},
+				CreatedAt:         time.Time{}.Format(time.RFC3339),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,40 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type disputePresenter struct{}
+
+// NewDisputePresenter creates new disputePresenter
+func NewDisputePresenter() usecase.DisputePresenter {
+	return disputePresenter{}
+}
+
+// Output returns the dispute response with its evidence
+func (d disputePresenter) Output(dispute entity.Dispute) usecase.DisputeOutput {
+	var evidence = make([]usecase.DisputeEvidenceOutput, 0, len(dispute.Evidence()))
+	for _, e := range dispute.Evidence() {
+		evidence = append(evidence, usecase.DisputeEvidenceOutput{
+			SubmittedBy: e.SubmittedBy().Value(),
+			Metadata:    e.Metadata(),
+			SubmittedAt: e.SubmittedAt().Format(time.RFC3339),
+		})
+	}
+
+	return usecase.DisputeOutput{
+		ID:         dispute.ID().Value(),
+		TransferID: dispute.Transfer().Value(),
+		PayerID:    dispute.Payer().Value(),
+		PayeeID:    dispute.Payee().Value(),
+		Value:      dispute.Value().Amount().Value(),
+		Reason:     dispute.Reason(),
+		Status:     string(dispute.Status()),
+		Evidence:   evidence,
+		CreatedAt:  dispute.CreatedAt().Format(time.RFC3339),
+		UpdatedAt:  dispute.UpdatedAt().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,86 @@
+package presenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+func Test_disputePresenter_Output(t *testing.T) {
+	var newDispute = func(evidence []entity.Evidence) entity.Dispute {
+		return entity.RestoreDispute(
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewMoneyBRL(vo.NewAmountTest(100)),
+			"not recognized",
+			entity.DisputeUnderReview,
+			evidence,
+			time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, 11, 10, 0, 0, 0, 0, time.UTC),
+		)
+	}
+
+	tests := []struct {
+		name    string
+		dispute entity.Dispute
+		want    usecase.DisputeOutput
+	}{
+		{
+			name: "Dispute with evidence",
+			dispute: newDispute([]entity.Evidence{
+				entity.RestoreEvidence(
+					vo.NewUuidStaticTest(),
+					map[string]string{"type": "receipt"},
+					time.Date(2020, 11, 10, 0, 0, 0, 0, time.UTC),
+				),
+			}),
+			want: usecase.DisputeOutput{
+				ID:         "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				TransferID: "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayerID:    "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayeeID:    "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:      100,
+				Reason:     "not recognized",
+				Status:     "UNDER_REVIEW",
+				Evidence: []usecase.DisputeEvidenceOutput{
+					{
+						SubmittedBy: "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+						Metadata:    map[string]string{"type": "receipt"},
+						SubmittedAt: "2020-11-10T00:00:00Z",
+					},
+				},
+				CreatedAt: "2020-11-09T00:00:00Z",
+				UpdatedAt: "2020-11-10T00:00:00Z",
+			},
+		},
+		{
+			name:    "Dispute without evidence",
+			dispute: newDispute(nil),
+			want: usecase.DisputeOutput{
+				ID:         "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				TransferID: "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayerID:    "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayeeID:    "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:      100,
+				Reason:     "not recognized",
+				Status:     "UNDER_REVIEW",
+				Evidence:   []usecase.DisputeEvidenceOutput{},
+				CreatedAt:  "2020-11-09T00:00:00Z",
+				UpdatedAt:  "2020-11-10T00:00:00Z",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewDisputePresenter().Output(tt.dispute); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type (
+	escrowTransferPresenter struct{}
+
+	releaseEscrowTransfersPresenter struct{}
+)
+
+// NewEscrowTransferPresenter creates new escrowTransferPresenter
+func NewEscrowTransferPresenter() usecase.EscrowTransferPresenter {
+	return escrowTransferPresenter{}
+}
+
+// Output returns the escrow transfer response
+func (e escrowTransferPresenter) Output(escrow entity.EscrowTransfer) usecase.EscrowTransferOutput {
+	return usecase.EscrowTransferOutput{
+		ID:            escrow.ID().Value(),
+		PayerID:       escrow.Payer().Value(),
+		PayeeID:       escrow.Payee().Value(),
+		Value:         escrow.Value().Amount().Value(),
+		Status:        string(escrow.Status()),
+		DisputeReason: escrow.DisputeReason(),
+		ReleaseAt:     escrow.ReleaseAt().Format(time.RFC3339),
+		CreatedAt:     escrow.CreatedAt().Format(time.RFC3339),
+		UpdatedAt:     escrow.UpdatedAt().Format(time.RFC3339),
+	}
+}
+
+// NewReleaseEscrowTransfersPresenter creates new releaseEscrowTransfersPresenter
+func NewReleaseEscrowTransfersPresenter() usecase.ReleaseEscrowTransfersPresenter {
+	return releaseEscrowTransfersPresenter{}
+}
+
+// Output returns the escrow transfers released
+func (r releaseEscrowTransfersPresenter) Output(escrows []entity.EscrowTransfer) usecase.ReleaseEscrowTransfersOutput {
+	var IDs = make([]string, 0, len(escrows))
+	for _, escrow := range escrows {
+		IDs = append(IDs, escrow.ID().Value())
+	}
+
+	return usecase.ReleaseEscrowTransfersOutput{
+		Released: len(escrows),
+		IDs:      IDs,
+	}
+}
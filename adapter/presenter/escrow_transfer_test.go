@@ -0,0 +1,71 @@
+package presenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+func Test_escrowTransferPresenter_Output(t *testing.T) {
+	var newEscrow = func(status entity.TransferStatus, reason string) entity.EscrowTransfer {
+		return entity.RestoreEscrowTransfer(
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewMoneyBRL(vo.NewAmountTest(100)),
+			status,
+			reason,
+			time.Date(2020, 11, 23, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, 11, 10, 0, 0, 0, 0, time.UTC),
+		)
+	}
+
+	tests := []struct {
+		name   string
+		escrow entity.EscrowTransfer
+		want   usecase.EscrowTransferOutput
+	}{
+		{
+			name:   "Escrow transfer in escrow",
+			escrow: newEscrow(entity.EscrowHeld, ""),
+			want: usecase.EscrowTransferOutput{
+				ID:        "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayerID:   "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayeeID:   "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:     100,
+				Status:    "IN_ESCROW",
+				ReleaseAt: "2020-11-23T00:00:00Z",
+				CreatedAt: "2020-11-09T00:00:00Z",
+				UpdatedAt: "2020-11-10T00:00:00Z",
+			},
+		},
+		{
+			name:   "Disputed escrow transfer",
+			escrow: newEscrow(entity.EscrowDisputed, "item not delivered"),
+			want: usecase.EscrowTransferOutput{
+				ID:            "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayerID:       "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayeeID:       "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:         100,
+				Status:        "DISPUTED",
+				DisputeReason: "item not delivered",
+				ReleaseAt:     "2020-11-23T00:00:00Z",
+				CreatedAt:     "2020-11-09T00:00:00Z",
+				UpdatedAt:     "2020-11-10T00:00:00Z",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewEscrowTransferPresenter().Output(tt.escrow); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
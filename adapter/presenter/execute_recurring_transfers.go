@@ -0,0 +1,30 @@
+package presenter
+
+import (
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type executeRecurringTransfersPresenter struct{}
+
+// NewExecuteRecurringTransfersPresenter creates new executeRecurringTransfersPresenter
+func NewExecuteRecurringTransfersPresenter() usecase.ExecuteRecurringTransfersPresenter {
+	return executeRecurringTransfersPresenter{}
+}
+
+// Output returns the summary of the occurrences executed by a worker run
+func (e executeRecurringTransfersPresenter) Output(r usecase.ExecuteRecurringTransfersResult) usecase.ExecuteRecurringTransfersOutput {
+	var output = usecase.ExecuteRecurringTransfersOutput{
+		Executed: r.Executed,
+		Failed:   r.Failed,
+		Paused:   make([]string, 0),
+	}
+
+	for _, t := range r.Processed {
+		if t.Status() == entity.RecurrencePaused {
+			output.Paused = append(output.Paused, t.ID().Value())
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,39 @@
+package presenter
+
+import (
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type executeScheduledTransfersPresenter struct{}
+
+// NewExecuteScheduledTransfersPresenter creates new executeScheduledTransfersPresenter
+func NewExecuteScheduledTransfersPresenter() usecase.ExecuteScheduledTransfersPresenter {
+	return executeScheduledTransfersPresenter{}
+}
+
+// Output returns the summary of the scheduled transfers executed by a worker run
+func (e executeScheduledTransfersPresenter) Output(transfers []entity.ScheduledTransfer) usecase.ExecuteScheduledTransfersOutput {
+	var output = usecase.ExecuteScheduledTransfersOutput{
+		Failures: make([]usecase.ExecuteScheduledTransfersFailureOutput, 0),
+	}
+
+	for _, t := range transfers {
+		switch t.Status() {
+		case entity.TransferScheduled:
+			output.Retried++
+			continue
+		case entity.TransferExecuted:
+			output.Executed++
+			continue
+		}
+
+		output.Failed++
+		output.Failures = append(output.Failures, usecase.ExecuteScheduledTransfersFailureOutput{
+			ID:     t.ID().Value(),
+			Reason: t.FailureReason(),
+		})
+	}
+
+	return output
+}
@@ -24,13 +24,16 @@ func (f findUserByIDPresenter) Output(u entity.User) usecase.FindUserByIDOutput
 			Value: u.Document().Value(),
 		},
 		Wallet: usecase.FindUserByIDWalletOutput{
-			Currency: u.Wallet().Money().Currency().String(),
-			Amount:   u.Wallet().Money().Amount().Value(),
+			Currency:  u.Wallet().Money().Currency().String(),
+			Amount:    u.Wallet().Money().Amount().Value(),
+			Held:      u.Wallet().Held().Amount().Value(),
+			Available: u.Wallet().Available().Amount().Value(),
 		},
 		Roles: usecase.FindUserByIDRolesOutput{
 			CanTransfer: u.Roles().CanTransfer,
 		},
 		Type:      u.TypeUser().String(),
+		KYCLevel:  u.KYCLevel().String(),
 		CreatedAt: u.CreatedAt().Format(time.RFC3339),
 	}
 }
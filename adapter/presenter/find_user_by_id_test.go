@@ -41,13 +41,15 @@ func Test_findUserByIDPresenter_Output(t *testing.T) {
 					Value: "98.521.079/0001-09",
 				},
 				Wallet: usecase.FindUserByIDWalletOutput{
-					Currency: "BRL",
-					Amount:   100,
+					Currency:  "BRL",
+					Amount:    100,
+					Available: 100,
 				},
 				Roles: usecase.FindUserByIDRolesOutput{
 					CanTransfer: true,
 				},
 				Type:      "COMMON",
+				KYCLevel:  "BASIC",
 				CreatedAt: time.Time{}.Format(time.RFC3339),
 			},
 		},
@@ -73,13 +75,15 @@ func Test_findUserByIDPresenter_Output(t *testing.T) {
 					Value: "07091054965",
 				},
 				Wallet: usecase.FindUserByIDWalletOutput{
-					Currency: "BRL",
-					Amount:   100,
+					Currency:  "BRL",
+					Amount:    100,
+					Available: 100,
 				},
 				Roles: usecase.FindUserByIDRolesOutput{
 					CanTransfer: false,
 				},
 				Type:      "MERCHANT",
+				KYCLevel:  "BASIC",
 				CreatedAt: time.Time{}.Format(time.RFC3339),
 			},
 		},
@@ -0,0 +1,54 @@
+package presenter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type findUserEventsPresenter struct{}
+
+// NewFindUserEventsPresenter creates new findUserEventsPresenter
+func NewFindUserEventsPresenter() usecase.FindUserEventsPresenter {
+	return findUserEventsPresenter{}
+}
+
+// Output returns the user events response, omitting the password from the event data
+func (f findUserEventsPresenter) Output(ID vo.Uuid, events []entity.Event) usecase.FindUserEventsOutput {
+	var items = make([]usecase.FindUserEventsItemOutput, 0, len(events))
+	for _, event := range events {
+		items = append(items, usecase.FindUserEventsItemOutput{
+			Sequence:   event.Sequence(),
+			Type:       string(event.Type()),
+			Data:       redactEventData(event.Data()),
+			OccurredAt: event.OccurredAt().Format(time.RFC3339),
+		})
+	}
+
+	return usecase.FindUserEventsOutput{
+		ID:     ID.Value(),
+		Events: items,
+	}
+}
+
+func redactEventData(data []byte) json.RawMessage {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return json.RawMessage("{}")
+	}
+
+	if _, ok := fields["password"]; !ok {
+		return data
+	}
+	delete(fields, "password")
+
+	redacted, err := json.Marshal(fields)
+	if err != nil {
+		return json.RawMessage("{}")
+	}
+
+	return redacted
+}
@@ -0,0 +1,84 @@
+package presenter
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+func Test_findUserEventsPresenter_Output(t *testing.T) {
+	type args struct {
+		ID     vo.Uuid
+		events []entity.Event
+	}
+	tests := []struct {
+		name string
+		args args
+		want usecase.FindUserEventsOutput
+	}{
+		{
+			name: "Create find user events output without password",
+			args: args{
+				ID: vo.NewUuidStaticTest(),
+				events: []entity.Event{
+					entity.NewEvent(
+						vo.NewUuidStaticTest(),
+						entity.UserAggregate,
+						1,
+						entity.UserCreated,
+						[]byte(`{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0791","password":"passw"}`),
+						time.Time{},
+					),
+					entity.NewEvent(
+						vo.NewUuidStaticTest(),
+						entity.UserAggregate,
+						2,
+						entity.WalletCredited,
+						[]byte(`{"amount":100,"balance":200,"currency":"BRL"}`),
+						time.Time{},
+					),
+				},
+			},
+			want: usecase.FindUserEventsOutput{
+				ID: "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Events: []usecase.FindUserEventsItemOutput{
+					{
+						Sequence:   1,
+						Type:       "UserCreated",
+						Data:       json.RawMessage(`{"id":"0db298eb-c8e7-4829-84b7-c1036b4f0791"}`),
+						OccurredAt: time.Time{}.Format(time.RFC3339),
+					},
+					{
+						Sequence:   2,
+						Type:       "WalletCredited",
+						Data:       json.RawMessage(`{"amount":100,"balance":200,"currency":"BRL"}`),
+						OccurredAt: time.Time{}.Format(time.RFC3339),
+					},
+				},
+			},
+		},
+		{
+			name: "Create find user events output without events",
+			args: args{
+				ID: vo.NewUuidStaticTest(),
+			},
+			want: usecase.FindUserEventsOutput{
+				ID:     "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Events: []usecase.FindUserEventsItemOutput{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pre := NewFindUserEventsPresenter()
+			if got := pre.Output(tt.args.ID, tt.args.events); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
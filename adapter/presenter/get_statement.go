@@ -0,0 +1,40 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type getStatementPresenter struct{}
+
+// NewGetStatementPresenter creates new getStatementPresenter
+func NewGetStatementPresenter() usecase.GetStatementPresenter {
+	return getStatementPresenter{}
+}
+
+// Output returns the statement response
+func (g getStatementPresenter) Output(s entity.Statement) usecase.GetStatementOutput {
+	var entries = make([]usecase.GetStatementEntryOutput, 0, len(s.Entries()))
+	for _, entry := range s.Entries() {
+		entries = append(entries, usecase.GetStatementEntryOutput{
+			ReferenceID:  entry.ReferenceID().Value(),
+			Type:         string(entry.Type()),
+			Counterparty: entry.Counterparty().Value(),
+			Amount:       entry.Amount().Amount().Value(),
+			Balance:      entry.Balance().Amount().Value(),
+			CreatedAt:    entry.OccurredAt().Format(time.RFC3339),
+		})
+	}
+
+	return usecase.GetStatementOutput{
+		UserID:         s.UserID().Value(),
+		Currency:       s.Closing().Currency().String(),
+		From:           s.From().Format(time.RFC3339),
+		To:             s.To().Format(time.RFC3339),
+		OpeningBalance: s.Opening().Amount().Value(),
+		ClosingBalance: s.Closing().Amount().Value(),
+		Entries:        entries,
+	}
+}
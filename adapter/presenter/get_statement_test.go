@@ -0,0 +1,62 @@
+package presenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+func Test_getStatementPresenter_Output(t *testing.T) {
+	var (
+		userID, _  = vo.NewUuid("0db298eb-c8e7-4829-84b7-c1036b4f0791")
+		otherID, _ = vo.NewUuid("0db298eb-c8e7-4829-84b7-c1036b4f0792")
+		from       = time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC)
+		to         = time.Date(2020, 11, 30, 0, 0, 0, 0, time.UTC)
+	)
+
+	statement, err := entity.NewStatement(
+		userID,
+		vo.NewMoneyBRL(vo.NewAmountTest(70)),
+		from,
+		to,
+		[]entity.StatementEntry{
+			entity.NewStatementEntry(
+				vo.NewUuidStaticTest(),
+				otherID,
+				entity.Debit,
+				vo.NewMoneyBRL(vo.NewAmountTest(30)),
+				time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC),
+			),
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := usecase.GetStatementOutput{
+		UserID:         "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+		Currency:       "BRL",
+		From:           "2020-11-01T00:00:00Z",
+		To:             "2020-11-30T00:00:00Z",
+		OpeningBalance: 100,
+		ClosingBalance: 70,
+		Entries: []usecase.GetStatementEntryOutput{
+			{
+				ReferenceID:  "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Type:         "DEBIT",
+				Counterparty: "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+				Amount:       30,
+				Balance:      70,
+				CreatedAt:    "2020-11-09T00:00:00Z",
+			},
+		},
+	}
+
+	if got := NewGetStatementPresenter().Output(statement); !reflect.DeepEqual(got, want) {
+		t.Errorf("Got: '%+v' | Want: '%+v'", got, want)
+	}
+}
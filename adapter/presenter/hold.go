@@ -0,0 +1,71 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type (
+	holdPresenter struct{}
+
+	captureHoldPresenter struct {
+		holdPresenter
+	}
+
+	expireHoldsPresenter struct{}
+)
+
+// NewHoldPresenter creates new holdPresenter
+func NewHoldPresenter() usecase.HoldPresenter {
+	return holdPresenter{}
+}
+
+// Output returns the hold response
+func (h holdPresenter) Output(hold entity.Hold) usecase.HoldOutput {
+	return usecase.HoldOutput{
+		ID:        hold.ID().Value(),
+		PayerID:   hold.Payer().Value(),
+		PayeeID:   hold.Payee().Value(),
+		Value:     hold.Value().Amount().Value(),
+		Captured:  hold.Captured().Amount().Value(),
+		Status:    string(hold.Status()),
+		ExpiresAt: hold.ExpiresAt().Format(time.RFC3339),
+		CreatedAt: hold.CreatedAt().Format(time.RFC3339),
+		UpdatedAt: hold.UpdatedAt().Format(time.RFC3339),
+	}
+}
+
+// NewCaptureHoldPresenter creates new captureHoldPresenter
+func NewCaptureHoldPresenter() usecase.CaptureHoldPresenter {
+	return captureHoldPresenter{}
+}
+
+// Output returns the captured hold response with the transfer of the captured value
+func (c captureHoldPresenter) Output(hold entity.Hold, transfer usecase.CreateTransferOutput) usecase.HoldOutput {
+	var output = c.holdPresenter.Output(hold)
+	if hold.Status() == entity.HoldCaptured {
+		output.Transfer = &transfer
+	}
+
+	return output
+}
+
+// NewExpireHoldsPresenter creates new expireHoldsPresenter
+func NewExpireHoldsPresenter() usecase.ExpireHoldsPresenter {
+	return expireHoldsPresenter{}
+}
+
+// Output returns the holds expired
+func (e expireHoldsPresenter) Output(holds []entity.Hold) usecase.ExpireHoldsOutput {
+	var IDs = make([]string, 0, len(holds))
+	for _, hold := range holds {
+		IDs = append(IDs, hold.ID().Value())
+	}
+
+	return usecase.ExpireHoldsOutput{
+		Expired: len(holds),
+		IDs:     IDs,
+	}
+}
@@ -0,0 +1,76 @@
+package presenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+func Test_captureHoldPresenter_Output(t *testing.T) {
+	var (
+		newHold = func(status entity.HoldStatus, captured int64) entity.Hold {
+			return entity.RestoreHold(
+				vo.NewUuidStaticTest(),
+				vo.NewUuidStaticTest(),
+				vo.NewUuidStaticTest(),
+				vo.NewMoneyBRL(vo.NewAmountTest(100)),
+				vo.NewMoneyBRL(vo.NewAmountTest(captured)),
+				status,
+				time.Date(2020, 11, 16, 0, 0, 0, 0, time.UTC),
+				time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC),
+				time.Date(2020, 11, 10, 0, 0, 0, 0, time.UTC),
+			)
+		}
+		transfer = usecase.CreateTransferOutput{ID: "0db298eb-c8e7-4829-84b7-c1036b4f0791", Value: 60}
+	)
+
+	tests := []struct {
+		name     string
+		hold     entity.Hold
+		transfer usecase.CreateTransferOutput
+		want     usecase.HoldOutput
+	}{
+		{
+			name:     "Captured hold",
+			hold:     newHold(entity.HoldCaptured, 60),
+			transfer: transfer,
+			want: usecase.HoldOutput{
+				ID:        "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayerID:   "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayeeID:   "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:     100,
+				Captured:  60,
+				Status:    "CAPTURED",
+				Transfer:  &transfer,
+				ExpiresAt: "2020-11-16T00:00:00Z",
+				CreatedAt: "2020-11-09T00:00:00Z",
+				UpdatedAt: "2020-11-10T00:00:00Z",
+			},
+		},
+		{
+			name: "Active hold",
+			hold: newHold(entity.HoldActive, 0),
+			want: usecase.HoldOutput{
+				ID:        "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayerID:   "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayeeID:   "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:     100,
+				Status:    "ACTIVE",
+				ExpiresAt: "2020-11-16T00:00:00Z",
+				CreatedAt: "2020-11-09T00:00:00Z",
+				UpdatedAt: "2020-11-10T00:00:00Z",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewCaptureHoldPresenter().Output(tt.hold, tt.transfer); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package presenter
+
+import (
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type kycLevelPresenter struct{}
+
+// NewKYCLevelPresenter creates new kycLevelPresenter
+func NewKYCLevelPresenter() usecase.KYCLevelPresenter {
+	return kycLevelPresenter{}
+}
+
+// Output returns the KYC level of the user with its limits
+func (k kycLevelPresenter) Output(u entity.User, previous entity.KYCLevel) usecase.KYCLevelOutput {
+	var limits = u.KYCLevel().Limits()
+
+	return usecase.KYCLevelOutput{
+		UserID:        u.ID().Value(),
+		PreviousLevel: previous.String(),
+		Level:         u.KYCLevel().String(),
+		Limits: usecase.KYCLimitsOutput{
+			SingleTransfer: limits.SingleTransfer(),
+			DailyOutgoing:  limits.DailyOutgoing(),
+			WalletBalance:  limits.WalletBalance(),
+		},
+	}
+}
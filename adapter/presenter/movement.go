@@ -0,0 +1,35 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type movementPresenter struct{}
+
+// NewMovementPresenter creates new movementPresenter
+func NewMovementPresenter() usecase.MovementPresenter {
+	return movementPresenter{}
+}
+
+// Output returns the deposit, withdrawal or reversal response
+func (m movementPresenter) Output(movement entity.Movement) usecase.MovementOutput {
+	var reversedAt string
+	if !movement.ReversedAt().IsZero() {
+		reversedAt = movement.ReversedAt().Format(time.RFC3339)
+	}
+
+	return usecase.MovementOutput{
+		ID:                movement.ID().Value(),
+		UserID:            movement.UserID().Value(),
+		Type:              string(movement.Type()),
+		Currency:          movement.Value().Currency().String(),
+		Value:             movement.Value().Amount().Value(),
+		ExternalReference: movement.ExternalReference(),
+		Status:            string(movement.Status()),
+		CreatedAt:         movement.CreatedAt().Format(time.RFC3339),
+		ReversedAt:        reversedAt,
+	}
+}
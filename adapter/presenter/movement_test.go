@@ -0,0 +1,76 @@
+package presenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+func Test_movementPresenter_Output(t *testing.T) {
+	var userID, _ = vo.NewUuid("0db298eb-c8e7-4829-84b7-c1036b4f0792")
+
+	tests := []struct {
+		name     string
+		movement entity.Movement
+		want     usecase.MovementOutput
+	}{
+		{
+			name: "Completed deposit",
+			movement: entity.RestoreMovement(
+				vo.NewUuidStaticTest(),
+				userID,
+				entity.Deposit,
+				vo.NewMoneyBRL(vo.NewAmountTest(100)),
+				"bank-slip-0001",
+				entity.MovementCompleted,
+				time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC),
+				time.Time{},
+			),
+			want: usecase.MovementOutput{
+				ID:                "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				UserID:            "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+				Type:              "DEPOSIT",
+				Currency:          "BRL",
+				Value:             100,
+				ExternalReference: "bank-slip-0001",
+				Status:            "COMPLETED",
+				CreatedAt:         "2020-11-09T00:00:00Z",
+			},
+		},
+		{
+			name: "Reversed withdrawal",
+			movement: entity.RestoreMovement(
+				vo.NewUuidStaticTest(),
+				userID,
+				entity.Withdrawal,
+				vo.NewMoneyBRL(vo.NewAmountTest(100)),
+				"bank-transfer-0001",
+				entity.MovementReversed,
+				time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC),
+				time.Date(2020, 11, 10, 0, 0, 0, 0, time.UTC),
+			),
+			want: usecase.MovementOutput{
+				ID:                "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				UserID:            "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+				Type:              "WITHDRAWAL",
+				Currency:          "BRL",
+				Value:             100,
+				ExternalReference: "bank-transfer-0001",
+				Status:            "REVERSED",
+				CreatedAt:         "2020-11-09T00:00:00Z",
+				ReversedAt:        "2020-11-10T00:00:00Z",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewMovementPresenter().Output(tt.movement); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
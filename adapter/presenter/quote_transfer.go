@@ -0,0 +1,23 @@
+package presenter
+
+import (
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type quoteTransferPresenter struct{}
+
+// NewQuoteTransferPresenter creates new quoteTransferPresenter
+func NewQuoteTransferPresenter() usecase.QuoteTransferPresenter {
+	return quoteTransferPresenter{}
+}
+
+// Output returns the fee breakdown of a transfer not yet created
+func (q quoteTransferPresenter) Output(t entity.Transfer) usecase.QuoteTransferOutput {
+	return usecase.QuoteTransferOutput{
+		PayerID: t.Payer().Value(),
+		PayeeID: t.Payee().Value(),
+		Value:   t.Value().Amount().Value(),
+		Fee:     *newTransferFeeOutput(t),
+	}
+}
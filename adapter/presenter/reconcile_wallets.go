@@ -0,0 +1,42 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type reconcileWalletsPresenter struct{}
+
+// NewReconcileWalletsPresenter creates new reconcileWalletsPresenter
+func NewReconcileWalletsPresenter() usecase.ReconcileWalletsPresenter {
+	return reconcileWalletsPresenter{}
+}
+
+// Output returns the reconciliation report with every wallet that did not match
+func (r reconcileWalletsPresenter) Output(result usecase.ReconcileWalletsResult) usecase.ReconcileWalletsOutput {
+	var mismatches = make([]usecase.ReconcileWalletsItemOutput, 0)
+	for _, reconciliation := range result.Reconciliations {
+		if reconciliation.Status() == entity.ReconciliationMatched {
+			continue
+		}
+
+		mismatches = append(mismatches, usecase.ReconcileWalletsItemOutput{
+			UserID:     reconciliation.UserID().Value(),
+			Currency:   reconciliation.Currency().String(),
+			Expected:   reconciliation.Expected(),
+			Actual:     reconciliation.Actual(),
+			Difference: reconciliation.Difference(),
+			Status:     string(reconciliation.Status()),
+		})
+	}
+
+	return usecase.ReconcileWalletsOutput{
+		From:        result.From.Format(time.RFC3339),
+		RunAt:       result.RunAt.Format(time.RFC3339),
+		Incremental: result.Incremental,
+		Checked:     len(result.Reconciliations),
+		Mismatches:  mismatches,
+	}
+}
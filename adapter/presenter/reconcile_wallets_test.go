@@ -0,0 +1,60 @@
+package presenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+func Test_reconcileWalletsPresenter_Output(t *testing.T) {
+	var (
+		userID, _  = vo.NewUuid("0db298eb-c8e7-4829-84b7-c1036b4f0791")
+		otherID, _ = vo.NewUuid("0db298eb-c8e7-4829-84b7-c1036b4f0792")
+		initial    = vo.NewMoneyBRL(vo.NewAmountTest(100))
+	)
+
+	result := usecase.ReconcileWalletsResult{
+		From:        time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC),
+		RunAt:       time.Date(2020, 11, 2, 0, 0, 0, 0, time.UTC),
+		Incremental: true,
+		Reconciliations: []entity.Reconciliation{
+			entity.ReconcileWallet(
+				entity.NewWalletBalance(userID, vo.NewMoneyBRL(vo.NewAmountTest(100)), &initial),
+				100,
+				true,
+				entity.MovementTotals{},
+			),
+			entity.ReconcileWallet(
+				entity.NewWalletBalance(otherID, vo.NewMoneyBRL(vo.NewAmountTest(150)), &initial),
+				100,
+				true,
+				entity.MovementTotals{Received: 20},
+			),
+		},
+	}
+
+	want := usecase.ReconcileWalletsOutput{
+		From:        "2020-11-01T00:00:00Z",
+		RunAt:       "2020-11-02T00:00:00Z",
+		Incremental: true,
+		Checked:     2,
+		Mismatches: []usecase.ReconcileWalletsItemOutput{
+			{
+				UserID:     "0db298eb-c8e7-4829-84b7-c1036b4f0792",
+				Currency:   "BRL",
+				Expected:   120,
+				Actual:     150,
+				Difference: 30,
+				Status:     "MISMATCHED",
+			},
+		},
+	}
+
+	if got := NewReconcileWalletsPresenter().Output(result); !reflect.DeepEqual(got, want) {
+		t.Errorf("Got: '%+v' | Want: '%+v'", got, want)
+	}
+}
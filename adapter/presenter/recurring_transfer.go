@@ -0,0 +1,46 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type recurringTransferPresenter struct{}
+
+// NewRecurringTransferPresenter creates new recurringTransferPresenter
+func NewRecurringTransferPresenter() usecase.RecurringTransferPresenter {
+	return recurringTransferPresenter{}
+}
+
+// Output returns the recurring transfer response
+func (r recurringTransferPresenter) Output(t entity.RecurringTransfer) usecase.RecurringTransferOutput {
+	var output = usecase.RecurringTransferOutput{
+		ID:                  t.ID().Value(),
+		PayerID:             t.Payer().Value(),
+		PayeeID:             t.Payee().Value(),
+		Value:               t.Value().Amount().Value(),
+		Frequency:           string(t.Frequency()),
+		DayOfMonth:          t.DayOfMonth(),
+		StartAt:             t.StartAt().Format(time.RFC3339),
+		Count:               t.Count(),
+		Occurrences:         t.Occurrences(),
+		ConsecutiveFailures: t.ConsecutiveFailures(),
+		MaxFailures:         t.MaxFailures(),
+		LastFailureReason:   t.LastFailureReason(),
+		Status:              string(t.Status()),
+		CreatedAt:           t.CreatedAt().Format(time.RFC3339),
+		UpdatedAt:           t.UpdatedAt().Format(time.RFC3339),
+	}
+
+	if !t.EndAt().IsZero() {
+		output.EndAt = t.EndAt().Format(time.RFC3339)
+	}
+
+	if t.Status() == entity.RecurrenceActive {
+		output.NextRunAt = t.NextRunAt().Format(time.RFC3339)
+	}
+
+	return output
+}
@@ -0,0 +1,86 @@
+package presenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+func Test_recurringTransferPresenter_Output(t *testing.T) {
+	var newRecurringTransfer = func(status entity.RecurrenceStatus, occurrences int, endAt time.Time) entity.RecurringTransfer {
+		return entity.RestoreRecurringTransfer(
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewMoneyBRL(vo.NewAmountTest(100)),
+			entity.Monthly,
+			5,
+			time.Date(2020, 11, 9, 9, 0, 0, 0, time.UTC),
+			endAt,
+			0,
+			occurrences,
+			0,
+			3,
+			"",
+			status,
+			time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC),
+		)
+	}
+
+	tests := []struct {
+		name      string
+		recurring entity.RecurringTransfer
+		want      usecase.RecurringTransferOutput
+	}{
+		{
+			name:      "Active recurring transfer",
+			recurring: newRecurringTransfer(entity.RecurrenceActive, 1, time.Time{}),
+			want: usecase.RecurringTransferOutput{
+				ID:          "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayerID:     "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayeeID:     "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:       100,
+				Frequency:   "MONTHLY",
+				DayOfMonth:  5,
+				StartAt:     "2020-11-09T09:00:00Z",
+				Occurrences: 1,
+				NextRunAt:   "2021-01-05T09:00:00Z",
+				MaxFailures: 3,
+				Status:      "ACTIVE",
+				CreatedAt:   "2020-11-09T00:00:00Z",
+				UpdatedAt:   "2020-11-09T00:00:00Z",
+			},
+		},
+		{
+			name:      "Paused recurring transfer",
+			recurring: newRecurringTransfer(entity.RecurrencePaused, 1, time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)),
+			want: usecase.RecurringTransferOutput{
+				ID:          "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayerID:     "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayeeID:     "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:       100,
+				Frequency:   "MONTHLY",
+				DayOfMonth:  5,
+				StartAt:     "2020-11-09T09:00:00Z",
+				EndAt:       "2021-06-01T00:00:00Z",
+				Occurrences: 1,
+				MaxFailures: 3,
+				Status:      "PAUSED",
+				CreatedAt:   "2020-11-09T00:00:00Z",
+				UpdatedAt:   "2020-11-09T00:00:00Z",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewRecurringTransferPresenter().Output(tt.recurring); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
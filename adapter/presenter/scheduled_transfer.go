@@ -0,0 +1,32 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type scheduledTransferPresenter struct{}
+
+// NewScheduledTransferPresenter creates new scheduledTransferPresenter
+func NewScheduledTransferPresenter() usecase.ScheduledTransferPresenter {
+	return scheduledTransferPresenter{}
+}
+
+// Output returns the scheduled transfer response
+func (s scheduledTransferPresenter) Output(t entity.ScheduledTransfer) usecase.ScheduledTransferOutput {
+	return usecase.ScheduledTransferOutput{
+		ID:                t.ID().Value(),
+		PayerID:           t.Payer().Value(),
+		PayeeID:           t.Payee().Value(),
+		Value:             t.Value().Amount().Value(),
+		Description:       t.Details().Description(),
+		ExternalReference: t.Details().ExternalReference(),
+		Metadata:          t.Details().Metadata(),
+		ExecuteAt:         t.ExecuteAt().Format(time.RFC3339),
+		Status:            string(t.Status()),
+		FailureReason:     t.FailureReason(),
+		CreatedAt:         t.CreatedAt().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,68 @@
+package presenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+func Test_scheduledTransferPresenter_Output(t *testing.T) {
+	var newScheduledTransfer = func(status entity.TransferStatus, reason string) entity.ScheduledTransfer {
+		return entity.RestoreScheduledTransfer(
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewMoneyBRL(vo.NewAmountTest(100)),
+			time.Date(2020, 11, 10, 9, 0, 0, 0, time.UTC),
+			status,
+			reason,
+			time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, 11, 10, 9, 0, 0, 0, time.UTC),
+		)
+	}
+
+	tests := []struct {
+		name     string
+		transfer entity.ScheduledTransfer
+		want     usecase.ScheduledTransferOutput
+	}{
+		{
+			name:     "Scheduled transfer",
+			transfer: newScheduledTransfer(entity.TransferScheduled, ""),
+			want: usecase.ScheduledTransferOutput{
+				ID:        "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayerID:   "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayeeID:   "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:     100,
+				ExecuteAt: "2020-11-10T09:00:00Z",
+				Status:    "SCHEDULED",
+				CreatedAt: "2020-11-09T00:00:00Z",
+			},
+		},
+		{
+			name:     "Failed scheduled transfer",
+			transfer: newScheduledTransfer(entity.TransferFailed, "user does not have sufficient balance"),
+			want: usecase.ScheduledTransferOutput{
+				ID:            "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayerID:       "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayeeID:       "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:         100,
+				ExecuteAt:     "2020-11-10T09:00:00Z",
+				Status:        "FAILED",
+				FailureReason: "user does not have sufficient balance",
+				CreatedAt:     "2020-11-09T00:00:00Z",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewScheduledTransferPresenter().Output(tt.transfer); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package presenter
+
+import (
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type searchTransfersPresenter struct{}
+
+// NewSearchTransfersPresenter creates new searchTransfersPresenter
+func NewSearchTransfersPresenter() usecase.SearchTransfersPresenter {
+	return searchTransfersPresenter{}
+}
+
+// Output returns the transfers found, each one as the transfer creation response
+func (s searchTransfersPresenter) Output(transfers []entity.Transfer) usecase.SearchTransfersOutput {
+	var output = usecase.SearchTransfersOutput{Transfers: make([]usecase.CreateTransferOutput, 0, len(transfers))}
+	for _, transfer := range transfers {
+		output.Transfers = append(output.Transfers, createTransferPresenter{}.Output(transfer))
+	}
+
+	return output
+}
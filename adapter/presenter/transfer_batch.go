@@ -0,0 +1,65 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type (
+	transferBatchPresenter struct{}
+
+	resumeTransferBatchesPresenter struct{}
+)
+
+// NewTransferBatchPresenter creates new transferBatchPresenter
+func NewTransferBatchPresenter() usecase.TransferBatchPresenter {
+	return transferBatchPresenter{}
+}
+
+// Output returns the transfer batch response with the progress of its items
+func (t transferBatchPresenter) Output(batch entity.TransferBatch) usecase.TransferBatchOutput {
+	var items = make([]usecase.TransferBatchItemOutput, 0, len(batch.Items()))
+	for _, item := range batch.Items() {
+		items = append(items, usecase.TransferBatchItemOutput{
+			ID:            item.ID().Value(),
+			PayeeID:       item.Payee().Value(),
+			Value:         item.Value().Amount().Value(),
+			Status:        string(item.Status()),
+			FailureReason: item.FailureReason(),
+		})
+	}
+
+	return usecase.TransferBatchOutput{
+		ID:             batch.ID().Value(),
+		PayerID:        batch.Payer().Value(),
+		Mode:           string(batch.Mode()),
+		Status:         string(batch.Status()),
+		TotalItems:     len(batch.Items()),
+		ProcessedItems: batch.Processed(),
+		SucceededItems: batch.Succeeded(),
+		FailedItems:    batch.Failed(),
+		Items:          items,
+		CreatedAt:      batch.CreatedAt().Format(time.RFC3339),
+		UpdatedAt:      batch.UpdatedAt().Format(time.RFC3339),
+	}
+}
+
+// NewResumeTransferBatchesPresenter creates new resumeTransferBatchesPresenter
+func NewResumeTransferBatchesPresenter() usecase.ResumeTransferBatchesPresenter {
+	return resumeTransferBatchesPresenter{}
+}
+
+// Output returns the transfer batches resumed
+func (r resumeTransferBatchesPresenter) Output(batches []entity.TransferBatch) usecase.ResumeTransferBatchesOutput {
+	var IDs = make([]string, 0, len(batches))
+	for _, batch := range batches {
+		IDs = append(IDs, batch.ID().Value())
+	}
+
+	return usecase.ResumeTransferBatchesOutput{
+		Resumed: len(batches),
+		IDs:     IDs,
+	}
+}
@@ -0,0 +1,73 @@
+package presenter
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+func Test_transferBatchPresenter_Output(t *testing.T) {
+	var (
+		item = entity.NewTransferBatchItem(
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewMoneyBRL(vo.NewAmountTest(100)),
+		)
+		batch = entity.RestoreTransferBatch(
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			entity.BatchBestEffort,
+			[]entity.TransferBatchItem{
+				item.Succeeded(),
+				item.Failed(errors.New("user does not have sufficient balance")),
+				item,
+			},
+			entity.BatchProcessing,
+			time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, 11, 9, 0, 1, 0, 0, time.UTC),
+		)
+	)
+
+	want := usecase.TransferBatchOutput{
+		ID:             "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+		PayerID:        "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+		Mode:           "BEST_EFFORT",
+		Status:         "PROCESSING",
+		TotalItems:     3,
+		ProcessedItems: 2,
+		SucceededItems: 1,
+		FailedItems:    1,
+		Items: []usecase.TransferBatchItemOutput{
+			{
+				ID:      "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayeeID: "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:   100,
+				Status:  "SUCCEEDED",
+			},
+			{
+				ID:            "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayeeID:       "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:         100,
+				Status:        "FAILED",
+				FailureReason: "user does not have sufficient balance",
+			},
+			{
+				ID:      "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				PayeeID: "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:   100,
+				Status:  "PENDING",
+			},
+		},
+		CreatedAt: "2020-11-09T00:00:00Z",
+		UpdatedAt: "2020-11-09T00:01:00Z",
+	}
+
+	if got := NewTransferBatchPresenter().Output(batch); !reflect.DeepEqual(got, want) {
+		t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", "Batch in progress", got, want)
+	}
+}
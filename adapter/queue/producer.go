@@ -1,50 +1,110 @@
 package queue
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
 	"github.com/streadway/amqp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
-type producer struct {
-	channel   *amqp.Channel
-	queueName string
-	log       logger.Logger
-	logKey    string
-}
+type (
+	producer struct {
+		channel    *amqp.Channel
+		queueName  string
+		tracer     trace.Tracer
+		propagator propagation.TextMapPropagator
+		log        logger.Logger
+		logKey     string
+	}
+
+	// headersCarrier carries the trace context in the headers of a message
+	headersCarrier amqp.Table
+)
 
 // NewProducer creates new producer with its dependencies
-func NewProducer(ch *amqp.Channel, qn string, l logger.Logger) Producer {
+func NewProducer(ch *amqp.Channel, qn string, tracer trace.Tracer, l logger.Logger) Producer {
 	return producer{
-		channel:   ch,
-		queueName: qn,
-		log:       l,
-		logKey:    "queue_producer",
+		channel:    ch,
+		queueName:  qn,
+		tracer:     tracer,
+		propagator: propagation.TraceContext{},
+		log:        l,
+		logKey:     "queue_producer",
 	}
 }
 
-// Publish sends a Publishing from the client to an exchange on the server
-func (p producer) Publish(message []byte) error {
+// Publish sends a Publishing from the client to an exchange on the server, the trace context is sent in the
+// traceparent header so that the consumer continues the trace
+func (p producer) Publish(ctx context.Context, message []byte) error {
+	ctx, span := p.tracer.Start(
+		ctx,
+		fmt.Sprintf("%s send", p.queueName),
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "rabbitmq"),
+			attribute.String("messaging.destination", p.queueName),
+		),
+	)
+	defer span.End()
+
+	var (
+		log     = logger.FromContext(ctx, p.log)
+		headers = amqp.Table{}
+	)
+	p.propagator.Inject(ctx, headersCarrier(headers))
+
 	if err := p.channel.Publish(
 		"",
 		p.queueName,
 		false,
 		false,
 		amqp.Publishing{
-			Headers:     amqp.Table{},
+			Headers:     headers,
 			ContentType: "text/plain",
 			Body:        message,
 		}); err != nil {
-		p.log.WithFields(logger.Fields{
-			"key":   p.logKey,
-			"error": err.Error(),
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		log.WithFields(logger.Fields{
+			"key":      p.logKey,
+			"trace_id": span.SpanContext().TraceID().String(),
+			"error":    err.Error(),
 		}).Errorf("failed to publish message: %s", message)
 
 		return err
 	}
 
-	p.log.WithFields(logger.Fields{
-		"key": p.logKey,
+	log.WithFields(logger.Fields{
+		"key":      p.logKey,
+		"trace_id": span.SpanContext().TraceID().String(),
 	}).Infof("new message publish: %s", message)
 
 	return nil
 }
+
+// Get returns the value of the header, empty when missing or not a string
+func (c headersCarrier) Get(key string) string {
+	value, _ := c[key].(string)
+	return value
+}
+
+// Set sets the value of the header
+func (c headersCarrier) Set(key string, value string) {
+	c[key] = value
+}
+
+// Keys returns the names of the headers
+func (c headersCarrier) Keys() []string {
+	var keys = make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
@@ -1,9 +1,11 @@
 package queue
 
+import "context"
+
 type (
 	// Producer port
 	Producer interface {
-		Publish([]byte) error
+		Publish(context.Context, []byte) error
 	}
 
 	// Consumer port
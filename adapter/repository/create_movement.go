@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+)
+
+type (
+	// Bson data
+	createMovementBSON struct {
+		ID                string    `bson:"id"`
+		UserID            string    `bson:"user_id"`
+		Type              string    `bson:"type"`
+		Currency          string    `bson:"currency"`
+		Value             int64     `bson:"value"`
+		ExternalReference string    `bson:"external_reference"`
+		Status            string    `bson:"status"`
+		CreatedAt         time.Time `bson:"created_at"`
+	}
+
+	createMovementRepository struct {
+		handler    *database.MongoHandler
+		collection string
+	}
+)
+
+// NewCreateMovementRepository creates new createMovementRepository with its dependencies
+func NewCreateMovementRepository(handler *database.MongoHandler) entity.MovementRepositoryCreator {
+	return createMovementRepository{
+		handler:    handler,
+		collection: "movements",
+	}
+}
+
+// Create performs insertOne into the database, the external reference is unique
+func (c createMovementRepository) Create(ctx context.Context, m entity.Movement) (entity.Movement, error) {
+	var bson = createMovementBSON{
+		ID:                m.ID().Value(),
+		UserID:            m.UserID().Value(),
+		Type:              string(m.Type()),
+		Currency:          m.Value().Currency().String(),
+		Value:             m.Value().Amount().Value(),
+		ExternalReference: m.ExternalReference(),
+		Status:            string(m.Status()),
+		CreatedAt:         m.CreatedAt(),
+	}
+
+	if _, err := c.handler.Db().Collection(c.collection).InsertOne(ctx, bson); err != nil {
+		if database.IsDuplicateKeyError(err) {
+			return entity.Movement{}, entity.WrapError(entity.ErrCreateMovement, entity.ErrDuplicateExternalReference)
+		}
+
+		return entity.Movement{}, entity.WrapError(entity.ErrCreateMovement, err)
+	}
+
+	return m, nil
+}
+
+// WithTransaction runs fn inside a transaction
+func (c createMovementRepository) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return withTransaction(ctx, c.handler, fn)
+}
@@ -2,22 +2,28 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/GSabadini/golang-clean-architecture/domain/entity"
 	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
-	"github.com/pkg/errors"
-
-	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type (
 	// Bson Data
 	createTransferBSON struct {
-		ID        string `bson:"id"`
-		PayerID   string `bson:"payer"`
-		PayeeID   string `bson:"payee"`
-		Value     int64  `bson:"value"`
-		CreatedAt string `bson:"created_at"`
+		ID                string            `bson:"id"`
+		PayerID           string            `bson:"payer"`
+		PayeeID           string            `bson:"payee"`
+		Currency          string            `bson:"currency"`
+		Value             int64             `bson:"value"`
+		PayerFee          int64             `bson:"payer_fee,omitempty"`
+		PayeeFee          int64             `bson:"payee_fee,omitempty"`
+		FeeAccount        string            `bson:"fee_account,omitempty"`
+		Description       string            `bson:"description,omitempty"`
+		ExternalReference string            `bson:"external_reference,omitempty"`
+		Metadata          map[string]string `bson:"metadata,omitempty"`
+		RefundOf          string            `bson:"refund_of,omitempty"`
+		CreatedAt         time.Time         `bson:"created_at"`
 	}
 
 	createTransferRepository struct {
@@ -37,39 +43,43 @@ func NewCreateTransferRepository(handler *database.MongoHandler) entity.Transfer
 // Create performs insertOne into the database
 func (c createTransferRepository) Create(ctx context.Context, t entity.Transfer) (entity.Transfer, error) {
 	var bson = createTransferBSON{
-		ID:        t.ID().Value(),
-		PayerID:   t.Payer().Value(),
-		PayeeID:   t.Payee().Value(),
-		Value:     t.Value().Amount().Value(),
-		CreatedAt: t.CreatedAt().String(),
+		ID:                t.ID().Value(),
+		PayerID:           t.Payer().Value(),
+		PayeeID:           t.Payee().Value(),
+		Currency:          t.Value().Currency().String(),
+		Value:             t.Value().Amount().Value(),
+		Description:       t.Details().Description(),
+		ExternalReference: t.Details().ExternalReference(),
+		Metadata:          t.Details().Metadata(),
+		CreatedAt:         t.CreatedAt(),
 	}
 
-	if _, err := c.handler.Db().Collection(c.collection).InsertOne(ctx, bson); err != nil {
-		return entity.Transfer{}, errors.Wrap(err, entity.ErrCreateTransfer.Error())
+	if fee := t.Fee(); !fee.IsZero() {
+		bson.PayerFee = fee.Payer().Amount().Value()
+		bson.PayeeFee = fee.Payee().Amount().Value()
+		bson.FeeAccount = fee.Account().Value()
 	}
 
-	return t, nil
-}
+	if t.IsRefund() {
+		bson.RefundOf = t.RefundOf().Value()
+	}
 
-func (c createTransferRepository) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
-	callback := func(sessCtx mongo.SessionContext) (interface{}, error) {
-		err := fn(sessCtx)
-		if err != nil {
-			return nil, err
+	if _, err := c.handler.Db().Collection(c.collection).InsertOne(ctx, bson); err != nil {
+		if database.IsDuplicateKeyErrorOn(err, "payee_external_reference_unique") {
+			return entity.Transfer{}, entity.WrapError(entity.ErrCreateTransfer, entity.ErrDuplicateTransferReference)
 		}
-		return nil, nil
-	}
 
-	session, err := c.handler.Client().StartSession()
-	if err != nil {
-		return err
-	}
-	defer session.EndSession(ctx)
+		if database.IsDuplicateKeyError(err) {
+			return entity.Transfer{}, entity.WrapError(entity.ErrCreateTransfer, entity.ErrDuplicateTransfer)
+		}
 
-	_, err = session.WithTransaction(ctx, callback)
-	if err != nil {
-		return err
+		return entity.Transfer{}, entity.WrapError(entity.ErrCreateTransfer, err)
 	}
 
-	return nil
+	return t, nil
+}
+
+// WithTransaction runs fn inside a transaction
+func (c createTransferRepository) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return withTransaction(ctx, c.handler, fn)
 }
@@ -6,7 +6,6 @@ import (
 
 	"github.com/GSabadini/golang-clean-architecture/domain/entity"
 	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
-	"github.com/pkg/errors"
 )
 
 type (
@@ -20,6 +19,7 @@ type (
 		Wallet    createUserWalletBSON   `bson:"wallet"`
 		Roles     createUserRolesBSON    `bson:"roles"`
 		Type      string                 `bson:"type"`
+		KYCLevel  string                 `bson:"kyc_level"`
 		CreatedAt time.Time              `bson:"created_at"`
 	}
 
@@ -31,8 +31,9 @@ type (
 
 	// Bson data
 	createUserWalletBSON struct {
-		Currency string `bson:"currency"`
-		Amount   int64  `bson:"amount"`
+		Currency      string `bson:"currency"`
+		Amount        int64  `bson:"amount"`
+		InitialAmount int64  `bson:"initial_amount"`
 	}
 
 	// Bson data
@@ -66,15 +67,20 @@ func (c createUserRepository) Create(ctx context.Context, u entity.User) (entity
 		Email:    u.Email().Value(),
 		Password: u.Password().Value(),
 		Wallet: createUserWalletBSON{
-			Currency: u.Wallet().Money().Currency().String(),
-			Amount:   u.Wallet().Money().Amount().Value(),
+			Currency:      u.Wallet().Money().Currency().String(),
+			Amount:        u.Wallet().Money().Amount().Value(),
+			InitialAmount: u.Wallet().Money().Amount().Value(),
+		},
+		Roles: createUserRolesBSON{
+			CanTransfer: u.Roles().CanTransfer,
 		},
 		Type:      u.TypeUser().String(),
+		KYCLevel:  u.KYCLevel().String(),
 		CreatedAt: u.CreatedAt(),
 	}
 
 	if _, err := c.handler.Db().Collection(c.collection).InsertOne(ctx, bson); err != nil {
-		return entity.User{}, errors.Wrap(err, entity.ErrCreateUser.Error())
+		return entity.User{}, entity.WrapError(entity.ErrCreateUser, err)
 	}
 
 	return u, nil
@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type (
+	// Bson data
+	disputeBSON struct {
+		ID         string         `bson:"id"`
+		TransferID string         `bson:"transfer_id"`
+		PayerID    string         `bson:"payer"`
+		PayeeID    string         `bson:"payee"`
+		Currency   string         `bson:"currency"`
+		Value      int64          `bson:"value"`
+		Reason     string         `bson:"reason"`
+		Status     string         `bson:"status"`
+		Evidence   []evidenceBSON `bson:"evidence"`
+		CreatedAt  time.Time      `bson:"created_at"`
+		UpdatedAt  time.Time      `bson:"updated_at"`
+	}
+
+	// Bson data
+	evidenceBSON struct {
+		SubmittedBy string            `bson:"submitted_by"`
+		Metadata    map[string]string `bson:"metadata"`
+		SubmittedAt time.Time         `bson:"submitted_at"`
+	}
+
+	disputeRepository struct {
+		handler    *database.MongoHandler
+		collection string
+	}
+)
+
+// NewDisputeRepository creates new disputeRepository with its dependencies
+func NewDisputeRepository(handler *database.MongoHandler) entity.DisputeRepository {
+	return disputeRepository{
+		handler:    handler,
+		collection: "disputes",
+	}
+}
+
+// Create performs insertOne into the database, a transfer has a single dispute
+func (d disputeRepository) Create(ctx context.Context, dispute entity.Dispute) (entity.Dispute, error) {
+	var bson = disputeBSON{
+		ID:         dispute.ID().Value(),
+		TransferID: dispute.Transfer().Value(),
+		PayerID:    dispute.Payer().Value(),
+		PayeeID:    dispute.Payee().Value(),
+		Currency:   dispute.Value().Currency().String(),
+		Value:      dispute.Value().Amount().Value(),
+		Reason:     dispute.Reason(),
+		Status:     string(dispute.Status()),
+		Evidence:   newEvidenceBSON(dispute.Evidence()),
+		CreatedAt:  dispute.CreatedAt(),
+		UpdatedAt:  dispute.UpdatedAt(),
+	}
+
+	if _, err := d.handler.Db().Collection(d.collection).InsertOne(ctx, bson); err != nil {
+		if database.IsDuplicateKeyError(err) {
+			return entity.Dispute{}, entity.WrapError(entity.ErrCreateDispute, entity.ErrTransferAlreadyDisputed)
+		}
+
+		return entity.Dispute{}, entity.WrapError(entity.ErrCreateDispute, err)
+	}
+
+	return dispute, nil
+}
+
+// FindByID performs findOne into the database
+func (d disputeRepository) FindByID(ctx context.Context, ID vo.Uuid) (entity.Dispute, error) {
+	var disputeBSON = &disputeBSON{}
+
+	err := d.handler.Db().Collection(d.collection).
+		FindOne(ctx, bson.M{"id": ID.Value()}).
+		Decode(disputeBSON)
+	if err != nil {
+		switch err {
+		case mongo.ErrNoDocuments:
+			return entity.Dispute{}, entity.ErrNotFoundDispute
+		default:
+			return entity.Dispute{}, entity.WrapError(entity.ErrFindDispute, err)
+		}
+	}
+
+	return disputeBSON.entity()
+}
+
+// Update performs updateOne into the database, only a dispute still in the status from is updated so concurrent
+// requests never review or resolve the same dispute twice
+func (d disputeRepository) Update(ctx context.Context, from entity.DisputeStatus, dispute entity.Dispute) error {
+	var (
+		query  = bson.M{"id": dispute.ID().Value(), "status": string(from)}
+		update = bson.M{"$set": bson.M{
+			"status":     string(dispute.Status()),
+			"updated_at": dispute.UpdatedAt(),
+		}}
+	)
+
+	return d.updateOne(ctx, query, update)
+}
+
+// AddEvidence performs updateOne into the database appending the evidence, only to a dispute not resolved
+// and below the maximum number of evidences
+func (d disputeRepository) AddEvidence(ctx context.Context, dispute entity.Dispute, evidence entity.Evidence) error {
+	var (
+		query = bson.M{
+			"id":     dispute.ID().Value(),
+			"status": bson.M{"$in": bson.A{string(entity.DisputeOpen), string(entity.DisputeUnderReview)}},
+			fmt.Sprintf("evidence.%d", entity.MaxDisputeEvidence-1): bson.M{"$exists": false},
+		}
+		update = bson.M{
+			"$push": bson.M{"evidence": newEvidenceBSON([]entity.Evidence{evidence})[0]},
+			"$set":  bson.M{"updated_at": evidence.SubmittedAt()},
+		}
+	)
+
+	return d.updateOne(ctx, query, update)
+}
+
+func (d disputeRepository) updateOne(ctx context.Context, query bson.M, update bson.M) error {
+	result, err := d.handler.Db().Collection(d.collection).UpdateOne(ctx, query, update)
+	if err != nil {
+		return entity.WrapError(entity.ErrUpdateDispute, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return entity.ErrDisputeChanged
+	}
+
+	return nil
+}
+
+// WithTransaction runs fn inside a transaction
+func (d disputeRepository) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return withTransaction(ctx, d.handler, fn)
+}
+
+func newEvidenceBSON(evidence []entity.Evidence) []evidenceBSON {
+	var result = make([]evidenceBSON, 0, len(evidence))
+	for _, e := range evidence {
+		result = append(result, evidenceBSON{
+			SubmittedBy: e.SubmittedBy().Value(),
+			Metadata:    e.Metadata(),
+			SubmittedAt: e.SubmittedAt(),
+		})
+	}
+
+	return result
+}
+
+func (d disputeBSON) entity() (entity.Dispute, error) {
+	ID, err := vo.NewUuid(d.ID)
+	if err != nil {
+		return entity.Dispute{}, err
+	}
+
+	transferID, err := vo.NewUuid(d.TransferID)
+	if err != nil {
+		return entity.Dispute{}, err
+	}
+
+	payerID, err := vo.NewUuid(d.PayerID)
+	if err != nil {
+		return entity.Dispute{}, err
+	}
+
+	payeeID, err := vo.NewUuid(d.PayeeID)
+	if err != nil {
+		return entity.Dispute{}, err
+	}
+
+	currency, err := vo.NewCurrency(d.Currency)
+	if err != nil {
+		return entity.Dispute{}, err
+	}
+
+	value, err := vo.NewAmount(d.Value)
+	if err != nil {
+		return entity.Dispute{}, err
+	}
+
+	var evidence = make([]entity.Evidence, 0, len(d.Evidence))
+	for _, e := range d.Evidence {
+		submittedBy, err := vo.NewUuid(e.SubmittedBy)
+		if err != nil {
+			return entity.Dispute{}, err
+		}
+
+		evidence = append(evidence, entity.RestoreEvidence(submittedBy, e.Metadata, e.SubmittedAt))
+	}
+
+	return entity.RestoreDispute(
+		ID,
+		transferID,
+		payerID,
+		payeeID,
+		vo.NewMoney(currency, value),
+		d.Reason,
+		entity.DisputeStatus(d.Status),
+		evidence,
+		d.CreatedAt,
+		d.UpdatedAt,
+	), nil
+}
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type (
+	// Bson data
+	escrowTransferBSON struct {
+		ID            string    `bson:"id"`
+		PayerID       string    `bson:"payer"`
+		PayeeID       string    `bson:"payee"`
+		AccountID     string    `bson:"account"`
+		Currency      string    `bson:"currency"`
+		Value         int64     `bson:"value"`
+		Status        string    `bson:"status"`
+		DisputeReason string    `bson:"dispute_reason,omitempty"`
+		ReleaseAt     time.Time `bson:"release_at"`
+		CreatedAt     time.Time `bson:"created_at"`
+		UpdatedAt     time.Time `bson:"updated_at"`
+	}
+
+	escrowTransferRepository struct {
+		handler    *database.MongoHandler
+		collection string
+	}
+)
+
+// NewEscrowTransferRepository creates new escrowTransferRepository with its dependencies
+func NewEscrowTransferRepository(handler *database.MongoHandler) entity.EscrowTransferRepository {
+	return escrowTransferRepository{
+		handler:    handler,
+		collection: "escrow_transfers",
+	}
+}
+
+// Create performs insertOne into the database
+func (e escrowTransferRepository) Create(ctx context.Context, escrow entity.EscrowTransfer) (entity.EscrowTransfer, error) {
+	var bson = escrowTransferBSON{
+		ID:            escrow.ID().Value(),
+		PayerID:       escrow.Payer().Value(),
+		PayeeID:       escrow.Payee().Value(),
+		AccountID:     escrow.Account().Value(),
+		Currency:      escrow.Value().Currency().String(),
+		Value:         escrow.Value().Amount().Value(),
+		Status:        string(escrow.Status()),
+		DisputeReason: escrow.DisputeReason(),
+		ReleaseAt:     escrow.ReleaseAt(),
+		CreatedAt:     escrow.CreatedAt(),
+		UpdatedAt:     escrow.UpdatedAt(),
+	}
+
+	if _, err := e.handler.Db().Collection(e.collection).InsertOne(ctx, bson); err != nil {
+		return entity.EscrowTransfer{}, entity.WrapError(entity.ErrCreateEscrowTransfer, err)
+	}
+
+	return escrow, nil
+}
+
+// FindByID performs findOne into the database
+func (e escrowTransferRepository) FindByID(ctx context.Context, ID vo.Uuid) (entity.EscrowTransfer, error) {
+	var escrowBSON = &escrowTransferBSON{}
+
+	err := e.handler.Db().Collection(e.collection).
+		FindOne(ctx, bson.M{"id": ID.Value()}).
+		Decode(escrowBSON)
+	if err != nil {
+		switch err {
+		case mongo.ErrNoDocuments:
+			return entity.EscrowTransfer{}, entity.ErrNotFoundEscrowTransfer
+		default:
+			return entity.EscrowTransfer{}, entity.WrapError(entity.ErrFindEscrowTransfer, err)
+		}
+	}
+
+	return escrowBSON.entity()
+}
+
+// FindDue performs find into the database returning up to limit escrow transfers in escrow whose
+// release date passed at now
+func (e escrowTransferRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]entity.EscrowTransfer, error) {
+	var (
+		query = bson.M{
+			"status":     string(entity.EscrowHeld),
+			"release_at": bson.M{"$lte": now},
+		}
+		opts = options.Find().
+			SetSort(bson.D{{Key: "release_at", Value: 1}}).
+			SetLimit(int64(limit))
+	)
+
+	cursor, err := e.handler.Db().Collection(e.collection).Find(ctx, query, opts)
+	if err != nil {
+		return nil, entity.WrapError(entity.ErrFindEscrowTransfer, err)
+	}
+	defer cursor.Close(ctx)
+
+	var escrows []entity.EscrowTransfer
+	for cursor.Next(ctx) {
+		var escrowBSON = escrowTransferBSON{}
+		if err := cursor.Decode(&escrowBSON); err != nil {
+			return nil, entity.WrapError(entity.ErrFindEscrowTransfer, err)
+		}
+
+		escrow, err := escrowBSON.entity()
+		if err != nil {
+			return nil, err
+		}
+
+		escrows = append(escrows, escrow)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, entity.WrapError(entity.ErrFindEscrowTransfer, err)
+	}
+
+	return escrows, nil
+}
+
+// Update performs updateOne into the database, only an escrow transfer still in the status from is updated
+// so concurrent confirmations, disputes and the worker never settle the same funds twice
+func (e escrowTransferRepository) Update(ctx context.Context, from entity.TransferStatus, escrow entity.EscrowTransfer) error {
+	var (
+		query  = bson.M{"id": escrow.ID().Value(), "status": string(from)}
+		update = bson.M{"$set": bson.M{
+			"status":         string(escrow.Status()),
+			"dispute_reason": escrow.DisputeReason(),
+			"updated_at":     escrow.UpdatedAt(),
+		}}
+	)
+
+	result, err := e.handler.Db().Collection(e.collection).UpdateOne(ctx, query, update)
+	if err != nil {
+		return entity.WrapError(entity.ErrUpdateEscrowTransfer, err)
+	}
+
+	if result.MatchedCount == 0 {
+		if from == entity.EscrowDisputed {
+			return entity.ErrEscrowTransferNotDisputed
+		}
+
+		return entity.ErrEscrowTransferNotInEscrow
+	}
+
+	return nil
+}
+
+// WithTransaction runs fn inside a transaction
+func (e escrowTransferRepository) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return withTransaction(ctx, e.handler, fn)
+}
+
+func (e escrowTransferBSON) entity() (entity.EscrowTransfer, error) {
+	ID, err := vo.NewUuid(e.ID)
+	if err != nil {
+		return entity.EscrowTransfer{}, err
+	}
+
+	payerID, err := vo.NewUuid(e.PayerID)
+	if err != nil {
+		return entity.EscrowTransfer{}, err
+	}
+
+	payeeID, err := vo.NewUuid(e.PayeeID)
+	if err != nil {
+		return entity.EscrowTransfer{}, err
+	}
+
+	accountID, err := vo.NewUuid(e.AccountID)
+	if err != nil {
+		return entity.EscrowTransfer{}, err
+	}
+
+	currency, err := vo.NewCurrency(e.Currency)
+	if err != nil {
+		return entity.EscrowTransfer{}, err
+	}
+
+	value, err := vo.NewAmount(e.Value)
+	if err != nil {
+		return entity.EscrowTransfer{}, err
+	}
+
+	return entity.RestoreEscrowTransfer(
+		ID,
+		payerID,
+		payeeID,
+		accountID,
+		vo.NewMoney(currency, value),
+		entity.TransferStatus(e.Status),
+		e.DisputeReason,
+		e.ReleaseAt,
+		e.CreatedAt,
+		e.UpdatedAt,
+	), nil
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+type (
+	eventSourcedTransferCreator struct {
+		repo   entity.TransferRepositoryCreator
+		events entity.EventRepository
+	}
+
+	eventSourcedAuthorizer struct {
+		authorizer usecase.Authorizer
+		events     entity.EventRepository
+	}
+)
+
+// NewEventSourcedTransferCreator decorates a TransferRepositoryCreator appending the TransferCreated event and,
+// for a refund, the TransferRefunded event of the transfer refunded
+func NewEventSourcedTransferCreator(
+	repo entity.TransferRepositoryCreator,
+	events entity.EventRepository,
+) entity.TransferRepositoryCreator {
+	return eventSourcedTransferCreator{
+		repo:   repo,
+		events: events,
+	}
+}
+
+// Create creates the transfer and appends the TransferCreated event, a refund also appends the TransferRefunded
+// event to the stream of the transfer refunded
+func (e eventSourcedTransferCreator) Create(ctx context.Context, t entity.Transfer) (entity.Transfer, error) {
+	transfer, err := e.repo.Create(ctx, t)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	event, err := entity.NewTransferCreatedEvent(transfer, time.Now())
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	if err := e.events.Append(ctx, event); err != nil {
+		return entity.Transfer{}, err
+	}
+
+	if !transfer.IsRefund() {
+		return transfer, nil
+	}
+
+	sequence, err := nextSequence(ctx, e.events, transfer.RefundOf())
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	refunded, err := entity.NewTransferRefundedEvent(transfer, sequence, time.Now())
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	if err := e.events.Append(ctx, refunded); err != nil {
+		return entity.Transfer{}, err
+	}
+
+	return transfer, nil
+}
+
+// WithTransaction delegates to the decorated repository so events are appended in the same transaction
+func (e eventSourcedTransferCreator) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return e.repo.WithTransaction(ctx, fn)
+}
+
+// NewEventSourcedAuthorizer decorates an Authorizer appending the TransferAuthorized event
+func NewEventSourcedAuthorizer(authorizer usecase.Authorizer, events entity.EventRepository) usecase.Authorizer {
+	return eventSourcedAuthorizer{
+		authorizer: authorizer,
+		events:     events,
+	}
+}
+
+// Authorized authorizes the operation and appends the TransferAuthorized event of each approved transfer,
+// a split transfer is authorized once for all of its transfers
+func (e eventSourcedAuthorizer) Authorized(ctx context.Context, a entity.Authorizable) (bool, error) {
+	ok, err := e.authorizer.Authorized(ctx, a)
+	if err != nil || !ok {
+		return ok, err
+	}
+
+	var transfers []entity.Transfer
+	switch t := a.(type) {
+	case entity.Transfer:
+		transfers = []entity.Transfer{t}
+	case entity.SplitTransfer:
+		transfers = t.Transfers()
+	}
+
+	for _, t := range transfers {
+		sequence, err := nextSequence(ctx, e.events, t.ID())
+		if err != nil {
+			return false, err
+		}
+
+		event, err := entity.NewTransferAuthorizedEvent(t, sequence, time.Now())
+		if err != nil {
+			return false, err
+		}
+
+		if err := e.events.Append(ctx, event); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// nextSequence returns the sequence following the last event of the aggregate
+func nextSequence(ctx context.Context, events entity.EventRepositoryFinder, ID vo.Uuid) (int64, error) {
+	stream, err := events.FindByAggregateID(ctx, ID, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	return lastSequence(nil, stream) + 1, nil
+}
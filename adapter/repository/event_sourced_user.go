@@ -0,0 +1,314 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+)
+
+const defaultSnapshotInterval = 50
+
+type (
+	eventSourcedUserCreator struct {
+		handler *database.MongoHandler
+		repo    entity.UserRepositoryCreator
+		events  entity.EventRepositoryAppender
+	}
+
+	// eventSourcedUser loads the stream of a user and records its events, taking a snapshot every snapshotInterval events
+	eventSourcedUser struct {
+		finder           entity.UserRepositoryFinder
+		events           entity.EventRepository
+		snapshots        entity.UserSnapshotRepository
+		snapshotInterval int64
+	}
+
+	eventSourcedUserUpdater struct {
+		eventSourcedUser
+		repo entity.UserRepositoryUpdater
+	}
+
+	eventSourcedUserHolder struct {
+		eventSourcedUser
+		repo entity.UserRepositoryHolder
+	}
+
+	eventSourcedUserKYCUpdater struct {
+		eventSourcedUser
+		repo entity.UserRepositoryKYCUpdater
+	}
+)
+
+// NewEventSourcedUserCreator decorates a UserRepositoryCreator appending the UserCreated event in the same
+// transaction that creates the user
+func NewEventSourcedUserCreator(
+	handler *database.MongoHandler,
+	repo entity.UserRepositoryCreator,
+	events entity.EventRepositoryAppender,
+) entity.UserRepositoryCreator {
+	return eventSourcedUserCreator{
+		handler: handler,
+		repo:    repo,
+		events:  events,
+	}
+}
+
+// Create creates the user and appends the UserCreated event, a user is never created without its event
+func (e eventSourcedUserCreator) Create(ctx context.Context, u entity.User) (entity.User, error) {
+	var user entity.User
+
+	err := withTransaction(ctx, e.handler, func(sessCtx context.Context) error {
+		created, err := e.repo.Create(sessCtx, u)
+		if err != nil {
+			return err
+		}
+
+		event, err := entity.NewUserCreatedEvent(created, time.Now())
+		if err != nil {
+			return err
+		}
+
+		if err := e.events.Append(sessCtx, event); err != nil {
+			return err
+		}
+
+		user = created
+
+		return nil
+	})
+	if err != nil {
+		return entity.User{}, err
+	}
+
+	return user, nil
+}
+
+func newEventSourcedUser(
+	finder entity.UserRepositoryFinder,
+	events entity.EventRepository,
+	snapshots entity.UserSnapshotRepository,
+) eventSourcedUser {
+	return eventSourcedUser{
+		finder:           finder,
+		events:           events,
+		snapshots:        snapshots,
+		snapshotInterval: defaultSnapshotInterval,
+	}
+}
+
+// NewEventSourcedUserUpdater decorates a UserRepositoryUpdater appending WalletCredited and WalletDebited events
+func NewEventSourcedUserUpdater(
+	repo entity.UserRepositoryUpdater,
+	finder entity.UserRepositoryFinder,
+	events entity.EventRepository,
+	snapshots entity.UserSnapshotRepository,
+) entity.UserRepositoryUpdater {
+	return eventSourcedUserUpdater{
+		eventSourcedUser: newEventSourcedUser(finder, events, snapshots),
+		repo:             repo,
+	}
+}
+
+// UpdateWallet updates the wallet and appends the event with the difference to the previous balance
+func (e eventSourcedUserUpdater) UpdateWallet(ctx context.Context, ID vo.Uuid, money vo.Money) error {
+	user, snapshot, stream, err := e.load(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	if err := e.repo.UpdateWallet(ctx, ID, money); err != nil {
+		return err
+	}
+
+	var (
+		previous = user.Wallet().Money().Amount().Value()
+		current  = money.Amount().Value()
+		sequence = lastSequence(snapshot, stream) + 1
+		event    entity.Event
+	)
+
+	switch {
+	case current > previous:
+		amount, err := vo.NewAmount(current - previous)
+		if err != nil {
+			return err
+		}
+
+		event, err = entity.NewWalletCreditedEvent(ID, sequence, vo.NewMoney(money.Currency(), amount), money, time.Now())
+		if err != nil {
+			return err
+		}
+	case current < previous:
+		amount, err := vo.NewAmount(previous - current)
+		if err != nil {
+			return err
+		}
+
+		event, err = entity.NewWalletDebitedEvent(ID, sequence, vo.NewMoney(money.Currency(), amount), money, time.Now())
+		if err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	return e.record(ctx, snapshot, stream, event)
+}
+
+// NewEventSourcedUserHolder decorates a UserRepositoryHolder appending the WalletHeldChanged event
+func NewEventSourcedUserHolder(
+	repo entity.UserRepositoryHolder,
+	finder entity.UserRepositoryFinder,
+	events entity.EventRepository,
+	snapshots entity.UserSnapshotRepository,
+) entity.UserRepositoryHolder {
+	return eventSourcedUserHolder{
+		eventSourcedUser: newEventSourcedUser(finder, events, snapshots),
+		repo:             repo,
+	}
+}
+
+// UpdateHeld updates the money held and appends the event with the new value held
+func (e eventSourcedUserHolder) UpdateHeld(ctx context.Context, ID vo.Uuid, money vo.Money) error {
+	user, snapshot, stream, err := e.load(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	if err := e.repo.UpdateHeld(ctx, ID, money); err != nil {
+		return err
+	}
+
+	if user.Wallet().Held().Amount() == money.Amount() {
+		return nil
+	}
+
+	event, err := entity.NewWalletHeldChangedEvent(ID, lastSequence(snapshot, stream)+1, money, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return e.record(ctx, snapshot, stream, event)
+}
+
+// NewEventSourcedUserKYCUpdater decorates a UserRepositoryKYCUpdater appending the KYCLevelChanged event
+func NewEventSourcedUserKYCUpdater(
+	repo entity.UserRepositoryKYCUpdater,
+	finder entity.UserRepositoryFinder,
+	events entity.EventRepository,
+	snapshots entity.UserSnapshotRepository,
+) entity.UserRepositoryKYCUpdater {
+	return eventSourcedUserKYCUpdater{
+		eventSourcedUser: newEventSourcedUser(finder, events, snapshots),
+		repo:             repo,
+	}
+}
+
+// UpdateKYCLevel updates the KYC level and appends the event with the new level
+func (e eventSourcedUserKYCUpdater) UpdateKYCLevel(ctx context.Context, ID vo.Uuid, level entity.KYCLevel) error {
+	user, snapshot, stream, err := e.load(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	if err := e.repo.UpdateKYCLevel(ctx, ID, level); err != nil {
+		return err
+	}
+
+	if user.KYCLevel() == level {
+		return nil
+	}
+
+	event, err := entity.NewKYCLevelChangedEvent(ID, lastSequence(snapshot, stream)+1, level, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return e.record(ctx, snapshot, stream, event)
+}
+
+// load rehydrates the user from its latest snapshot and events, starting the stream of users created before the event store
+func (e eventSourcedUser) load(ctx context.Context, ID vo.Uuid) (entity.User, *entity.UserSnapshot, []entity.Event, error) {
+	var snapshot *entity.UserSnapshot
+
+	latest, err := e.snapshots.FindLatest(ctx, ID)
+	switch err {
+	case nil:
+		snapshot = &latest
+	case entity.ErrNotFoundSnapshot:
+	default:
+		return entity.User{}, nil, nil, err
+	}
+
+	stream, err := e.events.FindByAggregateID(ctx, ID, lastSequence(snapshot, nil))
+	if err != nil {
+		return entity.User{}, nil, nil, err
+	}
+
+	if snapshot == nil && len(stream) == 0 {
+		user, err := e.finder.FindByID(ctx, ID)
+		if err != nil {
+			return entity.User{}, nil, nil, err
+		}
+
+		event, err := entity.NewUserCreatedEvent(user, time.Now())
+		if err != nil {
+			return entity.User{}, nil, nil, err
+		}
+
+		if err := e.events.Append(ctx, event); err != nil {
+			return entity.User{}, nil, nil, err
+		}
+
+		stream = []entity.Event{event}
+	}
+
+	user, _, err := entity.RehydrateUser(snapshot, stream)
+	if err != nil {
+		return entity.User{}, nil, nil, err
+	}
+
+	return user, snapshot, stream, nil
+}
+
+// record appends the event that follows the stream, taking a snapshot when its sequence reaches the interval
+func (e eventSourcedUser) record(ctx context.Context, snapshot *entity.UserSnapshot, stream []entity.Event, event entity.Event) error {
+	if err := e.events.Append(ctx, event); err != nil {
+		return err
+	}
+
+	if event.Sequence()%e.snapshotInterval == 0 {
+		return e.snapshot(ctx, snapshot, append(stream, event))
+	}
+
+	return nil
+}
+
+func (e eventSourcedUser) snapshot(ctx context.Context, snapshot *entity.UserSnapshot, stream []entity.Event) error {
+	user, sequence, err := entity.RehydrateUser(snapshot, stream)
+	if err != nil {
+		return err
+	}
+
+	s, err := entity.NewUserSnapshot(user, sequence, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return e.snapshots.Save(ctx, s)
+}
+
+func lastSequence(snapshot *entity.UserSnapshot, stream []entity.Event) int64 {
+	if len(stream) > 0 {
+		return stream[len(stream)-1].Sequence()
+	}
+
+	if snapshot != nil {
+		return snapshot.Sequence()
+	}
+
+	return 0
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type (
+	// Bson data
+	eventBSON struct {
+		AggregateID   string    `bson:"aggregate_id"`
+		AggregateType string    `bson:"aggregate_type"`
+		Sequence      int64     `bson:"sequence"`
+		Type          string    `bson:"type"`
+		Data          bson.M    `bson:"data"`
+		OccurredAt    time.Time `bson:"occurred_at"`
+	}
+
+	eventStoreRepository struct {
+		handler    *database.MongoHandler
+		collection string
+	}
+)
+
+// NewEventStoreRepository creates new eventStoreRepository with its dependencies
+func NewEventStoreRepository(handler *database.MongoHandler) entity.EventRepository {
+	return eventStoreRepository{
+		handler:    handler,
+		collection: "events",
+	}
+}
+
+// Append performs insertMany into the database, a repeated sequence of an aggregate is rejected by the unique index
+func (e eventStoreRepository) Append(ctx context.Context, events ...entity.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	var docs []interface{}
+	for _, event := range events {
+		var data bson.M
+		if err := bson.UnmarshalExtJSON(event.Data(), false, &data); err != nil {
+			return entity.WrapError(entity.ErrAppendEvent, err)
+		}
+
+		docs = append(docs, eventBSON{
+			AggregateID:   event.AggregateID().Value(),
+			AggregateType: string(event.AggregateType()),
+			Sequence:      event.Sequence(),
+			Type:          string(event.Type()),
+			Data:          data,
+			OccurredAt:    event.OccurredAt(),
+		})
+	}
+
+	if _, err := e.handler.Db().Collection(e.collection).InsertMany(ctx, docs); err != nil {
+		if database.IsDuplicateKeyError(err) {
+			return entity.ErrEventSequenceConflict
+		}
+
+		return entity.WrapError(entity.ErrAppendEvent, err)
+	}
+
+	return nil
+}
+
+// FindByAggregateID performs find into the database returning the events after the given sequence in order
+func (e eventStoreRepository) FindByAggregateID(ctx context.Context, ID vo.Uuid, after int64) ([]entity.Event, error) {
+	var query = bson.M{
+		"aggregate_id": ID.Value(),
+		"sequence":     bson.M{"$gt": after},
+	}
+
+	cursor, err := e.handler.Db().Collection(e.collection).Find(
+		ctx,
+		query,
+		options.Find().SetSort(bson.D{{Key: "sequence", Value: 1}}),
+	)
+	if err != nil {
+		return nil, entity.WrapError(entity.ErrFindEvents, err)
+	}
+	defer cursor.Close(ctx)
+
+	var events []entity.Event
+	for cursor.Next(ctx) {
+		var eventBSON eventBSON
+		if err := cursor.Decode(&eventBSON); err != nil {
+			return nil, entity.WrapError(entity.ErrFindEvents, err)
+		}
+
+		data, err := bson.MarshalExtJSON(eventBSON.Data, false, false)
+		if err != nil {
+			return nil, entity.WrapError(entity.ErrFindEvents, err)
+		}
+
+		aggregateID, err := vo.NewUuid(eventBSON.AggregateID)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, entity.NewEvent(
+			aggregateID,
+			entity.AggregateType(eventBSON.AggregateType),
+			eventBSON.Sequence,
+			entity.EventType(eventBSON.Type),
+			data,
+			eventBSON.OccurredAt,
+		))
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, entity.WrapError(entity.ErrFindEvents, err)
+	}
+
+	return events, nil
+}
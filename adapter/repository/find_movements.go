@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type (
+	// Bson data
+	findMovementBSON struct {
+		ID                string    `bson:"id"`
+		UserID            string    `bson:"user_id"`
+		Type              string    `bson:"type"`
+		Currency          string    `bson:"currency"`
+		Value             int64     `bson:"value"`
+		ExternalReference string    `bson:"external_reference"`
+		Status            string    `bson:"status"`
+		CreatedAt         time.Time `bson:"created_at"`
+		ReversedAt        time.Time `bson:"reversed_at"`
+	}
+
+	findMovementsRepository struct {
+		handler    *database.MongoHandler
+		collection string
+	}
+)
+
+// NewFindMovementsRepository creates new findMovementsRepository with its dependencies
+func NewFindMovementsRepository(handler *database.MongoHandler) entity.MovementRepositoryFinder {
+	return findMovementsRepository{
+		handler:    handler,
+		collection: "movements",
+	}
+}
+
+// FindByID performs findOne into the database
+func (f findMovementsRepository) FindByID(ctx context.Context, ID vo.Uuid) (entity.Movement, error) {
+	var movementBSON = &findMovementBSON{}
+
+	err := f.handler.Db().Collection(f.collection).
+		FindOne(ctx, bson.M{"id": ID.Value()}).
+		Decode(movementBSON)
+	if err != nil {
+		switch err {
+		case mongo.ErrNoDocuments:
+			return entity.Movement{}, entity.ErrNotFoundMovement
+		default:
+			return entity.Movement{}, entity.WrapError(entity.ErrFindMovement, err)
+		}
+	}
+
+	return movementBSON.entity()
+}
+
+// FindByUserID performs find into the database returning the movements of the user created or reversed since a date
+func (f findMovementsRepository) FindByUserID(ctx context.Context, userID vo.Uuid, since time.Time) ([]entity.Movement, error) {
+	var query = bson.M{
+		"user_id": userID.Value(),
+		"$or": bson.A{
+			bson.M{"created_at": bson.M{"$gte": since}},
+			bson.M{"reversed_at": bson.M{"$gte": since}},
+		},
+	}
+
+	cursor, err := f.handler.Db().Collection(f.collection).Find(
+		ctx,
+		query,
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}),
+	)
+	if err != nil {
+		return nil, entity.WrapError(entity.ErrFindMovement, err)
+	}
+	defer cursor.Close(ctx)
+
+	var movements []entity.Movement
+	for cursor.Next(ctx) {
+		var movementBSON findMovementBSON
+		if err := cursor.Decode(&movementBSON); err != nil {
+			return nil, entity.WrapError(entity.ErrFindMovement, err)
+		}
+
+		movement, err := movementBSON.entity()
+		if err != nil {
+			return nil, err
+		}
+
+		movements = append(movements, movement)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, entity.WrapError(entity.ErrFindMovement, err)
+	}
+
+	return movements, nil
+}
+
+func (m findMovementBSON) entity() (entity.Movement, error) {
+	ID, err := vo.NewUuid(m.ID)
+	if err != nil {
+		return entity.Movement{}, err
+	}
+
+	userID, err := vo.NewUuid(m.UserID)
+	if err != nil {
+		return entity.Movement{}, err
+	}
+
+	currency, err := vo.NewCurrency(m.Currency)
+	if err != nil {
+		return entity.Movement{}, err
+	}
+
+	amount, err := vo.NewAmount(m.Value)
+	if err != nil {
+		return entity.Movement{}, err
+	}
+
+	return entity.RestoreMovement(
+		ID,
+		userID,
+		entity.MovementType(m.Type),
+		vo.NewMoney(currency, amount),
+		m.ExternalReference,
+		entity.MovementStatus(m.Status),
+		m.CreatedAt,
+		m.ReversedAt,
+	), nil
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type findTransferByIDRepository struct {
+	handler    *database.MongoHandler
+	collection string
+}
+
+// NewFindTransferByIDRepository creates new findTransferByIDRepository with its dependencies
+func NewFindTransferByIDRepository(handler *database.MongoHandler) entity.TransferRepositoryFinderByID {
+	return findTransferByIDRepository{
+		handler:    handler,
+		collection: "transfers",
+	}
+}
+
+// FindByID performs findOne into the database
+func (f findTransferByIDRepository) FindByID(ctx context.Context, ID vo.Uuid) (entity.Transfer, error) {
+	var transferBSON = &findTransferBSON{}
+
+	err := f.handler.Db().Collection(f.collection).
+		FindOne(ctx, bson.M{"id": ID.Value()}).
+		Decode(transferBSON)
+	if err != nil {
+		switch err {
+		case mongo.ErrNoDocuments:
+			return entity.Transfer{}, entity.ErrNotFoundTransfer
+		default:
+			return entity.Transfer{}, entity.WrapError(entity.ErrFindTransfers, err)
+		}
+	}
+
+	return transferBSON.entity()
+}
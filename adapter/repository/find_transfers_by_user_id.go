@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type (
+	// Bson data
+	findTransferBSON struct {
+		ID                string            `bson:"id"`
+		PayerID           string            `bson:"payer"`
+		PayeeID           string            `bson:"payee"`
+		Currency          string            `bson:"currency"`
+		Value             int64             `bson:"value"`
+		PayerFee          int64             `bson:"payer_fee"`
+		PayeeFee          int64             `bson:"payee_fee"`
+		FeeAccount        string            `bson:"fee_account"`
+		Description       string            `bson:"description"`
+		ExternalReference string            `bson:"external_reference"`
+		Metadata          map[string]string `bson:"metadata"`
+		RefundOf          string            `bson:"refund_of"`
+		CreatedAt         time.Time         `bson:"created_at"`
+	}
+
+	findTransfersByUserIDRepository struct {
+		handler    *database.MongoHandler
+		collection string
+	}
+)
+
+// NewFindTransfersByUserIDRepository creates new findTransfersByUserIDRepository with its dependencies
+func NewFindTransfersByUserIDRepository(handler *database.MongoHandler) entity.TransferRepositoryFinder {
+	return findTransfersByUserIDRepository{
+		handler:    handler,
+		collection: "transfers",
+	}
+}
+
+// FindByUserID performs find into the database returning the transfers sent or received by the user since a date,
+// including the transfers whose fees were credited to the user
+func (f findTransfersByUserIDRepository) FindByUserID(ctx context.Context, ID vo.Uuid, since time.Time) ([]entity.Transfer, error) {
+	var query = bson.M{
+		"$or":        bson.A{bson.M{"payer": ID.Value()}, bson.M{"payee": ID.Value()}, bson.M{"fee_account": ID.Value()}},
+		"created_at": bson.M{"$gte": since},
+	}
+
+	cursor, err := f.handler.Db().Collection(f.collection).Find(
+		ctx,
+		query,
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}),
+	)
+	if err != nil {
+		return nil, entity.WrapError(entity.ErrFindTransfers, err)
+	}
+	defer cursor.Close(ctx)
+
+	var transfers []entity.Transfer
+	for cursor.Next(ctx) {
+		var transferBSON findTransferBSON
+		if err := cursor.Decode(&transferBSON); err != nil {
+			return nil, entity.WrapError(entity.ErrFindTransfers, err)
+		}
+
+		transfer, err := transferBSON.entity()
+		if err != nil {
+			return nil, err
+		}
+
+		transfers = append(transfers, transfer)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, entity.WrapError(entity.ErrFindTransfers, err)
+	}
+
+	return transfers, nil
+}
+
+func (t findTransferBSON) entity() (entity.Transfer, error) {
+	ID, err := vo.NewUuid(t.ID)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	payerID, err := vo.NewUuid(t.PayerID)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	payeeID, err := vo.NewUuid(t.PayeeID)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	// Transfers created before the currency was persisted were always in BRL
+	if t.Currency == "" {
+		t.Currency = vo.BRL.String()
+	}
+
+	currency, err := vo.NewCurrency(t.Currency)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	amount, err := vo.NewAmount(t.Value)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	var transfer = entity.NewTransfer(
+		ID,
+		payerID,
+		payeeID,
+		vo.NewMoney(currency, amount),
+		t.CreatedAt,
+	).WithDetails(entity.RestoreTransferDetails(t.Description, t.ExternalReference, t.Metadata))
+
+	if t.RefundOf != "" {
+		refundOf, err := vo.NewUuid(t.RefundOf)
+		if err != nil {
+			return entity.Transfer{}, err
+		}
+
+		transfer = transfer.WithRefundOf(refundOf)
+	}
+
+	if t.FeeAccount == "" {
+		return transfer, nil
+	}
+
+	feeAccount, err := vo.NewUuid(t.FeeAccount)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	payerFee, err := vo.NewAmount(t.PayerFee)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	payeeFee, err := vo.NewAmount(t.PayeeFee)
+	if err != nil {
+		return entity.Transfer{}, err
+	}
+
+	return transfer.WithFee(entity.NewFee(
+		feeAccount,
+		vo.NewMoney(currency, payerFee),
+		vo.NewMoney(currency, payeeFee),
+	)), nil
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/GSabadini/golang-clean-architecture/domain/entity"
 	"github.com/GSabadini/golang-clean-architecture/domain/vo"
 	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
-	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 )
@@ -23,6 +22,7 @@ type (
 		Wallet    findUserByIDWalletBSON   `bson:"wallet"`
 		Roles     findUserByIDRolesBSON    `bson:"roles"`
 		Type      string                   `bson:"type"`
+		KYCLevel  string                   `bson:"kyc_level"`
 		CreatedAt time.Time                `bson:"created_at"`
 	}
 
@@ -36,6 +36,7 @@ type (
 	findUserByIDWalletBSON struct {
 		Currency string `bson:"currency"`
 		Amount   int64  `bson:"amount"`
+		Held     int64  `bson:"held"`
 	}
 
 	// Bson data
@@ -74,7 +75,7 @@ func (f findUserByIDRepository) FindByID(ctx context.Context, ID vo.Uuid) (entit
 		case mongo.ErrNoDocuments:
 			return entity.User{}, entity.ErrNotFoundUser
 		default:
-			return entity.User{}, errors.Wrap(err, entity.ErrFindUserByID.Error())
+			return entity.User{}, entity.WrapError(entity.ErrFindUserByID, err)
 		}
 	}
 
@@ -103,7 +104,13 @@ func (f findUserByIDRepository) FindByID(ctx context.Context, ID vo.Uuid) (entit
 		return entity.User{}, err
 	}
 
+	held, err := vo.NewAmount(userBSON.Wallet.Held)
+	if err != nil {
+		return entity.User{}, err
+	}
+
 	wallet := vo.NewWallet(vo.NewMoney(currency, amount))
+	wallet.Hold(held)
 
 	u, err := entity.NewUser(
 		uuid,
@@ -119,5 +126,15 @@ func (f findUserByIDRepository) FindByID(ctx context.Context, ID vo.Uuid) (entit
 		return entity.User{}, err
 	}
 
-	return u, nil
+	// The users created before the KYC levels stay at the basic level
+	if userBSON.KYCLevel == "" {
+		return u, nil
+	}
+
+	level, err := entity.NewKYCLevel(userBSON.KYCLevel)
+	if err != nil {
+		return entity.User{}, err
+	}
+
+	return u.WithKYCLevel(level), nil
 }
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type (
+	// Bson data
+	findWalletBSON struct {
+		ID     string `bson:"id"`
+		Wallet struct {
+			Currency      string `bson:"currency"`
+			Amount        int64  `bson:"amount"`
+			InitialAmount *int64 `bson:"initial_amount"`
+		} `bson:"wallet"`
+	}
+
+	findWalletsRepository struct {
+		handler    *database.MongoHandler
+		collection string
+	}
+)
+
+// NewFindWalletsRepository creates new findWalletsRepository with its dependencies
+func NewFindWalletsRepository(handler *database.MongoHandler) entity.WalletRepositoryLister {
+	return findWalletsRepository{
+		handler:    handler,
+		collection: "users",
+	}
+}
+
+// FindAll performs find into the database returning the wallet of every user
+func (f findWalletsRepository) FindAll(ctx context.Context) ([]entity.WalletBalance, error) {
+	cursor, err := f.handler.Db().Collection(f.collection).Find(
+		ctx,
+		bson.M{},
+		options.Find().SetProjection(bson.M{"id": 1, "wallet": 1}),
+	)
+	if err != nil {
+		return nil, entity.WrapError(entity.ErrFindWallets, err)
+	}
+	defer cursor.Close(ctx)
+
+	var wallets []entity.WalletBalance
+	for cursor.Next(ctx) {
+		var walletBSON findWalletBSON
+		if err := cursor.Decode(&walletBSON); err != nil {
+			return nil, entity.WrapError(entity.ErrFindWallets, err)
+		}
+
+		ID, err := vo.NewUuid(walletBSON.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		currency, err := vo.NewCurrency(walletBSON.Wallet.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		var initial *vo.Money
+		if walletBSON.Wallet.InitialAmount != nil {
+			money := vo.NewMoney(currency, vo.NewAmountTest(*walletBSON.Wallet.InitialAmount))
+			initial = &money
+		}
+
+		wallets = append(wallets, entity.NewWalletBalance(
+			ID,
+			vo.NewMoney(currency, vo.NewAmountTest(walletBSON.Wallet.Amount)),
+			initial,
+		))
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, entity.WrapError(entity.ErrFindWallets, err)
+	}
+
+	return wallets, nil
+}
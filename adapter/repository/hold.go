@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type (
+	// Bson data
+	holdBSON struct {
+		ID        string    `bson:"id"`
+		PayerID   string    `bson:"payer"`
+		PayeeID   string    `bson:"payee"`
+		Currency  string    `bson:"currency"`
+		Value     int64     `bson:"value"`
+		Captured  int64     `bson:"captured"`
+		Status    string    `bson:"status"`
+		ExpiresAt time.Time `bson:"expires_at"`
+		CreatedAt time.Time `bson:"created_at"`
+		UpdatedAt time.Time `bson:"updated_at"`
+	}
+
+	holdRepository struct {
+		handler    *database.MongoHandler
+		collection string
+	}
+)
+
+// NewHoldRepository creates new holdRepository with its dependencies
+func NewHoldRepository(handler *database.MongoHandler) entity.HoldRepository {
+	return holdRepository{
+		handler:    handler,
+		collection: "holds",
+	}
+}
+
+// Create performs insertOne into the database
+func (h holdRepository) Create(ctx context.Context, hold entity.Hold) (entity.Hold, error) {
+	var bson = holdBSON{
+		ID:        hold.ID().Value(),
+		PayerID:   hold.Payer().Value(),
+		PayeeID:   hold.Payee().Value(),
+		Currency:  hold.Value().Currency().String(),
+		Value:     hold.Value().Amount().Value(),
+		Captured:  hold.Captured().Amount().Value(),
+		Status:    string(hold.Status()),
+		ExpiresAt: hold.ExpiresAt(),
+		CreatedAt: hold.CreatedAt(),
+		UpdatedAt: hold.UpdatedAt(),
+	}
+
+	if _, err := h.handler.Db().Collection(h.collection).InsertOne(ctx, bson); err != nil {
+		return entity.Hold{}, entity.WrapError(entity.ErrCreateHold, err)
+	}
+
+	return hold, nil
+}
+
+// FindByID performs findOne into the database
+func (h holdRepository) FindByID(ctx context.Context, ID vo.Uuid) (entity.Hold, error) {
+	var holdBSON = &holdBSON{}
+
+	err := h.handler.Db().Collection(h.collection).
+		FindOne(ctx, bson.M{"id": ID.Value()}).
+		Decode(holdBSON)
+	if err != nil {
+		switch err {
+		case mongo.ErrNoDocuments:
+			return entity.Hold{}, entity.ErrNotFoundHold
+		default:
+			return entity.Hold{}, entity.WrapError(entity.ErrFindHold, err)
+		}
+	}
+
+	return holdBSON.entity()
+}
+
+// FindExpired performs find into the database returning up to limit active holds expired at now
+func (h holdRepository) FindExpired(ctx context.Context, now time.Time, limit int) ([]entity.Hold, error) {
+	var (
+		query = bson.M{
+			"status":     string(entity.HoldActive),
+			"expires_at": bson.M{"$lte": now},
+		}
+		opts = options.Find().
+			SetSort(bson.D{{Key: "expires_at", Value: 1}}).
+			SetLimit(int64(limit))
+	)
+
+	cursor, err := h.handler.Db().Collection(h.collection).Find(ctx, query, opts)
+	if err != nil {
+		return nil, entity.WrapError(entity.ErrFindHold, err)
+	}
+	defer cursor.Close(ctx)
+
+	var holds []entity.Hold
+	for cursor.Next(ctx) {
+		var holdBSON = holdBSON{}
+		if err := cursor.Decode(&holdBSON); err != nil {
+			return nil, entity.WrapError(entity.ErrFindHold, err)
+		}
+
+		hold, err := holdBSON.entity()
+		if err != nil {
+			return nil, err
+		}
+
+		holds = append(holds, hold)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, entity.WrapError(entity.ErrFindHold, err)
+	}
+
+	return holds, nil
+}
+
+// Close performs updateOne into the database, only an active hold is closed so concurrent captures, voids
+// and the sweeper never release the same funds twice
+func (h holdRepository) Close(ctx context.Context, hold entity.Hold) error {
+	var (
+		query  = bson.M{"id": hold.ID().Value(), "status": string(entity.HoldActive)}
+		update = bson.M{"$set": bson.M{
+			"status":     string(hold.Status()),
+			"captured":   hold.Captured().Amount().Value(),
+			"updated_at": hold.UpdatedAt(),
+		}}
+	)
+
+	result, err := h.handler.Db().Collection(h.collection).UpdateOne(ctx, query, update)
+	if err != nil {
+		return entity.WrapError(entity.ErrUpdateHold, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return entity.ErrHoldNotActive
+	}
+
+	return nil
+}
+
+// WithTransaction runs fn inside a transaction
+func (h holdRepository) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return withTransaction(ctx, h.handler, fn)
+}
+
+func (h holdBSON) entity() (entity.Hold, error) {
+	ID, err := vo.NewUuid(h.ID)
+	if err != nil {
+		return entity.Hold{}, err
+	}
+
+	payerID, err := vo.NewUuid(h.PayerID)
+	if err != nil {
+		return entity.Hold{}, err
+	}
+
+	payeeID, err := vo.NewUuid(h.PayeeID)
+	if err != nil {
+		return entity.Hold{}, err
+	}
+
+	currency, err := vo.NewCurrency(h.Currency)
+	if err != nil {
+		return entity.Hold{}, err
+	}
+
+	value, err := vo.NewAmount(h.Value)
+	if err != nil {
+		return entity.Hold{}, err
+	}
+
+	captured, err := vo.NewAmount(h.Captured)
+	if err != nil {
+		return entity.Hold{}, err
+	}
+
+	return entity.RestoreHold(
+		ID,
+		payerID,
+		payeeID,
+		vo.NewMoney(currency, value),
+		vo.NewMoney(currency, captured),
+		entity.HoldStatus(h.Status),
+		h.ExpiresAt,
+		h.CreatedAt,
+		h.UpdatedAt,
+	), nil
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type (
+	// Bson data
+	reconciliationCheckpointBSON struct {
+		At       time.Time        `bson:"at"`
+		Balances map[string]int64 `bson:"balances"`
+	}
+
+	reconciliationCheckpointRepository struct {
+		handler    *database.MongoHandler
+		collection string
+	}
+)
+
+// NewReconciliationCheckpointRepository creates new reconciliationCheckpointRepository with its dependencies
+func NewReconciliationCheckpointRepository(handler *database.MongoHandler) entity.ReconciliationCheckpointRepository {
+	return reconciliationCheckpointRepository{
+		handler:    handler,
+		collection: "reconciliation_checkpoints",
+	}
+}
+
+// FindLatest performs find into the database returning the most recent checkpoint
+func (r reconciliationCheckpointRepository) FindLatest(ctx context.Context) (entity.ReconciliationCheckpoint, error) {
+	var checkpointBSON = &reconciliationCheckpointBSON{}
+
+	err := r.handler.Db().Collection(r.collection).FindOne(
+		ctx,
+		bson.M{},
+		options.FindOne().SetSort(bson.D{{Key: "at", Value: -1}}),
+	).Decode(checkpointBSON)
+	if err != nil {
+		switch err {
+		case mongo.ErrNoDocuments:
+			return entity.ReconciliationCheckpoint{}, entity.ErrNotFoundReconciliationCheckpoint
+		default:
+			return entity.ReconciliationCheckpoint{}, entity.WrapError(entity.ErrNotFoundReconciliationCheckpoint, err)
+		}
+	}
+
+	var balances = make(map[vo.Uuid]int64, len(checkpointBSON.Balances))
+	for userID, balance := range checkpointBSON.Balances {
+		ID, err := vo.NewUuid(userID)
+		if err != nil {
+			return entity.ReconciliationCheckpoint{}, err
+		}
+
+		balances[ID] = balance
+	}
+
+	return entity.NewReconciliationCheckpoint(checkpointBSON.At, balances), nil
+}
+
+// Save performs insert into the database
+func (r reconciliationCheckpointRepository) Save(ctx context.Context, checkpoint entity.ReconciliationCheckpoint) error {
+	var balances = make(map[string]int64, len(checkpoint.Balances()))
+	for ID, balance := range checkpoint.Balances() {
+		balances[ID.Value()] = balance
+	}
+
+	if _, err := r.handler.Db().Collection(r.collection).InsertOne(ctx, reconciliationCheckpointBSON{
+		At:       checkpoint.At(),
+		Balances: balances,
+	}); err != nil {
+		return entity.WrapError(entity.ErrSaveReconciliationCheckpoint, err)
+	}
+
+	return nil
+}
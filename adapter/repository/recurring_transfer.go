@@ -0,0 +1,242 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type (
+	// Bson data
+	recurringTransferBSON struct {
+		ID                  string    `bson:"id"`
+		PayerID             string    `bson:"payer"`
+		PayeeID             string    `bson:"payee"`
+		Currency            string    `bson:"currency"`
+		Value               int64     `bson:"value"`
+		Frequency           string    `bson:"frequency"`
+		DayOfMonth          int       `bson:"day_of_month,omitempty"`
+		StartAt             time.Time `bson:"start_at"`
+		EndAt               time.Time `bson:"end_at,omitempty"`
+		Count               int       `bson:"count,omitempty"`
+		Occurrences         int       `bson:"occurrences"`
+		NextRunAt           time.Time `bson:"next_run_at,omitempty"`
+		ConsecutiveFailures int       `bson:"consecutive_failures"`
+		MaxFailures         int       `bson:"max_failures"`
+		LastFailureReason   string    `bson:"last_failure_reason,omitempty"`
+		Status              string    `bson:"status"`
+		CreatedAt           time.Time `bson:"created_at"`
+		UpdatedAt           time.Time `bson:"updated_at"`
+	}
+
+	recurringTransferRepository struct {
+		handler    *database.MongoHandler
+		collection string
+	}
+)
+
+// NewRecurringTransferRepository creates new recurringTransferRepository with its dependencies
+func NewRecurringTransferRepository(handler *database.MongoHandler) entity.RecurringTransferRepository {
+	return recurringTransferRepository{
+		handler:    handler,
+		collection: "recurring_transfers",
+	}
+}
+
+// Create performs insertOne into the database
+func (r recurringTransferRepository) Create(ctx context.Context, t entity.RecurringTransfer) (entity.RecurringTransfer, error) {
+	if _, err := r.handler.Db().Collection(r.collection).InsertOne(ctx, newRecurringTransferBSON(t)); err != nil {
+		return entity.RecurringTransfer{}, entity.WrapError(entity.ErrCreateRecurringTransfer, err)
+	}
+
+	return t, nil
+}
+
+// FindByID performs findOne into the database
+func (r recurringTransferRepository) FindByID(ctx context.Context, ID vo.Uuid) (entity.RecurringTransfer, error) {
+	var recurringBSON = &recurringTransferBSON{}
+
+	err := r.handler.Db().Collection(r.collection).
+		FindOne(ctx, bson.M{"id": ID.Value()}).
+		Decode(recurringBSON)
+	if err != nil {
+		switch err {
+		case mongo.ErrNoDocuments:
+			return entity.RecurringTransfer{}, entity.ErrNotFoundRecurringTransfer
+		default:
+			return entity.RecurringTransfer{}, entity.WrapError(entity.ErrFindRecurringTransfer, err)
+		}
+	}
+
+	return recurringBSON.entity()
+}
+
+// Update performs replaceOne into the database, a recurring transfer leased by a worker is being executed
+// and cannot be changed
+func (r recurringTransferRepository) Update(ctx context.Context, t entity.RecurringTransfer) error {
+	var query = bson.M{
+		"id": t.ID().Value(),
+		"$or": bson.A{
+			bson.M{"lease_expires_at": bson.M{"$exists": false}},
+			bson.M{"lease_expires_at": bson.M{"$lt": t.UpdatedAt()}},
+		},
+	}
+
+	result, err := r.handler.Db().Collection(r.collection).ReplaceOne(ctx, query, newRecurringTransferBSON(t))
+	if err != nil {
+		return entity.WrapError(entity.ErrUpdateRecurringTransfer, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return entity.ErrRecurringTransferBusy
+	}
+
+	return nil
+}
+
+// LeaseDue leases up to limit active recurring transfers with an occurrence due at now, each one with
+// an atomic findOneAndUpdate so concurrent workers never lease the same recurring transfer
+func (r recurringTransferRepository) LeaseDue(
+	ctx context.Context,
+	now time.Time,
+	owner string,
+	ttl time.Duration,
+	limit int,
+) ([]entity.RecurringTransfer, error) {
+	var (
+		query = bson.M{
+			"status":      string(entity.RecurrenceActive),
+			"next_run_at": bson.M{"$lte": now},
+			"$or": bson.A{
+				bson.M{"lease_expires_at": bson.M{"$exists": false}},
+				bson.M{"lease_expires_at": bson.M{"$lt": now}},
+			},
+		}
+		update = bson.M{"$set": bson.M{
+			"lease_owner":      owner,
+			"lease_expires_at": now.Add(ttl),
+		}}
+		opts = options.FindOneAndUpdate().
+			SetSort(bson.D{{Key: "next_run_at", Value: 1}}).
+			SetReturnDocument(options.After)
+	)
+
+	var leased []entity.RecurringTransfer
+	for len(leased) < limit {
+		var recurringBSON = &recurringTransferBSON{}
+
+		err := r.handler.Db().Collection(r.collection).
+			FindOneAndUpdate(ctx, query, update, opts).
+			Decode(recurringBSON)
+		if err == mongo.ErrNoDocuments {
+			break
+		}
+		if err != nil {
+			return leased, entity.WrapError(entity.ErrLeaseRecurringTransfers, err)
+		}
+
+		recurring, err := recurringBSON.entity()
+		if err != nil {
+			return leased, err
+		}
+
+		leased = append(leased, recurring)
+	}
+
+	return leased, nil
+}
+
+// Complete performs replaceOne into the database storing the progress of the schedule, the replacement
+// releases the lease and fails when the lease was taken over by another worker
+func (r recurringTransferRepository) Complete(ctx context.Context, owner string, t entity.RecurringTransfer) error {
+	result, err := r.handler.Db().Collection(r.collection).ReplaceOne(
+		ctx,
+		bson.M{"id": t.ID().Value(), "lease_owner": owner},
+		newRecurringTransferBSON(t),
+	)
+	if err != nil {
+		return entity.WrapError(entity.ErrUpdateRecurringTransfer, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return entity.WrapError(entity.ErrUpdateRecurringTransfer, errors.New("lease lost"))
+	}
+
+	return nil
+}
+
+func newRecurringTransferBSON(t entity.RecurringTransfer) recurringTransferBSON {
+	return recurringTransferBSON{
+		ID:                  t.ID().Value(),
+		PayerID:             t.Payer().Value(),
+		PayeeID:             t.Payee().Value(),
+		Currency:            t.Value().Currency().String(),
+		Value:               t.Value().Amount().Value(),
+		Frequency:           string(t.Frequency()),
+		DayOfMonth:          t.DayOfMonth(),
+		StartAt:             t.StartAt(),
+		EndAt:               t.EndAt(),
+		Count:               t.Count(),
+		Occurrences:         t.Occurrences(),
+		NextRunAt:           t.NextRunAt(),
+		ConsecutiveFailures: t.ConsecutiveFailures(),
+		MaxFailures:         t.MaxFailures(),
+		LastFailureReason:   t.LastFailureReason(),
+		Status:              string(t.Status()),
+		CreatedAt:           t.CreatedAt(),
+		UpdatedAt:           t.UpdatedAt(),
+	}
+}
+
+func (r recurringTransferBSON) entity() (entity.RecurringTransfer, error) {
+	ID, err := vo.NewUuid(r.ID)
+	if err != nil {
+		return entity.RecurringTransfer{}, err
+	}
+
+	payerID, err := vo.NewUuid(r.PayerID)
+	if err != nil {
+		return entity.RecurringTransfer{}, err
+	}
+
+	payeeID, err := vo.NewUuid(r.PayeeID)
+	if err != nil {
+		return entity.RecurringTransfer{}, err
+	}
+
+	currency, err := vo.NewCurrency(r.Currency)
+	if err != nil {
+		return entity.RecurringTransfer{}, err
+	}
+
+	amount, err := vo.NewAmount(r.Value)
+	if err != nil {
+		return entity.RecurringTransfer{}, err
+	}
+
+	return entity.RestoreRecurringTransfer(
+		ID,
+		payerID,
+		payeeID,
+		vo.NewMoney(currency, amount),
+		entity.Frequency(r.Frequency),
+		r.DayOfMonth,
+		r.StartAt,
+		r.EndAt,
+		r.Count,
+		r.Occurrences,
+		r.ConsecutiveFailures,
+		r.MaxFailures,
+		r.LastFailureReason,
+		entity.RecurrenceStatus(r.Status),
+		r.CreatedAt,
+		r.UpdatedAt,
+	), nil
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type reverseMovementRepository struct {
+	handler    *database.MongoHandler
+	collection string
+}
+
+// NewReverseMovementRepository creates new reverseMovementRepository with its dependencies
+func NewReverseMovementRepository(handler *database.MongoHandler) entity.MovementRepositoryUpdater {
+	return reverseMovementRepository{
+		handler:    handler,
+		collection: "movements",
+	}
+}
+
+// Reverse performs updateOne into the database, only a completed movement can be reversed
+func (r reverseMovementRepository) Reverse(ctx context.Context, m entity.Movement) error {
+	var (
+		query  = bson.M{"id": m.ID().Value(), "status": string(entity.MovementCompleted)}
+		update = bson.M{"$set": bson.M{
+			"status":      string(m.Status()),
+			"reversed_at": m.ReversedAt(),
+		}}
+	)
+
+	result, err := r.handler.Db().Collection(r.collection).UpdateOne(ctx, query, update)
+	if err != nil {
+		return entity.WrapError(entity.ErrUpdateMovement, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return entity.ErrMovementAlreadyReversed
+	}
+
+	return nil
+}
+
+// WithTransaction runs fn inside a transaction
+func (r reverseMovementRepository) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return withTransaction(ctx, r.handler, fn)
+}
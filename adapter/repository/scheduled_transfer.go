@@ -0,0 +1,245 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type (
+	// Bson data
+	scheduledTransferBSON struct {
+		ID                string            `bson:"id"`
+		PayerID           string            `bson:"payer"`
+		PayeeID           string            `bson:"payee"`
+		Currency          string            `bson:"currency"`
+		Value             int64             `bson:"value"`
+		ExecuteAt         time.Time         `bson:"execute_at"`
+		Status            string            `bson:"status"`
+		FailureReason     string            `bson:"failure_reason,omitempty"`
+		Attempts          int               `bson:"attempts,omitempty"`
+		Description       string            `bson:"description,omitempty"`
+		ExternalReference string            `bson:"external_reference,omitempty"`
+		Metadata          map[string]string `bson:"metadata,omitempty"`
+		CreatedAt         time.Time         `bson:"created_at"`
+		UpdatedAt         time.Time         `bson:"updated_at"`
+	}
+
+	scheduledTransferRepository struct {
+		handler    *database.MongoHandler
+		collection string
+	}
+)
+
+// NewScheduledTransferRepository creates new scheduledTransferRepository with its dependencies
+func NewScheduledTransferRepository(handler *database.MongoHandler) entity.ScheduledTransferRepository {
+	return scheduledTransferRepository{
+		handler:    handler,
+		collection: "scheduled_transfers",
+	}
+}
+
+// Create performs insertOne into the database
+func (s scheduledTransferRepository) Create(ctx context.Context, t entity.ScheduledTransfer) (entity.ScheduledTransfer, error) {
+	var bson = scheduledTransferBSON{
+		ID:                t.ID().Value(),
+		PayerID:           t.Payer().Value(),
+		PayeeID:           t.Payee().Value(),
+		Currency:          t.Value().Currency().String(),
+		Value:             t.Value().Amount().Value(),
+		ExecuteAt:         t.ExecuteAt(),
+		Status:            string(t.Status()),
+		CreatedAt:         t.CreatedAt(),
+		UpdatedAt:         t.UpdatedAt(),
+		Description:       t.Details().Description(),
+		ExternalReference: t.Details().ExternalReference(),
+		Metadata:          t.Details().Metadata(),
+	}
+
+	if _, err := s.handler.Db().Collection(s.collection).InsertOne(ctx, bson); err != nil {
+		return entity.ScheduledTransfer{}, entity.WrapError(entity.ErrCreateScheduledTransfer, err)
+	}
+
+	return t, nil
+}
+
+// FindByID performs findOne into the database
+func (s scheduledTransferRepository) FindByID(ctx context.Context, ID vo.Uuid) (entity.ScheduledTransfer, error) {
+	var scheduledBSON = &scheduledTransferBSON{}
+
+	err := s.handler.Db().Collection(s.collection).
+		FindOne(ctx, bson.M{"id": ID.Value()}).
+		Decode(scheduledBSON)
+	if err != nil {
+		switch err {
+		case mongo.ErrNoDocuments:
+			return entity.ScheduledTransfer{}, entity.ErrNotFoundScheduledTransfer
+		default:
+			return entity.ScheduledTransfer{}, entity.WrapError(entity.ErrFindScheduledTransfer, err)
+		}
+	}
+
+	return scheduledBSON.entity()
+}
+
+// Cancel performs updateOne into the database, a transfer leased by a worker is being executed and cannot be canceled
+func (s scheduledTransferRepository) Cancel(ctx context.Context, t entity.ScheduledTransfer) error {
+	var (
+		query = bson.M{
+			"id":     t.ID().Value(),
+			"status": string(entity.TransferScheduled),
+			"$or": bson.A{
+				bson.M{"lease_expires_at": bson.M{"$exists": false}},
+				bson.M{"lease_expires_at": bson.M{"$lt": t.UpdatedAt()}},
+			},
+		}
+		update = bson.M{"$set": bson.M{
+			"status":     string(t.Status()),
+			"updated_at": t.UpdatedAt(),
+		}}
+	)
+
+	result, err := s.handler.Db().Collection(s.collection).UpdateOne(ctx, query, update)
+	if err != nil {
+		return entity.WrapError(entity.ErrUpdateScheduledTransfer, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return entity.ErrScheduledTransferNotCancelable
+	}
+
+	return nil
+}
+
+// LeaseDue leases up to limit scheduled transfers due at now, each one with an atomic findOneAndUpdate
+// so concurrent workers never lease the same transfer
+func (s scheduledTransferRepository) LeaseDue(
+	ctx context.Context,
+	now time.Time,
+	owner string,
+	ttl time.Duration,
+	limit int,
+) ([]entity.ScheduledTransfer, error) {
+	var (
+		query = bson.M{
+			"status":     string(entity.TransferScheduled),
+			"execute_at": bson.M{"$lte": now},
+			"$or": bson.A{
+				bson.M{"lease_expires_at": bson.M{"$exists": false}},
+				bson.M{"lease_expires_at": bson.M{"$lt": now}},
+			},
+		}
+		update = bson.M{"$set": bson.M{
+			"lease_owner":      owner,
+			"lease_expires_at": now.Add(ttl),
+		}}
+		opts = options.FindOneAndUpdate().
+			SetSort(bson.D{{Key: "execute_at", Value: 1}}).
+			SetReturnDocument(options.After)
+	)
+
+	var leased []entity.ScheduledTransfer
+	for len(leased) < limit {
+		var scheduledBSON = &scheduledTransferBSON{}
+
+		err := s.handler.Db().Collection(s.collection).
+			FindOneAndUpdate(ctx, query, update, opts).
+			Decode(scheduledBSON)
+		if err == mongo.ErrNoDocuments {
+			break
+		}
+		if err != nil {
+			return leased, entity.WrapError(entity.ErrLeaseScheduledTransfers, err)
+		}
+
+		scheduled, err := scheduledBSON.entity()
+		if err != nil {
+			return leased, err
+		}
+
+		leased = append(leased, scheduled)
+	}
+
+	return leased, nil
+}
+
+// Complete performs updateOne into the database storing the result of the execution, or the date it is retried at,
+// and releasing the lease. Only a transfer still scheduled is completed, it fails with a conflict when the lease was
+// taken over by another worker or the transfer was canceled after the lease expired
+func (s scheduledTransferRepository) Complete(ctx context.Context, owner string, t entity.ScheduledTransfer) error {
+	var (
+		query = bson.M{
+			"id":          t.ID().Value(),
+			"lease_owner": owner,
+			"status":      string(entity.TransferScheduled),
+		}
+		update = bson.M{
+			"$set": bson.M{
+				"status":         string(t.Status()),
+				"failure_reason": t.FailureReason(),
+				"attempts":       t.Attempts(),
+				"execute_at":     t.ExecuteAt(),
+				"updated_at":     t.UpdatedAt(),
+			},
+			"$unset": bson.M{"lease_owner": "", "lease_expires_at": ""},
+		}
+	)
+
+	result, err := s.handler.Db().Collection(s.collection).UpdateOne(ctx, query, update)
+	if err != nil {
+		return entity.WrapError(entity.ErrUpdateScheduledTransfer, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return entity.ErrScheduledTransferChanged
+	}
+
+	return nil
+}
+
+func (s scheduledTransferBSON) entity() (entity.ScheduledTransfer, error) {
+	ID, err := vo.NewUuid(s.ID)
+	if err != nil {
+		return entity.ScheduledTransfer{}, err
+	}
+
+	payerID, err := vo.NewUuid(s.PayerID)
+	if err != nil {
+		return entity.ScheduledTransfer{}, err
+	}
+
+	payeeID, err := vo.NewUuid(s.PayeeID)
+	if err != nil {
+		return entity.ScheduledTransfer{}, err
+	}
+
+	currency, err := vo.NewCurrency(s.Currency)
+	if err != nil {
+		return entity.ScheduledTransfer{}, err
+	}
+
+	amount, err := vo.NewAmount(s.Value)
+	if err != nil {
+		return entity.ScheduledTransfer{}, err
+	}
+
+	return entity.RestoreScheduledTransfer(
+		ID,
+		payerID,
+		payeeID,
+		vo.NewMoney(currency, amount),
+		s.ExecuteAt,
+		entity.TransferStatus(s.Status),
+		s.FailureReason,
+		s.CreatedAt,
+		s.UpdatedAt,
+	).
+		WithDetails(entity.RestoreTransferDetails(s.Description, s.ExternalReference, s.Metadata)).
+		WithAttempts(s.Attempts), nil
+}
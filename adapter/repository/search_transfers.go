@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type searchTransfersRepository struct {
+	handler    *database.MongoHandler
+	collection string
+}
+
+// NewSearchTransfersRepository creates new searchTransfersRepository with its dependencies
+func NewSearchTransfersRepository(handler *database.MongoHandler) entity.TransferRepositorySearcher {
+	return searchTransfersRepository{
+		handler:    handler,
+		collection: "transfers",
+	}
+}
+
+// Search performs find into the database returning the transfers sent or received by the user matching
+// the search, the most recent first
+func (s searchTransfersRepository) Search(ctx context.Context, search entity.TransferSearch) ([]entity.Transfer, error) {
+	var query = bson.M{
+		"$or": bson.A{bson.M{"payer": search.User().Value()}, bson.M{"payee": search.User().Value()}},
+	}
+
+	if search.ExternalReference() != "" {
+		query["external_reference"] = search.ExternalReference()
+	}
+
+	if search.Text() != "" {
+		query["description"] = primitive.Regex{Pattern: regexp.QuoteMeta(search.Text()), Options: "i"}
+	}
+
+	for key, value := range search.Metadata() {
+		query["metadata."+key] = value
+	}
+
+	cursor, err := s.handler.Db().Collection(s.collection).Find(
+		ctx,
+		query,
+		options.Find().
+			SetSort(bson.D{{Key: "created_at", Value: -1}}).
+			SetLimit(int64(search.Limit())),
+	)
+	if err != nil {
+		return nil, entity.WrapError(entity.ErrFindTransfers, err)
+	}
+	defer cursor.Close(ctx)
+
+	var transfers = make([]entity.Transfer, 0)
+	for cursor.Next(ctx) {
+		var transferBSON findTransferBSON
+		if err := cursor.Decode(&transferBSON); err != nil {
+			return nil, entity.WrapError(entity.ErrFindTransfers, err)
+		}
+
+		transfer, err := transferBSON.entity()
+		if err != nil {
+			return nil, err
+		}
+
+		transfers = append(transfers, transfer)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, entity.WrapError(entity.ErrFindTransfers, err)
+	}
+
+	return transfers, nil
+}
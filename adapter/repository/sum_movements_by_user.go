@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type sumMovementsByUserRepository struct {
+	handler    *database.MongoHandler
+	collection string
+}
+
+// NewSumMovementsByUserRepository creates new sumMovementsByUserRepository with its dependencies
+func NewSumMovementsByUserRepository(handler *database.MongoHandler) entity.MovementRepositorySummarizer {
+	return sumMovementsByUserRepository{
+		handler:    handler,
+		collection: "movements",
+	}
+}
+
+// SumByUser performs aggregations into the database returning the money deposited and withdrawn by each user
+// after from and up to to, a reversal counts as the opposite movement at the time it happened
+func (s sumMovementsByUserRepository) SumByUser(ctx context.Context, from time.Time, to time.Time) (map[vo.Uuid]entity.MovementTotals, error) {
+	var (
+		collection = s.handler.Db().Collection(s.collection)
+		created    = bson.M{"$gt": from, "$lte": to}
+		totals     = make(map[vo.Uuid]entity.MovementTotals)
+	)
+
+	for _, movement := range []struct {
+		filter   bson.M
+		received bool
+	}{
+		{filter: bson.M{"type": string(entity.Deposit), "created_at": created}, received: true},
+		{filter: bson.M{"type": string(entity.Withdrawal), "created_at": created}},
+		{filter: bson.M{"type": string(entity.Deposit), "reversed_at": created}},
+		{filter: bson.M{"type": string(entity.Withdrawal), "reversed_at": created}, received: true},
+	} {
+		sum, err := sumByUser(ctx, collection, movement.filter, "$user_id", "$value")
+		if err != nil {
+			return nil, entity.WrapError(entity.ErrSumMovements, err)
+		}
+
+		if movement.received {
+			addTotals(totals, nil, sum)
+		} else {
+			addTotals(totals, sum, nil)
+		}
+	}
+
+	return totals, nil
+}
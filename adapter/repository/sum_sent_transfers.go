@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type sumSentTransfersRepository struct {
+	handler    *database.MongoHandler
+	collection string
+}
+
+// NewSumSentTransfersRepository creates new sumSentTransfersRepository with its dependencies
+func NewSumSentTransfersRepository(handler *database.MongoHandler) entity.TransferRepositorySentSummarizer {
+	return sumSentTransfersRepository{
+		handler:    handler,
+		collection: "transfers",
+	}
+}
+
+// SumSent performs an aggregation into the database returning the money debited by the transfers sent by the user
+// created since the date, the value plus the fee paid by the user
+func (s sumSentTransfersRepository) SumSent(ctx context.Context, userID vo.Uuid, since time.Time) (int64, error) {
+	var filter = bson.M{
+		"payer":      userID.Value(),
+		"created_at": bson.M{"$gte": since},
+	}
+
+	totals, err := sumByUser(ctx, s.handler.Db().Collection(s.collection), filter, "$payer", bson.M{"$add": bson.A{"$value", payerFee}})
+	if err != nil {
+		return 0, entity.WrapError(entity.ErrSumSentTransfers, err)
+	}
+
+	return totals[userID], nil
+}
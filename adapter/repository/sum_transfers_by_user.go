@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type (
+	// Bson data
+	sumByUserBSON struct {
+		UserID string `bson:"_id"`
+		Total  int64  `bson:"total"`
+	}
+
+	sumTransfersByUserRepository struct {
+		handler    *database.MongoHandler
+		collection string
+	}
+)
+
+// NewSumTransfersByUserRepository creates new sumTransfersByUserRepository with its dependencies
+func NewSumTransfersByUserRepository(handler *database.MongoHandler) entity.TransferRepositorySummarizer {
+	return sumTransfersByUserRepository{
+		handler:    handler,
+		collection: "transfers",
+	}
+}
+
+// SumByUser performs an aggregation into the database returning the money sent and received by each user
+// in transfers created after from and up to to, the fees are sent by whoever pays them and received by the fee account
+func (s sumTransfersByUserRepository) SumByUser(ctx context.Context, from time.Time, to time.Time) (map[vo.Uuid]entity.MovementTotals, error) {
+	var (
+		collection = s.handler.Db().Collection(s.collection)
+		period     = bson.M{"created_at": bson.M{"$gt": from, "$lte": to}}
+		totals     = make(map[vo.Uuid]entity.MovementTotals)
+	)
+
+	sent, err := sumByUser(ctx, collection, period, "$payer", bson.M{"$add": bson.A{"$value", payerFee}})
+	if err != nil {
+		return nil, entity.WrapError(entity.ErrSumTransfers, err)
+	}
+
+	received, err := sumByUser(ctx, collection, period, "$payee", bson.M{"$subtract": bson.A{"$value", payeeFee}})
+	if err != nil {
+		return nil, entity.WrapError(entity.ErrSumTransfers, err)
+	}
+
+	fees, err := sumByUser(
+		ctx,
+		collection,
+		bson.M{"created_at": period["created_at"], "fee_account": bson.M{"$exists": true}},
+		"$fee_account",
+		bson.M{"$add": bson.A{payerFee, payeeFee}},
+	)
+	if err != nil {
+		return nil, entity.WrapError(entity.ErrSumTransfers, err)
+	}
+
+	addTotals(totals, sent, received)
+	addTotals(totals, nil, fees)
+
+	return totals, nil
+}
+
+var (
+	// The fees of the transfers, absent from the transfers created before fees were charged
+	payerFee = bson.M{"$ifNull": bson.A{"$payer_fee", 0}}
+	payeeFee = bson.M{"$ifNull": bson.A{"$payee_fee", 0}}
+)
+
+// sumByUser returns the sum of the amount of the documents matching the filter grouped by the user field
+func sumByUser(ctx context.Context, collection *mongo.Collection, filter bson.M, userField string, amount interface{}) (map[vo.Uuid]int64, error) {
+	var pipeline = bson.A{
+		bson.M{"$match": filter},
+		bson.M{"$group": bson.M{"_id": userField, "total": bson.M{"$sum": amount}}},
+	}
+
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var totals = make(map[vo.Uuid]int64)
+	for cursor.Next(ctx) {
+		var sumBSON sumByUserBSON
+		if err := cursor.Decode(&sumBSON); err != nil {
+			return nil, err
+		}
+
+		ID, err := vo.NewUuid(sumBSON.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		totals[ID] = sumBSON.Total
+	}
+
+	return totals, cursor.Err()
+}
+
+// addTotals adds the money sent and received by each user to totals
+func addTotals(totals map[vo.Uuid]entity.MovementTotals, sent map[vo.Uuid]int64, received map[vo.Uuid]int64) {
+	for ID, total := range sent {
+		movement := totals[ID]
+		movement.Sent += total
+		totals[ID] = movement
+	}
+
+	for ID, total := range received {
+		movement := totals[ID]
+		movement.Received += total
+		totals[ID] = movement
+	}
+}
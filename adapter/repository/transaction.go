@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// withTransaction runs fn inside a transaction of a new session, or inside the transaction already
+// carried by ctx so nested operations commit or abort together. The driver runs fn again on transient
+// errors, those retries are recorded by the handler
+func withTransaction(ctx context.Context, handler *database.MongoHandler, fn func(context.Context) error) error {
+	if mongo.SessionFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	var attempts int
+	callback := func(sessCtx mongo.SessionContext) (interface{}, error) {
+		attempts++
+		err := fn(sessCtx)
+		if err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	session, err := handler.Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, callback)
+	handler.TransactionRetried(attempts - 1)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
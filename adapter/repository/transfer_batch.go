@@ -0,0 +1,275 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type (
+	// Bson data
+	transferBatchBSON struct {
+		ID        string                  `bson:"id"`
+		PayerID   string                  `bson:"payer"`
+		Mode      string                  `bson:"mode"`
+		Items     []transferBatchItemBSON `bson:"items"`
+		Status    string                  `bson:"status"`
+		CreatedAt time.Time               `bson:"created_at"`
+		UpdatedAt time.Time               `bson:"updated_at"`
+	}
+
+	// Bson data
+	transferBatchItemBSON struct {
+		ID            string `bson:"id"`
+		PayeeID       string `bson:"payee"`
+		Currency      string `bson:"currency"`
+		Value         int64  `bson:"value"`
+		Status        string `bson:"status"`
+		FailureReason string `bson:"failure_reason,omitempty"`
+	}
+
+	transferBatchRepository struct {
+		handler    *database.MongoHandler
+		collection string
+	}
+)
+
+// NewTransferBatchRepository creates new transferBatchRepository with its dependencies
+func NewTransferBatchRepository(handler *database.MongoHandler) entity.TransferBatchRepository {
+	return transferBatchRepository{
+		handler:    handler,
+		collection: "transfer_batches",
+	}
+}
+
+// Create performs insertOne into the database
+func (t transferBatchRepository) Create(ctx context.Context, batch entity.TransferBatch) (entity.TransferBatch, error) {
+	var items = make([]transferBatchItemBSON, 0, len(batch.Items()))
+	for _, item := range batch.Items() {
+		items = append(items, newTransferBatchItemBSON(item))
+	}
+
+	var bson = transferBatchBSON{
+		ID:        batch.ID().Value(),
+		PayerID:   batch.Payer().Value(),
+		Mode:      string(batch.Mode()),
+		Items:     items,
+		Status:    string(batch.Status()),
+		CreatedAt: batch.CreatedAt(),
+		UpdatedAt: batch.UpdatedAt(),
+	}
+
+	if _, err := t.handler.Db().Collection(t.collection).InsertOne(ctx, bson); err != nil {
+		return entity.TransferBatch{}, entity.WrapError(entity.ErrCreateTransferBatch, err)
+	}
+
+	return batch, nil
+}
+
+// FindByID performs findOne into the database
+func (t transferBatchRepository) FindByID(ctx context.Context, ID vo.Uuid) (entity.TransferBatch, error) {
+	var batchBSON = &transferBatchBSON{}
+
+	err := t.handler.Db().Collection(t.collection).
+		FindOne(ctx, bson.M{"id": ID.Value()}).
+		Decode(batchBSON)
+	if err != nil {
+		switch err {
+		case mongo.ErrNoDocuments:
+			return entity.TransferBatch{}, entity.ErrNotFoundTransferBatch
+		default:
+			return entity.TransferBatch{}, entity.WrapError(entity.ErrFindTransferBatch, err)
+		}
+	}
+
+	return batchBSON.entity()
+}
+
+// Start performs updateOne into the database, only a pending batch is started
+func (t transferBatchRepository) Start(ctx context.Context, batch entity.TransferBatch) error {
+	var (
+		query  = bson.M{"id": batch.ID().Value(), "status": string(entity.BatchPending)}
+		update = bson.M{"$set": bson.M{
+			"status":     string(batch.Status()),
+			"updated_at": batch.UpdatedAt(),
+		}}
+	)
+
+	result, err := t.handler.Db().Collection(t.collection).UpdateOne(ctx, query, update)
+	if err != nil {
+		return entity.WrapError(entity.ErrUpdateTransferBatch, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return entity.ErrTransferBatchNotPending
+	}
+
+	return nil
+}
+
+// UpdateItem performs updateOne into the database setting the status of a single item, the update date
+// tells the batch is still being processed
+func (t transferBatchRepository) UpdateItem(ctx context.Context, ID vo.Uuid, item entity.TransferBatchItem) error {
+	var (
+		query  = bson.M{"id": ID.Value(), "items.id": item.ID().Value()}
+		update = bson.M{"$set": bson.M{
+			"items.$.status":         string(item.Status()),
+			"items.$.failure_reason": item.FailureReason(),
+			"updated_at":             time.Now(),
+		}}
+	)
+
+	if _, err := t.handler.Db().Collection(t.collection).UpdateOne(ctx, query, update); err != nil {
+		return entity.WrapError(entity.ErrUpdateTransferBatch, err)
+	}
+
+	return nil
+}
+
+// Finish performs updateOne into the database storing the status of the batch and of all its items
+func (t transferBatchRepository) Finish(ctx context.Context, batch entity.TransferBatch) error {
+	var items = make([]transferBatchItemBSON, 0, len(batch.Items()))
+	for _, item := range batch.Items() {
+		items = append(items, newTransferBatchItemBSON(item))
+	}
+
+	var (
+		query  = bson.M{"id": batch.ID().Value()}
+		update = bson.M{"$set": bson.M{
+			"items":      items,
+			"status":     string(batch.Status()),
+			"updated_at": batch.UpdatedAt(),
+		}}
+	)
+
+	if _, err := t.handler.Db().Collection(t.collection).UpdateOne(ctx, query, update); err != nil {
+		return entity.WrapError(entity.ErrUpdateTransferBatch, err)
+	}
+
+	return nil
+}
+
+// LeaseStale leases up to limit batches left pending or processing since before now minus staleAfter, each one
+// with an atomic findOneAndUpdate so concurrent workers never lease the same batch
+func (t transferBatchRepository) LeaseStale(
+	ctx context.Context,
+	now time.Time,
+	staleAfter time.Duration,
+	limit int,
+) ([]entity.TransferBatch, error) {
+	var (
+		query = bson.M{
+			"status": bson.M{"$in": bson.A{
+				string(entity.BatchPending),
+				string(entity.BatchProcessing),
+			}},
+			"updated_at": bson.M{"$lt": now.Add(-staleAfter)},
+		}
+		update = bson.M{"$set": bson.M{
+			"status":     string(entity.BatchProcessing),
+			"updated_at": now,
+		}}
+		opts = options.FindOneAndUpdate().
+			SetSort(bson.D{{Key: "updated_at", Value: 1}}).
+			SetReturnDocument(options.After)
+	)
+
+	var leased []entity.TransferBatch
+	for len(leased) < limit {
+		var batchBSON = &transferBatchBSON{}
+
+		err := t.handler.Db().Collection(t.collection).
+			FindOneAndUpdate(ctx, query, update, opts).
+			Decode(batchBSON)
+		if err == mongo.ErrNoDocuments {
+			break
+		}
+		if err != nil {
+			return leased, entity.WrapError(entity.ErrLeaseTransferBatches, err)
+		}
+
+		batch, err := batchBSON.entity()
+		if err != nil {
+			return leased, err
+		}
+
+		leased = append(leased, batch)
+	}
+
+	return leased, nil
+}
+
+// WithTransaction runs fn inside a transaction
+func (t transferBatchRepository) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return withTransaction(ctx, t.handler, fn)
+}
+
+func newTransferBatchItemBSON(item entity.TransferBatchItem) transferBatchItemBSON {
+	return transferBatchItemBSON{
+		ID:            item.ID().Value(),
+		PayeeID:       item.Payee().Value(),
+		Currency:      item.Value().Currency().String(),
+		Value:         item.Value().Amount().Value(),
+		Status:        string(item.Status()),
+		FailureReason: item.FailureReason(),
+	}
+}
+
+func (t transferBatchBSON) entity() (entity.TransferBatch, error) {
+	ID, err := vo.NewUuid(t.ID)
+	if err != nil {
+		return entity.TransferBatch{}, err
+	}
+
+	payerID, err := vo.NewUuid(t.PayerID)
+	if err != nil {
+		return entity.TransferBatch{}, err
+	}
+
+	var items = make([]entity.TransferBatchItem, 0, len(t.Items))
+	for _, item := range t.Items {
+		itemID, err := vo.NewUuid(item.ID)
+		if err != nil {
+			return entity.TransferBatch{}, err
+		}
+
+		payeeID, err := vo.NewUuid(item.PayeeID)
+		if err != nil {
+			return entity.TransferBatch{}, err
+		}
+
+		currency, err := vo.NewCurrency(item.Currency)
+		if err != nil {
+			return entity.TransferBatch{}, err
+		}
+
+		amount, err := vo.NewAmount(item.Value)
+		if err != nil {
+			return entity.TransferBatch{}, err
+		}
+
+		items = append(items, entity.RestoreTransferBatchItem(
+			itemID,
+			payeeID,
+			vo.NewMoney(currency, amount),
+			entity.BatchItemStatus(item.Status),
+			item.FailureReason,
+		))
+	}
+
+	return entity.RestoreTransferBatch(
+		ID,
+		payerID,
+		entity.BatchMode(t.Mode),
+		items,
+		entity.BatchStatus(t.Status),
+		t.CreatedAt,
+		t.UpdatedAt,
+	), nil
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type updateUserHeldRepository struct {
+	handler    *database.MongoHandler
+	collection string
+}
+
+// NewUpdateUserHeldRepository creates new updateUserHeldRepository with its dependencies
+func NewUpdateUserHeldRepository(handler *database.MongoHandler) entity.UserRepositoryHolder {
+	return updateUserHeldRepository{
+		handler:    handler,
+		collection: "users",
+	}
+}
+
+// UpdateHeld performs updateOne into the database
+func (u updateUserHeldRepository) UpdateHeld(ctx context.Context, ID vo.Uuid, money vo.Money) error {
+	var (
+		query  = bson.M{"id": ID.Value()}
+		update = bson.M{"$set": bson.M{"wallet.held": money.Amount().Value()}}
+	)
+
+	result, err := u.handler.Db().Collection(u.collection).UpdateOne(ctx, query, update)
+	if err != nil {
+		return entity.WrapError(entity.ErrUpdateUserWallet, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return entity.WrapError(entity.ErrUpdateUserWallet, entity.ErrNotFoundUser)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type updateUserKYCLevelRepository struct {
+	handler    *database.MongoHandler
+	collection string
+}
+
+// NewUpdateUserKYCLevelRepository creates new updateUserKYCLevelRepository with its dependencies
+func NewUpdateUserKYCLevelRepository(handler *database.MongoHandler) entity.UserRepositoryKYCUpdater {
+	return updateUserKYCLevelRepository{
+		handler:    handler,
+		collection: "users",
+	}
+}
+
+// UpdateKYCLevel performs updateOne into the database
+func (u updateUserKYCLevelRepository) UpdateKYCLevel(ctx context.Context, ID vo.Uuid, level entity.KYCLevel) error {
+	var (
+		query  = bson.M{"id": ID.Value()}
+		update = bson.M{"$set": bson.M{"kyc_level": level.String()}}
+	)
+
+	result, err := u.handler.Db().Collection(u.collection).UpdateOne(ctx, query, update)
+	if err != nil {
+		return entity.WrapError(entity.ErrUpdateUserKYCLevel, err)
+	}
+
+	if result.MatchedCount == 0 {
+		return entity.WrapError(entity.ErrUpdateUserKYCLevel, entity.ErrNotFoundUser)
+	}
+
+	return nil
+}
@@ -6,7 +6,6 @@ import (
 	"github.com/GSabadini/golang-clean-architecture/domain/entity"
 	"github.com/GSabadini/golang-clean-architecture/domain/vo"
 	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
-	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 )
@@ -34,9 +33,9 @@ func (u updateUserWalletRepository) UpdateWallet(ctx context.Context, ID vo.Uuid
 	if _, err := u.handler.Db().Collection(u.collection).UpdateOne(ctx, query, update); err != nil {
 		switch err {
 		case mongo.ErrNilDocument:
-			return errors.Wrap(entity.ErrNotFoundUser, entity.ErrUpdateUserWallet.Error())
+			return entity.WrapError(entity.ErrUpdateUserWallet, entity.ErrNotFoundUser)
 		default:
-			return errors.Wrap(err, entity.ErrUpdateUserWallet.Error())
+			return entity.WrapError(entity.ErrUpdateUserWallet, err)
 		}
 	}
 
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type (
+	// Bson data
+	userSnapshotBSON struct {
+		AggregateID string    `bson:"aggregate_id"`
+		Sequence    int64     `bson:"sequence"`
+		Data        string    `bson:"data"`
+		CreatedAt   time.Time `bson:"created_at"`
+	}
+
+	userSnapshotRepository struct {
+		handler    *database.MongoHandler
+		collection string
+	}
+)
+
+// NewUserSnapshotRepository creates new userSnapshotRepository with its dependencies
+func NewUserSnapshotRepository(handler *database.MongoHandler) entity.UserSnapshotRepository {
+	return userSnapshotRepository{
+		handler:    handler,
+		collection: "user_snapshots",
+	}
+}
+
+// Save performs insertOne into the database
+func (u userSnapshotRepository) Save(ctx context.Context, s entity.UserSnapshot) error {
+	var bson = userSnapshotBSON{
+		AggregateID: s.AggregateID().Value(),
+		Sequence:    s.Sequence(),
+		Data:        string(s.Data()),
+		CreatedAt:   s.CreatedAt(),
+	}
+
+	if _, err := u.handler.Db().Collection(u.collection).InsertOne(ctx, bson); err != nil {
+		if database.IsDuplicateKeyError(err) {
+			return nil
+		}
+
+		return entity.WrapError(entity.ErrSaveSnapshot, err)
+	}
+
+	return nil
+}
+
+// FindLatest performs findOne into the database returning the snapshot with the highest sequence
+func (u userSnapshotRepository) FindLatest(ctx context.Context, ID vo.Uuid) (entity.UserSnapshot, error) {
+	var snapshotBSON = &userSnapshotBSON{}
+
+	err := u.handler.Db().Collection(u.collection).
+		FindOne(
+			ctx,
+			bson.M{"aggregate_id": ID.Value()},
+			options.FindOne().SetSort(bson.D{{Key: "sequence", Value: -1}}),
+		).Decode(snapshotBSON)
+	if err != nil {
+		switch err {
+		case mongo.ErrNoDocuments:
+			return entity.UserSnapshot{}, entity.ErrNotFoundSnapshot
+		default:
+			return entity.UserSnapshot{}, entity.WrapError(entity.ErrFindEvents, err)
+		}
+	}
+
+	return entity.RestoreUserSnapshot(ID, snapshotBSON.Sequence, []byte(snapshotBSON.Data), snapshotBSON.CreatedAt), nil
+}
@@ -0,0 +1,16 @@
+package entity
+
+import (
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/google/uuid"
+)
+
+// derivedID returns the ID named name in the namespace of ID, always the same for the same ID and name
+func derivedID(ID vo.Uuid, name string) vo.Uuid {
+	var (
+		namespace  = uuid.MustParse(ID.Value())
+		derived, _ = vo.NewUuid(uuid.NewSHA1(namespace, []byte(name)).String())
+	)
+
+	return derived
+}
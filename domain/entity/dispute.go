@@ -0,0 +1,358 @@
+package entity
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+const (
+	// Dispute status, won and lost are from the point of view of the payee
+	DisputeOpen        DisputeStatus = "OPEN"
+	DisputeUnderReview DisputeStatus = "UNDER_REVIEW"
+	DisputeWon         DisputeStatus = "WON"
+	DisputeLost        DisputeStatus = "LOST"
+
+	// Dispute notices sent to its parties
+	DisputeOpenedNotice        DisputeNoticeKind = "DISPUTE_OPENED"
+	DisputeEvidenceAddedNotice DisputeNoticeKind = "DISPUTE_EVIDENCE_ADDED"
+	DisputeResolvedNotice      DisputeNoticeKind = "DISPUTE_RESOLVED"
+
+	// MaxDisputeEvidence is the maximum number of evidences of a dispute
+	MaxDisputeEvidence = 20
+
+	// Bounds of the metadata of an evidence
+	MaxEvidenceMetadataKeys        = 20
+	MaxEvidenceMetadataKeyLength   = 64
+	MaxEvidenceMetadataValueLength = 1024
+)
+
+var (
+	ErrCreateDispute = NewError(KindInternal, "create_dispute", "error creating dispute")
+
+	ErrNotFoundDispute = NewError(KindNotFound, "not_found_dispute", "not found dispute")
+
+	ErrFindDispute = NewError(KindInternal, "find_dispute", "error fetching dispute")
+
+	ErrUpdateDispute = NewError(KindInternal, "update_dispute", "error updating dispute")
+
+	ErrTransferAlreadyDisputed = NewError(KindConflict, "transfer_already_disputed", "transfer already has a dispute")
+
+	ErrDisputeChanged = NewError(KindConflict, "dispute_changed", "dispute was changed by another request")
+
+	ErrDisputeNotOpen = NewError(KindConflict, "dispute_not_open", "dispute is not open")
+
+	ErrDisputeResolved = NewError(KindConflict, "dispute_resolved", "dispute was already resolved")
+
+	ErrInvalidDisputeOutcome = NewError(KindValidation, "invalid_dispute_outcome", "dispute outcome must be WON or LOST")
+
+	ErrNotDisputeParty = NewError(KindForbidden, "not_dispute_party", "only the payer or the payee of the transfer take part in the dispute")
+
+	ErrInvalidEvidence = NewError(KindValidation, "invalid_evidence", "evidence metadata must have from 1 to 20 keys up to 64 characters and values up to 1024 characters")
+
+	ErrTooManyEvidence = NewError(KindValidation, "too_many_evidence", "dispute has too many evidences")
+)
+
+type (
+	// DisputeStatus defines the status of a dispute
+	DisputeStatus string
+
+	// DisputeNoticeKind defines the change of a dispute notified to its parties
+	DisputeNoticeKind string
+
+	// DisputeRepositoryCreator defines the operation of creating a dispute entity,
+	// Create fails with ErrTransferAlreadyDisputed when the transfer already has a dispute
+	DisputeRepositoryCreator interface {
+		Create(context.Context, Dispute) (Dispute, error)
+	}
+
+	// DisputeRepositoryFinder defines the search operation for a dispute entity
+	DisputeRepositoryFinder interface {
+		FindByID(context.Context, vo.Uuid) (Dispute, error)
+	}
+
+	// DisputeRepositoryUpdater defines the operations of moving a dispute out of the status from and of adding
+	// an evidence to it, both fail with ErrDisputeChanged when the dispute was changed meanwhile
+	DisputeRepositoryUpdater interface {
+		Update(ctx context.Context, from DisputeStatus, dispute Dispute) error
+		AddEvidence(context.Context, Dispute, Evidence) error
+		WithTransaction(context.Context, func(context.Context) error) error
+	}
+
+	// DisputeRepository defines the operations of the disputes
+	DisputeRepository interface {
+		DisputeRepositoryCreator
+		DisputeRepositoryFinder
+		DisputeRepositoryUpdater
+	}
+
+	// Dispute defines the contestation of a transfer by its payer. While the dispute is not resolved the value
+	// is held from the balance of the payee, even beyond its available balance, a lost dispute charges it back
+	// to the payer
+	Dispute struct {
+		id        vo.Uuid
+		transfer  vo.Uuid
+		payer     vo.Uuid
+		payee     vo.Uuid
+		value     vo.Money
+		reason    string
+		status    DisputeStatus
+		evidence  []Evidence
+		createdAt time.Time
+		updatedAt time.Time
+	}
+
+	// DisputeNotice defines the notification of a change of a dispute to one of its parties, the status of a
+	// resolved dispute is its outcome
+	DisputeNotice struct {
+		kind      DisputeNoticeKind
+		dispute   vo.Uuid
+		transfer  vo.Uuid
+		recipient vo.Uuid
+		value     vo.Money
+		status    DisputeStatus
+		at        time.Time
+	}
+
+	// Evidence defines the attachments submitted by a party of a dispute, described by its metadata
+	Evidence struct {
+		submittedBy vo.Uuid
+		metadata    map[string]string
+		submittedAt time.Time
+	}
+)
+
+// NewDispute creates new open dispute of the value credited to the payee by the transfer
+func NewDispute(ID vo.Uuid, transfer Transfer, reason string, createdAt time.Time) Dispute {
+	return Dispute{
+		id:        ID,
+		transfer:  transfer.ID(),
+		payer:     transfer.Payer(),
+		payee:     transfer.Payee(),
+		value:     transfer.Credited(),
+		reason:    reason,
+		status:    DisputeOpen,
+		createdAt: createdAt,
+		updatedAt: createdAt,
+	}
+}
+
+// RestoreDispute creates a dispute from its persisted representation
+func RestoreDispute(
+	ID vo.Uuid,
+	transferID vo.Uuid,
+	payerID vo.Uuid,
+	payeeID vo.Uuid,
+	value vo.Money,
+	reason string,
+	status DisputeStatus,
+	evidence []Evidence,
+	createdAt time.Time,
+	updatedAt time.Time,
+) Dispute {
+	return Dispute{
+		id:        ID,
+		transfer:  transferID,
+		payer:     payerID,
+		payee:     payeeID,
+		value:     value,
+		reason:    reason,
+		status:    status,
+		evidence:  evidence,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// NewEvidence creates new evidence, its metadata is bounded in number and length of the keys and values
+func NewEvidence(submittedBy vo.Uuid, metadata map[string]string, submittedAt time.Time) (Evidence, error) {
+	if len(metadata) == 0 ||
+		!validMetadata(metadata, MaxEvidenceMetadataKeys, MaxEvidenceMetadataKeyLength, MaxEvidenceMetadataValueLength) {
+		return Evidence{}, ErrInvalidEvidence
+	}
+
+	return Evidence{submittedBy: submittedBy, metadata: metadata, submittedAt: submittedAt}, nil
+}
+
+// AddEvidence returns the dispute with the evidence submitted by one of its parties
+func (d Dispute) AddEvidence(evidence Evidence) (Dispute, error) {
+	if d.Resolved() {
+		return Dispute{}, ErrDisputeResolved
+	}
+
+	if evidence.submittedBy != d.payer && evidence.submittedBy != d.payee {
+		return Dispute{}, ErrNotDisputeParty
+	}
+
+	if len(d.evidence) >= MaxDisputeEvidence {
+		return Dispute{}, ErrTooManyEvidence
+	}
+
+	d.evidence = append(append([]Evidence{}, d.evidence...), evidence)
+	d.updatedAt = evidence.submittedAt
+
+	return d, nil
+}
+
+// Review returns the open dispute under review
+func (d Dispute) Review(at time.Time) (Dispute, error) {
+	if d.status != DisputeOpen {
+		return Dispute{}, ErrDisputeNotOpen
+	}
+
+	d.status = DisputeUnderReview
+	d.updatedAt = at
+
+	return d, nil
+}
+
+// Resolve returns the dispute won or lost by the payee
+func (d Dispute) Resolve(outcome DisputeStatus, at time.Time) (Dispute, error) {
+	if d.Resolved() {
+		return Dispute{}, ErrDisputeResolved
+	}
+
+	if outcome != DisputeWon && outcome != DisputeLost {
+		return Dispute{}, ErrInvalidDisputeOutcome
+	}
+
+	d.status = outcome
+	d.updatedAt = at
+
+	return d, nil
+}
+
+// Resolved returns whether the dispute was won or lost
+func (d Dispute) Resolved() bool {
+	return d.status == DisputeWon || d.status == DisputeLost
+}
+
+// Chargeback returns the transfer returning the value from the payee to the payer, refunding the disputed
+// transfer. Its ID is derived from the ID of the dispute so a dispute is charged back only once
+func (d Dispute) Chargeback() Transfer {
+	return NewTransfer(derivedID(d.id, "chargeback"), d.payee, d.payer, d.value, d.updatedAt).WithRefundOf(d.transfer)
+}
+
+// Notices returns the notice of the change of the dispute addressed to each party, the payer and the payee
+func (d Dispute) Notices(kind DisputeNoticeKind) []DisputeNotice {
+	var notices = make([]DisputeNotice, 0, 2)
+	for _, recipient := range []vo.Uuid{d.payer, d.payee} {
+		notices = append(notices, DisputeNotice{
+			kind:      kind,
+			dispute:   d.id,
+			transfer:  d.transfer,
+			recipient: recipient,
+			value:     d.value,
+			status:    d.status,
+			at:        d.updatedAt,
+		})
+	}
+
+	return notices
+}
+
+// ID returns the id property
+func (d Dispute) ID() vo.Uuid {
+	return d.id
+}
+
+// Transfer returns the ID of the disputed transfer
+func (d Dispute) Transfer() vo.Uuid {
+	return d.transfer
+}
+
+// Payer returns the payer property
+func (d Dispute) Payer() vo.Uuid {
+	return d.payer
+}
+
+// Payee returns the payee property
+func (d Dispute) Payee() vo.Uuid {
+	return d.payee
+}
+
+// Value returns the value disputed
+func (d Dispute) Value() vo.Money {
+	return d.value
+}
+
+// Reason returns the reason property
+func (d Dispute) Reason() string {
+	return d.reason
+}
+
+// Status returns the status property
+func (d Dispute) Status() DisputeStatus {
+	return d.status
+}
+
+// Evidence returns the evidence property
+func (d Dispute) Evidence() []Evidence {
+	return d.evidence
+}
+
+// CreatedAt returns the createdAt property
+func (d Dispute) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+// UpdatedAt returns the updatedAt property
+func (d Dispute) UpdatedAt() time.Time {
+	return d.updatedAt
+}
+
+// Kind returns the kind property
+func (n DisputeNotice) Kind() DisputeNoticeKind {
+	return n.kind
+}
+
+// Dispute returns the ID of the dispute
+func (n DisputeNotice) Dispute() vo.Uuid {
+	return n.dispute
+}
+
+// Transfer returns the ID of the disputed transfer
+func (n DisputeNotice) Transfer() vo.Uuid {
+	return n.transfer
+}
+
+// Recipient returns the party of the dispute notified
+func (n DisputeNotice) Recipient() vo.Uuid {
+	return n.recipient
+}
+
+// Value returns the value disputed
+func (n DisputeNotice) Value() vo.Money {
+	return n.value
+}
+
+// Status returns the status of the dispute, its outcome once resolved
+func (n DisputeNotice) Status() DisputeStatus {
+	return n.status
+}
+
+// At returns the date of the change notified
+func (n DisputeNotice) At() time.Time {
+	return n.at
+}
+
+// RestoreEvidence creates an evidence from its persisted representation
+func RestoreEvidence(submittedBy vo.Uuid, metadata map[string]string, submittedAt time.Time) Evidence {
+	return Evidence{submittedBy: submittedBy, metadata: metadata, submittedAt: submittedAt}
+}
+
+// SubmittedBy returns the submittedBy property
+func (e Evidence) SubmittedBy() vo.Uuid {
+	return e.submittedBy
+}
+
+// Metadata returns the metadata property
+func (e Evidence) Metadata() map[string]string {
+	return e.metadata
+}
+
+// SubmittedAt returns the submittedAt property
+func (e Evidence) SubmittedAt() time.Time {
+	return e.submittedAt
+}
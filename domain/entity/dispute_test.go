@@ -0,0 +1,165 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func newDisputeTest() Dispute {
+	var (
+		payer, _ = vo.NewUuid("3f6c1d2a-8b4e-4c7f-9a1d-5e2b7c9f0a13")
+		payee, _ = vo.NewUuid("7b2f9e4c-1d3a-4b8e-9c6f-2a5d8e1b4c70")
+		fee      = NewFee(vo.NewUuidStaticTest(), vo.NewMoneyBRL(vo.NewAmountTest(0)), vo.NewMoneyBRL(vo.NewAmountTest(5)))
+		transfer = NewTransfer(
+			vo.NewUuidStaticTest(),
+			payer,
+			payee,
+			vo.NewMoneyBRL(vo.NewAmountTest(100)),
+			time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC),
+		).WithFee(fee)
+	)
+
+	return NewDispute(vo.NewUuidStaticTest(), transfer, "not recognized", time.Date(2020, 11, 10, 0, 0, 0, 0, time.UTC))
+}
+
+func TestNewDispute(t *testing.T) {
+	var dispute = newDisputeTest()
+
+	if dispute.Status() != DisputeOpen || dispute.Value().Amount().Value() != 95 {
+		t.Errorf("[TestCase '%s'] Got: '%v %v' | Want: '%v %v'", "Dispute the credited value", dispute.Status(), dispute.Value().Amount().Value(), DisputeOpen, 95)
+	}
+
+	var chargeback = dispute.Chargeback()
+	if chargeback.Payer() != dispute.Payee() || chargeback.Payee() != dispute.Payer() || chargeback.ID() == dispute.ID() {
+		t.Errorf("[TestCase '%s'] Got: '%v -> %v' | Want: '%v -> %v'", "Chargeback", chargeback.Payer(), chargeback.Payee(), dispute.Payee(), dispute.Payer())
+	}
+
+	if chargeback.RefundOf() != dispute.Transfer() {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Chargeback refunds the transfer", chargeback.RefundOf(), dispute.Transfer())
+	}
+}
+
+func TestNewEvidence(t *testing.T) {
+	var tooMany = make(map[string]string)
+	for _, key := range strings.Split("abcdefghijklmnopqrstu", "") {
+		tooMany[key] = "value"
+	}
+
+	tests := []struct {
+		name     string
+		metadata map[string]string
+		wantErr  error
+	}{
+		{
+			name:     "Evidence with metadata",
+			metadata: map[string]string{"file": "https://files.example.com/receipt.pdf", "type": "receipt"},
+		},
+		{
+			name:    "Evidence without metadata",
+			wantErr: ErrInvalidEvidence,
+		},
+		{
+			name:     "Evidence with too many keys",
+			metadata: tooMany,
+			wantErr:  ErrInvalidEvidence,
+		},
+		{
+			name:     "Evidence with a long key",
+			metadata: map[string]string{strings.Repeat("k", MaxEvidenceMetadataKeyLength+1): "value"},
+			wantErr:  ErrInvalidEvidence,
+		},
+		{
+			name:     "Evidence with a long value",
+			metadata: map[string]string{"note": strings.Repeat("v", MaxEvidenceMetadataValueLength+1)},
+			wantErr:  ErrInvalidEvidence,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewEvidence(vo.NewUuidStaticTest(), tt.metadata, time.Time{}); !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDispute_Transitions(t *testing.T) {
+	var (
+		at         = time.Date(2020, 11, 11, 0, 0, 0, 0, time.UTC)
+		dispute    = newDisputeTest()
+		review, _  = dispute.Review(at)
+		lost, _    = review.Resolve(DisputeLost, at)
+		payerProof = RestoreEvidence(dispute.Payer(), map[string]string{"type": "receipt"}, at)
+		stranger   = RestoreEvidence(vo.NewUuidStaticTest(), map[string]string{"type": "receipt"}, at)
+	)
+
+	tests := []struct {
+		name       string
+		transition func() (Dispute, error)
+		wantStatus DisputeStatus
+		wantErr    error
+	}{
+		{
+			name:       "Review open dispute",
+			transition: func() (Dispute, error) { return dispute.Review(at) },
+			wantStatus: DisputeUnderReview,
+		},
+		{
+			name:       "Review dispute under review",
+			transition: func() (Dispute, error) { return review.Review(at) },
+			wantErr:    ErrDisputeNotOpen,
+		},
+		{
+			name:       "Resolve open dispute",
+			transition: func() (Dispute, error) { return dispute.Resolve(DisputeWon, at) },
+			wantStatus: DisputeWon,
+		},
+		{
+			name:       "Resolve dispute under review",
+			transition: func() (Dispute, error) { return review.Resolve(DisputeLost, at) },
+			wantStatus: DisputeLost,
+		},
+		{
+			name:       "Resolve with invalid outcome",
+			transition: func() (Dispute, error) { return review.Resolve(DisputeOpen, at) },
+			wantErr:    ErrInvalidDisputeOutcome,
+		},
+		{
+			name:       "Resolve resolved dispute",
+			transition: func() (Dispute, error) { return lost.Resolve(DisputeWon, at) },
+			wantErr:    ErrDisputeResolved,
+		},
+		{
+			name:       "Add evidence of the payer",
+			transition: func() (Dispute, error) { return review.AddEvidence(payerProof) },
+			wantStatus: DisputeUnderReview,
+		},
+		{
+			name:       "Add evidence of someone else",
+			transition: func() (Dispute, error) { return review.AddEvidence(stranger) },
+			wantErr:    ErrNotDisputeParty,
+		},
+		{
+			name:       "Add evidence to resolved dispute",
+			transition: func() (Dispute, error) { return lost.AddEvidence(payerProof) },
+			wantErr:    ErrDisputeResolved,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.transition()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if err == nil && got.Status() != tt.wantStatus {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Status(), tt.wantStatus)
+			}
+		})
+	}
+}
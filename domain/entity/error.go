@@ -0,0 +1,165 @@
+package entity
+
+import (
+	"errors"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+const (
+	// KindValidation is an input that breaks the rules of the domain
+	KindValidation ErrorKind = "VALIDATION"
+	// KindNotFound is a resource that does not exist
+	KindNotFound ErrorKind = "NOT_FOUND"
+	// KindConflict is a request in conflict with the current state of a resource
+	KindConflict ErrorKind = "CONFLICT"
+	// KindRuleViolation is a valid request refused by a business rule, such as a balance or a limit
+	KindRuleViolation ErrorKind = "RULE_VIOLATION"
+	// KindForbidden is an operation not allowed to the user
+	KindForbidden ErrorKind = "FORBIDDEN"
+	// KindUnavailable is an upstream service that could not be reached
+	KindUnavailable ErrorKind = "UNAVAILABLE"
+	// KindInternal is a failure of the application, such as a database error
+	KindInternal ErrorKind = "INTERNAL"
+)
+
+var (
+	ErrInternal = NewError(KindInternal, "internal_error", "internal error")
+
+	// valueObjectErrors classifies the errors of the value objects, which do not depend on the entities
+	valueObjectErrors = map[error]*Error{
+		vo.ErrNotAllowedTypeUser:  {kind: KindForbidden, code: "not_allowed_type_user"},
+		vo.ErrInvalidTypeUser:     {kind: KindValidation, code: "invalid_type_user"},
+		vo.ErrInvalidTypeDocument: {kind: KindValidation, code: "invalid_type_document"},
+		vo.ErrInvalidDocument:     {kind: KindValidation, code: "invalid_document"},
+		vo.ErrInvalidCPF:          {kind: KindValidation, code: "invalid_cpf"},
+		vo.ErrInvalidCNPJ:         {kind: KindValidation, code: "invalid_cnpj"},
+		vo.ErrInvalidEmail:        {kind: KindValidation, code: "invalid_email"},
+		vo.ErrInvalidCurrency:     {kind: KindValidation, code: "invalid_currency"},
+		vo.ErrInvalidUuid:         {kind: KindValidation, code: "invalid_uuid"},
+	}
+)
+
+type (
+	// ErrorKind defines the category of a domain error
+	ErrorKind string
+
+	// Error defines a domain error with its kind and a stable code for clients
+	Error struct {
+		kind    ErrorKind
+		code    string
+		message string
+	}
+
+	// wrappedError defines a domain error caused by another error, matching both with errors.Is
+	wrappedError struct {
+		err   error
+		cause error
+	}
+)
+
+// NewError creates new domain Error
+func NewError(kind ErrorKind, code string, message string) error {
+	return &Error{
+		kind:    kind,
+		code:    code,
+		message: message,
+	}
+}
+
+// Error returns the message of the error
+func (e *Error) Error() string {
+	return e.message
+}
+
+// Kind returns the kind property
+func (e *Error) Kind() ErrorKind {
+	return e.kind
+}
+
+// Code returns the code property
+func (e *Error) Code() string {
+	return e.code
+}
+
+// WrapError returns err caused by cause, unlike errors.Wrap from pkg/errors both stay reachable by errors.Is
+func WrapError(err error, cause error) error {
+	if cause == nil {
+		return err
+	}
+
+	return wrappedError{err: err, cause: cause}
+}
+
+// Error returns the message of the error followed by its cause
+func (w wrappedError) Error() string {
+	return w.err.Error() + ": " + w.cause.Error()
+}
+
+// Is reports whether the target is the wrapping error, the cause is matched by Unwrap
+func (w wrappedError) Is(target error) bool {
+	return errors.Is(w.err, target)
+}
+
+// Unwrap returns the cause of the error
+func (w wrappedError) Unwrap() error {
+	return w.cause
+}
+
+// ErrorOf returns the domain error that classifies err, the first one along the chain that is not internal, so that
+// an internal error caused by a conflict is a conflict, errors outside of the domain are ErrInternal
+func ErrorOf(err error) *Error {
+	var internal *Error
+	for ; err != nil; err = errors.Unwrap(err) {
+		var e *Error
+		switch t := err.(type) {
+		case wrappedError:
+			e = ErrorOf(t.err)
+		case *Error:
+			e = t
+		default:
+			e = valueObjectError(err)
+		}
+
+		if e == nil {
+			continue
+		}
+
+		if e.kind != KindInternal {
+			return e
+		}
+
+		if internal == nil {
+			internal = e
+		}
+	}
+
+	if internal == nil {
+		return ErrInternal.(*Error)
+	}
+
+	return internal
+}
+
+// IsTransient returns whether err may go away when the operation is tried again, an upstream service unavailable
+// or a failure of the application such as a database error, unlike the errors refused by the domain
+func IsTransient(err error) bool {
+	switch ErrorOf(err).Kind() {
+	case KindUnavailable, KindInternal:
+		return true
+	}
+
+	return false
+}
+
+// valueObjectError returns the classification of an error of the value objects, compared one by one since
+// errors from outside of the domain may not be hashable
+func valueObjectError(err error) *Error {
+	for voErr, e := range valueObjectErrors {
+		if err == voErr {
+			return &Error{kind: e.kind, code: e.code, message: err.Error()}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	pkgerrors "github.com/pkg/errors"
+)
+
+func TestErrorOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantKind ErrorKind
+		wantCode string
+	}{
+		{
+			name:     "Domain error",
+			err:      ErrNotFoundUser,
+			wantKind: KindNotFound,
+			wantCode: "not_found_user",
+		},
+		{
+			name:     "Domain error wrapped by pkg/errors",
+			err:      pkgerrors.Wrap(ErrUserInsufficientBalance, "payer"),
+			wantKind: KindRuleViolation,
+			wantCode: "user_insufficient_balance",
+		},
+		{
+			name:     "Internal error caused by a conflict",
+			err:      WrapError(ErrCreateTransfer, ErrDuplicateTransferReference),
+			wantKind: KindConflict,
+			wantCode: "duplicate_transfer_reference",
+		},
+		{
+			name:     "Domain error caused by a value object error",
+			err:      WrapError(ErrUnauthorizedTransfer, vo.ErrNotAllowedTypeUser),
+			wantKind: KindForbidden,
+			wantCode: "unauthorized_transfer",
+		},
+		{
+			name:     "Value object error",
+			err:      fmt.Errorf("payer: %w", vo.ErrNotAllowedTypeUser),
+			wantKind: KindForbidden,
+			wantCode: "not_allowed_type_user",
+		},
+		{
+			name:     "Limit of the KYC level exceeded",
+			err:      NewKYCLimitError(KYCBasic, DailyOutgoingLimit, 100),
+			wantKind: KindRuleViolation,
+			wantCode: "kyc_limit_exceeded",
+		},
+		{
+			name:     "Internal error caused by an error outside of the domain",
+			err:      WrapError(ErrFindUserByID, errors.New("connection refused")),
+			wantKind: KindInternal,
+			wantCode: "find_user_by_id",
+		},
+		{
+			name:     "Error outside of the domain",
+			err:      errors.New("db_error"),
+			wantKind: KindInternal,
+			wantCode: "internal_error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ErrorOf(tt.err)
+			if got.Kind() != tt.wantKind || got.Code() != tt.wantCode {
+				t.Errorf(
+					"[TestCase '%s'] Got: '%v, %v' | Want: '%v, %v'",
+					tt.name,
+					got.Kind(),
+					got.Code(),
+					tt.wantKind,
+					tt.wantCode,
+				)
+			}
+		})
+	}
+}
+
+func TestWrapError(t *testing.T) {
+	var err = WrapError(ErrUnauthorizedTransfer, vo.ErrNotAllowedTypeUser)
+
+	if !errors.Is(err, ErrUnauthorizedTransfer) || !errors.Is(err, vo.ErrNotAllowedTypeUser) {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Wrap keeps the cause", err, "both errors")
+	}
+
+	if want := "unauthorized transfer: not allowed user type"; err.Error() != want {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Wrap message", err.Error(), want)
+	}
+
+	if got := WrapError(ErrCreateTransfer, nil); got != ErrCreateTransfer {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Wrap without cause", got, ErrCreateTransfer)
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "Unavailable upstream service",
+			err:  ErrAuthorizerUnavailable,
+			want: true,
+		},
+		{
+			name: "Database error",
+			err:  WrapError(ErrCreateTransfer, errors.New("connection reset")),
+			want: true,
+		},
+		{
+			name: "Rule violation",
+			err:  ErrUserInsufficientBalance,
+		},
+		{
+			name: "Internal error caused by a conflict",
+			err:  WrapError(ErrCreateTransfer, ErrDuplicateTransfer),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTransient(tt.err); got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package entity
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+const (
+	// Escrow transfer status
+	EscrowHeld     TransferStatus = "IN_ESCROW"
+	EscrowDisputed TransferStatus = "DISPUTED"
+	EscrowReleased TransferStatus = "RELEASED"
+	EscrowRefunded TransferStatus = "REFUNDED"
+
+	// Resolutions of a disputed escrow transfer
+	EscrowReleaseToPayee EscrowResolution = "RELEASE"
+	EscrowRefundToPayer  EscrowResolution = "REFUND"
+
+	// DefaultEscrowTimeout is the time the funds stay in escrow when no release date is given
+	DefaultEscrowTimeout = 14 * 24 * time.Hour
+)
+
+var (
+	ErrCreateEscrowTransfer = NewError(KindInternal, "create_escrow_transfer", "error creating escrow transfer")
+
+	ErrNotFoundEscrowTransfer = NewError(KindNotFound, "not_found_escrow_transfer", "not found escrow transfer")
+
+	ErrFindEscrowTransfer = NewError(KindInternal, "find_escrow_transfer", "error fetching escrow transfer")
+
+	ErrUpdateEscrowTransfer = NewError(KindInternal, "update_escrow_transfer", "error updating escrow transfer")
+
+	ErrEscrowAccountRequired = NewError(KindInternal, "escrow_account_required", "escrow account is required to create escrow transfers")
+
+	ErrInvalidEscrowRelease = NewError(KindValidation, "invalid_escrow_release", "escrow release date must be in the future")
+
+	ErrInvalidEscrowResolution = NewError(KindValidation, "invalid_escrow_resolution", "escrow resolution must be RELEASE or REFUND")
+
+	ErrEscrowTransferNotInEscrow = NewError(KindConflict, "escrow_transfer_not_in_escrow", "escrow transfer is no longer in escrow")
+
+	ErrEscrowTransferNotDisputed = NewError(KindConflict, "escrow_transfer_not_disputed", "escrow transfer is not disputed")
+)
+
+type (
+	// EscrowResolution defines who receives the funds of a disputed escrow transfer
+	EscrowResolution string
+
+	// EscrowTransferRepositoryCreator defines the operation of creating an escrow transfer entity
+	EscrowTransferRepositoryCreator interface {
+		Create(context.Context, EscrowTransfer) (EscrowTransfer, error)
+	}
+
+	// EscrowTransferRepositoryFinder defines the search operations for escrow transfer entities
+	EscrowTransferRepositoryFinder interface {
+		FindByID(context.Context, vo.Uuid) (EscrowTransfer, error)
+		FindDue(ctx context.Context, now time.Time, limit int) ([]EscrowTransfer, error)
+	}
+
+	// EscrowTransferRepositoryUpdater defines the operation of moving an escrow transfer out of a status,
+	// Update fails with ErrEscrowTransferNotInEscrow or ErrEscrowTransferNotDisputed when the escrow transfer
+	// is no longer in the status from
+	EscrowTransferRepositoryUpdater interface {
+		Update(ctx context.Context, from TransferStatus, escrow EscrowTransfer) error
+		WithTransaction(context.Context, func(context.Context) error) error
+	}
+
+	// EscrowTransferRepository defines the operations of the escrow transfers
+	EscrowTransferRepository interface {
+		EscrowTransferRepositoryCreator
+		EscrowTransferRepositoryFinder
+		EscrowTransferRepositoryUpdater
+	}
+
+	// EscrowTransfer defines a transfer whose value is kept in an escrow account until the payer confirms
+	// the delivery or the release date passes. A dispute freezes the funds until it is resolved
+	EscrowTransfer struct {
+		id            vo.Uuid
+		payer         vo.Uuid
+		payee         vo.Uuid
+		account       vo.Uuid
+		value         vo.Money
+		status        TransferStatus
+		disputeReason string
+		releaseAt     time.Time
+		createdAt     time.Time
+		updatedAt     time.Time
+	}
+)
+
+// NewEscrowTransfer creates new escrow transfer kept in the escrow account, the release date must be after
+// its creation
+func NewEscrowTransfer(
+	ID vo.Uuid,
+	payerID vo.Uuid,
+	payeeID vo.Uuid,
+	account vo.Uuid,
+	value vo.Money,
+	releaseAt time.Time,
+	createdAt time.Time,
+) (EscrowTransfer, error) {
+	if account == (vo.Uuid{}) {
+		return EscrowTransfer{}, ErrEscrowAccountRequired
+	}
+
+	if !releaseAt.After(createdAt) {
+		return EscrowTransfer{}, ErrInvalidEscrowRelease
+	}
+
+	return EscrowTransfer{
+		id:        ID,
+		payer:     payerID,
+		payee:     payeeID,
+		account:   account,
+		value:     value,
+		status:    EscrowHeld,
+		releaseAt: releaseAt,
+		createdAt: createdAt,
+		updatedAt: createdAt,
+	}, nil
+}
+
+// RestoreEscrowTransfer creates an escrow transfer from its persisted representation
+func RestoreEscrowTransfer(
+	ID vo.Uuid,
+	payerID vo.Uuid,
+	payeeID vo.Uuid,
+	account vo.Uuid,
+	value vo.Money,
+	status TransferStatus,
+	disputeReason string,
+	releaseAt time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) EscrowTransfer {
+	return EscrowTransfer{
+		id:            ID,
+		payer:         payerID,
+		payee:         payeeID,
+		account:       account,
+		value:         value,
+		status:        status,
+		disputeReason: disputeReason,
+		releaseAt:     releaseAt,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
+
+// Confirm returns the escrow transfer released to the payee on the confirmation of the payer
+func (e EscrowTransfer) Confirm(at time.Time) (EscrowTransfer, error) {
+	if e.status != EscrowHeld {
+		return EscrowTransfer{}, ErrEscrowTransferNotInEscrow
+	}
+
+	e.status = EscrowReleased
+	e.updatedAt = at
+
+	return e, nil
+}
+
+// Timeout returns the escrow transfer released to the payee once the release date passes
+func (e EscrowTransfer) Timeout(at time.Time) (EscrowTransfer, error) {
+	if e.status != EscrowHeld || at.Before(e.releaseAt) {
+		return EscrowTransfer{}, ErrEscrowTransferNotInEscrow
+	}
+
+	e.status = EscrowReleased
+	e.updatedAt = at
+
+	return e, nil
+}
+
+// Dispute returns the escrow transfer disputed, its funds stay in escrow until the dispute is resolved.
+// A transfer past its release date can no longer be disputed even before the worker releases it
+func (e EscrowTransfer) Dispute(reason string, at time.Time) (EscrowTransfer, error) {
+	if e.status != EscrowHeld || !at.Before(e.releaseAt) {
+		return EscrowTransfer{}, ErrEscrowTransferNotInEscrow
+	}
+
+	e.status = EscrowDisputed
+	e.disputeReason = reason
+	e.updatedAt = at
+
+	return e, nil
+}
+
+// Resolve returns the disputed escrow transfer released to the payee or refunded to the payer
+func (e EscrowTransfer) Resolve(resolution EscrowResolution, at time.Time) (EscrowTransfer, error) {
+	if e.status != EscrowDisputed {
+		return EscrowTransfer{}, ErrEscrowTransferNotDisputed
+	}
+
+	switch resolution {
+	case EscrowReleaseToPayee:
+		e.status = EscrowReleased
+	case EscrowRefundToPayer:
+		e.status = EscrowRefunded
+	default:
+		return EscrowTransfer{}, ErrInvalidEscrowResolution
+	}
+
+	e.updatedAt = at
+
+	return e, nil
+}
+
+// Funding returns the transfer from the payer to the escrow account, it has the ID of the escrow transfer
+func (e EscrowTransfer) Funding() Transfer {
+	return NewTransfer(e.id, e.payer, e.account, e.value, e.createdAt)
+}
+
+// Settlement returns the transfer from the escrow account to the payee of a released escrow transfer or back
+// to the payer of a refunded one, refunding the funding. Its ID is derived from the ID of the escrow transfer so it
+// is settled only once
+func (e EscrowTransfer) Settlement() Transfer {
+	var settlementID = derivedID(e.id, "settlement")
+	if e.status == EscrowRefunded {
+		return NewTransfer(settlementID, e.account, e.payer, e.value, e.updatedAt).WithRefundOf(e.id)
+	}
+
+	return NewTransfer(settlementID, e.account, e.payee, e.value, e.updatedAt)
+}
+
+// ID returns the id property
+func (e EscrowTransfer) ID() vo.Uuid {
+	return e.id
+}
+
+// Payer returns the payer property
+func (e EscrowTransfer) Payer() vo.Uuid {
+	return e.payer
+}
+
+// Payee returns the payee property
+func (e EscrowTransfer) Payee() vo.Uuid {
+	return e.payee
+}
+
+// Account returns the escrow account keeping the funds
+func (e EscrowTransfer) Account() vo.Uuid {
+	return e.account
+}
+
+// Value returns the value property
+func (e EscrowTransfer) Value() vo.Money {
+	return e.value
+}
+
+// Status returns the status property
+func (e EscrowTransfer) Status() TransferStatus {
+	return e.status
+}
+
+// DisputeReason returns the disputeReason property
+func (e EscrowTransfer) DisputeReason() string {
+	return e.disputeReason
+}
+
+// ReleaseAt returns the releaseAt property
+func (e EscrowTransfer) ReleaseAt() time.Time {
+	return e.releaseAt
+}
+
+// CreatedAt returns the createdAt property
+func (e EscrowTransfer) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+// UpdatedAt returns the updatedAt property
+func (e EscrowTransfer) UpdatedAt() time.Time {
+	return e.updatedAt
+}
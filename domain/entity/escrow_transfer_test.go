@@ -0,0 +1,226 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func newEscrowTransferTest(t *testing.T, createdAt time.Time, releaseAt time.Time) EscrowTransfer {
+	escrow, err := NewEscrowTransfer(
+		vo.NewUuidStaticTest(),
+		vo.NewUuidStaticTest(),
+		vo.NewUuidStaticTest(),
+		vo.NewUuidStaticTest(),
+		vo.NewMoneyBRL(vo.NewAmountTest(100)),
+		releaseAt,
+		createdAt,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return escrow
+}
+
+func TestNewEscrowTransfer(t *testing.T) {
+	var createdAt = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		account   vo.Uuid
+		releaseAt time.Time
+		wantErr   error
+	}{
+		{
+			name:      "Release date in the future",
+			account:   vo.NewUuidStaticTest(),
+			releaseAt: createdAt.Add(time.Hour),
+		},
+		{
+			name:      "Release date equal to the creation",
+			account:   vo.NewUuidStaticTest(),
+			releaseAt: createdAt,
+			wantErr:   ErrInvalidEscrowRelease,
+		},
+		{
+			name:      "Without escrow account",
+			releaseAt: createdAt.Add(time.Hour),
+			wantErr:   ErrEscrowAccountRequired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewEscrowTransfer(
+				vo.NewUuidStaticTest(),
+				vo.NewUuidStaticTest(),
+				vo.NewUuidStaticTest(),
+				tt.account,
+				vo.NewMoneyBRL(vo.NewAmountTest(100)),
+				tt.releaseAt,
+				createdAt,
+			)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if err == nil && got.Status() != EscrowHeld {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Status(), EscrowHeld)
+			}
+		})
+	}
+}
+
+func TestEscrowTransfer_Transitions(t *testing.T) {
+	var (
+		createdAt   = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+		releaseAt   = createdAt.Add(time.Hour)
+		escrow      = newEscrowTransferTest(t, createdAt, releaseAt)
+		disputed, _ = escrow.Dispute("item not delivered", createdAt)
+		released, _ = escrow.Confirm(createdAt)
+	)
+
+	tests := []struct {
+		name       string
+		transition func() (EscrowTransfer, error)
+		wantStatus TransferStatus
+		wantErr    error
+	}{
+		{
+			name:       "Confirm in escrow",
+			transition: func() (EscrowTransfer, error) { return escrow.Confirm(createdAt) },
+			wantStatus: EscrowReleased,
+		},
+		{
+			name:       "Confirm after the release date",
+			transition: func() (EscrowTransfer, error) { return escrow.Confirm(releaseAt.Add(time.Minute)) },
+			wantStatus: EscrowReleased,
+		},
+		{
+			name:       "Confirm disputed",
+			transition: func() (EscrowTransfer, error) { return disputed.Confirm(createdAt) },
+			wantErr:    ErrEscrowTransferNotInEscrow,
+		},
+		{
+			name:       "Timeout at the release date",
+			transition: func() (EscrowTransfer, error) { return escrow.Timeout(releaseAt) },
+			wantStatus: EscrowReleased,
+		},
+		{
+			name:       "Timeout before the release date",
+			transition: func() (EscrowTransfer, error) { return escrow.Timeout(createdAt) },
+			wantErr:    ErrEscrowTransferNotInEscrow,
+		},
+		{
+			name:       "Timeout disputed",
+			transition: func() (EscrowTransfer, error) { return disputed.Timeout(releaseAt) },
+			wantErr:    ErrEscrowTransferNotInEscrow,
+		},
+		{
+			name:       "Dispute after the release date",
+			transition: func() (EscrowTransfer, error) { return escrow.Dispute("late", releaseAt) },
+			wantErr:    ErrEscrowTransferNotInEscrow,
+		},
+		{
+			name:       "Dispute released",
+			transition: func() (EscrowTransfer, error) { return released.Dispute("late", createdAt) },
+			wantErr:    ErrEscrowTransferNotInEscrow,
+		},
+		{
+			name:       "Resolve disputed releasing to the payee",
+			transition: func() (EscrowTransfer, error) { return disputed.Resolve(EscrowReleaseToPayee, createdAt) },
+			wantStatus: EscrowReleased,
+		},
+		{
+			name:       "Resolve disputed refunding the payer",
+			transition: func() (EscrowTransfer, error) { return disputed.Resolve(EscrowRefundToPayer, createdAt) },
+			wantStatus: EscrowRefunded,
+		},
+		{
+			name:       "Resolve with invalid resolution",
+			transition: func() (EscrowTransfer, error) { return disputed.Resolve("SPLIT", createdAt) },
+			wantErr:    ErrInvalidEscrowResolution,
+		},
+		{
+			name:       "Resolve not disputed",
+			transition: func() (EscrowTransfer, error) { return escrow.Resolve(EscrowRefundToPayer, createdAt) },
+			wantErr:    ErrEscrowTransferNotDisputed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.transition()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if err == nil && got.Status() != tt.wantStatus {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Status(), tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestEscrowTransfer_Settlement(t *testing.T) {
+	var (
+		createdAt = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+		payer, _  = vo.NewUuid("3f6c1d2a-8b4e-4c7f-9a1d-5e2b7c9f0a13")
+		payee, _  = vo.NewUuid("7b2f9e4c-1d3a-4b8e-9c6f-2a5d8e1b4c70")
+		account   = vo.NewUuidStaticTest()
+		escrow, _ = NewEscrowTransfer(
+			vo.NewUuidStaticTest(),
+			payer,
+			payee,
+			account,
+			vo.NewMoneyBRL(vo.NewAmountTest(100)),
+			createdAt.Add(time.Hour),
+			createdAt,
+		)
+		disputed, _ = escrow.Dispute("item not delivered", createdAt)
+		released, _ = disputed.Resolve(EscrowReleaseToPayee, createdAt)
+		refunded, _ = disputed.Resolve(EscrowRefundToPayer, createdAt)
+	)
+
+	if funding := escrow.Funding(); funding.ID() != escrow.ID() || funding.Payer() != payer || funding.Payee() != account {
+		t.Errorf("[TestCase '%s'] Got: '%v -> %v' | Want: '%v -> %v'", "Funding", funding.Payer(), funding.Payee(), payer, account)
+	}
+
+	tests := []struct {
+		name      string
+		escrow    EscrowTransfer
+		recipient vo.Uuid
+		refund    bool
+	}{
+		{
+			name:      "Settlement of released",
+			escrow:    released,
+			recipient: payee,
+		},
+		{
+			name:      "Settlement of refunded",
+			escrow:    refunded,
+			recipient: payer,
+			refund:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.escrow.Settlement()
+			if got.Payer() != account || got.Payee() != tt.recipient || got.ID() == escrow.ID() {
+				t.Errorf("[TestCase '%s'] Got: '%v -> %v' | Want: '%v -> %v'", tt.name, got.Payer(), got.Payee(), account, tt.recipient)
+			}
+
+			if got.ID() != released.Settlement().ID() {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.ID(), released.Settlement().ID())
+			}
+
+			if got.IsRefund() != tt.refund || (tt.refund && got.RefundOf() != escrow.ID()) {
+				t.Errorf("[TestCase '%s'] Got refund of: '%v' | Want refund: '%v'", tt.name, got.RefundOf(), tt.refund)
+			}
+		})
+	}
+}
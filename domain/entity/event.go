@@ -0,0 +1,497 @@
+package entity
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+const (
+	// Aggregate types
+	UserAggregate     AggregateType = "USER"
+	TransferAggregate AggregateType = "TRANSFER"
+
+	// Event types
+	UserCreated        EventType = "UserCreated"
+	WalletCredited     EventType = "WalletCredited"
+	WalletDebited      EventType = "WalletDebited"
+	WalletHeldChanged  EventType = "WalletHeldChanged"
+	KYCLevelChanged    EventType = "KYCLevelChanged"
+	TransferCreated    EventType = "TransferCreated"
+	TransferAuthorized EventType = "TransferAuthorized"
+	TransferRefunded   EventType = "TransferRefunded"
+)
+
+var (
+	ErrAppendEvent = NewError(KindInternal, "append_event", "error appending event")
+
+	ErrFindEvents = NewError(KindInternal, "find_events", "error fetching events")
+
+	ErrEventSequenceConflict = NewError(KindConflict, "event_sequence_conflict", "event sequence already exists for the aggregate")
+
+	ErrInvalidEventStream = NewError(KindInternal, "invalid_event_stream", "invalid event stream")
+
+	ErrNotFoundSnapshot = NewError(KindNotFound, "not_found_snapshot", "not found snapshot")
+
+	ErrSaveSnapshot = NewError(KindInternal, "save_snapshot", "error saving snapshot")
+)
+
+type (
+	// AggregateType defines the aggregate types that produce events
+	AggregateType string
+
+	// EventType defines the event types
+	EventType string
+
+	// EventRepositoryAppender defines the append operation of the event store
+	EventRepositoryAppender interface {
+		Append(context.Context, ...Event) error
+	}
+
+	// EventRepositoryFinder defines the search operation of the events of an aggregate after a sequence
+	EventRepositoryFinder interface {
+		FindByAggregateID(context.Context, vo.Uuid, int64) ([]Event, error)
+	}
+
+	// EventRepository defines the operations of the event store
+	EventRepository interface {
+		EventRepositoryAppender
+		EventRepositoryFinder
+	}
+
+	// UserSnapshotRepository defines the operations of the user snapshots
+	UserSnapshotRepository interface {
+		Save(context.Context, UserSnapshot) error
+		FindLatest(context.Context, vo.Uuid) (UserSnapshot, error)
+	}
+
+	// Event defines a state change of an aggregate
+	Event struct {
+		aggregateID   vo.Uuid
+		aggregateType AggregateType
+		sequence      int64
+		eventType     EventType
+		data          []byte
+		occurredAt    time.Time
+	}
+
+	// UserSnapshot defines the state of a user at a sequence of its event stream
+	UserSnapshot struct {
+		aggregateID vo.Uuid
+		sequence    int64
+		data        []byte
+		createdAt   time.Time
+	}
+
+	userState struct {
+		ID            string    `json:"id"`
+		FullName      string    `json:"full_name"`
+		Email         string    `json:"email"`
+		Password      string    `json:"password"`
+		DocumentType  string    `json:"document_type"`
+		DocumentValue string    `json:"document_value"`
+		Currency      string    `json:"currency"`
+		Amount        int64     `json:"amount"`
+		Type          string    `json:"type"`
+		KYCLevel      string    `json:"kyc_level,omitempty"`
+		Held          int64     `json:"held,omitempty"`
+		CreatedAt     time.Time `json:"created_at"`
+	}
+
+	walletChangedData struct {
+		Currency string `json:"currency"`
+		Amount   int64  `json:"amount"`
+		Balance  int64  `json:"balance"`
+	}
+
+	walletHeldChangedData struct {
+		Currency string `json:"currency"`
+		Held     int64  `json:"held"`
+	}
+
+	kycLevelChangedData struct {
+		KYCLevel string `json:"kyc_level"`
+	}
+
+	transferData struct {
+		ID                string            `json:"id"`
+		PayerID           string            `json:"payer"`
+		PayeeID           string            `json:"payee"`
+		Currency          string            `json:"currency"`
+		Value             int64             `json:"value"`
+		PayerFee          int64             `json:"payer_fee,omitempty"`
+		PayeeFee          int64             `json:"payee_fee,omitempty"`
+		FeeAccount        string            `json:"fee_account,omitempty"`
+		Description       string            `json:"description,omitempty"`
+		ExternalReference string            `json:"external_reference,omitempty"`
+		Metadata          map[string]string `json:"metadata,omitempty"`
+		RefundOf          string            `json:"refund_of,omitempty"`
+	}
+)
+
+// NewEvent creates new event
+func NewEvent(
+	aggregateID vo.Uuid,
+	aggregateType AggregateType,
+	sequence int64,
+	eventType EventType,
+	data []byte,
+	occurredAt time.Time,
+) Event {
+	return Event{
+		aggregateID:   aggregateID,
+		aggregateType: aggregateType,
+		sequence:      sequence,
+		eventType:     eventType,
+		data:          data,
+		occurredAt:    occurredAt,
+	}
+}
+
+// NewUserCreatedEvent creates the first event of a user stream
+func NewUserCreatedEvent(u User, occurredAt time.Time) (Event, error) {
+	data, err := json.Marshal(newUserState(u))
+	if err != nil {
+		return Event{}, err
+	}
+
+	return NewEvent(u.ID(), UserAggregate, 1, UserCreated, data, occurredAt), nil
+}
+
+// NewWalletCreditedEvent creates the event of money added to a user wallet
+func NewWalletCreditedEvent(userID vo.Uuid, sequence int64, amount vo.Money, balance vo.Money, occurredAt time.Time) (Event, error) {
+	return newWalletChangedEvent(userID, sequence, WalletCredited, amount, balance, occurredAt)
+}
+
+// NewWalletDebitedEvent creates the event of money removed from a user wallet
+func NewWalletDebitedEvent(userID vo.Uuid, sequence int64, amount vo.Money, balance vo.Money, occurredAt time.Time) (Event, error) {
+	return newWalletChangedEvent(userID, sequence, WalletDebited, amount, balance, occurredAt)
+}
+
+func newWalletChangedEvent(
+	userID vo.Uuid,
+	sequence int64,
+	eventType EventType,
+	amount vo.Money,
+	balance vo.Money,
+	occurredAt time.Time,
+) (Event, error) {
+	data, err := json.Marshal(walletChangedData{
+		Currency: amount.Currency().String(),
+		Amount:   amount.Amount().Value(),
+		Balance:  balance.Amount().Value(),
+	})
+	if err != nil {
+		return Event{}, err
+	}
+
+	return NewEvent(userID, UserAggregate, sequence, eventType, data, occurredAt), nil
+}
+
+// NewWalletHeldChangedEvent creates the event of the money held on a user wallet changed by a hold or a dispute
+func NewWalletHeldChangedEvent(userID vo.Uuid, sequence int64, held vo.Money, occurredAt time.Time) (Event, error) {
+	data, err := json.Marshal(walletHeldChangedData{
+		Currency: held.Currency().String(),
+		Held:     held.Amount().Value(),
+	})
+	if err != nil {
+		return Event{}, err
+	}
+
+	return NewEvent(userID, UserAggregate, sequence, WalletHeldChanged, data, occurredAt), nil
+}
+
+// NewKYCLevelChangedEvent creates the event of the KYC level of a user changed
+func NewKYCLevelChangedEvent(userID vo.Uuid, sequence int64, level KYCLevel, occurredAt time.Time) (Event, error) {
+	data, err := json.Marshal(kycLevelChangedData{KYCLevel: level.String()})
+	if err != nil {
+		return Event{}, err
+	}
+
+	return NewEvent(userID, UserAggregate, sequence, KYCLevelChanged, data, occurredAt), nil
+}
+
+// NewTransferCreatedEvent creates the first event of a transfer stream
+func NewTransferCreatedEvent(t Transfer, occurredAt time.Time) (Event, error) {
+	return newTransferEvent(t, 1, TransferCreated, occurredAt)
+}
+
+// NewTransferAuthorizedEvent creates the event of a transfer approved by the authorizer
+func NewTransferAuthorizedEvent(t Transfer, sequence int64, occurredAt time.Time) (Event, error) {
+	return newTransferEvent(t, sequence, TransferAuthorized, occurredAt)
+}
+
+// NewTransferRefundedEvent creates the event appended to the stream of the transfer returned to the payer,
+// its data is the refund
+func NewTransferRefundedEvent(refund Transfer, sequence int64, occurredAt time.Time) (Event, error) {
+	event, err := newTransferEvent(refund, sequence, TransferRefunded, occurredAt)
+	if err != nil {
+		return Event{}, err
+	}
+
+	event.aggregateID = refund.RefundOf()
+
+	return event, nil
+}
+
+func newTransferEvent(t Transfer, sequence int64, eventType EventType, occurredAt time.Time) (Event, error) {
+	var refundOf string
+	if t.IsRefund() {
+		refundOf = t.RefundOf().Value()
+	}
+
+	var feeAccount string
+	if !t.Fee().IsZero() {
+		feeAccount = t.Fee().Account().Value()
+	}
+
+	data, err := json.Marshal(transferData{
+		ID:                t.ID().Value(),
+		PayerID:           t.Payer().Value(),
+		PayeeID:           t.Payee().Value(),
+		Currency:          t.Value().Currency().String(),
+		Value:             t.Value().Amount().Value(),
+		PayerFee:          t.Fee().Payer().Amount().Value(),
+		PayeeFee:          t.Fee().Payee().Amount().Value(),
+		FeeAccount:        feeAccount,
+		Description:       t.Details().Description(),
+		ExternalReference: t.Details().ExternalReference(),
+		Metadata:          t.Details().Metadata(),
+		RefundOf:          refundOf,
+	})
+	if err != nil {
+		return Event{}, err
+	}
+
+	return NewEvent(t.ID(), TransferAggregate, sequence, eventType, data, occurredAt), nil
+}
+
+// AggregateID returns the aggregateID property
+func (e Event) AggregateID() vo.Uuid {
+	return e.aggregateID
+}
+
+// AggregateType returns the aggregateType property
+func (e Event) AggregateType() AggregateType {
+	return e.aggregateType
+}
+
+// Sequence returns the sequence property
+func (e Event) Sequence() int64 {
+	return e.sequence
+}
+
+// Type returns the eventType property
+func (e Event) Type() EventType {
+	return e.eventType
+}
+
+// Data returns the data property encoded as JSON
+func (e Event) Data() []byte {
+	return e.data
+}
+
+// OccurredAt returns the occurredAt property
+func (e Event) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// NewUserSnapshot creates new user snapshot at the given sequence
+func NewUserSnapshot(u User, sequence int64, createdAt time.Time) (UserSnapshot, error) {
+	data, err := json.Marshal(newUserState(u))
+	if err != nil {
+		return UserSnapshot{}, err
+	}
+
+	return RestoreUserSnapshot(u.ID(), sequence, data, createdAt), nil
+}
+
+// RestoreUserSnapshot creates a user snapshot from its persisted representation
+func RestoreUserSnapshot(aggregateID vo.Uuid, sequence int64, data []byte, createdAt time.Time) UserSnapshot {
+	return UserSnapshot{
+		aggregateID: aggregateID,
+		sequence:    sequence,
+		data:        data,
+		createdAt:   createdAt,
+	}
+}
+
+// AggregateID returns the aggregateID property
+func (s UserSnapshot) AggregateID() vo.Uuid {
+	return s.aggregateID
+}
+
+// Sequence returns the sequence property
+func (s UserSnapshot) Sequence() int64 {
+	return s.sequence
+}
+
+// Data returns the data property encoded as JSON
+func (s UserSnapshot) Data() []byte {
+	return s.data
+}
+
+// CreatedAt returns the createdAt property
+func (s UserSnapshot) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+// RehydrateUser rebuilds a user from an optional snapshot and the events that followed it,
+// returning the user and the sequence of the last applied event
+func RehydrateUser(snapshot *UserSnapshot, events []Event) (User, int64, error) {
+	var (
+		state    userState
+		sequence int64
+	)
+
+	if snapshot != nil {
+		if err := json.Unmarshal(snapshot.data, &state); err != nil {
+			return User{}, 0, err
+		}
+		sequence = snapshot.sequence
+	}
+
+	for _, event := range events {
+		if event.aggregateType != UserAggregate || event.sequence != sequence+1 {
+			return User{}, 0, ErrInvalidEventStream
+		}
+
+		switch event.eventType {
+		case UserCreated:
+			if err := json.Unmarshal(event.data, &state); err != nil {
+				return User{}, 0, err
+			}
+		case WalletCredited, WalletDebited:
+			if sequence == 0 {
+				return User{}, 0, ErrInvalidEventStream
+			}
+
+			var data walletChangedData
+			if err := json.Unmarshal(event.data, &data); err != nil {
+				return User{}, 0, err
+			}
+
+			if event.eventType == WalletCredited {
+				state.Amount += data.Amount
+			} else {
+				state.Amount -= data.Amount
+			}
+		case WalletHeldChanged:
+			if sequence == 0 {
+				return User{}, 0, ErrInvalidEventStream
+			}
+
+			var data walletHeldChangedData
+			if err := json.Unmarshal(event.data, &data); err != nil {
+				return User{}, 0, err
+			}
+
+			state.Held = data.Held
+		case KYCLevelChanged:
+			if sequence == 0 {
+				return User{}, 0, ErrInvalidEventStream
+			}
+
+			var data kycLevelChangedData
+			if err := json.Unmarshal(event.data, &data); err != nil {
+				return User{}, 0, err
+			}
+
+			state.KYCLevel = data.KYCLevel
+		default:
+			return User{}, 0, ErrInvalidEventStream
+		}
+
+		sequence = event.sequence
+	}
+
+	if sequence == 0 {
+		return User{}, 0, ErrInvalidEventStream
+	}
+
+	u, err := state.user()
+	if err != nil {
+		return User{}, 0, err
+	}
+
+	return u, sequence, nil
+}
+
+func newUserState(u User) userState {
+	return userState{
+		ID:            u.ID().Value(),
+		FullName:      u.FullName().Value(),
+		Email:         u.Email().Value(),
+		Password:      u.Password().Value(),
+		DocumentType:  u.Document().Type().String(),
+		DocumentValue: u.Document().Value(),
+		Currency:      u.Wallet().Money().Currency().String(),
+		Amount:        u.Wallet().Money().Amount().Value(),
+		Type:          u.TypeUser().String(),
+		KYCLevel:      u.KYCLevel().String(),
+		Held:          u.Wallet().Held().Amount().Value(),
+		CreatedAt:     u.CreatedAt(),
+	}
+}
+
+func (s userState) user() (User, error) {
+	uuid, err := vo.NewUuid(s.ID)
+	if err != nil {
+		return User{}, err
+	}
+
+	email, err := vo.NewEmail(s.Email)
+	if err != nil {
+		return User{}, err
+	}
+
+	doc, err := vo.NewDocument(vo.TypeDocument(s.DocumentType), s.DocumentValue)
+	if err != nil {
+		return User{}, err
+	}
+
+	currency, err := vo.NewCurrency(s.Currency)
+	if err != nil {
+		return User{}, err
+	}
+
+	amount, err := vo.NewAmount(s.Amount)
+	if err != nil {
+		return User{}, err
+	}
+
+	held, err := vo.NewAmount(s.Held)
+	if err != nil {
+		return User{}, err
+	}
+
+	u, err := NewUser(
+		uuid,
+		vo.NewFullName(s.FullName),
+		email,
+		vo.NewPassword(s.Password),
+		doc,
+		vo.NewWallet(vo.NewMoney(currency, amount)),
+		vo.TypeUser(s.Type),
+		s.CreatedAt,
+	)
+	if err != nil {
+		return User{}, err
+	}
+
+	u.Wallet().Hold(held)
+
+	// Streams started before the KYC levels keep the level of a new user
+	if s.KYCLevel == "" {
+		return u, nil
+	}
+
+	level, err := NewKYCLevel(s.KYCLevel)
+	if err != nil {
+		return User{}, err
+	}
+
+	return u.WithKYCLevel(level), nil
+}
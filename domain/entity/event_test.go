@@ -0,0 +1,197 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func newUserEventsTest(t *testing.T) (User, []Event) {
+	user := NewCommonUser(
+		vo.NewUuidStaticTest(),
+		vo.NewFullName("Test testing"),
+		vo.NewEmailTest("test@testing.com"),
+		vo.NewPassword("passw"),
+		vo.NewDocumentTest(vo.CPF, "07091054954"),
+		vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(100))),
+		time.Time{},
+	)
+
+	created, err := NewUserCreatedEvent(user, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	credited, err := NewWalletCreditedEvent(
+		user.ID(),
+		2,
+		vo.NewMoneyBRL(vo.NewAmountTest(50)),
+		vo.NewMoneyBRL(vo.NewAmountTest(150)),
+		time.Time{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	debited, err := NewWalletDebitedEvent(
+		user.ID(),
+		3,
+		vo.NewMoneyBRL(vo.NewAmountTest(30)),
+		vo.NewMoneyBRL(vo.NewAmountTest(120)),
+		time.Time{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return user, []Event{created, credited, debited}
+}
+
+func TestRehydrateUser(t *testing.T) {
+	user, events := newUserEventsTest(t)
+
+	snapshot, err := NewUserSnapshot(user, 1, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		snapshot     *UserSnapshot
+		events       []Event
+		wantAmount   int64
+		wantSequence int64
+		wantErr      error
+	}{
+		{
+			name:         "Rehydrate user from events",
+			events:       events,
+			wantAmount:   120,
+			wantSequence: 3,
+		},
+		{
+			name:         "Rehydrate user from snapshot and events",
+			snapshot:     &snapshot,
+			events:       events[1:],
+			wantAmount:   120,
+			wantSequence: 3,
+		},
+		{
+			name:         "Rehydrate user from snapshot only",
+			snapshot:     &snapshot,
+			wantAmount:   100,
+			wantSequence: 1,
+		},
+		{
+			name:    "Rehydrate user with gap in sequence",
+			events:  []Event{events[0], events[2]},
+			wantErr: ErrInvalidEventStream,
+		},
+		{
+			name:    "Rehydrate user without UserCreated",
+			events:  events[1:],
+			wantErr: ErrInvalidEventStream,
+		},
+		{
+			name:    "Rehydrate user without events",
+			wantErr: ErrInvalidEventStream,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, sequence, err := RehydrateUser(tt.snapshot, tt.events)
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.Wallet().Money().Amount().Value() != tt.wantAmount {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Wallet().Money().Amount().Value(), tt.wantAmount)
+			}
+
+			if sequence != tt.wantSequence {
+				t.Errorf("[TestCase '%s'] Got sequence: '%v' | Want sequence: '%v'", tt.name, sequence, tt.wantSequence)
+			}
+
+			if got.ID() != user.ID() || got.TypeUser() != user.TypeUser() || got.Roles() != user.Roles() {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, user)
+			}
+		})
+	}
+}
+
+func TestRehydrateUser_HeldAndKYCLevel(t *testing.T) {
+	user, events := newUserEventsTest(t)
+
+	held, err := NewWalletHeldChangedEvent(user.ID(), 4, vo.NewMoneyBRL(vo.NewAmountTest(20)), time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	level, err := NewKYCLevelChangedEvent(user.ID(), 5, KYCVerified, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, sequence, err := RehydrateUser(nil, append(events, held, level))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Wallet().Held().Amount().Value() != 20 || got.Wallet().Available().Amount().Value() != 100 {
+		t.Errorf("[TestCase '%s'] Got held: '%v' | Want held: '%v'", t.Name(), got.Wallet().Held(), 20)
+	}
+
+	if got.KYCLevel() != KYCVerified {
+		t.Errorf("[TestCase '%s'] Got KYC level: '%v' | Want KYC level: '%v'", t.Name(), got.KYCLevel(), KYCVerified)
+	}
+
+	if sequence != 5 {
+		t.Errorf("[TestCase '%s'] Got sequence: '%v' | Want sequence: '%v'", t.Name(), sequence, 5)
+	}
+
+	snapshot, err := NewUserSnapshot(got, sequence, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restored, _, err := RehydrateUser(&snapshot, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if restored.Wallet().Held() != got.Wallet().Held() || restored.KYCLevel() != got.KYCLevel() {
+		t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", t.Name(), restored, got)
+	}
+}
+
+func TestNewTransferRefundedEvent(t *testing.T) {
+	var (
+		refunded = vo.NewUuidStaticTest()
+		refund   = NewTransfer(
+			derivedID(refunded, "refund"),
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewMoneyBRL(vo.NewAmountTest(100)),
+			time.Time{},
+		).WithRefundOf(refunded)
+	)
+
+	event, err := NewTransferRefundedEvent(refund, 3, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.AggregateID() != refunded || event.AggregateType() != TransferAggregate || event.Sequence() != 3 {
+		t.Errorf("[TestCase '%s'] Got: '%+v' | Want aggregate: '%v'", t.Name(), event, refunded)
+	}
+
+	if !refund.IsRefund() || refund.RefundOf() != refunded {
+		t.Errorf("[TestCase '%s'] Got refund of: '%v' | Want refund of: '%v'", t.Name(), refund.RefundOf(), refunded)
+	}
+}
@@ -0,0 +1,176 @@
+package entity
+
+import (
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+const (
+	// Who pays a fee
+	FeeOnSend    FeeCharge = "SEND"
+	FeeOnReceipt FeeCharge = "RECEIPT"
+)
+
+var (
+	ErrInvalidFeeCharge = NewError(KindValidation, "invalid_fee_charge", "fee must be charged on SEND or RECEIPT")
+
+	ErrInvalidFeeTiers = NewError(KindValidation, "invalid_fee_tiers", "fee tiers must be ascending, only the last one without limit")
+
+	ErrFeeAccountRequired = NewError(KindInternal, "fee_account_required", "fee account is required to charge fees")
+
+	ErrFeeExceedsValue = NewError(KindRuleViolation, "fee_exceeds_value", "fee exceeds the transfer value")
+)
+
+type (
+	// FeeCharge defines whether a fee is paid by the payer on sending or by the payee on receipt
+	FeeCharge string
+
+	// FeeTier defines the fee of the transfers up to a value, a tier without limit covers any value.
+	// The fee is a flat value plus a percentage, in basis points, of the transfer value
+	FeeTier struct {
+		upTo        int64
+		flat        int64
+		basisPoints int64
+	}
+
+	// FeeRule defines the fee paid by a type of user on sending or on receipt
+	FeeRule struct {
+		userType vo.TypeUser
+		charge   FeeCharge
+		tiers    []FeeTier
+	}
+
+	// FeeSchedule defines the fees of the transfers and the account crediting them
+	FeeSchedule struct {
+		account vo.Uuid
+		rules   []FeeRule
+	}
+
+	// Fee defines the fees of a transfer paid by the payer and by the payee
+	Fee struct {
+		account vo.Uuid
+		payer   vo.Money
+		payee   vo.Money
+	}
+)
+
+// NewFeeTier creates new fee tier, upTo zero means without limit
+func NewFeeTier(upTo int64, flat int64, basisPoints int64) (FeeTier, error) {
+	if upTo < 0 || flat < 0 || basisPoints < 0 || basisPoints > WholePercentage {
+		return FeeTier{}, ErrInvalidFeeTiers
+	}
+
+	return FeeTier{upTo: upTo, flat: flat, basisPoints: basisPoints}, nil
+}
+
+// NewFeeRule creates new fee rule, a flat or percentage fee is a single tier without limit
+func NewFeeRule(userType vo.TypeUser, charge FeeCharge, tiers []FeeTier) (FeeRule, error) {
+	if _, err := vo.NewTypeUser(string(userType)); err != nil {
+		return FeeRule{}, err
+	}
+
+	if charge != FeeOnSend && charge != FeeOnReceipt {
+		return FeeRule{}, ErrInvalidFeeCharge
+	}
+
+	if len(tiers) == 0 {
+		return FeeRule{}, ErrInvalidFeeTiers
+	}
+
+	for idx, tier := range tiers {
+		var last = idx == len(tiers)-1
+		if (tier.upTo == 0 && !last) || (idx > 0 && tier.upTo != 0 && tier.upTo <= tiers[idx-1].upTo) {
+			return FeeRule{}, ErrInvalidFeeTiers
+		}
+	}
+
+	return FeeRule{userType: userType.ToUpper(), charge: charge, tiers: tiers}, nil
+}
+
+// NewFeeSchedule creates new fee schedule, a schedule without rules charges no fees
+func NewFeeSchedule(account vo.Uuid, rules []FeeRule) (FeeSchedule, error) {
+	if len(rules) > 0 && account == (vo.Uuid{}) {
+		return FeeSchedule{}, ErrFeeAccountRequired
+	}
+
+	return FeeSchedule{account: account, rules: rules}, nil
+}
+
+// NewFee creates new fee credited to the account
+func NewFee(account vo.Uuid, payer vo.Money, payee vo.Money) Fee {
+	return Fee{account: account, payer: payer, payee: payee}
+}
+
+// Quote returns the fees of a transfer of value between users of the given types, the payer fees are
+// added to the debit of the payer and the payee fees taken from the credit of the payee
+func (s FeeSchedule) Quote(payerType vo.TypeUser, payeeType vo.TypeUser, value vo.Money) (Fee, error) {
+	var payer, payee int64
+	for _, rule := range s.rules {
+		switch {
+		case rule.charge == FeeOnSend && rule.userType == payerType.ToUpper():
+			payer += rule.fee(value.Amount().Value())
+		case rule.charge == FeeOnReceipt && rule.userType == payeeType.ToUpper():
+			payee += rule.fee(value.Amount().Value())
+		}
+	}
+
+	if payee > value.Amount().Value() {
+		return Fee{}, ErrFeeExceedsValue
+	}
+
+	payerFee, err := vo.NewAmount(payer)
+	if err != nil {
+		return Fee{}, err
+	}
+
+	payeeFee, err := vo.NewAmount(payee)
+	if err != nil {
+		return Fee{}, err
+	}
+
+	return NewFee(
+		s.account,
+		vo.NewMoney(value.Currency(), payerFee),
+		vo.NewMoney(value.Currency(), payeeFee),
+	), nil
+}
+
+// Account returns the account property
+func (s FeeSchedule) Account() vo.Uuid {
+	return s.account
+}
+
+// fee returns the fee of the tier covering the value, the percentage is rounded half up to the cent
+func (r FeeRule) fee(value int64) int64 {
+	for _, tier := range r.tiers {
+		if tier.upTo == 0 || value <= tier.upTo {
+			return tier.flat + (value*tier.basisPoints+WholePercentage/2)/WholePercentage
+		}
+	}
+
+	return 0
+}
+
+// IsZero returns whether the transfer has no fees
+func (f Fee) IsZero() bool {
+	return f.Total().Amount().Value() == 0
+}
+
+// Total returns the fees credited to the fee account
+func (f Fee) Total() vo.Money {
+	return f.payer.Add(f.payee.Amount())
+}
+
+// Account returns the account property
+func (f Fee) Account() vo.Uuid {
+	return f.account
+}
+
+// Payer returns the fee paid by the payer
+func (f Fee) Payer() vo.Money {
+	return f.payer
+}
+
+// Payee returns the fee paid by the payee
+func (f Fee) Payee() vo.Money {
+	return f.payee
+}
@@ -0,0 +1,198 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func TestFeeSchedule_Quote(t *testing.T) {
+	var (
+		account, _ = vo.NewUuid("00000000-0000-4000-8000-000000000001")
+
+		tier = func(upTo int64, flat int64, basisPoints int64) FeeTier {
+			tier, _ := NewFeeTier(upTo, flat, basisPoints)
+			return tier
+		}
+		rule = func(userType vo.TypeUser, charge FeeCharge, tiers ...FeeTier) FeeRule {
+			rule, _ := NewFeeRule(userType, charge, tiers)
+			return rule
+		}
+		tiered = rule(vo.COMMON, FeeOnSend, tier(10000, 0, 0), tier(100000, 50, 0), tier(0, 50, 50))
+	)
+
+	tests := []struct {
+		name      string
+		rules     []FeeRule
+		payerType vo.TypeUser
+		payeeType vo.TypeUser
+		value     int64
+		wantPayer int64
+		wantPayee int64
+		wantErr   error
+	}{
+		{
+			name:      "Without rules",
+			payerType: vo.COMMON,
+			payeeType: vo.MERCHANT,
+			value:     1000,
+		},
+		{
+			name:      "Flat fee on send",
+			rules:     []FeeRule{rule(vo.COMMON, FeeOnSend, tier(0, 10, 0))},
+			payerType: vo.COMMON,
+			payeeType: vo.COMMON,
+			value:     1000,
+			wantPayer: 10,
+		},
+		{
+			name:      "Percentage fee on receipt rounded half up",
+			rules:     []FeeRule{rule(vo.MERCHANT, FeeOnReceipt, tier(0, 0, 250))},
+			payerType: vo.COMMON,
+			payeeType: vo.MERCHANT,
+			value:     1020,
+			wantPayee: 26,
+		},
+		{
+			name:      "Rule of another user type",
+			rules:     []FeeRule{rule(vo.MERCHANT, FeeOnReceipt, tier(0, 0, 250))},
+			payerType: vo.MERCHANT,
+			payeeType: vo.COMMON,
+			value:     1000,
+		},
+		{
+			name:      "Tiered fee first tier",
+			rules:     []FeeRule{tiered},
+			payerType: vo.COMMON,
+			payeeType: vo.COMMON,
+			value:     10000,
+		},
+		{
+			name:      "Tiered fee middle tier",
+			rules:     []FeeRule{tiered},
+			payerType: vo.COMMON,
+			payeeType: vo.COMMON,
+			value:     10001,
+			wantPayer: 50,
+		},
+		{
+			name:      "Tiered fee tier without limit",
+			rules:     []FeeRule{tiered},
+			payerType: vo.COMMON,
+			payeeType: vo.COMMON,
+			value:     200000,
+			wantPayer: 1050,
+		},
+		{
+			name: "Fees on send and on receipt",
+			rules: []FeeRule{
+				rule(vo.COMMON, FeeOnSend, tier(0, 10, 0)),
+				rule(vo.MERCHANT, FeeOnReceipt, tier(0, 5, 100)),
+			},
+			payerType: vo.COMMON,
+			payeeType: vo.MERCHANT,
+			value:     1000,
+			wantPayer: 10,
+			wantPayee: 15,
+		},
+		{
+			name:      "Fee on receipt exceeds value",
+			rules:     []FeeRule{rule(vo.MERCHANT, FeeOnReceipt, tier(0, 100, 0))},
+			payerType: vo.COMMON,
+			payeeType: vo.MERCHANT,
+			value:     99,
+			wantErr:   ErrFeeExceedsValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := NewFeeSchedule(account, tt.rules)
+			if err != nil {
+				t.Fatalf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, nil)
+			}
+
+			got, err := schedule.Quote(tt.payerType, tt.payeeType, vo.NewMoneyBRL(vo.NewAmountTest(tt.value)))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if got.Payer().Amount().Value() != tt.wantPayer || got.Payee().Amount().Value() != tt.wantPayee {
+				t.Errorf(
+					"[TestCase '%s'] Got: '%v/%v' | Want: '%v/%v'",
+					tt.name,
+					got.Payer().Amount().Value(),
+					got.Payee().Amount().Value(),
+					tt.wantPayer,
+					tt.wantPayee,
+				)
+			}
+		})
+	}
+}
+
+func TestNewFeeRule(t *testing.T) {
+	var tier = func(upTo int64) FeeTier {
+		tier, _ := NewFeeTier(upTo, 10, 0)
+		return tier
+	}
+
+	tests := []struct {
+		name     string
+		userType vo.TypeUser
+		charge   FeeCharge
+		tiers    []FeeTier
+		wantErr  error
+	}{
+		{
+			name:     "Valid tiers",
+			userType: vo.COMMON,
+			charge:   FeeOnSend,
+			tiers:    []FeeTier{tier(100), tier(1000), tier(0)},
+		},
+		{
+			name:     "Invalid charge",
+			userType: vo.COMMON,
+			charge:   "TRANSFER",
+			tiers:    []FeeTier{tier(0)},
+			wantErr:  ErrInvalidFeeCharge,
+		},
+		{
+			name:     "Without tiers",
+			userType: vo.COMMON,
+			charge:   FeeOnSend,
+			wantErr:  ErrInvalidFeeTiers,
+		},
+		{
+			name:     "Tiers out of order",
+			userType: vo.COMMON,
+			charge:   FeeOnSend,
+			tiers:    []FeeTier{tier(1000), tier(100)},
+			wantErr:  ErrInvalidFeeTiers,
+		},
+		{
+			name:     "Tier without limit before the last",
+			userType: vo.MERCHANT,
+			charge:   FeeOnReceipt,
+			tiers:    []FeeTier{tier(0), tier(100)},
+			wantErr:  ErrInvalidFeeTiers,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewFeeRule(tt.userType, tt.charge, tt.tiers); !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewFeeSchedule(t *testing.T) {
+	tier, _ := NewFeeTier(0, 10, 0)
+	rule, _ := NewFeeRule(vo.COMMON, FeeOnSend, []FeeTier{tier})
+
+	if _, err := NewFeeSchedule(vo.Uuid{}, []FeeRule{rule}); !errors.Is(err, ErrFeeAccountRequired) {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Rules without fee account", err, ErrFeeAccountRequired)
+	}
+}
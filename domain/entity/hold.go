@@ -0,0 +1,230 @@
+package entity
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+const (
+	// Hold status
+	HoldActive   HoldStatus = "ACTIVE"
+	HoldCaptured HoldStatus = "CAPTURED"
+	HoldVoided   HoldStatus = "VOIDED"
+	HoldExpired  HoldStatus = "EXPIRED"
+
+	// DefaultHoldDuration is the time a hold reserves the funds when no expiration is given
+	DefaultHoldDuration = 7 * 24 * time.Hour
+)
+
+var (
+	ErrCreateHold = NewError(KindInternal, "create_hold", "error creating hold")
+
+	ErrNotFoundHold = NewError(KindNotFound, "not_found_hold", "not found hold")
+
+	ErrFindHold = NewError(KindInternal, "find_hold", "error fetching hold")
+
+	ErrUpdateHold = NewError(KindInternal, "update_hold", "error updating hold")
+
+	ErrHoldNotActive = NewError(KindConflict, "hold_not_active", "hold was already captured, voided or expired")
+
+	ErrInvalidHoldExpiration = NewError(KindValidation, "invalid_hold_expiration", "hold expiration date must be in the future")
+
+	ErrInvalidCaptureValue = NewError(KindValidation, "invalid_capture_value", "capture value must be positive and up to the held value")
+)
+
+type (
+	// HoldStatus defines the status of a hold
+	HoldStatus string
+
+	// HoldRepositoryCreator defines the operation of creating a hold entity
+	HoldRepositoryCreator interface {
+		Create(context.Context, Hold) (Hold, error)
+	}
+
+	// HoldRepositoryFinder defines the search operations for hold entities
+	HoldRepositoryFinder interface {
+		FindByID(context.Context, vo.Uuid) (Hold, error)
+		FindExpired(ctx context.Context, now time.Time, limit int) ([]Hold, error)
+	}
+
+	// HoldRepositoryUpdater defines the operation of closing an active hold,
+	// Close fails with ErrHoldNotActive when the hold was already closed
+	HoldRepositoryUpdater interface {
+		Close(context.Context, Hold) error
+		WithTransaction(context.Context, func(context.Context) error) error
+	}
+
+	// HoldRepository defines the operations of the holds
+	HoldRepository interface {
+		HoldRepositoryCreator
+		HoldRepositoryFinder
+		HoldRepositoryUpdater
+	}
+
+	// Hold defines funds of a payer reserved for a payee, captured later as a transfer or released
+	Hold struct {
+		id        vo.Uuid
+		payer     vo.Uuid
+		payee     vo.Uuid
+		value     vo.Money
+		captured  vo.Money
+		status    HoldStatus
+		expiresAt time.Time
+		createdAt time.Time
+		updatedAt time.Time
+	}
+)
+
+// NewHold creates new active hold, the expiration date must be after its creation
+func NewHold(
+	ID vo.Uuid,
+	payerID vo.Uuid,
+	payeeID vo.Uuid,
+	value vo.Money,
+	expiresAt time.Time,
+	createdAt time.Time,
+) (Hold, error) {
+	if !expiresAt.After(createdAt) {
+		return Hold{}, ErrInvalidHoldExpiration
+	}
+
+	return Hold{
+		id:        ID,
+		payer:     payerID,
+		payee:     payeeID,
+		value:     value,
+		captured:  vo.NewMoney(value.Currency(), vo.Amount{}),
+		status:    HoldActive,
+		expiresAt: expiresAt,
+		createdAt: createdAt,
+		updatedAt: createdAt,
+	}, nil
+}
+
+// RestoreHold creates a hold from its persisted representation
+func RestoreHold(
+	ID vo.Uuid,
+	payerID vo.Uuid,
+	payeeID vo.Uuid,
+	value vo.Money,
+	captured vo.Money,
+	status HoldStatus,
+	expiresAt time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) Hold {
+	return Hold{
+		id:        ID,
+		payer:     payerID,
+		payee:     payeeID,
+		value:     value,
+		captured:  captured,
+		status:    status,
+		expiresAt: expiresAt,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// Capture returns the hold captured for value, a partial capture releases the rest of the funds held
+func (h Hold) Capture(value vo.Money, at time.Time) (Hold, error) {
+	if err := h.active(at); err != nil {
+		return Hold{}, err
+	}
+
+	if value.Amount().Value() <= 0 || value.Amount().Value() > h.value.Amount().Value() {
+		return Hold{}, ErrInvalidCaptureValue
+	}
+
+	h.status = HoldCaptured
+	h.captured = value
+	h.updatedAt = at
+
+	return h, nil
+}
+
+// Void returns the hold voided, releasing the funds held
+func (h Hold) Void(at time.Time) (Hold, error) {
+	if err := h.active(at); err != nil {
+		return Hold{}, err
+	}
+
+	h.status = HoldVoided
+	h.updatedAt = at
+
+	return h, nil
+}
+
+// Expire returns the hold expired, releasing the funds held
+func (h Hold) Expire(at time.Time) (Hold, error) {
+	if h.status != HoldActive {
+		return Hold{}, ErrHoldNotActive
+	}
+
+	h.status = HoldExpired
+	h.updatedAt = at
+
+	return h, nil
+}
+
+// active checks the hold can still be captured or voided, a hold past its expiration is not active
+// even before the sweeper expires it
+func (h Hold) active(at time.Time) error {
+	if h.status != HoldActive || !at.Before(h.expiresAt) {
+		return ErrHoldNotActive
+	}
+
+	return nil
+}
+
+// Transfer returns the transfer of the captured value, it has the ID of the hold so a hold is captured only once
+func (h Hold) Transfer() Transfer {
+	return NewTransfer(h.id, h.payer, h.payee, h.captured, h.updatedAt)
+}
+
+// ID returns the id property
+func (h Hold) ID() vo.Uuid {
+	return h.id
+}
+
+// Payer returns the payer property
+func (h Hold) Payer() vo.Uuid {
+	return h.payer
+}
+
+// Payee returns the payee property
+func (h Hold) Payee() vo.Uuid {
+	return h.payee
+}
+
+// Value returns the value held
+func (h Hold) Value() vo.Money {
+	return h.value
+}
+
+// Captured returns the value captured
+func (h Hold) Captured() vo.Money {
+	return h.captured
+}
+
+// Status returns the status property
+func (h Hold) Status() HoldStatus {
+	return h.status
+}
+
+// ExpiresAt returns the expiresAt property
+func (h Hold) ExpiresAt() time.Time {
+	return h.expiresAt
+}
+
+// CreatedAt returns the createdAt property
+func (h Hold) CreatedAt() time.Time {
+	return h.createdAt
+}
+
+// UpdatedAt returns the updatedAt property
+func (h Hold) UpdatedAt() time.Time {
+	return h.updatedAt
+}
@@ -0,0 +1,162 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func TestNewHold(t *testing.T) {
+	var createdAt = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		wantErr   error
+	}{
+		{
+			name:      "Expiration date in the future",
+			expiresAt: createdAt.Add(time.Hour),
+		},
+		{
+			name:      "Expiration date equal to the creation",
+			expiresAt: createdAt,
+			wantErr:   ErrInvalidHoldExpiration,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewHold(
+				vo.NewUuidStaticTest(),
+				vo.NewUuidStaticTest(),
+				vo.NewUuidStaticTest(),
+				vo.NewMoneyBRL(vo.NewAmountTest(100)),
+				tt.expiresAt,
+				createdAt,
+			)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if err == nil && got.Status() != HoldActive {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Status(), HoldActive)
+			}
+		})
+	}
+}
+
+func TestHold_Capture(t *testing.T) {
+	var (
+		createdAt = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+		expiresAt = createdAt.Add(time.Hour)
+		hold, _   = NewHold(
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewMoneyBRL(vo.NewAmountTest(100)),
+			expiresAt,
+			createdAt,
+		)
+		voided, _ = hold.Void(createdAt)
+	)
+
+	tests := []struct {
+		name    string
+		hold    Hold
+		value   int64
+		at      time.Time
+		wantErr error
+	}{
+		{
+			name:  "Capture the whole value",
+			hold:  hold,
+			value: 100,
+			at:    createdAt,
+		},
+		{
+			name:  "Capture part of the value",
+			hold:  hold,
+			value: 40,
+			at:    createdAt,
+		},
+		{
+			name:    "Capture more than the value held",
+			hold:    hold,
+			value:   101,
+			at:      createdAt,
+			wantErr: ErrInvalidCaptureValue,
+		},
+		{
+			name:    "Capture nothing",
+			hold:    hold,
+			value:   0,
+			at:      createdAt,
+			wantErr: ErrInvalidCaptureValue,
+		},
+		{
+			name:    "Capture after the expiration",
+			hold:    hold,
+			value:   100,
+			at:      expiresAt,
+			wantErr: ErrHoldNotActive,
+		},
+		{
+			name:    "Capture a voided hold",
+			hold:    voided,
+			value:   100,
+			at:      createdAt,
+			wantErr: ErrHoldNotActive,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.hold.Capture(vo.NewMoneyBRL(vo.NewAmountTest(tt.value)), tt.at)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			if got.Status() != HoldCaptured || got.Captured().Amount().Value() != tt.value {
+				t.Errorf("[TestCase '%s'] Got: '%v %v' | Want: '%v %v'", tt.name, got.Status(), got.Captured().Amount().Value(), HoldCaptured, tt.value)
+			}
+
+			var transfer = got.Transfer()
+			if transfer.ID() != got.ID() || transfer.Value().Amount().Value() != tt.value {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, transfer.Value().Amount().Value(), tt.value)
+			}
+		})
+	}
+}
+
+func TestHold_Expire(t *testing.T) {
+	var (
+		createdAt = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+		hold, _   = NewHold(
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewMoneyBRL(vo.NewAmountTest(100)),
+			createdAt.Add(time.Hour),
+			createdAt,
+		)
+	)
+
+	expired, err := hold.Expire(createdAt.Add(time.Hour))
+	if err != nil || expired.Status() != HoldExpired {
+		t.Errorf("[TestCase '%s'] Got: '%v %v' | Want: '%v'", "Expire active hold", expired.Status(), err, HoldExpired)
+	}
+
+	if _, err := expired.Expire(createdAt.Add(time.Hour)); !errors.Is(err, ErrHoldNotActive) {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Expire expired hold", err, ErrHoldNotActive)
+	}
+
+	if _, err := expired.Void(createdAt); !errors.Is(err, ErrHoldNotActive) {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Void expired hold", err, ErrHoldNotActive)
+	}
+}
@@ -0,0 +1,159 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+const (
+	KYCBasic    KYCLevel = "BASIC"
+	KYCVerified KYCLevel = "VERIFIED"
+	KYCFull     KYCLevel = "FULL"
+
+	SingleTransferLimit KYCLimit = "single transfer"
+	DailyOutgoingLimit  KYCLimit = "daily outgoing"
+	WalletBalanceLimit  KYCLimit = "wallet balance"
+)
+
+var (
+	ErrInvalidKYCLevel = NewError(KindValidation, "invalid_kyc_level", "invalid KYC level, expected BASIC, VERIFIED or FULL")
+
+	ErrKYCLimitExceeded = NewError(KindRuleViolation, "kyc_limit_exceeded", "limit of the KYC level exceeded")
+
+	ErrUpdateUserKYCLevel = NewError(KindInternal, "update_user_kyc_level", "error updating the KYC level of the user")
+
+	ErrSumSentTransfers = NewError(KindInternal, "sum_sent_transfers", "error summing the transfers sent by the user")
+
+	// kycLimits defines the limits of each KYC level, in cents
+	kycLimits = map[KYCLevel]KYCLimits{
+		KYCBasic:    {singleTransfer: 100000, dailyOutgoing: 200000, walletBalance: 500000},
+		KYCVerified: {singleTransfer: 1000000, dailyOutgoing: 2000000, walletBalance: 5000000},
+		KYCFull:     {singleTransfer: 5000000, dailyOutgoing: 20000000, walletBalance: 100000000},
+	}
+)
+
+type (
+	// KYCLevel defines how much of the identity of a user is verified
+	KYCLevel string
+
+	// KYCLimit defines the limits enforced by a KYC level
+	KYCLimit string
+
+	// UserRepositoryKYCUpdater defines the update operation of the KYC level of a user entity
+	UserRepositoryKYCUpdater interface {
+		UpdateKYCLevel(context.Context, vo.Uuid, KYCLevel) error
+	}
+
+	// TransferRepositorySentSummarizer defines the operation of summing the money debited by the transfers sent by a user
+	// since a date, fees included
+	TransferRepositorySentSummarizer interface {
+		SumSent(context.Context, vo.Uuid, time.Time) (int64, error)
+	}
+
+	// KYCLimits defines the single transfer, daily outgoing and wallet balance limits of a KYC level
+	KYCLimits struct {
+		singleTransfer int64
+		dailyOutgoing  int64
+		walletBalance  int64
+	}
+
+	// KYCLimitError defines a limit of a KYC level exceeded with the allowance still remaining
+	KYCLimitError struct {
+		level     KYCLevel
+		limit     KYCLimit
+		remaining int64
+	}
+)
+
+// NewKYCLevel creates new KYCLevel
+func NewKYCLevel(value string) (KYCLevel, error) {
+	var level = KYCLevel(strings.ToUpper(strings.TrimSpace(value)))
+	if _, ok := kycLimits[level]; !ok {
+		return "", ErrInvalidKYCLevel
+	}
+
+	return level, nil
+}
+
+// Limits returns the limits of the KYC level
+func (k KYCLevel) Limits() KYCLimits {
+	return kycLimits[k]
+}
+
+// String returns string representation of the KYCLevel
+func (k KYCLevel) String() string {
+	return string(k)
+}
+
+// SingleTransfer returns the singleTransfer property
+func (k KYCLimits) SingleTransfer() int64 {
+	return k.singleTransfer
+}
+
+// DailyOutgoing returns the dailyOutgoing property
+func (k KYCLimits) DailyOutgoing() int64 {
+	return k.dailyOutgoing
+}
+
+// WalletBalance returns the walletBalance property
+func (k KYCLimits) WalletBalance() int64 {
+	return k.walletBalance
+}
+
+// NewKYCLimitError creates new KYCLimitError
+func NewKYCLimitError(level KYCLevel, limit KYCLimit, remaining int64) KYCLimitError {
+	return KYCLimitError{
+		level:     level,
+		limit:     limit,
+		remaining: remaining,
+	}
+}
+
+// Error returns the limit exceeded with the remaining allowance
+func (k KYCLimitError) Error() string {
+	return fmt.Sprintf(
+		"%s limit of the KYC level %s exceeded, remaining allowance of %d",
+		k.limit,
+		k.level,
+		k.remaining,
+	)
+}
+
+// Unwrap returns ErrKYCLimitExceeded, the domain error of every limit exceeded
+func (k KYCLimitError) Unwrap() error {
+	return ErrKYCLimitExceeded
+}
+
+// Level returns the level property
+func (k KYCLimitError) Level() KYCLevel {
+	return k.level
+}
+
+// Limit returns the limit property
+func (k KYCLimitError) Limit() KYCLimit {
+	return k.limit
+}
+
+// Remaining returns the remaining property
+func (k KYCLimitError) Remaining() int64 {
+	return k.remaining
+}
+
+// StartOfDay returns the start of the UTC day of t, from when the daily outgoing volume is summed
+func StartOfDay(t time.Time) time.Time {
+	var y, m, d = t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// allowance returns what remains of a limit after used, never negative
+func allowance(limit int64, used int64) int64 {
+	if used >= limit {
+		return 0
+	}
+
+	return limit - used
+}
@@ -0,0 +1,148 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func TestNewKYCLevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    KYCLevel
+		wantErr error
+	}{
+		{
+			name:  "Create KYC level",
+			value: "VERIFIED",
+			want:  KYCVerified,
+		},
+		{
+			name:  "Create KYC level case insensitive",
+			value: " full ",
+			want:  KYCFull,
+		},
+		{
+			name:    "Create invalid KYC level",
+			value:   "PREMIUM",
+			wantErr: ErrInvalidKYCLevel,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewKYCLevel(tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUser_CanSend(t *testing.T) {
+	tests := []struct {
+		name      string
+		level     KYCLevel
+		value     int64
+		sentToday int64
+		want      error
+	}{
+		{
+			name:  "Send up to the single transfer limit",
+			level: KYCBasic,
+			value: 100000,
+		},
+		{
+			name:  "Send above the single transfer limit",
+			level: KYCBasic,
+			value: 100001,
+			want:  NewKYCLimitError(KYCBasic, SingleTransferLimit, 100000),
+		},
+		{
+			name:      "Send above the daily outgoing limit",
+			level:     KYCBasic,
+			value:     60000,
+			sentToday: 150000,
+			want:      NewKYCLimitError(KYCBasic, DailyOutgoingLimit, 50000),
+		},
+		{
+			name:      "Send after the daily outgoing limit is used up",
+			level:     KYCVerified,
+			value:     1,
+			sentToday: 2500000,
+			want:      NewKYCLimitError(KYCVerified, DailyOutgoingLimit, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var user = NewCommonUser(
+				vo.NewUuidStaticTest(),
+				vo.NewFullName("Test testing"),
+				vo.Email{},
+				vo.NewPassword("123"),
+				vo.NewDocumentTest(vo.CPF, "07010965836"),
+				vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(0))),
+				time.Time{},
+			).WithKYCLevel(tt.level)
+
+			err := user.CanSend(vo.NewMoneyBRL(vo.NewAmountTest(tt.value)), tt.sentToday)
+			if err != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUser_CanReceive(t *testing.T) {
+	tests := []struct {
+		name    string
+		level   KYCLevel
+		balance int64
+		value   int64
+		want    error
+	}{
+		{
+			name:    "Receive up to the wallet balance limit",
+			level:   KYCBasic,
+			balance: 400000,
+			value:   100000,
+		},
+		{
+			name:    "Receive above the wallet balance limit",
+			level:   KYCBasic,
+			balance: 400000,
+			value:   100001,
+			want:    NewKYCLimitError(KYCBasic, WalletBalanceLimit, 100000),
+		},
+		{
+			name:    "Receive within the wallet balance limit of an upgraded level",
+			level:   KYCFull,
+			balance: 400000,
+			value:   100001,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var user = NewMerchantUser(
+				vo.NewUuidStaticTest(),
+				vo.NewFullName("Test testing"),
+				vo.Email{},
+				vo.NewPassword("123"),
+				vo.NewDocumentTest(vo.CNPJ, "90.691.635/0001-75"),
+				vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(tt.balance))),
+				time.Time{},
+			).WithKYCLevel(tt.level)
+
+			err := user.CanReceive(vo.NewMoneyBRL(vo.NewAmountTest(tt.value)))
+			if err != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.want)
+			}
+		})
+	}
+}
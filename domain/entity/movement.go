@@ -0,0 +1,202 @@
+package entity
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+const (
+	// Movement types
+	Deposit    MovementType = "DEPOSIT"
+	Withdrawal MovementType = "WITHDRAWAL"
+
+	// Movement status
+	MovementCompleted MovementStatus = "COMPLETED"
+	MovementReversed  MovementStatus = "REVERSED"
+)
+
+var (
+	ErrCreateMovement = NewError(KindInternal, "create_movement", "error creating movement")
+
+	ErrNotFoundMovement = NewError(KindNotFound, "not_found_movement", "not found movement")
+
+	ErrFindMovement = NewError(KindInternal, "find_movement", "error fetching movement")
+
+	ErrUpdateMovement = NewError(KindInternal, "update_movement", "error updating movement")
+
+	ErrDuplicateExternalReference = NewError(KindConflict, "duplicate_external_reference", "movement with the external reference already exists")
+
+	ErrMovementAlreadyReversed = NewError(KindConflict, "movement_already_reversed", "movement already reversed")
+
+	ErrUnauthorizedMovement = NewError(KindRuleViolation, "unauthorized_movement", "unauthorized movement")
+
+	ErrAuthorizerUnavailable = NewError(KindUnavailable, "authorizer_unavailable", "authorizer is unavailable")
+
+	ErrInvalidExternalReference = NewError(KindValidation, "invalid_external_reference", "invalid external reference")
+)
+
+type (
+	// MovementType defines whether a movement puts money into or takes money out of a wallet
+	MovementType string
+
+	// MovementStatus defines the status of a movement
+	MovementStatus string
+
+	// Authorizable defines an operation that must be approved by the authorizer
+	Authorizable interface {
+		ID() vo.Uuid
+		Value() vo.Money
+	}
+
+	// MovementRepositoryCreator defines the operation of creating a movement entity
+	MovementRepositoryCreator interface {
+		Create(context.Context, Movement) (Movement, error)
+		WithTransaction(context.Context, func(context.Context) error) error
+	}
+
+	// MovementRepositoryFinder defines the search operations of movement entities
+	MovementRepositoryFinder interface {
+		FindByID(context.Context, vo.Uuid) (Movement, error)
+		FindByUserID(context.Context, vo.Uuid, time.Time) ([]Movement, error)
+	}
+
+	// MovementRepositoryUpdater defines the operation of reversing a movement entity
+	MovementRepositoryUpdater interface {
+		Reverse(context.Context, Movement) error
+		WithTransaction(context.Context, func(context.Context) error) error
+	}
+
+	// Movement defines a deposit or withdrawal of money between a wallet and an external account
+	Movement struct {
+		id                vo.Uuid
+		userID            vo.Uuid
+		movementType      MovementType
+		value             vo.Money
+		externalReference string
+		status            MovementStatus
+		createdAt         time.Time
+		reversedAt        time.Time
+	}
+)
+
+// NewMovement creates new completed movement
+func NewMovement(
+	ID vo.Uuid,
+	userID vo.Uuid,
+	movementType MovementType,
+	value vo.Money,
+	externalReference string,
+	createdAt time.Time,
+) (Movement, error) {
+	if externalReference == "" {
+		return Movement{}, ErrInvalidExternalReference
+	}
+
+	return Movement{
+		id:                ID,
+		userID:            userID,
+		movementType:      movementType,
+		value:             value,
+		externalReference: externalReference,
+		status:            MovementCompleted,
+		createdAt:         createdAt,
+	}, nil
+}
+
+// RestoreMovement creates a movement from its persisted representation
+func RestoreMovement(
+	ID vo.Uuid,
+	userID vo.Uuid,
+	movementType MovementType,
+	value vo.Money,
+	externalReference string,
+	status MovementStatus,
+	createdAt time.Time,
+	reversedAt time.Time,
+) Movement {
+	return Movement{
+		id:                ID,
+		userID:            userID,
+		movementType:      movementType,
+		value:             value,
+		externalReference: externalReference,
+		status:            status,
+		createdAt:         createdAt,
+		reversedAt:        reversedAt,
+	}
+}
+
+// Apply changes the wallet of the user by the movement, a deposit is limited by the KYC level of the user
+func (m Movement) Apply(u User) error {
+	if m.movementType == Withdrawal {
+		return u.Withdraw(m.value)
+	}
+
+	if err := u.CanReceive(m.value); err != nil {
+		return err
+	}
+
+	u.Deposit(m.value)
+
+	return nil
+}
+
+// Reverse returns the reversed movement and changes the wallet of the user back
+func (m Movement) Reverse(u User, at time.Time) (Movement, error) {
+	if m.status == MovementReversed {
+		return Movement{}, ErrMovementAlreadyReversed
+	}
+
+	if m.movementType == Withdrawal {
+		u.Deposit(m.value)
+	} else if err := u.Withdraw(m.value); err != nil {
+		return Movement{}, err
+	}
+
+	m.status = MovementReversed
+	m.reversedAt = at
+
+	return m, nil
+}
+
+// ID returns the id property
+func (m Movement) ID() vo.Uuid {
+	return m.id
+}
+
+// UserID returns the userID property
+func (m Movement) UserID() vo.Uuid {
+	return m.userID
+}
+
+// Type returns the movementType property
+func (m Movement) Type() MovementType {
+	return m.movementType
+}
+
+// Value returns the value property
+func (m Movement) Value() vo.Money {
+	return m.value
+}
+
+// ExternalReference returns the externalReference property
+func (m Movement) ExternalReference() string {
+	return m.externalReference
+}
+
+// Status returns the status property
+func (m Movement) Status() MovementStatus {
+	return m.status
+}
+
+// CreatedAt returns the createdAt property
+func (m Movement) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+// ReversedAt returns the reversedAt property, zero while the movement is not reversed
+func (m Movement) ReversedAt() time.Time {
+	return m.reversedAt
+}
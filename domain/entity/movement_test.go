@@ -0,0 +1,124 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func TestMovement_Reverse(t *testing.T) {
+	var newUser = func(balance int64) User {
+		return NewCommonUser(
+			vo.NewUuidStaticTest(),
+			vo.NewFullName("Test testing"),
+			vo.NewEmailTest("test@testing.com"),
+			vo.NewPassword("passw"),
+			vo.NewDocumentTest(vo.CPF, "07091054954"),
+			vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(balance))),
+			time.Time{},
+		)
+	}
+
+	tests := []struct {
+		name         string
+		movementType MovementType
+		status       MovementStatus
+		balance      int64
+		wantBalance  int64
+		wantErr      error
+	}{
+		{
+			name:         "Reverse deposit",
+			movementType: Deposit,
+			status:       MovementCompleted,
+			balance:      100,
+			wantBalance:  70,
+		},
+		{
+			name:         "Reverse withdrawal",
+			movementType: Withdrawal,
+			status:       MovementCompleted,
+			balance:      100,
+			wantBalance:  130,
+		},
+		{
+			name:         "Reverse deposit already spent",
+			movementType: Deposit,
+			status:       MovementCompleted,
+			balance:      10,
+			wantBalance:  10,
+			wantErr:      ErrUserInsufficientBalance,
+		},
+		{
+			name:         "Reverse movement already reversed",
+			movementType: Withdrawal,
+			status:       MovementReversed,
+			balance:      100,
+			wantBalance:  100,
+			wantErr:      ErrMovementAlreadyReversed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				user     = newUser(tt.balance)
+				at       = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+				movement = RestoreMovement(
+					vo.NewUuidStaticTest(),
+					vo.NewUuidStaticTest(),
+					tt.movementType,
+					vo.NewMoneyBRL(vo.NewAmountTest(30)),
+					"bank-slip-0001",
+					tt.status,
+					time.Time{},
+					time.Time{},
+				)
+			)
+
+			got, err := movement.Reverse(user, at)
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if user.Wallet().Money().Amount().Value() != tt.wantBalance {
+				t.Errorf("[TestCase '%s'] Got balance: '%v' | Want: '%v'", tt.name, user.Wallet().Money().Amount().Value(), tt.wantBalance)
+			}
+
+			if err == nil && (got.Status() != MovementReversed || !got.ReversedAt().Equal(at)) {
+				t.Errorf("[TestCase '%s'] Got: '%v' at '%v' | Want: '%v' at '%v'", tt.name, got.Status(), got.ReversedAt(), MovementReversed, at)
+			}
+		})
+	}
+}
+
+func TestNewMovementStatementEntries(t *testing.T) {
+	var (
+		createdAt  = time.Date(2020, 11, 2, 0, 0, 0, 0, time.UTC)
+		reversedAt = time.Date(2020, 11, 5, 0, 0, 0, 0, time.UTC)
+	)
+
+	entries := NewMovementStatementEntries(RestoreMovement(
+		vo.NewUuidStaticTest(),
+		vo.NewUuidStaticTest(),
+		Withdrawal,
+		vo.NewMoneyBRL(vo.NewAmountTest(30)),
+		"bank-transfer-0001",
+		MovementReversed,
+		createdAt,
+		reversedAt,
+	))
+
+	if len(entries) != 2 {
+		t.Fatalf("Got: '%d' entries | Want: '2'", len(entries))
+	}
+
+	if entries[0].Type() != Debit || !entries[0].OccurredAt().Equal(createdAt) {
+		t.Errorf("Got: '%v' at '%v' | Want: '%v' at '%v'", entries[0].Type(), entries[0].OccurredAt(), Debit, createdAt)
+	}
+
+	if entries[1].Type() != Credit || !entries[1].OccurredAt().Equal(reversedAt) {
+		t.Errorf("Got: '%v' at '%v' | Want: '%v' at '%v'", entries[1].Type(), entries[1].OccurredAt(), Credit, reversedAt)
+	}
+}
@@ -0,0 +1,196 @@
+package entity
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+const (
+	// Reconciliation status
+	ReconciliationMatched         ReconciliationStatus = "MATCHED"
+	ReconciliationMismatched      ReconciliationStatus = "MISMATCHED"
+	ReconciliationMissingBaseline ReconciliationStatus = "MISSING_BASELINE"
+)
+
+var (
+	ErrFindWallets = NewError(KindInternal, "find_wallets", "error fetching wallets")
+
+	ErrSumTransfers = NewError(KindInternal, "sum_transfers", "error summing transfers")
+
+	ErrSumMovements = NewError(KindInternal, "sum_movements", "error summing movements")
+
+	ErrNotFoundReconciliationCheckpoint = NewError(KindNotFound, "not_found_reconciliation_checkpoint", "not found reconciliation checkpoint")
+
+	ErrSaveReconciliationCheckpoint = NewError(KindInternal, "save_reconciliation_checkpoint", "error saving reconciliation checkpoint")
+)
+
+type (
+	// ReconciliationStatus defines the result of the reconciliation of a wallet
+	ReconciliationStatus string
+
+	// WalletRepositoryLister defines the search operation of the wallets of every user
+	WalletRepositoryLister interface {
+		FindAll(context.Context) ([]WalletBalance, error)
+	}
+
+	// TransferRepositorySummarizer defines the operation summing the transfers sent and received by each user in a period
+	TransferRepositorySummarizer interface {
+		SumByUser(context.Context, time.Time, time.Time) (map[vo.Uuid]MovementTotals, error)
+	}
+
+	// MovementRepositorySummarizer defines the operation summing the deposits and withdrawals of each user in a period
+	MovementRepositorySummarizer interface {
+		SumByUser(context.Context, time.Time, time.Time) (map[vo.Uuid]MovementTotals, error)
+	}
+
+	// ReconciliationCheckpointRepository defines the operations of the reconciliation checkpoints
+	ReconciliationCheckpointRepository interface {
+		FindLatest(context.Context) (ReconciliationCheckpoint, error)
+		Save(context.Context, ReconciliationCheckpoint) error
+	}
+
+	// WalletBalance defines the persisted balance of a user wallet and the amount it was opened with
+	WalletBalance struct {
+		userID     vo.Uuid
+		balance    vo.Money
+		initial    vo.Money
+		hasInitial bool
+	}
+
+	// MovementTotals defines the money received and sent by a user
+	MovementTotals struct {
+		Received int64
+		Sent     int64
+	}
+
+	// ReconciliationCheckpoint defines the expected balance of each wallet at a point in time
+	ReconciliationCheckpoint struct {
+		at       time.Time
+		balances map[vo.Uuid]int64
+	}
+
+	// Reconciliation defines the comparison of a wallet balance with the balance expected from its history
+	Reconciliation struct {
+		userID   vo.Uuid
+		currency vo.Currency
+		expected int64
+		actual   int64
+		status   ReconciliationStatus
+	}
+)
+
+// NewWalletBalance creates new wallet balance, a wallet created before the initial amount was persisted has no initial amount
+func NewWalletBalance(userID vo.Uuid, balance vo.Money, initial *vo.Money) WalletBalance {
+	w := WalletBalance{
+		userID:  userID,
+		balance: balance,
+	}
+
+	if initial != nil {
+		w.initial = *initial
+		w.hasInitial = true
+	}
+
+	return w
+}
+
+// UserID returns the userID property
+func (w WalletBalance) UserID() vo.Uuid {
+	return w.userID
+}
+
+// Balance returns the balance property
+func (w WalletBalance) Balance() vo.Money {
+	return w.balance
+}
+
+// Initial returns the initial amount and whether it is known
+func (w WalletBalance) Initial() (vo.Money, bool) {
+	return w.initial, w.hasInitial
+}
+
+// Net returns the money received minus the money sent
+func (m MovementTotals) Net() int64 {
+	return m.Received - m.Sent
+}
+
+// Add returns the sum of both totals
+func (m MovementTotals) Add(other MovementTotals) MovementTotals {
+	return MovementTotals{
+		Received: m.Received + other.Received,
+		Sent:     m.Sent + other.Sent,
+	}
+}
+
+// NewReconciliationCheckpoint creates new reconciliation checkpoint
+func NewReconciliationCheckpoint(at time.Time, balances map[vo.Uuid]int64) ReconciliationCheckpoint {
+	return ReconciliationCheckpoint{
+		at:       at,
+		balances: balances,
+	}
+}
+
+// At returns the at property
+func (c ReconciliationCheckpoint) At() time.Time {
+	return c.at
+}
+
+// Balances returns the expected balance of each wallet at the checkpoint
+func (c ReconciliationCheckpoint) Balances() map[vo.Uuid]int64 {
+	return c.balances
+}
+
+// ReconcileWallet compares the wallet balance with the baseline plus the net movements since the baseline
+func ReconcileWallet(wallet WalletBalance, baseline int64, hasBaseline bool, totals MovementTotals) Reconciliation {
+	r := Reconciliation{
+		userID:   wallet.userID,
+		currency: wallet.balance.Currency(),
+		actual:   wallet.balance.Amount().Value(),
+	}
+
+	if !hasBaseline {
+		r.status = ReconciliationMissingBaseline
+		return r
+	}
+
+	r.expected = baseline + totals.Net()
+	if r.expected == r.actual {
+		r.status = ReconciliationMatched
+	} else {
+		r.status = ReconciliationMismatched
+	}
+
+	return r
+}
+
+// UserID returns the userID property
+func (r Reconciliation) UserID() vo.Uuid {
+	return r.userID
+}
+
+// Currency returns the currency property
+func (r Reconciliation) Currency() vo.Currency {
+	return r.currency
+}
+
+// Expected returns the balance expected from the wallet history
+func (r Reconciliation) Expected() int64 {
+	return r.expected
+}
+
+// Actual returns the balance persisted in the wallet
+func (r Reconciliation) Actual() int64 {
+	return r.actual
+}
+
+// Difference returns the persisted balance minus the expected balance
+func (r Reconciliation) Difference() int64 {
+	return r.actual - r.expected
+}
+
+// Status returns the status property
+func (r Reconciliation) Status() ReconciliationStatus {
+	return r.status
+}
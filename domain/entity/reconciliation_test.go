@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func TestReconcileWallet(t *testing.T) {
+	var initial = vo.NewMoneyBRL(vo.NewAmountTest(100))
+
+	tests := []struct {
+		name           string
+		wallet         WalletBalance
+		baseline       int64
+		hasBaseline    bool
+		totals         MovementTotals
+		wantExpected   int64
+		wantDifference int64
+		wantStatus     ReconciliationStatus
+	}{
+		{
+			name:         "Balance matches the history",
+			wallet:       NewWalletBalance(vo.NewUuidStaticTest(), vo.NewMoneyBRL(vo.NewAmountTest(130)), &initial),
+			baseline:     100,
+			hasBaseline:  true,
+			totals:       MovementTotals{Received: 50, Sent: 20},
+			wantExpected: 130,
+			wantStatus:   ReconciliationMatched,
+		},
+		{
+			name:           "Balance above the history",
+			wallet:         NewWalletBalance(vo.NewUuidStaticTest(), vo.NewMoneyBRL(vo.NewAmountTest(150)), &initial),
+			baseline:       100,
+			hasBaseline:    true,
+			totals:         MovementTotals{Received: 50, Sent: 20},
+			wantExpected:   130,
+			wantDifference: 20,
+			wantStatus:     ReconciliationMismatched,
+		},
+		{
+			name:           "Balance below the history",
+			wallet:         NewWalletBalance(vo.NewUuidStaticTest(), vo.NewMoneyBRL(vo.NewAmountTest(80)), &initial),
+			baseline:       100,
+			hasBaseline:    true,
+			wantExpected:   100,
+			wantDifference: -20,
+			wantStatus:     ReconciliationMismatched,
+		},
+		{
+			name:           "Wallet without baseline",
+			wallet:         NewWalletBalance(vo.NewUuidStaticTest(), vo.NewMoneyBRL(vo.NewAmountTest(80)), nil),
+			totals:         MovementTotals{Received: 50},
+			wantDifference: 80,
+			wantStatus:     ReconciliationMissingBaseline,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ReconcileWallet(tt.wallet, tt.baseline, tt.hasBaseline, tt.totals)
+
+			if got.Status() != tt.wantStatus {
+				t.Errorf("[TestCase '%s'] Got status: '%v' | Want: '%v'", tt.name, got.Status(), tt.wantStatus)
+			}
+
+			if got.Expected() != tt.wantExpected {
+				t.Errorf("[TestCase '%s'] Got expected: '%v' | Want: '%v'", tt.name, got.Expected(), tt.wantExpected)
+			}
+
+			if got.Difference() != tt.wantDifference {
+				t.Errorf("[TestCase '%s'] Got difference: '%v' | Want: '%v'", tt.name, got.Difference(), tt.wantDifference)
+			}
+		})
+	}
+}
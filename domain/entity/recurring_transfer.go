@@ -0,0 +1,432 @@
+package entity
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+const (
+	// Recurrence frequencies
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+
+	// Recurring transfer status
+	RecurrenceActive   RecurrenceStatus = "ACTIVE"
+	RecurrencePaused   RecurrenceStatus = "PAUSED"
+	RecurrenceFinished RecurrenceStatus = "FINISHED"
+	RecurrenceCanceled RecurrenceStatus = "CANCELED"
+
+	// DefaultMaxConsecutiveFailures is the number of consecutive failed occurrences pausing a recurring transfer
+	DefaultMaxConsecutiveFailures = 3
+)
+
+var (
+	ErrCreateRecurringTransfer = NewError(KindInternal, "create_recurring_transfer", "error creating recurring transfer")
+
+	ErrNotFoundRecurringTransfer = NewError(KindNotFound, "not_found_recurring_transfer", "not found recurring transfer")
+
+	ErrFindRecurringTransfer = NewError(KindInternal, "find_recurring_transfer", "error fetching recurring transfer")
+
+	ErrUpdateRecurringTransfer = NewError(KindInternal, "update_recurring_transfer", "error updating recurring transfer")
+
+	ErrLeaseRecurringTransfers = NewError(KindInternal, "lease_recurring_transfers", "error leasing recurring transfers")
+
+	ErrRecurringTransferBusy = NewError(KindConflict, "recurring_transfer_busy", "recurring transfer is being executed")
+
+	ErrRecurringTransferClosed = NewError(KindConflict, "recurring_transfer_closed", "recurring transfer is finished or canceled")
+
+	ErrInvalidFrequency = NewError(KindValidation, "invalid_frequency", "invalid frequency")
+
+	ErrInvalidDayOfMonth = NewError(KindValidation, "invalid_day_of_month", "day of month must be between 1 and 31")
+
+	ErrInvalidRecurrenceEnd = NewError(KindValidation, "invalid_recurrence_end", "recurrence must end either at a date after its start or after a positive count")
+
+	ErrInvalidMaxFailures = NewError(KindValidation, "invalid_max_failures", "max failures must be positive")
+
+	ErrInvalidRecurrenceStatus = NewError(KindValidation, "invalid_recurrence_status", "status must be ACTIVE or PAUSED")
+)
+
+type (
+	// Frequency defines how often a recurring transfer is executed
+	Frequency string
+
+	// RecurrenceStatus defines the status of a recurring transfer
+	RecurrenceStatus string
+
+	// RecurringTransferRepositoryCreator defines the operation of creating a recurring transfer entity
+	RecurringTransferRepositoryCreator interface {
+		Create(context.Context, RecurringTransfer) (RecurringTransfer, error)
+	}
+
+	// RecurringTransferRepositoryFinder defines the search operation for a recurring transfer entity
+	RecurringTransferRepositoryFinder interface {
+		FindByID(context.Context, vo.Uuid) (RecurringTransfer, error)
+	}
+
+	// RecurringTransferRepositoryUpdater defines the operation of updating a recurring transfer
+	// that is not being executed by a worker
+	RecurringTransferRepositoryUpdater interface {
+		Update(context.Context, RecurringTransfer) error
+	}
+
+	// RecurringTransferRepositoryLeaser defines the operations of the workers executing the due occurrences,
+	// a leased recurring transfer is not picked up by other workers until the lease expires
+	RecurringTransferRepositoryLeaser interface {
+		LeaseDue(ctx context.Context, now time.Time, owner string, ttl time.Duration, limit int) ([]RecurringTransfer, error)
+		Complete(ctx context.Context, owner string, r RecurringTransfer) error
+	}
+
+	// RecurringTransferRepository defines the operations of the recurring transfers
+	RecurringTransferRepository interface {
+		RecurringTransferRepositoryCreator
+		RecurringTransferRepositoryFinder
+		RecurringTransferRepositoryUpdater
+		RecurringTransferRepositoryLeaser
+	}
+
+	// RecurringTransfer defines a mandate transferring a value from the payer to the payee on a schedule,
+	// the schedule ends at a date, after a count of occurrences or never
+	RecurringTransfer struct {
+		id                  vo.Uuid
+		payer               vo.Uuid
+		payee               vo.Uuid
+		value               vo.Money
+		frequency           Frequency
+		dayOfMonth          int
+		startAt             time.Time
+		endAt               time.Time
+		count               int
+		occurrences         int
+		consecutiveFailures int
+		maxFailures         int
+		lastFailureReason   string
+		status              RecurrenceStatus
+		createdAt           time.Time
+		updatedAt           time.Time
+	}
+)
+
+// NewRecurringTransfer creates new active recurring transfer, dayOfMonth is only used by the monthly frequency
+// and a zero endAt or count means the schedule does not end by that criterion
+func NewRecurringTransfer(
+	ID vo.Uuid,
+	payerID vo.Uuid,
+	payeeID vo.Uuid,
+	value vo.Money,
+	frequency Frequency,
+	dayOfMonth int,
+	startAt time.Time,
+	endAt time.Time,
+	count int,
+	maxFailures int,
+	createdAt time.Time,
+) (RecurringTransfer, error) {
+	switch frequency {
+	case Daily, Weekly:
+		dayOfMonth = 0
+	case Monthly:
+		if dayOfMonth < 1 || dayOfMonth > 31 {
+			return RecurringTransfer{}, ErrInvalidDayOfMonth
+		}
+	default:
+		return RecurringTransfer{}, ErrInvalidFrequency
+	}
+
+	if maxFailures <= 0 {
+		return RecurringTransfer{}, ErrInvalidMaxFailures
+	}
+
+	r := RecurringTransfer{
+		id:          ID,
+		payer:       payerID,
+		payee:       payeeID,
+		value:       value,
+		frequency:   frequency,
+		dayOfMonth:  dayOfMonth,
+		startAt:     startAt,
+		maxFailures: maxFailures,
+		status:      RecurrenceActive,
+		createdAt:   createdAt,
+		updatedAt:   createdAt,
+	}
+
+	if err := r.setEnd(endAt, count); err != nil {
+		return RecurringTransfer{}, err
+	}
+
+	if r.ended() {
+		return RecurringTransfer{}, ErrInvalidRecurrenceEnd
+	}
+
+	return r, nil
+}
+
+// RestoreRecurringTransfer creates a recurring transfer from its persisted representation
+func RestoreRecurringTransfer(
+	ID vo.Uuid,
+	payerID vo.Uuid,
+	payeeID vo.Uuid,
+	value vo.Money,
+	frequency Frequency,
+	dayOfMonth int,
+	startAt time.Time,
+	endAt time.Time,
+	count int,
+	occurrences int,
+	consecutiveFailures int,
+	maxFailures int,
+	lastFailureReason string,
+	status RecurrenceStatus,
+	createdAt time.Time,
+	updatedAt time.Time,
+) RecurringTransfer {
+	return RecurringTransfer{
+		id:                  ID,
+		payer:               payerID,
+		payee:               payeeID,
+		value:               value,
+		frequency:           frequency,
+		dayOfMonth:          dayOfMonth,
+		startAt:             startAt,
+		endAt:               endAt,
+		count:               count,
+		occurrences:         occurrences,
+		consecutiveFailures: consecutiveFailures,
+		maxFailures:         maxFailures,
+		lastFailureReason:   lastFailureReason,
+		status:              status,
+		createdAt:           createdAt,
+		updatedAt:           updatedAt,
+	}
+}
+
+// RunAt returns the date of the nth occurrence of the schedule, starting at zero. Monthly occurrences
+// fall on the last day of the months shorter than the day of month
+func (r RecurringTransfer) RunAt(n int) time.Time {
+	switch r.frequency {
+	case Daily:
+		return r.startAt.AddDate(0, 0, n)
+	case Weekly:
+		return r.startAt.AddDate(0, 0, 7*n)
+	}
+
+	if first := r.monthlyRunAt(0); first.Before(r.startAt) {
+		n++
+	}
+
+	return r.monthlyRunAt(n)
+}
+
+func (r RecurringTransfer) monthlyRunAt(months int) time.Time {
+	var (
+		year, month, _ = r.startAt.Date()
+		hour, min, sec = r.startAt.Clock()
+		lastDay        = time.Date(year, month+time.Month(months)+1, 0, 0, 0, 0, 0, r.startAt.Location()).Day()
+		day            = r.dayOfMonth
+	)
+
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(year, month+time.Month(months), day, hour, min, sec, r.startAt.Nanosecond(), r.startAt.Location())
+}
+
+// NextRunAt returns the date of the next occurrence, zero when the schedule is over
+func (r RecurringTransfer) NextRunAt() time.Time {
+	if r.ended() {
+		return time.Time{}
+	}
+
+	return r.RunAt(r.occurrences)
+}
+
+// Due reports whether the next occurrence must be executed at now
+func (r RecurringTransfer) Due(now time.Time) bool {
+	return r.status == RecurrenceActive && !r.ended() && !r.RunAt(r.occurrences).After(now)
+}
+
+// OccurrenceID returns the ID of the transfer generated by the next occurrence. It is derived from the
+// recurring transfer and the occurrence number, so executing the same occurrence twice yields the same transfer
+func (r RecurringTransfer) OccurrenceID() vo.Uuid {
+	return derivedID(r.id, strconv.Itoa(r.occurrences))
+}
+
+// Succeeded returns the recurring transfer with the next occurrence executed
+func (r RecurringTransfer) Succeeded(at time.Time) RecurringTransfer {
+	r.occurrences++
+	r.consecutiveFailures = 0
+	r.lastFailureReason = ""
+
+	return r.advanced(at)
+}
+
+// Failed returns the recurring transfer with the next occurrence failed, it is paused after the maximum
+// of consecutive failures
+func (r RecurringTransfer) Failed(reason error, at time.Time) RecurringTransfer {
+	r.occurrences++
+	r.consecutiveFailures++
+	r.lastFailureReason = reason.Error()
+
+	if r.consecutiveFailures >= r.maxFailures {
+		r.status = RecurrencePaused
+	}
+
+	return r.advanced(at)
+}
+
+// Update returns the recurring transfer with the new value, end and status. The occurrences missed
+// while paused are skipped when it is resumed, and the consecutive failures start over
+func (r RecurringTransfer) Update(value vo.Money, endAt time.Time, count int, status RecurrenceStatus, at time.Time) (RecurringTransfer, error) {
+	if r.closed() {
+		return RecurringTransfer{}, ErrRecurringTransferClosed
+	}
+
+	if status != RecurrenceActive && status != RecurrencePaused {
+		return RecurringTransfer{}, ErrInvalidRecurrenceStatus
+	}
+
+	if err := r.setEnd(endAt, count); err != nil {
+		return RecurringTransfer{}, err
+	}
+
+	if r.status == RecurrencePaused && status == RecurrenceActive {
+		r.consecutiveFailures = 0
+		for !r.ended() && r.RunAt(r.occurrences).Before(at) {
+			r.occurrences++
+		}
+	}
+
+	r.value = value
+	r.status = status
+
+	return r.advanced(at), nil
+}
+
+// Cancel returns the canceled recurring transfer, no occurrence is executed afterwards
+func (r RecurringTransfer) Cancel(at time.Time) (RecurringTransfer, error) {
+	if r.closed() {
+		return RecurringTransfer{}, ErrRecurringTransferClosed
+	}
+
+	r.status = RecurrenceCanceled
+	r.updatedAt = at
+
+	return r, nil
+}
+
+func (r *RecurringTransfer) setEnd(endAt time.Time, count int) error {
+	if count < 0 || (!endAt.IsZero() && count > 0) || (!endAt.IsZero() && endAt.Before(r.startAt)) {
+		return ErrInvalidRecurrenceEnd
+	}
+
+	r.endAt = endAt
+	r.count = count
+
+	return nil
+}
+
+func (r RecurringTransfer) advanced(at time.Time) RecurringTransfer {
+	if r.ended() {
+		r.status = RecurrenceFinished
+	}
+	r.updatedAt = at
+
+	return r
+}
+
+func (r RecurringTransfer) ended() bool {
+	if r.count > 0 && r.occurrences >= r.count {
+		return true
+	}
+
+	return !r.endAt.IsZero() && r.RunAt(r.occurrences).After(r.endAt)
+}
+
+func (r RecurringTransfer) closed() bool {
+	return r.status == RecurrenceFinished || r.status == RecurrenceCanceled
+}
+
+// ID returns the id property
+func (r RecurringTransfer) ID() vo.Uuid {
+	return r.id
+}
+
+// Payer returns the payer property
+func (r RecurringTransfer) Payer() vo.Uuid {
+	return r.payer
+}
+
+// Payee returns the payee property
+func (r RecurringTransfer) Payee() vo.Uuid {
+	return r.payee
+}
+
+// Value returns the value property
+func (r RecurringTransfer) Value() vo.Money {
+	return r.value
+}
+
+// Frequency returns the frequency property
+func (r RecurringTransfer) Frequency() Frequency {
+	return r.frequency
+}
+
+// DayOfMonth returns the dayOfMonth property
+func (r RecurringTransfer) DayOfMonth() int {
+	return r.dayOfMonth
+}
+
+// StartAt returns the startAt property
+func (r RecurringTransfer) StartAt() time.Time {
+	return r.startAt
+}
+
+// EndAt returns the endAt property
+func (r RecurringTransfer) EndAt() time.Time {
+	return r.endAt
+}
+
+// Count returns the count property
+func (r RecurringTransfer) Count() int {
+	return r.count
+}
+
+// Occurrences returns the occurrences property
+func (r RecurringTransfer) Occurrences() int {
+	return r.occurrences
+}
+
+// ConsecutiveFailures returns the consecutiveFailures property
+func (r RecurringTransfer) ConsecutiveFailures() int {
+	return r.consecutiveFailures
+}
+
+// MaxFailures returns the maxFailures property
+func (r RecurringTransfer) MaxFailures() int {
+	return r.maxFailures
+}
+
+// LastFailureReason returns the lastFailureReason property
+func (r RecurringTransfer) LastFailureReason() string {
+	return r.lastFailureReason
+}
+
+// Status returns the status property
+func (r RecurringTransfer) Status() RecurrenceStatus {
+	return r.status
+}
+
+// CreatedAt returns the createdAt property
+func (r RecurringTransfer) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// UpdatedAt returns the updatedAt property
+func (r RecurringTransfer) UpdatedAt() time.Time {
+	return r.updatedAt
+}
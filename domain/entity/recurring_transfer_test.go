@@ -0,0 +1,191 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func newRecurringTransferTest(frequency Frequency, dayOfMonth int, startAt time.Time, count int) RecurringTransfer {
+	return RestoreRecurringTransfer(
+		vo.NewUuidStaticTest(),
+		vo.NewUuidStaticTest(),
+		vo.NewUuidStaticTest(),
+		vo.NewMoneyBRL(vo.NewAmountTest(100)),
+		frequency,
+		dayOfMonth,
+		startAt,
+		time.Time{},
+		count,
+		0,
+		0,
+		2,
+		"",
+		RecurrenceActive,
+		time.Time{},
+		time.Time{},
+	)
+}
+
+func TestRecurringTransfer_RunAt(t *testing.T) {
+	var startAt = time.Date(2021, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		frequency  Frequency
+		dayOfMonth int
+		n          int
+		want       time.Time
+	}{
+		{
+			name:      "Daily first occurrence",
+			frequency: Daily,
+			want:      startAt,
+		},
+		{
+			name:      "Weekly third occurrence",
+			frequency: Weekly,
+			n:         2,
+			want:      time.Date(2021, 1, 29, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "Monthly first occurrence after the start",
+			frequency:  Monthly,
+			dayOfMonth: 20,
+			want:       time.Date(2021, 1, 20, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "Monthly first occurrence in the next month",
+			frequency:  Monthly,
+			dayOfMonth: 5,
+			want:       time.Date(2021, 2, 5, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "Monthly occurrence on the last day of a shorter month",
+			frequency:  Monthly,
+			dayOfMonth: 31,
+			n:          1,
+			want:       time.Date(2021, 2, 28, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "Monthly occurrence after a shorter month",
+			frequency:  Monthly,
+			dayOfMonth: 31,
+			n:          2,
+			want:       time.Date(2021, 3, 31, 9, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRecurringTransferTest(tt.frequency, tt.dayOfMonth, startAt, 0)
+
+			if got := r.RunAt(tt.n); !got.Equal(tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewRecurringTransfer(t *testing.T) {
+	var startAt = time.Date(2021, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		frequency  Frequency
+		dayOfMonth int
+		endAt      time.Time
+		count      int
+		wantErr    error
+	}{
+		{
+			name:      "Valid recurring transfer without end",
+			frequency: Daily,
+		},
+		{
+			name:      "Invalid frequency",
+			frequency: "YEARLY",
+			wantErr:   ErrInvalidFrequency,
+		},
+		{
+			name:      "Monthly without day of month",
+			frequency: Monthly,
+			wantErr:   ErrInvalidDayOfMonth,
+		},
+		{
+			name:      "End date and count",
+			frequency: Weekly,
+			endAt:     startAt.AddDate(0, 1, 0),
+			count:     3,
+			wantErr:   ErrInvalidRecurrenceEnd,
+		},
+		{
+			name:       "End date before the first occurrence",
+			frequency:  Monthly,
+			dayOfMonth: 5,
+			endAt:      startAt.AddDate(0, 0, 7),
+			wantErr:    ErrInvalidRecurrenceEnd,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRecurringTransfer(
+				vo.NewUuidStaticTest(),
+				vo.NewUuidStaticTest(),
+				vo.NewUuidStaticTest(),
+				vo.NewMoneyBRL(vo.NewAmountTest(100)),
+				tt.frequency,
+				tt.dayOfMonth,
+				startAt,
+				tt.endAt,
+				tt.count,
+				DefaultMaxConsecutiveFailures,
+				startAt,
+			)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRecurringTransfer_Occurrences(t *testing.T) {
+	var (
+		startAt = time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC)
+		r       = newRecurringTransferTest(Daily, 0, startAt, 4)
+		first   = r.OccurrenceID()
+	)
+
+	if first != r.OccurrenceID() {
+		t.Errorf("[TestCase 'Deterministic occurrence ID'] Got: '%v' | Want: '%v'", r.OccurrenceID(), first)
+	}
+
+	r = r.Failed(ErrUserInsufficientBalance, startAt)
+	if r.OccurrenceID() == first || r.Status() != RecurrenceActive {
+		t.Errorf("[TestCase 'First failure'] Got: '%v' '%v' | Want: new ID and '%v'", r.OccurrenceID(), r.Status(), RecurrenceActive)
+	}
+
+	r = r.Failed(ErrUserInsufficientBalance, startAt)
+	if r.Status() != RecurrencePaused || r.Due(startAt.AddDate(0, 0, 2)) {
+		t.Errorf("[TestCase 'Paused after max failures'] Got: '%v' | Want: '%v'", r.Status(), RecurrencePaused)
+	}
+
+	r, err := r.Update(r.Value(), time.Time{}, 4, RecurrenceActive, startAt.AddDate(0, 0, 2).Add(time.Hour))
+	if err != nil {
+		t.Fatalf("[TestCase 'Resume'] Err: '%v'", err)
+	}
+
+	if r.Occurrences() != 3 || r.ConsecutiveFailures() != 0 {
+		t.Errorf("[TestCase 'Resume skips missed occurrences'] Got: '%v' '%v' | Want: '%v' '%v'", r.Occurrences(), r.ConsecutiveFailures(), 3, 0)
+	}
+
+	r = r.Succeeded(startAt.AddDate(0, 0, 3))
+	if r.Status() != RecurrenceFinished || !r.NextRunAt().IsZero() {
+		t.Errorf("[TestCase 'Finished after count'] Got: '%v' '%v' | Want: '%v'", r.Status(), r.NextRunAt(), RecurrenceFinished)
+	}
+
+	if _, err := r.Cancel(startAt); !errors.Is(err, ErrRecurringTransferClosed) {
+		t.Errorf("[TestCase 'Cancel finished'] Err: '%v' | WantErr: '%v'", err, ErrRecurringTransferClosed)
+	}
+}
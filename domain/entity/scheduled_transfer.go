@@ -0,0 +1,263 @@
+package entity
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+const (
+	// Scheduled transfer status
+	TransferScheduled TransferStatus = "SCHEDULED"
+	TransferExecuted  TransferStatus = "EXECUTED"
+	TransferFailed    TransferStatus = "FAILED"
+	TransferCanceled  TransferStatus = "CANCELED"
+
+	// MaxScheduledTransferAttempts is the number of executions of a scheduled transfer before an error that
+	// may go away, such as an unavailable authorizer, fails it
+	MaxScheduledTransferAttempts = 5
+
+	// maxScheduledTransferBackoff bounds the delay between the executions of a scheduled transfer
+	maxScheduledTransferBackoff = time.Hour
+)
+
+var (
+	ErrCreateScheduledTransfer = NewError(KindInternal, "create_scheduled_transfer", "error creating scheduled transfer")
+
+	ErrNotFoundScheduledTransfer = NewError(KindNotFound, "not_found_scheduled_transfer", "not found scheduled transfer")
+
+	ErrFindScheduledTransfer = NewError(KindInternal, "find_scheduled_transfer", "error fetching scheduled transfer")
+
+	ErrUpdateScheduledTransfer = NewError(KindInternal, "update_scheduled_transfer", "error updating scheduled transfer")
+
+	ErrLeaseScheduledTransfers = NewError(KindInternal, "lease_scheduled_transfers", "error leasing scheduled transfers")
+
+	ErrScheduledTransferNotCancelable = NewError(KindConflict, "scheduled_transfer_not_cancelable", "scheduled transfer can no longer be canceled")
+
+	ErrScheduledTransferChanged = NewError(KindConflict, "scheduled_transfer_changed", "scheduled transfer canceled or leased by another worker meanwhile")
+
+	ErrInvalidExecutionDate = NewError(KindValidation, "invalid_execution_date", "execution date must be in the future")
+)
+
+type (
+	// TransferStatus defines the status of a transfer
+	TransferStatus string
+
+	// ScheduledTransferRepositoryCreator defines the operation of creating a scheduled transfer entity
+	ScheduledTransferRepositoryCreator interface {
+		Create(context.Context, ScheduledTransfer) (ScheduledTransfer, error)
+	}
+
+	// ScheduledTransferRepositoryFinder defines the search operation for a scheduled transfer entity
+	ScheduledTransferRepositoryFinder interface {
+		FindByID(context.Context, vo.Uuid) (ScheduledTransfer, error)
+	}
+
+	// ScheduledTransferRepositoryCanceler defines the operation of canceling a scheduled transfer
+	// that was not picked up by a worker
+	ScheduledTransferRepositoryCanceler interface {
+		Cancel(context.Context, ScheduledTransfer) error
+	}
+
+	// ScheduledTransferRepositoryLeaser defines the operations of the workers executing the due transfers,
+	// a leased transfer is not picked up by other workers until the lease expires
+	ScheduledTransferRepositoryLeaser interface {
+		LeaseDue(ctx context.Context, now time.Time, owner string, ttl time.Duration, limit int) ([]ScheduledTransfer, error)
+		Complete(ctx context.Context, owner string, s ScheduledTransfer) error
+	}
+
+	// ScheduledTransferRepository defines the operations of the scheduled transfers
+	ScheduledTransferRepository interface {
+		ScheduledTransferRepositoryCreator
+		ScheduledTransferRepositoryFinder
+		ScheduledTransferRepositoryCanceler
+		ScheduledTransferRepositoryLeaser
+	}
+
+	// ScheduledTransfer defines a transfer to be executed at a future date
+	ScheduledTransfer struct {
+		id            vo.Uuid
+		payer         vo.Uuid
+		payee         vo.Uuid
+		value         vo.Money
+		executeAt     time.Time
+		status        TransferStatus
+		failureReason string
+		attempts      int
+		details       TransferDetails
+		createdAt     time.Time
+		updatedAt     time.Time
+	}
+)
+
+// NewScheduledTransfer creates new scheduled transfer, the execution date must be after its creation
+func NewScheduledTransfer(
+	ID vo.Uuid,
+	payerID vo.Uuid,
+	payeeID vo.Uuid,
+	value vo.Money,
+	executeAt time.Time,
+	createdAt time.Time,
+) (ScheduledTransfer, error) {
+	if !executeAt.After(createdAt) {
+		return ScheduledTransfer{}, ErrInvalidExecutionDate
+	}
+
+	return ScheduledTransfer{
+		id:        ID,
+		payer:     payerID,
+		payee:     payeeID,
+		value:     value,
+		executeAt: executeAt,
+		status:    TransferScheduled,
+		createdAt: createdAt,
+		updatedAt: createdAt,
+	}, nil
+}
+
+// RestoreScheduledTransfer creates a scheduled transfer from its persisted representation
+func RestoreScheduledTransfer(
+	ID vo.Uuid,
+	payerID vo.Uuid,
+	payeeID vo.Uuid,
+	value vo.Money,
+	executeAt time.Time,
+	status TransferStatus,
+	failureReason string,
+	createdAt time.Time,
+	updatedAt time.Time,
+) ScheduledTransfer {
+	return ScheduledTransfer{
+		id:            ID,
+		payer:         payerID,
+		payee:         payeeID,
+		value:         value,
+		executeAt:     executeAt,
+		status:        status,
+		failureReason: failureReason,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
+
+// WithDetails returns the scheduled transfer with the details given to the transfer it executes
+func (s ScheduledTransfer) WithDetails(details TransferDetails) ScheduledTransfer {
+	s.details = details
+
+	return s
+}
+
+// WithAttempts returns the scheduled transfer with the number of executions that ended in an error that may go away
+func (s ScheduledTransfer) WithAttempts(attempts int) ScheduledTransfer {
+	s.attempts = attempts
+
+	return s
+}
+
+// Cancel returns the canceled scheduled transfer, only a transfer not yet executed can be canceled
+func (s ScheduledTransfer) Cancel(at time.Time) (ScheduledTransfer, error) {
+	if s.status != TransferScheduled {
+		return ScheduledTransfer{}, ErrScheduledTransferNotCancelable
+	}
+
+	s.status = TransferCanceled
+	s.updatedAt = at
+
+	return s, nil
+}
+
+// Executed returns the scheduled transfer marked as executed
+func (s ScheduledTransfer) Executed(at time.Time) ScheduledTransfer {
+	s.status = TransferExecuted
+	s.failureReason = ""
+	s.updatedAt = at
+
+	return s
+}
+
+// Failed returns the scheduled transfer marked as failed with the reason of the failure
+func (s ScheduledTransfer) Failed(reason error, at time.Time) ScheduledTransfer {
+	s.status = TransferFailed
+	s.failureReason = reason.Error()
+	s.updatedAt = at
+
+	return s
+}
+
+// Retry returns the scheduled transfer executed again after the backoff, doubled at every attempt, when the
+// reason of the failure may go away. The transfer fails once it reaches MaxScheduledTransferAttempts
+func (s ScheduledTransfer) Retry(reason error, at time.Time, backoff time.Duration) ScheduledTransfer {
+	s.attempts++
+	if s.attempts >= MaxScheduledTransferAttempts {
+		return s.Failed(reason, at)
+	}
+
+	for i := 1; i < s.attempts && backoff < maxScheduledTransferBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxScheduledTransferBackoff {
+		backoff = maxScheduledTransferBackoff
+	}
+
+	s.executeAt = at.Add(backoff)
+	s.failureReason = reason.Error()
+	s.updatedAt = at
+
+	return s
+}
+
+// ID returns the id property
+func (s ScheduledTransfer) ID() vo.Uuid {
+	return s.id
+}
+
+// Payer returns the payer property
+func (s ScheduledTransfer) Payer() vo.Uuid {
+	return s.payer
+}
+
+// Payee returns the payee property
+func (s ScheduledTransfer) Payee() vo.Uuid {
+	return s.payee
+}
+
+// Value returns the value property
+func (s ScheduledTransfer) Value() vo.Money {
+	return s.value
+}
+
+// ExecuteAt returns the executeAt property
+func (s ScheduledTransfer) ExecuteAt() time.Time {
+	return s.executeAt
+}
+
+// Status returns the status property
+func (s ScheduledTransfer) Status() TransferStatus {
+	return s.status
+}
+
+// FailureReason returns the failureReason property
+func (s ScheduledTransfer) FailureReason() string {
+	return s.failureReason
+}
+
+// Attempts returns the attempts property
+func (s ScheduledTransfer) Attempts() int {
+	return s.attempts
+}
+
+// CreatedAt returns the createdAt property
+func (s ScheduledTransfer) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+// UpdatedAt returns the updatedAt property
+func (s ScheduledTransfer) UpdatedAt() time.Time {
+	return s.updatedAt
+}
+
+// Details returns the details property
+func (s ScheduledTransfer) Details() TransferDetails {
+	return s.details
+}
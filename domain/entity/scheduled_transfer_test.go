@@ -0,0 +1,193 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func TestNewScheduledTransfer(t *testing.T) {
+	var createdAt = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		executeAt time.Time
+		wantErr   error
+	}{
+		{
+			name:      "Execution date in the future",
+			executeAt: createdAt.Add(time.Hour),
+		},
+		{
+			name:      "Execution date equal to the creation",
+			executeAt: createdAt,
+			wantErr:   ErrInvalidExecutionDate,
+		},
+		{
+			name:      "Execution date in the past",
+			executeAt: createdAt.Add(-time.Hour),
+			wantErr:   ErrInvalidExecutionDate,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewScheduledTransfer(
+				vo.NewUuidStaticTest(),
+				vo.NewUuidStaticTest(),
+				vo.NewUuidStaticTest(),
+				vo.NewMoneyBRL(vo.NewAmountTest(100)),
+				tt.executeAt,
+				createdAt,
+			)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr == nil && got.Status() != TransferScheduled {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Status(), TransferScheduled)
+			}
+		})
+	}
+}
+
+func TestScheduledTransfer_Cancel(t *testing.T) {
+	var canceledAt = time.Date(2020, 11, 9, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		status  TransferStatus
+		wantErr error
+	}{
+		{
+			name:   "Cancel scheduled transfer",
+			status: TransferScheduled,
+		},
+		{
+			name:    "Cancel executed transfer",
+			status:  TransferExecuted,
+			wantErr: ErrScheduledTransferNotCancelable,
+		},
+		{
+			name:    "Cancel failed transfer",
+			status:  TransferFailed,
+			wantErr: ErrScheduledTransferNotCancelable,
+		},
+		{
+			name:    "Cancel canceled transfer",
+			status:  TransferCanceled,
+			wantErr: ErrScheduledTransferNotCancelable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := RestoreScheduledTransfer(
+				vo.NewUuidStaticTest(),
+				vo.NewUuidStaticTest(),
+				vo.NewUuidStaticTest(),
+				vo.NewMoneyBRL(vo.NewAmountTest(100)),
+				time.Time{},
+				tt.status,
+				"",
+				time.Time{},
+				time.Time{},
+			)
+
+			got, err := s.Cancel(canceledAt)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.Status() != TransferCanceled || !got.UpdatedAt().Equal(canceledAt) {
+				t.Errorf(
+					"[TestCase '%s'] Got: '%v' '%v' | Want: '%v' '%v'",
+					tt.name,
+					got.Status(),
+					got.UpdatedAt(),
+					TransferCanceled,
+					canceledAt,
+				)
+			}
+		})
+	}
+}
+
+func TestScheduledTransfer_Retry(t *testing.T) {
+	var (
+		at        = time.Date(2020, 11, 10, 10, 0, 0, 0, time.UTC)
+		scheduled = RestoreScheduledTransfer(
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewMoneyBRL(vo.NewAmountTest(100)),
+			at,
+			TransferScheduled,
+			"",
+			time.Time{},
+			time.Time{},
+		)
+	)
+
+	tests := []struct {
+		name          string
+		attempts      int
+		backoff       time.Duration
+		wantStatus    TransferStatus
+		wantExecuteAt time.Time
+	}{
+		{
+			name:          "Retry first attempt",
+			backoff:       time.Minute,
+			wantStatus:    TransferScheduled,
+			wantExecuteAt: at.Add(time.Minute),
+		},
+		{
+			name:          "Retry doubles the backoff",
+			attempts:      2,
+			backoff:       time.Minute,
+			wantStatus:    TransferScheduled,
+			wantExecuteAt: at.Add(4 * time.Minute),
+		},
+		{
+			name:          "Retry bounds the backoff",
+			attempts:      3,
+			backoff:       30 * time.Minute,
+			wantStatus:    TransferScheduled,
+			wantExecuteAt: at.Add(time.Hour),
+		},
+		{
+			name:          "Retry last attempt",
+			attempts:      MaxScheduledTransferAttempts - 1,
+			backoff:       time.Minute,
+			wantStatus:    TransferFailed,
+			wantExecuteAt: at,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := scheduled.WithAttempts(tt.attempts).Retry(ErrAuthorizerUnavailable, at, tt.backoff)
+
+			if got.Status() != tt.wantStatus || !got.ExecuteAt().Equal(tt.wantExecuteAt) {
+				t.Errorf(
+					"[TestCase '%s'] Got: '%v' '%v' | Want: '%v' '%v'",
+					tt.name,
+					got.Status(),
+					got.ExecuteAt(),
+					tt.wantStatus,
+					tt.wantExecuteAt,
+				)
+			}
+
+			if got.Attempts() != tt.attempts+1 || got.FailureReason() != ErrAuthorizerUnavailable.Error() {
+				t.Errorf("[TestCase '%s'] Got: '%v' '%v' | Want: '%v'", tt.name, got.Attempts(), got.FailureReason(), tt.attempts+1)
+			}
+		})
+	}
+}
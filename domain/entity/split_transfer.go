@@ -0,0 +1,239 @@
+package entity
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+const (
+	// WholePercentage is the percentage, in basis points, shared among the percentage shares of a split
+	WholePercentage = 10000
+)
+
+var (
+	ErrEmptySplit = NewError(KindValidation, "empty_split", "split transfer has no payees")
+
+	ErrInvalidSplitShare = NewError(KindValidation, "invalid_split_share", "split share must have either a positive value or a percentage up to 100")
+
+	ErrDuplicateSplitPayee = NewError(KindValidation, "duplicate_split_payee", "split transfer has a repeated payee")
+
+	ErrSplitPayerIsPayee = NewError(KindValidation, "split_payer_is_payee", "split transfer payer cannot be a payee")
+
+	ErrInvalidSplit = NewError(KindValidation, "invalid_split", "split shares must add up to the transfer value")
+)
+
+type (
+	// SplitShare defines the part of a split transfer owed to a payee, either a fixed value or a
+	// percentage in basis points of what remains after the fixed values
+	SplitShare struct {
+		payee       vo.Uuid
+		value       vo.Money
+		basisPoints int64
+	}
+
+	// SplitTransfer defines a transfer from a payer to several payees, executed and authorized as
+	// a single operation. Each part is an ordinary transfer
+	SplitTransfer struct {
+		id        vo.Uuid
+		payer     vo.Uuid
+		value     vo.Money
+		transfers []Transfer
+		createdAt time.Time
+	}
+)
+
+// NewFixedSplitShare creates new split share of a fixed value
+func NewFixedSplitShare(payeeID vo.Uuid, value vo.Money) (SplitShare, error) {
+	if value.Amount().Value() <= 0 {
+		return SplitShare{}, ErrInvalidSplitShare
+	}
+
+	return SplitShare{payee: payeeID, value: value}, nil
+}
+
+// NewPercentageSplitShare creates new split share of a percentage, in basis points, of the value not covered
+// by the fixed shares
+func NewPercentageSplitShare(payeeID vo.Uuid, basisPoints int64) (SplitShare, error) {
+	if basisPoints <= 0 || basisPoints > WholePercentage {
+		return SplitShare{}, ErrInvalidSplitShare
+	}
+
+	return SplitShare{payee: payeeID, basisPoints: basisPoints}, nil
+}
+
+// NewSplitTransfer creates new split transfer allocating the value among the shares. The fixed shares are
+// taken first and the rest is divided by the percentage shares, which must add up to 100%, with the largest
+// remainder method so the parts add up to the value without losing cents
+func NewSplitTransfer(
+	ID vo.Uuid,
+	payerID vo.Uuid,
+	value vo.Money,
+	shares []SplitShare,
+	createdAt time.Time,
+) (SplitTransfer, error) {
+	if len(shares) == 0 {
+		return SplitTransfer{}, ErrEmptySplit
+	}
+
+	var (
+		payees      = make(map[vo.Uuid]bool)
+		fixed       int64
+		basisPoints int64
+	)
+	for _, share := range shares {
+		if share.payee == payerID {
+			return SplitTransfer{}, ErrSplitPayerIsPayee
+		}
+
+		if payees[share.payee] {
+			return SplitTransfer{}, ErrDuplicateSplitPayee
+		}
+		payees[share.payee] = true
+
+		fixed += share.value.Amount().Value()
+		basisPoints += share.basisPoints
+	}
+
+	var remaining = value.Amount().Value() - fixed
+	if remaining < 0 || (basisPoints == 0 && remaining != 0) || (basisPoints != 0 && basisPoints != WholePercentage) {
+		return SplitTransfer{}, ErrInvalidSplit
+	}
+
+	var (
+		parts     = allocate(remaining, shares)
+		transfers = make([]Transfer, 0, len(shares))
+	)
+
+	for idx, share := range shares {
+		amount, err := vo.NewAmount(parts[idx])
+		if err != nil || amount.Value() == 0 {
+			return SplitTransfer{}, ErrInvalidSplit
+		}
+
+		transfers = append(transfers, NewTransfer(
+			splitPartID(ID, idx),
+			payerID,
+			share.payee,
+			vo.NewMoney(value.Currency(), amount),
+			createdAt,
+		))
+	}
+
+	return SplitTransfer{
+		id:        ID,
+		payer:     payerID,
+		value:     value,
+		transfers: transfers,
+		createdAt: createdAt,
+	}, nil
+}
+
+// allocate returns the value of each share, the cents left by truncating the percentage shares go one each
+// to the shares with the largest truncated fractions, ties broken by the order of the shares
+func allocate(remaining int64, shares []SplitShare) []int64 {
+	var (
+		parts      = make([]int64, len(shares))
+		fractions  = make([]int64, len(shares))
+		percentage []int
+		allocated  int64
+	)
+
+	for idx, share := range shares {
+		if share.basisPoints == 0 {
+			parts[idx] = share.value.Amount().Value()
+			continue
+		}
+
+		var exact = remaining * share.basisPoints
+		parts[idx] = exact / WholePercentage
+		fractions[idx] = exact % WholePercentage
+		allocated += parts[idx]
+		percentage = append(percentage, idx)
+	}
+
+	sort.SliceStable(percentage, func(i, j int) bool {
+		return fractions[percentage[i]] > fractions[percentage[j]]
+	})
+
+	for _, idx := range percentage[:remaining-allocated] {
+		parts[idx]++
+	}
+
+	return parts
+}
+
+func splitPartID(ID vo.Uuid, idx int) vo.Uuid {
+	return derivedID(ID, strconv.Itoa(idx))
+}
+
+// WithFees returns the split transfer with the fee of each transfer, given in the order of the transfers
+func (s SplitTransfer) WithFees(fees []Fee) SplitTransfer {
+	var transfers = make([]Transfer, 0, len(s.transfers))
+	for idx, transfer := range s.transfers {
+		if idx < len(fees) {
+			transfer = transfer.WithFee(fees[idx])
+		}
+		transfers = append(transfers, transfer)
+	}
+	s.transfers = transfers
+
+	return s
+}
+
+// Debited returns the money taken from the payer, the value plus the payer fees of the transfers
+func (s SplitTransfer) Debited() vo.Money {
+	var debited = s.value
+	for _, transfer := range s.transfers {
+		debited = debited.Add(transfer.Fee().Payer().Amount())
+	}
+
+	return debited
+}
+
+// Fee returns the fees of the transfers added up, credited at once to the fee account
+func (s SplitTransfer) Fee() Fee {
+	var (
+		account vo.Uuid
+		payer   = vo.NewMoney(s.value.Currency(), vo.Amount{})
+		payee   = vo.NewMoney(s.value.Currency(), vo.Amount{})
+	)
+	for _, transfer := range s.transfers {
+		if transfer.Fee().IsZero() {
+			continue
+		}
+
+		account = transfer.Fee().Account()
+		payer = payer.Add(transfer.Fee().Payer().Amount())
+		payee = payee.Add(transfer.Fee().Payee().Amount())
+	}
+
+	return NewFee(account, payer, payee)
+}
+
+// ID returns the id property
+func (s SplitTransfer) ID() vo.Uuid {
+	return s.id
+}
+
+// Payer returns the payer property
+func (s SplitTransfer) Payer() vo.Uuid {
+	return s.payer
+}
+
+// Value returns the value property
+func (s SplitTransfer) Value() vo.Money {
+	return s.value
+}
+
+// Transfers returns the transfer to each payee
+func (s SplitTransfer) Transfers() []Transfer {
+	return s.transfers
+}
+
+// CreatedAt returns the createdAt property
+func (s SplitTransfer) CreatedAt() time.Time {
+	return s.createdAt
+}
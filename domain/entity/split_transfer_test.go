@@ -0,0 +1,190 @@
+package entity
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/google/uuid"
+)
+
+func TestNewSplitTransfer(t *testing.T) {
+	var (
+		payee = func() vo.Uuid {
+			ID, _ := vo.NewUuid(uuid.New().String())
+			return ID
+		}
+		fixed = func(value int64) SplitShare {
+			share, _ := NewFixedSplitShare(payee(), vo.NewMoneyBRL(vo.NewAmountTest(value)))
+			return share
+		}
+		percentage = func(basisPoints int64) SplitShare {
+			share, _ := NewPercentageSplitShare(payee(), basisPoints)
+			return share
+		}
+		repeated = fixed(50)
+		payer, _ = NewFixedSplitShare(vo.NewUuidStaticTest(), vo.NewMoneyBRL(vo.NewAmountTest(50)))
+	)
+
+	tests := []struct {
+		name    string
+		value   int64
+		shares  []SplitShare
+		want    []int64
+		wantErr error
+	}{
+		{
+			name:   "Fixed values",
+			value:  300,
+			shares: []SplitShare{fixed(100), fixed(200)},
+			want:   []int64{100, 200},
+		},
+		{
+			name:   "Percentages without losing cents",
+			value:  100,
+			shares: []SplitShare{percentage(3333), percentage(3333), percentage(3334)},
+			want:   []int64{33, 33, 34},
+		},
+		{
+			name:   "Cents left go to the largest fractions",
+			value:  1001,
+			shares: []SplitShare{fixed(150), percentage(5000), percentage(5000)},
+			want:   []int64{150, 426, 425},
+		},
+		{
+			name:   "Cents left go to the largest fractions regardless of order",
+			value:  10,
+			shares: []SplitShare{percentage(1400), percentage(2600), percentage(6000)},
+			want:   []int64{1, 3, 6},
+		},
+		{
+			name:    "Without shares",
+			value:   100,
+			wantErr: ErrEmptySplit,
+		},
+		{
+			name:    "Fixed values not adding up to the value",
+			value:   300,
+			shares:  []SplitShare{fixed(100), fixed(100)},
+			wantErr: ErrInvalidSplit,
+		},
+		{
+			name:    "Fixed values beyond the value",
+			value:   100,
+			shares:  []SplitShare{fixed(100), percentage(10000)},
+			wantErr: ErrInvalidSplit,
+		},
+		{
+			name:    "Percentages not adding up to 100",
+			value:   100,
+			shares:  []SplitShare{percentage(5000), percentage(4000)},
+			wantErr: ErrInvalidSplit,
+		},
+		{
+			name:    "Share rounded to zero",
+			value:   1,
+			shares:  []SplitShare{percentage(5000), percentage(5000)},
+			wantErr: ErrInvalidSplit,
+		},
+		{
+			name:    "Repeated payee",
+			value:   100,
+			shares:  []SplitShare{repeated, repeated},
+			wantErr: ErrDuplicateSplitPayee,
+		},
+		{
+			name:    "Payer among the payees",
+			value:   100,
+			shares:  []SplitShare{fixed(50), payer},
+			wantErr: ErrSplitPayerIsPayee,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSplitTransfer(
+				vo.NewUuidStaticTest(),
+				vo.NewUuidStaticTest(),
+				vo.NewMoneyBRL(vo.NewAmountTest(tt.value)),
+				tt.shares,
+				time.Time{},
+			)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			var values []int64
+			for idx, transfer := range got.Transfers() {
+				values = append(values, transfer.Value().Amount().Value())
+
+				if transfer.Payee() != tt.shares[idx].payee {
+					t.Errorf("[TestCase '%s'] Payee Got: '%v' | Want: '%v'", tt.name, transfer.Payee(), tt.shares[idx].payee)
+				}
+			}
+
+			if !reflect.DeepEqual(values, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, values, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewSplitTransfer_TransferIDs(t *testing.T) {
+	var shares []SplitShare
+	for _, basisPoints := range []int64{5000, 5000} {
+		payeeID, _ := vo.NewUuid(uuid.New().String())
+		share, _ := NewPercentageSplitShare(payeeID, basisPoints)
+		shares = append(shares, share)
+	}
+
+	first, _ := NewSplitTransfer(vo.NewUuidStaticTest(), vo.NewUuidStaticTest(), vo.NewMoneyBRL(vo.NewAmountTest(100)), shares, time.Time{})
+	second, _ := NewSplitTransfer(vo.NewUuidStaticTest(), vo.NewUuidStaticTest(), vo.NewMoneyBRL(vo.NewAmountTest(100)), shares, time.Time{})
+
+	if first.Transfers()[0].ID() == first.Transfers()[1].ID() {
+		t.Errorf("[TestCase '%s'] Got the same ID '%v' for different transfers", "Transfer IDs", first.Transfers()[0].ID())
+	}
+
+	if first.Transfers()[0].ID() != second.Transfers()[0].ID() {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Transfer IDs", second.Transfers()[0].ID(), first.Transfers()[0].ID())
+	}
+}
+
+func TestNewSplitShare(t *testing.T) {
+	if _, err := NewFixedSplitShare(vo.NewUuidStaticTest(), vo.NewMoneyBRL(vo.NewAmountTest(0))); !errors.Is(err, ErrInvalidSplitShare) {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Zero value", err, ErrInvalidSplitShare)
+	}
+
+	if _, err := NewPercentageSplitShare(vo.NewUuidStaticTest(), WholePercentage+1); !errors.Is(err, ErrInvalidSplitShare) {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Percentage above 100", err, ErrInvalidSplitShare)
+	}
+}
+
+func TestSplitTransfer_WithFees(t *testing.T) {
+	var (
+		account, _ = vo.NewUuid("00000000-0000-4000-8000-000000000001")
+		payee, _   = vo.NewUuid("7a1f5e0c-2c6b-4f7a-9d3e-0c2b9a4c8e11")
+		other, _   = vo.NewUuid("3c5d7e9f-1a2b-4c3d-8e4f-5a6b7c8d9e01")
+		first, _   = NewFixedSplitShare(payee, vo.NewMoneyBRL(vo.NewAmountTest(400)))
+		second, _  = NewFixedSplitShare(other, vo.NewMoneyBRL(vo.NewAmountTest(100)))
+		split, _   = NewSplitTransfer(vo.NewUuidStaticTest(), vo.NewUuidStaticTest(), vo.NewMoneyBRL(vo.NewAmountTest(500)), []SplitShare{first, second}, time.Time{})
+	)
+
+	split = split.WithFees([]Fee{
+		NewFee(account, vo.NewMoneyBRL(vo.NewAmountTest(10)), vo.NewMoneyBRL(vo.NewAmountTest(10))),
+		NewFee(account, vo.NewMoneyBRL(vo.NewAmountTest(10)), vo.NewMoneyBRL(vo.NewAmountTest(2))),
+	})
+
+	if got := split.Debited().Amount().Value(); got != 520 {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Debited", got, 520)
+	}
+
+	if got := split.Transfers()[1].Credited().Amount().Value(); got != 98 {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Credited", got, 98)
+	}
+
+	if got := split.Fee(); got.Total().Amount().Value() != 32 || got.Account() != account {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Fee", got.Total().Amount().Value(), 32)
+	}
+}
@@ -0,0 +1,240 @@
+package entity
+
+import (
+	"sort"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+const (
+	// Statement entry types
+	Credit EntryType = "CREDIT"
+	Debit  EntryType = "DEBIT"
+)
+
+var (
+	ErrInvalidStatementPeriod = NewError(KindValidation, "invalid_statement_period", "invalid statement period")
+)
+
+type (
+	// EntryType defines whether a statement entry adds or removes money
+	EntryType string
+
+	// StatementEntry defines a movement of money in the wallet of a user
+	StatementEntry struct {
+		referenceID  vo.Uuid
+		counterparty vo.Uuid
+		entryType    EntryType
+		amount       vo.Money
+		balance      vo.Money
+		occurredAt   time.Time
+	}
+
+	// Statement defines the movements of a user wallet in a period with its running balances
+	Statement struct {
+		userID  vo.Uuid
+		from    time.Time
+		to      time.Time
+		opening vo.Money
+		closing vo.Money
+		entries []StatementEntry
+	}
+)
+
+// NewStatementEntry creates new statement entry
+func NewStatementEntry(
+	referenceID vo.Uuid,
+	counterparty vo.Uuid,
+	entryType EntryType,
+	amount vo.Money,
+	occurredAt time.Time,
+) StatementEntry {
+	return StatementEntry{
+		referenceID:  referenceID,
+		counterparty: counterparty,
+		entryType:    entryType,
+		amount:       amount,
+		occurredAt:   occurredAt,
+	}
+}
+
+// NewTransferStatementEntries creates the entries of a transfer as seen by the user, a debit when the user
+// is the payer and a credit when the user is the payee. The fees are entries of their own, debited from
+// whoever pays them and credited to the fee account
+func NewTransferStatementEntries(userID vo.Uuid, t Transfer) []StatementEntry {
+	var (
+		entries []StatementEntry
+		fee     = t.Fee()
+	)
+
+	if t.Payer() == userID {
+		entries = append(entries, NewStatementEntry(t.ID(), t.Payee(), Debit, t.Value(), t.CreatedAt()))
+
+		if fee.Payer().Amount().Value() > 0 {
+			entries = append(entries, NewStatementEntry(t.ID(), fee.Account(), Debit, fee.Payer(), t.CreatedAt()))
+		}
+	}
+
+	if t.Payee() == userID {
+		entries = append(entries, NewStatementEntry(t.ID(), t.Payer(), Credit, t.Value(), t.CreatedAt()))
+
+		if fee.Payee().Amount().Value() > 0 {
+			entries = append(entries, NewStatementEntry(t.ID(), fee.Account(), Debit, fee.Payee(), t.CreatedAt()))
+		}
+	}
+
+	if !fee.IsZero() && fee.Account() == userID {
+		entries = append(entries, NewStatementEntry(t.ID(), t.Payer(), Credit, fee.Total(), t.CreatedAt()))
+	}
+
+	return entries
+}
+
+// NewMovementStatementEntries creates the entries of a deposit or withdrawal, a reversed movement
+// also has the entry returning the money at the time of the reversal
+func NewMovementStatementEntries(m Movement) []StatementEntry {
+	var entryType, reversalType = Credit, Debit
+	if m.Type() == Withdrawal {
+		entryType, reversalType = Debit, Credit
+	}
+
+	var entries = []StatementEntry{
+		NewStatementEntry(m.ID(), vo.Uuid{}, entryType, m.Value(), m.CreatedAt()),
+	}
+
+	if m.Status() == MovementReversed {
+		entries = append(entries, NewStatementEntry(m.ID(), vo.Uuid{}, reversalType, m.Value(), m.ReversedAt()))
+	}
+
+	return entries
+}
+
+// NewStatement computes the statement of the period from the current balance of the wallet and
+// every entry since the beginning of the period, the entries after the period are only used to walk the balance back
+func NewStatement(
+	userID vo.Uuid,
+	balance vo.Money,
+	from time.Time,
+	to time.Time,
+	entries []StatementEntry,
+) (Statement, error) {
+	if to.Before(from) {
+		return Statement{}, ErrInvalidStatementPeriod
+	}
+
+	sorted := make([]StatementEntry, len(entries))
+	copy(sorted, entries)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].occurredAt.Before(sorted[j].occurredAt)
+	})
+
+	var (
+		closing = balance
+		period  []StatementEntry
+	)
+	for _, entry := range sorted {
+		switch {
+		case entry.occurredAt.After(to):
+			closing = entry.revert(closing)
+		case !entry.occurredAt.Before(from):
+			period = append(period, entry)
+		}
+	}
+
+	var opening = closing
+	for i := len(period) - 1; i >= 0; i-- {
+		opening = period[i].revert(opening)
+	}
+
+	var running = opening
+	for i := range period {
+		running = period[i].apply(running)
+		period[i].balance = running
+	}
+
+	return Statement{
+		userID:  userID,
+		from:    from,
+		to:      to,
+		opening: opening,
+		closing: closing,
+		entries: period,
+	}, nil
+}
+
+func (s StatementEntry) apply(balance vo.Money) vo.Money {
+	if s.entryType == Credit {
+		return balance.Add(s.amount.Amount())
+	}
+
+	return balance.Sub(s.amount.Amount())
+}
+
+func (s StatementEntry) revert(balance vo.Money) vo.Money {
+	if s.entryType == Credit {
+		return balance.Sub(s.amount.Amount())
+	}
+
+	return balance.Add(s.amount.Amount())
+}
+
+// ReferenceID returns the referenceID property
+func (s StatementEntry) ReferenceID() vo.Uuid {
+	return s.referenceID
+}
+
+// Counterparty returns the counterparty property
+func (s StatementEntry) Counterparty() vo.Uuid {
+	return s.counterparty
+}
+
+// Type returns the entryType property
+func (s StatementEntry) Type() EntryType {
+	return s.entryType
+}
+
+// Amount returns the amount property
+func (s StatementEntry) Amount() vo.Money {
+	return s.amount
+}
+
+// Balance returns the balance after the entry
+func (s StatementEntry) Balance() vo.Money {
+	return s.balance
+}
+
+// OccurredAt returns the occurredAt property
+func (s StatementEntry) OccurredAt() time.Time {
+	return s.occurredAt
+}
+
+// UserID returns the userID property
+func (s Statement) UserID() vo.Uuid {
+	return s.userID
+}
+
+// From returns the from property
+func (s Statement) From() time.Time {
+	return s.from
+}
+
+// To returns the to property
+func (s Statement) To() time.Time {
+	return s.to
+}
+
+// Opening returns the balance at the beginning of the period
+func (s Statement) Opening() vo.Money {
+	return s.opening
+}
+
+// Closing returns the balance at the end of the period
+func (s Statement) Closing() vo.Money {
+	return s.closing
+}
+
+// Entries returns the entries of the period in chronological order
+func (s Statement) Entries() []StatementEntry {
+	return s.entries
+}
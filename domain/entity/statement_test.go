@@ -0,0 +1,190 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func TestNewStatement(t *testing.T) {
+	var (
+		userID, _  = vo.NewUuid("0db298eb-c8e7-4829-84b7-c1036b4f0791")
+		otherID, _ = vo.NewUuid("0db298eb-c8e7-4829-84b7-c1036b4f0792")
+		day        = func(d int) time.Time { return time.Date(2020, 11, d, 12, 0, 0, 0, time.UTC) }
+	)
+
+	var entries []StatementEntry
+	for _, transfer := range []Transfer{
+		NewTransfer(vo.NewUuidStaticTest(), otherID, userID, vo.NewMoneyBRL(vo.NewAmountTest(100)), day(2)),
+		NewTransfer(vo.NewUuidStaticTest(), userID, otherID, vo.NewMoneyBRL(vo.NewAmountTest(30)), day(5)),
+		NewTransfer(vo.NewUuidStaticTest(), otherID, userID, vo.NewMoneyBRL(vo.NewAmountTest(10)), day(20)),
+	} {
+		entries = append(entries, NewTransferStatementEntries(userID, transfer)...)
+	}
+
+	tests := []struct {
+		name         string
+		from         time.Time
+		to           time.Time
+		wantOpening  int64
+		wantClosing  int64
+		wantBalances []int64
+		wantErr      error
+	}{
+		{
+			name:         "Statement of the whole history",
+			from:         day(1),
+			to:           day(30),
+			wantOpening:  50,
+			wantClosing:  130,
+			wantBalances: []int64{150, 120, 130},
+		},
+		{
+			name:         "Statement ending before the last transfer",
+			from:         day(1),
+			to:           day(10),
+			wantOpening:  50,
+			wantClosing:  120,
+			wantBalances: []int64{150, 120},
+		},
+		{
+			name:         "Statement starting after the first transfer",
+			from:         day(3),
+			to:           day(10),
+			wantOpening:  150,
+			wantClosing:  120,
+			wantBalances: []int64{120},
+		},
+		{
+			name:         "Statement without entries in the period",
+			from:         day(10),
+			to:           day(15),
+			wantOpening:  120,
+			wantClosing:  120,
+			wantBalances: nil,
+		},
+		{
+			name:    "Statement with invalid period",
+			from:    day(10),
+			to:      day(1),
+			wantErr: ErrInvalidStatementPeriod,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var inPeriod []StatementEntry
+			for _, entry := range entries {
+				if !entry.OccurredAt().Before(tt.from) {
+					inPeriod = append(inPeriod, entry)
+				}
+			}
+
+			got, err := NewStatement(userID, vo.NewMoneyBRL(vo.NewAmountTest(130)), tt.from, tt.to, inPeriod)
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.Opening().Amount().Value() != tt.wantOpening {
+				t.Errorf("[TestCase '%s'] Got opening: '%v' | Want opening: '%v'", tt.name, got.Opening().Amount().Value(), tt.wantOpening)
+			}
+
+			if got.Closing().Amount().Value() != tt.wantClosing {
+				t.Errorf("[TestCase '%s'] Got closing: '%v' | Want closing: '%v'", tt.name, got.Closing().Amount().Value(), tt.wantClosing)
+			}
+
+			var balances []int64
+			for _, entry := range got.Entries() {
+				balances = append(balances, entry.Balance().Amount().Value())
+			}
+
+			if len(balances) != len(tt.wantBalances) {
+				t.Errorf("[TestCase '%s'] Got balances: '%v' | Want balances: '%v'", tt.name, balances, tt.wantBalances)
+				return
+			}
+
+			for i := range balances {
+				if balances[i] != tt.wantBalances[i] {
+					t.Errorf("[TestCase '%s'] Got balances: '%v' | Want balances: '%v'", tt.name, balances, tt.wantBalances)
+				}
+			}
+		})
+	}
+}
+
+func TestNewTransferStatementEntries(t *testing.T) {
+	var (
+		userID, _  = vo.NewUuid("0db298eb-c8e7-4829-84b7-c1036b4f0791")
+		otherID, _ = vo.NewUuid("0db298eb-c8e7-4829-84b7-c1036b4f0792")
+		feeID, _   = vo.NewUuid("00000000-0000-4000-8000-000000000001")
+
+		withFee = func(payerID vo.Uuid, payeeID vo.Uuid) Transfer {
+			return NewTransfer(vo.NewUuidStaticTest(), payerID, payeeID, vo.NewMoneyBRL(vo.NewAmountTest(100)), time.Time{}).
+				WithFee(NewFee(feeID, vo.NewMoneyBRL(vo.NewAmountTest(1)), vo.NewMoneyBRL(vo.NewAmountTest(2))))
+		}
+	)
+
+	tests := []struct {
+		name     string
+		userID   vo.Uuid
+		transfer Transfer
+		want     []EntryType
+	}{
+		{
+			name:     "Entries of a sent transfer",
+			transfer: NewTransfer(vo.NewUuidStaticTest(), userID, otherID, vo.NewMoneyBRL(vo.NewAmountTest(10)), time.Time{}),
+			want:     []EntryType{Debit},
+		},
+		{
+			name:     "Entries of a received transfer",
+			transfer: NewTransfer(vo.NewUuidStaticTest(), otherID, userID, vo.NewMoneyBRL(vo.NewAmountTest(10)), time.Time{}),
+			want:     []EntryType{Credit},
+		},
+		{
+			name:     "Entries of a transfer to itself",
+			transfer: NewTransfer(vo.NewUuidStaticTest(), userID, userID, vo.NewMoneyBRL(vo.NewAmountTest(10)), time.Time{}),
+			want:     []EntryType{Debit, Credit},
+		},
+		{
+			name:     "Entries of a sent transfer with fee",
+			transfer: withFee(userID, otherID),
+			want:     []EntryType{Debit, Debit},
+		},
+		{
+			name:     "Entries of a received transfer with fee",
+			transfer: withFee(otherID, userID),
+			want:     []EntryType{Credit, Debit},
+		},
+		{
+			name:     "Entries of the fee account",
+			userID:   feeID,
+			transfer: withFee(otherID, userID),
+			want:     []EntryType{Credit},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ID = userID
+			if tt.userID != (vo.Uuid{}) {
+				ID = tt.userID
+			}
+
+			got := NewTransferStatementEntries(ID, tt.transfer)
+			if len(got) != len(tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+				return
+			}
+
+			for i := range got {
+				if got[i].Type() != tt.want[i] {
+					t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got[i].Type(), tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -2,16 +2,21 @@ package entity
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"github.com/GSabadini/golang-clean-architecture/domain/vo"
 )
 
 var (
-	ErrCreateTransfer = errors.New("error creating transfer")
+	ErrCreateTransfer = NewError(KindInternal, "create_transfer", "error creating transfer")
 
-	ErrUnauthorizedTransfer = errors.New("unauthorized transfer")
+	ErrUnauthorizedTransfer = NewError(KindForbidden, "unauthorized_transfer", "unauthorized transfer")
+
+	ErrFindTransfers = NewError(KindInternal, "find_transfers", "error fetching transfers")
+
+	ErrDuplicateTransfer = NewError(KindConflict, "duplicate_transfer", "transfer already exists")
+
+	ErrNotFoundTransfer = NewError(KindNotFound, "not_found_transfer", "not found transfer")
 )
 
 type (
@@ -21,12 +26,25 @@ type (
 		WithTransaction(context.Context, func(context.Context) error) error
 	}
 
+	// TransferRepositoryFinder defines the search operation of the transfers in which a user is payer or payee since a date
+	TransferRepositoryFinder interface {
+		FindByUserID(context.Context, vo.Uuid, time.Time) ([]Transfer, error)
+	}
+
+	// TransferRepositoryFinderByID defines the search operation for a transfer entity
+	TransferRepositoryFinderByID interface {
+		FindByID(context.Context, vo.Uuid) (Transfer, error)
+	}
+
 	// Transfer define the transfer entity
 	Transfer struct {
 		id        vo.Uuid
 		payer     vo.Uuid
 		payee     vo.Uuid
 		value     vo.Money
+		fee       Fee
+		details   TransferDetails
+		refundOf  vo.Uuid
 		createdAt time.Time
 	}
 )
@@ -48,6 +66,42 @@ func NewTransfer(
 	}
 }
 
+// WithFee returns the transfer charging the fee
+func (t Transfer) WithFee(fee Fee) Transfer {
+	t.fee = fee
+
+	return t
+}
+
+// WithDetails returns the transfer with the details given by the merchant
+func (t Transfer) WithDetails(details TransferDetails) Transfer {
+	t.details = details
+
+	return t
+}
+
+// WithRefundOf returns the transfer returning to its payer the value of the transfer of the ID
+func (t Transfer) WithRefundOf(ID vo.Uuid) Transfer {
+	t.refundOf = ID
+
+	return t
+}
+
+// IsRefund returns whether the transfer returns the value of another transfer to its payer
+func (t Transfer) IsRefund() bool {
+	return t.refundOf != (vo.Uuid{})
+}
+
+// Debited returns the money taken from the payer, the value plus the payer fee
+func (t Transfer) Debited() vo.Money {
+	return t.value.Add(t.fee.Payer().Amount())
+}
+
+// Credited returns the money given to the payee, the value minus the payee fee
+func (t Transfer) Credited() vo.Money {
+	return t.value.Sub(t.fee.Payee().Amount())
+}
+
 // ID returns the id property
 func (t Transfer) ID() vo.Uuid {
 	return t.id
@@ -68,7 +122,22 @@ func (t Transfer) Value() vo.Money {
 	return t.value
 }
 
+// RefundOf returns the ID of the transfer refunded
+func (t Transfer) RefundOf() vo.Uuid {
+	return t.refundOf
+}
+
 // CreatedAt returns the createdAt property
 func (t Transfer) CreatedAt() time.Time {
 	return t.createdAt
 }
+
+// Fee returns the fee property
+func (t Transfer) Fee() Fee {
+	return t.fee
+}
+
+// Details returns the details property
+func (t Transfer) Details() TransferDetails {
+	return t.details
+}
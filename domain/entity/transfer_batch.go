@@ -0,0 +1,348 @@
+package entity
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+const (
+	// Transfer batch modes
+	BatchAllOrNothing BatchMode = "ALL_OR_NOTHING"
+	BatchBestEffort   BatchMode = "BEST_EFFORT"
+
+	// Transfer batch status
+	BatchPending            BatchStatus = "PENDING"
+	BatchProcessing         BatchStatus = "PROCESSING"
+	BatchCompleted          BatchStatus = "COMPLETED"
+	BatchPartiallyCompleted BatchStatus = "PARTIALLY_COMPLETED"
+	BatchFailed             BatchStatus = "FAILED"
+
+	// Transfer batch item status
+	BatchItemPending    BatchItemStatus = "PENDING"
+	BatchItemSucceeded  BatchItemStatus = "SUCCEEDED"
+	BatchItemFailed     BatchItemStatus = "FAILED"
+	BatchItemRolledBack BatchItemStatus = "ROLLED_BACK"
+
+	// MaxTransferBatchItems is the maximum number of items of a transfer batch
+	MaxTransferBatchItems = 10000
+
+	// MaxAllOrNothingBatchItems is the maximum number of items of an all-or-nothing batch,
+	// whose transfers share a single database transaction
+	MaxAllOrNothingBatchItems = 500
+)
+
+var (
+	ErrCreateTransferBatch = NewError(KindInternal, "create_transfer_batch", "error creating transfer batch")
+
+	ErrNotFoundTransferBatch = NewError(KindNotFound, "not_found_transfer_batch", "not found transfer batch")
+
+	ErrFindTransferBatch = NewError(KindInternal, "find_transfer_batch", "error fetching transfer batch")
+
+	ErrUpdateTransferBatch = NewError(KindInternal, "update_transfer_batch", "error updating transfer batch")
+
+	ErrInvalidBatchMode = NewError(KindValidation, "invalid_batch_mode", "batch mode must be ALL_OR_NOTHING or BEST_EFFORT")
+
+	ErrEmptyTransferBatch = NewError(KindValidation, "empty_transfer_batch", "transfer batch has no items")
+
+	ErrTransferBatchTooLarge = NewError(KindValidation, "transfer_batch_too_large", "transfer batch has too many items")
+
+	ErrTransferBatchNotPending = NewError(KindConflict, "transfer_batch_not_pending", "transfer batch already processed")
+
+	ErrLeaseTransferBatches = NewError(KindInternal, "lease_transfer_batches", "error leasing stale transfer batches")
+)
+
+type (
+	// BatchMode defines whether a failed item rolls back the whole batch
+	BatchMode string
+
+	// BatchStatus defines the status of a transfer batch
+	BatchStatus string
+
+	// BatchItemStatus defines the status of an item of a transfer batch
+	BatchItemStatus string
+
+	// TransferBatchRepositoryCreator defines the operation of creating a transfer batch entity
+	TransferBatchRepositoryCreator interface {
+		Create(context.Context, TransferBatch) (TransferBatch, error)
+	}
+
+	// TransferBatchRepositoryFinder defines the search operation for a transfer batch entity
+	TransferBatchRepositoryFinder interface {
+		FindByID(context.Context, vo.Uuid) (TransferBatch, error)
+	}
+
+	// TransferBatchRepositoryUpdater defines the operations of recording the progress of a transfer batch,
+	// Start fails with ErrTransferBatchNotPending when the batch was already started
+	TransferBatchRepositoryUpdater interface {
+		Start(context.Context, TransferBatch) error
+		UpdateItem(context.Context, vo.Uuid, TransferBatchItem) error
+		Finish(context.Context, TransferBatch) error
+		WithTransaction(context.Context, func(context.Context) error) error
+	}
+
+	// TransferBatchRepositoryLeaser defines the operation of the workers resuming the batches left unfinished,
+	// a pending or processing batch whose progress was not recorded for staleAfter is leased as processing
+	TransferBatchRepositoryLeaser interface {
+		LeaseStale(ctx context.Context, now time.Time, staleAfter time.Duration, limit int) ([]TransferBatch, error)
+	}
+
+	// TransferBatchRepository defines the operations of the transfer batches
+	TransferBatchRepository interface {
+		TransferBatchRepositoryCreator
+		TransferBatchRepositoryFinder
+		TransferBatchRepositoryUpdater
+		TransferBatchRepositoryLeaser
+	}
+
+	// TransferBatch defines a list of transfers from a single payer executed together
+	TransferBatch struct {
+		id        vo.Uuid
+		payer     vo.Uuid
+		mode      BatchMode
+		items     []TransferBatchItem
+		status    BatchStatus
+		createdAt time.Time
+		updatedAt time.Time
+	}
+
+	// TransferBatchItem defines a transfer of a batch, its ID is the ID of the transfer created
+	TransferBatchItem struct {
+		id            vo.Uuid
+		payee         vo.Uuid
+		value         vo.Money
+		status        BatchItemStatus
+		failureReason string
+	}
+)
+
+// NewTransferBatch creates new pending transfer batch
+func NewTransferBatch(
+	ID vo.Uuid,
+	payerID vo.Uuid,
+	mode BatchMode,
+	items []TransferBatchItem,
+	createdAt time.Time,
+) (TransferBatch, error) {
+	if mode != BatchAllOrNothing && mode != BatchBestEffort {
+		return TransferBatch{}, ErrInvalidBatchMode
+	}
+
+	if len(items) == 0 {
+		return TransferBatch{}, ErrEmptyTransferBatch
+	}
+
+	if len(items) > MaxTransferBatchItems || (mode == BatchAllOrNothing && len(items) > MaxAllOrNothingBatchItems) {
+		return TransferBatch{}, ErrTransferBatchTooLarge
+	}
+
+	return TransferBatch{
+		id:        ID,
+		payer:     payerID,
+		mode:      mode,
+		items:     items,
+		status:    BatchPending,
+		createdAt: createdAt,
+		updatedAt: createdAt,
+	}, nil
+}
+
+// RestoreTransferBatch creates a transfer batch from its persisted representation
+func RestoreTransferBatch(
+	ID vo.Uuid,
+	payerID vo.Uuid,
+	mode BatchMode,
+	items []TransferBatchItem,
+	status BatchStatus,
+	createdAt time.Time,
+	updatedAt time.Time,
+) TransferBatch {
+	return TransferBatch{
+		id:        ID,
+		payer:     payerID,
+		mode:      mode,
+		items:     items,
+		status:    status,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// NewTransferBatchItem creates new pending transfer batch item
+func NewTransferBatchItem(ID vo.Uuid, payeeID vo.Uuid, value vo.Money) TransferBatchItem {
+	return TransferBatchItem{
+		id:     ID,
+		payee:  payeeID,
+		value:  value,
+		status: BatchItemPending,
+	}
+}
+
+// RestoreTransferBatchItem creates a transfer batch item from its persisted representation
+func RestoreTransferBatchItem(
+	ID vo.Uuid,
+	payeeID vo.Uuid,
+	value vo.Money,
+	status BatchItemStatus,
+	failureReason string,
+) TransferBatchItem {
+	return TransferBatchItem{
+		id:            ID,
+		payee:         payeeID,
+		value:         value,
+		status:        status,
+		failureReason: failureReason,
+	}
+}
+
+// Start returns the transfer batch being processed, a batch is processed only once
+func (t TransferBatch) Start(at time.Time) (TransferBatch, error) {
+	if t.status != BatchPending {
+		return TransferBatch{}, ErrTransferBatchNotPending
+	}
+
+	t.status = BatchProcessing
+	t.updatedAt = at
+
+	return t, nil
+}
+
+// Finish returns the transfer batch with the processed items and the status resulting from them
+func (t TransferBatch) Finish(items []TransferBatchItem, at time.Time) TransferBatch {
+	t.items = items
+	t.updatedAt = at
+
+	switch succeeded := t.Succeeded(); {
+	case succeeded == len(t.items):
+		t.status = BatchCompleted
+	case succeeded == 0:
+		t.status = BatchFailed
+	default:
+		t.status = BatchPartiallyCompleted
+	}
+
+	return t
+}
+
+// Total returns the sum of the values of the items
+func (t TransferBatch) Total() vo.Money {
+	var total vo.Money
+	for i, item := range t.items {
+		if i == 0 {
+			total = vo.NewMoney(item.value.Currency(), vo.Amount{})
+		}
+		total = total.Add(item.value.Amount())
+	}
+
+	return total
+}
+
+// Processed returns the number of items no longer pending
+func (t TransferBatch) Processed() int {
+	return len(t.items) - t.count(BatchItemPending)
+}
+
+// Succeeded returns the number of items transferred
+func (t TransferBatch) Succeeded() int {
+	return t.count(BatchItemSucceeded)
+}
+
+// Failed returns the number of items failed or rolled back
+func (t TransferBatch) Failed() int {
+	return t.count(BatchItemFailed) + t.count(BatchItemRolledBack)
+}
+
+func (t TransferBatch) count(status BatchItemStatus) int {
+	var count int
+	for _, item := range t.items {
+		if item.status == status {
+			count++
+		}
+	}
+
+	return count
+}
+
+// ID returns the id property
+func (t TransferBatch) ID() vo.Uuid {
+	return t.id
+}
+
+// Payer returns the payer property
+func (t TransferBatch) Payer() vo.Uuid {
+	return t.payer
+}
+
+// Mode returns the mode property
+func (t TransferBatch) Mode() BatchMode {
+	return t.mode
+}
+
+// Items returns the items property
+func (t TransferBatch) Items() []TransferBatchItem {
+	return t.items
+}
+
+// Status returns the status property
+func (t TransferBatch) Status() BatchStatus {
+	return t.status
+}
+
+// CreatedAt returns the createdAt property
+func (t TransferBatch) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// UpdatedAt returns the updatedAt property
+func (t TransferBatch) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// Succeeded returns the item transferred
+func (i TransferBatchItem) Succeeded() TransferBatchItem {
+	i.status = BatchItemSucceeded
+	i.failureReason = ""
+
+	return i
+}
+
+// Failed returns the item failed with the reason of the failure
+func (i TransferBatchItem) Failed(reason error) TransferBatchItem {
+	i.status = BatchItemFailed
+	i.failureReason = reason.Error()
+
+	return i
+}
+
+// RolledBack returns the item whose transfer was undone by the failure of another item
+func (i TransferBatchItem) RolledBack() TransferBatchItem {
+	i.status = BatchItemRolledBack
+
+	return i
+}
+
+// ID returns the id property
+func (i TransferBatchItem) ID() vo.Uuid {
+	return i.id
+}
+
+// Payee returns the payee property
+func (i TransferBatchItem) Payee() vo.Uuid {
+	return i.payee
+}
+
+// Value returns the value property
+func (i TransferBatchItem) Value() vo.Money {
+	return i.value
+}
+
+// Status returns the status property
+func (i TransferBatchItem) Status() BatchItemStatus {
+	return i.status
+}
+
+// FailureReason returns the failureReason property
+func (i TransferBatchItem) FailureReason() string {
+	return i.failureReason
+}
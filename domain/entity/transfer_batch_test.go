@@ -0,0 +1,170 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func newTransferBatchTestItems(values ...int64) []TransferBatchItem {
+	var items []TransferBatchItem
+	for _, value := range values {
+		items = append(items, NewTransferBatchItem(
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewMoneyBRL(vo.NewAmountTest(value)),
+		))
+	}
+
+	return items
+}
+
+func TestNewTransferBatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    BatchMode
+		items   []TransferBatchItem
+		wantErr error
+	}{
+		{
+			name:  "Best effort batch",
+			mode:  BatchBestEffort,
+			items: newTransferBatchTestItems(100, 200),
+		},
+		{
+			name:  "All or nothing batch",
+			mode:  BatchAllOrNothing,
+			items: newTransferBatchTestItems(100),
+		},
+		{
+			name:    "Invalid mode",
+			mode:    BatchMode("SOMETIMES"),
+			items:   newTransferBatchTestItems(100),
+			wantErr: ErrInvalidBatchMode,
+		},
+		{
+			name:    "Without items",
+			mode:    BatchBestEffort,
+			wantErr: ErrEmptyTransferBatch,
+		},
+		{
+			name:    "All or nothing batch above its limit",
+			mode:    BatchAllOrNothing,
+			items:   make([]TransferBatchItem, MaxAllOrNothingBatchItems+1),
+			wantErr: ErrTransferBatchTooLarge,
+		},
+		{
+			name:    "Best effort batch above its limit",
+			mode:    BatchBestEffort,
+			items:   make([]TransferBatchItem, MaxTransferBatchItems+1),
+			wantErr: ErrTransferBatchTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTransferBatch(vo.NewUuidStaticTest(), vo.NewUuidStaticTest(), tt.mode, tt.items, time.Time{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr == nil && got.Status() != BatchPending {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Status(), BatchPending)
+			}
+		})
+	}
+}
+
+func TestTransferBatch_Start(t *testing.T) {
+	batch, _ := NewTransferBatch(
+		vo.NewUuidStaticTest(),
+		vo.NewUuidStaticTest(),
+		BatchBestEffort,
+		newTransferBatchTestItems(100),
+		time.Time{},
+	)
+
+	started, err := batch.Start(time.Now())
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Start pending batch", err)
+	}
+
+	if started.Status() != BatchProcessing {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Start pending batch", started.Status(), BatchProcessing)
+	}
+
+	if _, err := started.Start(time.Now()); !errors.Is(err, ErrTransferBatchNotPending) {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Start batch twice", err, ErrTransferBatchNotPending)
+	}
+}
+
+func TestTransferBatch_Finish(t *testing.T) {
+	var items = newTransferBatchTestItems(100, 200, 300)
+
+	tests := []struct {
+		name          string
+		items         []TransferBatchItem
+		wantStatus    BatchStatus
+		wantSucceeded int
+		wantFailed    int
+	}{
+		{
+			name:          "Every item succeeded",
+			items:         []TransferBatchItem{items[0].Succeeded(), items[1].Succeeded(), items[2].Succeeded()},
+			wantStatus:    BatchCompleted,
+			wantSucceeded: 3,
+		},
+		{
+			name:          "Some items failed",
+			items:         []TransferBatchItem{items[0].Succeeded(), items[1].Failed(ErrUserInsufficientBalance), items[2].Succeeded()},
+			wantStatus:    BatchPartiallyCompleted,
+			wantSucceeded: 2,
+			wantFailed:    1,
+		},
+		{
+			name:       "Rolled back",
+			items:      []TransferBatchItem{items[0].RolledBack(), items[1].Failed(ErrUserInsufficientBalance), items[2].RolledBack()},
+			wantStatus: BatchFailed,
+			wantFailed: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batch, _ := NewTransferBatch(vo.NewUuidStaticTest(), vo.NewUuidStaticTest(), BatchBestEffort, items, time.Time{})
+
+			got := batch.Finish(tt.items, time.Now())
+
+			if got.Status() != tt.wantStatus {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Status(), tt.wantStatus)
+			}
+
+			if got.Processed() != len(tt.items) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Processed(), len(tt.items))
+			}
+
+			if got.Succeeded() != tt.wantSucceeded {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Succeeded(), tt.wantSucceeded)
+			}
+
+			if got.Failed() != tt.wantFailed {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Failed(), tt.wantFailed)
+			}
+		})
+	}
+}
+
+func TestTransferBatch_Total(t *testing.T) {
+	batch, _ := NewTransferBatch(
+		vo.NewUuidStaticTest(),
+		vo.NewUuidStaticTest(),
+		BatchBestEffort,
+		newTransferBatchTestItems(100, 200, 300),
+		time.Time{},
+	)
+
+	if got := batch.Total().Amount().Value(); got != 600 {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Sum of the items", got, 600)
+	}
+}
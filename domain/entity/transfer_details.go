@@ -0,0 +1,183 @@
+package entity
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+const (
+	// Bounds of the details of a transfer
+	MaxTransferDescriptionLength       = 255
+	MaxTransferExternalReferenceLength = 64
+	MaxTransferMetadataKeys            = 20
+	MaxTransferMetadataKeyLength       = 40
+	MaxTransferMetadataValueLength     = 500
+
+	// Bounds of the number of transfers returned by a search
+	DefaultTransferSearchLimit = 50
+	MaxTransferSearchLimit     = 100
+)
+
+var (
+	ErrInvalidTransferDescription = NewError(KindValidation, "invalid_transfer_description", "transfer description must have up to 255 characters")
+
+	ErrInvalidTransferExternalReference = NewError(KindValidation, "invalid_transfer_external_reference", "transfer external reference must have up to 64 characters")
+
+	ErrInvalidTransferMetadata = NewError(KindValidation, "invalid_transfer_metadata", "transfer metadata must have up to 20 keys of letters, digits, '_' or '-' up to 40 characters and values up to 500 characters")
+
+	ErrDuplicateTransferReference = NewError(KindConflict, "duplicate_transfer_reference", "transfer with the external reference already exists for the payee")
+
+	ErrInvalidTransferSearch = NewError(KindValidation, "invalid_transfer_search", "transfer search must have a user and a limit from 1 to 100")
+)
+
+// metadataKey restricts the metadata keys to the characters safe to be used as field names of the database
+var metadataKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+type (
+	// TransferRepositorySearcher defines the search operation of the transfers matching a TransferSearch,
+	// the most recent first
+	TransferRepositorySearcher interface {
+		Search(context.Context, TransferSearch) ([]Transfer, error)
+	}
+
+	// TransferDetails defines the description, the external reference and the metadata given to a transfer by
+	// the merchant. The external reference, usually an order ID, is unique for each payee
+	TransferDetails struct {
+		description       string
+		externalReference string
+		metadata          map[string]string
+	}
+
+	// TransferSearch defines the criteria of a search of the transfers in which a user is payer or payee,
+	// empty criteria match every transfer of the user
+	TransferSearch struct {
+		user              vo.Uuid
+		externalReference string
+		text              string
+		metadata          map[string]string
+		limit             int
+	}
+)
+
+// NewTransferDetails creates new transfer details, each of them is optional but bounded in length
+func NewTransferDetails(description string, externalReference string, metadata map[string]string) (TransferDetails, error) {
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > MaxTransferDescriptionLength {
+		return TransferDetails{}, ErrInvalidTransferDescription
+	}
+
+	externalReference = strings.TrimSpace(externalReference)
+	if utf8.RuneCountInString(externalReference) > MaxTransferExternalReferenceLength {
+		return TransferDetails{}, ErrInvalidTransferExternalReference
+	}
+
+	if !validMetadata(metadata, MaxTransferMetadataKeys, MaxTransferMetadataKeyLength, MaxTransferMetadataValueLength) {
+		return TransferDetails{}, ErrInvalidTransferMetadata
+	}
+
+	return TransferDetails{
+		description:       description,
+		externalReference: externalReference,
+		metadata:          metadata,
+	}, nil
+}
+
+// RestoreTransferDetails creates transfer details from their persisted representation
+func RestoreTransferDetails(description string, externalReference string, metadata map[string]string) TransferDetails {
+	return TransferDetails{
+		description:       description,
+		externalReference: externalReference,
+		metadata:          metadata,
+	}
+}
+
+// NewTransferSearch creates new search of the transfers of the user, the metadata matches the transfers having
+// every key with the given value and the text matches the description regardless of case
+func NewTransferSearch(
+	userID vo.Uuid,
+	externalReference string,
+	text string,
+	metadata map[string]string,
+	limit int,
+) (TransferSearch, error) {
+	if userID == (vo.Uuid{}) || limit < 1 || limit > MaxTransferSearchLimit {
+		return TransferSearch{}, ErrInvalidTransferSearch
+	}
+
+	if !validMetadata(metadata, MaxTransferMetadataKeys, MaxTransferMetadataKeyLength, MaxTransferMetadataValueLength) {
+		return TransferSearch{}, ErrInvalidTransferMetadata
+	}
+
+	return TransferSearch{
+		user:              userID,
+		externalReference: strings.TrimSpace(externalReference),
+		text:              strings.TrimSpace(text),
+		metadata:          metadata,
+		limit:             limit,
+	}, nil
+}
+
+// validMetadata reports whether the metadata is within the bounds of number of keys and length of the keys
+// and values
+func validMetadata(metadata map[string]string, maxKeys int, maxKeyLength int, maxValueLength int) bool {
+	if len(metadata) > maxKeys {
+		return false
+	}
+
+	for key, value := range metadata {
+		if !metadataKey.MatchString(key) || len(key) > maxKeyLength || utf8.RuneCountInString(value) > maxValueLength {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsZero reports whether the transfer has no details
+func (d TransferDetails) IsZero() bool {
+	return d.description == "" && d.externalReference == "" && len(d.metadata) == 0
+}
+
+// Description returns the description property
+func (d TransferDetails) Description() string {
+	return d.description
+}
+
+// ExternalReference returns the externalReference property
+func (d TransferDetails) ExternalReference() string {
+	return d.externalReference
+}
+
+// Metadata returns the metadata property
+func (d TransferDetails) Metadata() map[string]string {
+	return d.metadata
+}
+
+// User returns the user whose transfers are searched
+func (s TransferSearch) User() vo.Uuid {
+	return s.user
+}
+
+// ExternalReference returns the externalReference property
+func (s TransferSearch) ExternalReference() string {
+	return s.externalReference
+}
+
+// Text returns the text searched in the description
+func (s TransferSearch) Text() string {
+	return s.text
+}
+
+// Metadata returns the metadata property
+func (s TransferSearch) Metadata() map[string]string {
+	return s.metadata
+}
+
+// Limit returns the limit property
+func (s TransferSearch) Limit() int {
+	return s.limit
+}
@@ -0,0 +1,114 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func TestNewTransferDetails(t *testing.T) {
+	var manyKeys = make(map[string]string)
+	for _, key := range strings.Split("abcdefghijklmnopqrstu", "") {
+		manyKeys[key] = "value"
+	}
+
+	tests := []struct {
+		name              string
+		description       string
+		externalReference string
+		metadata          map[string]string
+		wantErr           error
+	}{
+		{
+			name:              "Details with every field",
+			description:       "Order #1234",
+			externalReference: "order-1234",
+			metadata:          map[string]string{"order_id": "1234", "sales-channel": "web"},
+		},
+		{
+			name: "Without details",
+		},
+		{
+			name:        "Description too long",
+			description: strings.Repeat("a", MaxTransferDescriptionLength+1),
+			wantErr:     ErrInvalidTransferDescription,
+		},
+		{
+			name:              "External reference too long",
+			externalReference: strings.Repeat("a", MaxTransferExternalReferenceLength+1),
+			wantErr:           ErrInvalidTransferExternalReference,
+		},
+		{
+			name:     "Too many metadata keys",
+			metadata: manyKeys,
+			wantErr:  ErrInvalidTransferMetadata,
+		},
+		{
+			name:     "Metadata key with a dot",
+			metadata: map[string]string{"order.id": "1234"},
+			wantErr:  ErrInvalidTransferMetadata,
+		},
+		{
+			name:     "Metadata value too long",
+			metadata: map[string]string{"order_id": strings.Repeat("a", MaxTransferMetadataValueLength+1)},
+			wantErr:  ErrInvalidTransferMetadata,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTransferDetails(tt.description, tt.externalReference, tt.metadata)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if err == nil && got.ExternalReference() != tt.externalReference {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.ExternalReference(), tt.externalReference)
+			}
+		})
+	}
+}
+
+func TestNewTransferSearch(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     vo.Uuid
+		metadata map[string]string
+		limit    int
+		wantErr  error
+	}{
+		{
+			name:     "Search of a user",
+			user:     vo.NewUuidStaticTest(),
+			metadata: map[string]string{"order_id": "1234"},
+			limit:    DefaultTransferSearchLimit,
+		},
+		{
+			name:    "Search without user",
+			limit:   DefaultTransferSearchLimit,
+			wantErr: ErrInvalidTransferSearch,
+		},
+		{
+			name:    "Search above the limit",
+			user:    vo.NewUuidStaticTest(),
+			limit:   MaxTransferSearchLimit + 1,
+			wantErr: ErrInvalidTransferSearch,
+		},
+		{
+			name:     "Search with an invalid metadata key",
+			user:     vo.NewUuidStaticTest(),
+			metadata: map[string]string{"$where": "1"},
+			limit:    DefaultTransferSearchLimit,
+			wantErr:  ErrInvalidTransferMetadata,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTransferSearch(tt.user, "", "", tt.metadata, tt.limit); !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
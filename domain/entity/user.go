@@ -2,22 +2,21 @@ package entity
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"github.com/GSabadini/golang-clean-architecture/domain/vo"
 )
 
 var (
-	ErrUserInsufficientBalance = errors.New("user does not have sufficient balance")
+	ErrUserInsufficientBalance = NewError(KindRuleViolation, "user_insufficient_balance", "user does not have sufficient balance")
 
-	ErrNotFoundUser = errors.New("not found user")
+	ErrNotFoundUser = NewError(KindNotFound, "not_found_user", "not found user")
 
-	ErrUpdateUserWallet = errors.New("error updating the value of the wallet")
+	ErrUpdateUserWallet = NewError(KindInternal, "update_user_wallet", "error updating the value of the wallet")
 
-	ErrCreateUser = errors.New("error creating user")
+	ErrCreateUser = NewError(KindInternal, "create_user", "error creating user")
 
-	ErrFindUserByID = errors.New("error fetching user by ID")
+	ErrFindUserByID = NewError(KindInternal, "find_user_by_id", "error fetching user by ID")
 )
 
 type (
@@ -36,6 +35,11 @@ type (
 		UpdateWallet(context.Context, vo.Uuid, vo.Money) error
 	}
 
+	// UserRepositoryHolder defines the update operation of the money held on a user entity wallet
+	UserRepositoryHolder interface {
+		UpdateHeld(context.Context, vo.Uuid, vo.Money) error
+	}
+
 	// User defines the user entity
 	User struct {
 		id        vo.Uuid
@@ -46,6 +50,7 @@ type (
 		wallet    *vo.Wallet
 		typeUser  vo.TypeUser
 		roles     vo.Roles
+		kycLevel  KYCLevel
 		createdAt time.Time
 	}
 )
@@ -108,6 +113,7 @@ func NewCommonUser(
 			CanTransfer: true,
 		},
 		typeUser:  vo.COMMON,
+		kycLevel:  KYCBasic,
 		createdAt: createdAt,
 	}
 }
@@ -133,13 +139,14 @@ func NewMerchantUser(
 			CanTransfer: false,
 		},
 		typeUser:  vo.MERCHANT,
+		kycLevel:  KYCBasic,
 		createdAt: createdAt,
 	}
 }
 
-// Withdraw remove value of money of wallet
+// Withdraw remove value of money of wallet, the money held is not available to withdraw
 func (u User) Withdraw(money vo.Money) error {
-	if u.Wallet().Money().Amount().Value() < money.Amount().Value() {
+	if u.Wallet().Available().Amount().Value() < money.Amount().Value() {
 		return ErrUserInsufficientBalance
 	}
 
@@ -148,11 +155,75 @@ func (u User) Withdraw(money vo.Money) error {
 	return nil
 }
 
+// Hold reserve value of money of wallet, the money held stays in the balance but is no longer available
+func (u User) Hold(money vo.Money) error {
+	if u.Wallet().Available().Amount().Value() < money.Amount().Value() {
+		return ErrUserInsufficientBalance
+	}
+
+	u.Wallet().Hold(money.Amount())
+
+	return nil
+}
+
+// HoldDebt reserve value of money of wallet even beyond the available balance, which goes negative and keeps
+// the money later received unavailable until the balance covers what is held
+func (u User) HoldDebt(money vo.Money) {
+	u.Wallet().Hold(money.Amount())
+}
+
+// Release return value of money held to the available balance of wallet
+func (u User) Release(money vo.Money) {
+	u.Wallet().Release(money.Amount())
+}
+
 // Deposit add value of money of wallet
 func (u User) Deposit(money vo.Money) {
 	u.Wallet().Add(money.Amount())
 }
 
+// WithKYCLevel returns the user with the KYC level
+func (u User) WithKYCLevel(level KYCLevel) User {
+	u.kycLevel = level
+	return u
+}
+
+// CanSend returns whether the KYC level allows sending the money, given the value already sent today,
+// the remaining allowance is the most that can still be sent in a single transfer
+func (u User) CanSend(money vo.Money, sentToday int64) error {
+	var (
+		limits = u.kycLevel.Limits()
+		single = limits.SingleTransfer()
+		daily  = allowance(limits.DailyOutgoing(), sentToday)
+		value  = money.Amount().Value()
+	)
+
+	if value > daily {
+		return NewKYCLimitError(u.kycLevel, DailyOutgoingLimit, daily)
+	}
+
+	if value > single {
+		return NewKYCLimitError(u.kycLevel, SingleTransferLimit, single)
+	}
+
+	return nil
+}
+
+// CanReceive returns whether the KYC level allows the balance of the wallet to grow by the money
+func (u User) CanReceive(money vo.Money) error {
+	var walletBalance = u.kycLevel.Limits().WalletBalance()
+
+	if u.Wallet().Money().Amount().Value()+money.Amount().Value() > walletBalance {
+		return NewKYCLimitError(
+			u.kycLevel,
+			WalletBalanceLimit,
+			allowance(walletBalance, u.Wallet().Money().Amount().Value()),
+		)
+	}
+
+	return nil
+}
+
 // CanTransfer returns whether it is possible to transfer
 func (u User) CanTransfer() error {
 	if u.Roles().CanTransfer {
@@ -202,6 +273,11 @@ func (u User) Document() vo.Document {
 	return u.document
 }
 
+// KYCLevel returns the kycLevel property
+func (u User) KYCLevel() KYCLevel {
+	return u.kycLevel
+}
+
 // CreatedAt returns the createdAt property
 func (u User) CreatedAt() time.Time {
 	return u.createdAt
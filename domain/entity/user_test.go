@@ -45,6 +45,7 @@ func TestNewUser(t *testing.T) {
 				roles:     vo.Roles{CanTransfer: true},
 				wallet:    vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(100))),
 				typeUser:  vo.COMMON,
+				kycLevel:  KYCBasic,
 				createdAt: time.Time{},
 			},
 		},
@@ -69,6 +70,7 @@ func TestNewUser(t *testing.T) {
 				roles:     vo.Roles{CanTransfer: false},
 				wallet:    vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(100))),
 				typeUser:  vo.MERCHANT,
+				kycLevel:  KYCBasic,
 				createdAt: time.Time{},
 			},
 		},
@@ -344,6 +346,28 @@ func TestUser_Withdraw(t *testing.T) {
 			},
 			wantErr: ErrUserInsufficientBalance,
 		},
+		{
+			name: "Test withdraw more than the available balance",
+			argsUser: argsUser{
+				id:       vo.NewUuidStaticTest(),
+				fullName: vo.NewFullName("Test testing"),
+				email:    vo.Email{},
+				password: vo.NewPassword("123"),
+				document: vo.Document{},
+				wallet: func() *vo.Wallet {
+					wallet := vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(100)))
+					wallet.Hold(vo.NewAmountTest(60))
+					return wallet
+				}(),
+				typeUser:  vo.COMMON,
+				roles:     vo.Roles{},
+				createdAt: time.Time{},
+			},
+			args: args{
+				money: vo.NewMoneyBRL(vo.NewAmountTest(50)),
+			},
+			wantErr: ErrUserInsufficientBalance,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -374,3 +398,77 @@ func TestUser_Withdraw(t *testing.T) {
 		})
 	}
 }
+
+func TestUser_Hold(t *testing.T) {
+	tests := []struct {
+		name          string
+		hold          []int64
+		wantHeld      int64
+		wantAvailable int64
+		wantErr       error
+	}{
+		{
+			name:          "Hold part of the balance",
+			hold:          []int64{30, 50},
+			wantHeld:      80,
+			wantAvailable: 20,
+		},
+		{
+			name:          "Hold the whole balance",
+			hold:          []int64{100},
+			wantHeld:      100,
+			wantAvailable: 0,
+		},
+		{
+			name:          "Hold more than the available balance",
+			hold:          []int64{60, 50},
+			wantHeld:      60,
+			wantAvailable: 40,
+			wantErr:       ErrUserInsufficientBalance,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				user = NewCommonUser(
+					vo.NewUuidStaticTest(),
+					vo.NewFullName("Test testing"),
+					vo.Email{},
+					vo.NewPassword("123"),
+					vo.Document{},
+					vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(100))),
+					time.Time{},
+				)
+				err error
+			)
+
+			for _, value := range tt.hold {
+				if err = user.Hold(vo.NewMoneyBRL(vo.NewAmountTest(value))); err != nil {
+					break
+				}
+			}
+
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if user.Wallet().Held().Amount().Value() != tt.wantHeld ||
+				user.Wallet().Available().Amount().Value() != tt.wantAvailable ||
+				user.Wallet().Money().Amount().Value() != 100 {
+				t.Errorf(
+					"[TestCase '%s'] Got: '%v/%v' | Want: '%v/%v'",
+					tt.name,
+					user.Wallet().Held().Amount().Value(),
+					user.Wallet().Available().Amount().Value(),
+					tt.wantHeld,
+					tt.wantAvailable,
+				)
+			}
+
+			user.Release(user.Wallet().Held())
+			if user.Wallet().Available().Amount().Value() != 100 {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, user.Wallet().Available().Amount().Value(), 100)
+			}
+		})
+	}
+}
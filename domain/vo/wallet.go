@@ -3,6 +3,7 @@ package vo
 //  Wallet structure
 type Wallet struct {
 	money Money
+	held  Amount
 }
 
 // Currency return value currency
@@ -15,6 +16,16 @@ func (w Wallet) Money() Money {
 	return w.money
 }
 
+// Held return the money reserved by holds, part of the balance but not available
+func (w Wallet) Held() Money {
+	return NewMoney(w.money.Currency(), w.held)
+}
+
+// Available return the balance not reserved by holds
+func (w Wallet) Available() Money {
+	return w.money.Sub(w.held)
+}
+
 // Add value in money value amount
 func (w *Wallet) Add(amount Amount) Money {
 	w.money = w.money.Add(amount)
@@ -27,10 +38,22 @@ func (w *Wallet) Sub(amount Amount) Money {
 	return w.money
 }
 
+// Hold reserve amount of the balance
+func (w *Wallet) Hold(amount Amount) Money {
+	w.held = Amount{value: w.held.Value() + amount.Value()}
+	return w.Held()
+}
+
+// Release return to the available balance amount reserved
+func (w *Wallet) Release(amount Amount) Money {
+	w.held = Amount{value: w.held.Value() - amount.Value()}
+	return w.Held()
+}
+
 // Equals checks that two Wallet are the same
 func (w *Wallet) Equals(value Value) bool {
 	o, ok := value.(*Wallet)
-	return ok && w.money == o.money
+	return ok && w.money == o.money && w.held == o.held
 }
 
 func (w *Wallet) NewMoney(money Money) {
@@ -0,0 +1,28 @@
+package infrastructure
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/config"
+)
+
+// RunCommand executes the command line command given by args with the configuration
+func RunCommand(cfg config.Config, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing command")
+	}
+
+	switch args[0] {
+	case "migrate":
+		return NewMigrateCommand(os.Stdout, cfg).Run(args[1:])
+	case "reconcile":
+		return NewReconcileCommand(os.Stdout, cfg).Run(args[1:])
+	case "scheduler":
+		return NewSchedulerCommand(os.Stdout, cfg).Run(args[1:])
+	case "config":
+		return NewConfigCommand(os.Stdout, cfg).Run(args[1:])
+	}
+
+	return fmt.Errorf("unknown command %q", args[0])
+}
@@ -0,0 +1,388 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v2"
+)
+
+// redacted replaces the secrets when the configuration is printed
+const redacted = "******"
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+type (
+	// Config defines the configuration of the application, loaded once at startup. Each field is read from
+	// the optional YAML file and then from the environment variable of its env tag, secret fields are redacted
+	// when printed
+	Config struct {
+		HTTP            HTTP          `yaml:"http"`
+		GRPC            GRPC          `yaml:"grpc"`
+		MongoDB         MongoDB       `yaml:"mongodb"`
+		RabbitMQ        RabbitMQ      `yaml:"rabbitmq"`
+		Authorizer      Client        `yaml:"authorizer" env:"AUTHORIZER"`
+		Notifier        Client        `yaml:"notifier" env:"NOTIFY"`
+		Health          Health        `yaml:"health"`
+		Tracing         Tracing       `yaml:"tracing"`
+		FeeConfigPath   string        `yaml:"fee_config_path" env:"FEE_CONFIG_PATH"`
+		EscrowAccountID string        `yaml:"escrow_account_id" env:"ESCROW_ACCOUNT_ID"`
+		AdminToken      string        `yaml:"admin_token" env:"ADMIN_TOKEN" secret:"true"`
+		MigrateOnStart  bool          `yaml:"migrate_on_startup" env:"MIGRATE_ON_STARTUP"`
+		ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT"`
+	}
+
+	// HTTP defines the configuration of the HTTP API
+	HTTP struct {
+		Port              string        `yaml:"port" env:"APP_PORT"`
+		ReadTimeout       time.Duration `yaml:"read_timeout" env:"HTTP_READ_TIMEOUT"`
+		WriteTimeout      time.Duration `yaml:"write_timeout" env:"HTTP_WRITE_TIMEOUT"`
+		OpenAPIValidation bool          `yaml:"openapi_validation" env:"OPENAPI_VALIDATION"`
+	}
+
+	// GRPC defines the configuration of the gRPC API, served alongside the HTTP API when enabled
+	GRPC struct {
+		Enabled bool   `yaml:"enabled" env:"GRPC_ENABLED"`
+		Port    string `yaml:"port" env:"GRPC_PORT"`
+	}
+
+	// MongoDB defines the connection to MongoDB
+	MongoDB struct {
+		URI      string `yaml:"uri" env:"MONGODB_URI" secret:"true"`
+		Database string `yaml:"database" env:"MONGODB_DATABASE"`
+	}
+
+	// RabbitMQ defines the connection to RabbitMQ
+	RabbitMQ struct {
+		URI string `yaml:"uri" env:"RABBITMQ_URI" secret:"true"`
+	}
+
+	// Client defines an external service requested over HTTP, its env tags are prefixed by the one of the field
+	Client struct {
+		URI           string        `yaml:"uri" env:"URI"`
+		Timeout       time.Duration `yaml:"timeout" env:"TIMEOUT"`
+		RetryAttempts int           `yaml:"retry_attempts" env:"RETRY_ATTEMPTS"`
+		RetryBackoff  time.Duration `yaml:"retry_backoff" env:"RETRY_BACKOFF"`
+	}
+
+	// Health defines the readiness probe of the dependencies
+	Health struct {
+		CheckAuthorizer bool          `yaml:"check_authorizer" env:"HEALTH_CHECK_AUTHORIZER"`
+		CheckTimeout    time.Duration `yaml:"check_timeout" env:"HEALTH_CHECK_TIMEOUT"`
+		CacheTTL        time.Duration `yaml:"cache_ttl" env:"HEALTH_CACHE_TTL"`
+	}
+
+	// Tracing defines the exporter of the spans, none still propagates the trace context without exporting it
+	Tracing struct {
+		Exporter    string  `yaml:"exporter" env:"TRACING_EXPORTER"`
+		Endpoint    string  `yaml:"endpoint" env:"TRACING_OTLP_ENDPOINT"`
+		Insecure    bool    `yaml:"insecure" env:"TRACING_OTLP_INSECURE"`
+		ServiceName string  `yaml:"service_name" env:"TRACING_SERVICE_NAME"`
+		SampleRatio float64 `yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO"`
+	}
+
+	// Errors defines the invalid fields of the configuration
+	Errors []error
+
+	// field defines a leaf of the configuration with its YAML path and environment variable
+	field struct {
+		path   string
+		env    string
+		secret bool
+		value  reflect.Value
+	}
+)
+
+// Default returns the configuration used for the fields missing from the file and the environment
+func Default() Config {
+	client := Client{
+		Timeout:       5 * time.Second,
+		RetryAttempts: 3,
+		RetryBackoff:  400 * time.Millisecond,
+	}
+
+	return Config{
+		HTTP: HTTP{
+			Port:         "3001",
+			ReadTimeout:  15 * time.Second,
+			WriteTimeout: 15 * time.Second,
+		},
+		GRPC: GRPC{
+			Port: "50051",
+		},
+		Authorizer: client,
+		Notifier:   client,
+		Health: Health{
+			CheckTimeout: 2 * time.Second,
+			CacheTTL:     5 * time.Second,
+		},
+		Tracing: Tracing{
+			Exporter:    "none",
+			Endpoint:    "localhost:4317",
+			ServiceName: "golang-clean-architecture",
+			SampleRatio: 1,
+		},
+		ShutdownTimeout: 30 * time.Second,
+	}
+}
+
+// Load returns the configuration from the defaults, overridden by the YAML file at path, if any, and then by
+// the environment, failing with every invalid field
+func Load(path string) (Config, error) {
+	return load(path, os.LookupEnv)
+}
+
+func load(path string, lookupEnv func(string) (string, bool)) (Config, error) {
+	var cfg = Default()
+
+	if path != "" {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return Config{}, fmt.Errorf("read config file: %w", err)
+		}
+
+		if err := yaml.UnmarshalStrict(content, &cfg); err != nil {
+			return Config{}, fmt.Errorf("parse config file %s: %w", path, err)
+		}
+	}
+
+	var errs Errors
+	for _, f := range cfg.fields() {
+		value, ok := lookupEnv(f.env)
+		if !ok {
+			continue
+		}
+
+		if err := set(f.value, value); err != nil {
+			errs = append(errs, fmt.Errorf("%s: invalid value %q: %w", f.env, value, err))
+		}
+	}
+
+	errs = append(errs, cfg.validate()...)
+	if len(errs) > 0 {
+		return Config{}, errs
+	}
+
+	return cfg, nil
+}
+
+// validate returns the fields required missing and the values out of range
+func (c *Config) validate() Errors {
+	var (
+		errs     Errors
+		required = func(value string, env string) {
+			if strings.TrimSpace(value) == "" {
+				errs = append(errs, fmt.Errorf("%s is required", env))
+			}
+		}
+		positive = func(value time.Duration, env string) {
+			if value <= 0 {
+				errs = append(errs, fmt.Errorf("%s must be positive", env))
+			}
+		}
+	)
+
+	required(c.HTTP.Port, "APP_PORT")
+	required(c.MongoDB.URI, "MONGODB_URI")
+	required(c.MongoDB.Database, "MONGODB_DATABASE")
+	required(c.RabbitMQ.URI, "RABBITMQ_URI")
+	required(c.Authorizer.URI, "AUTHORIZER_URI")
+	required(c.Notifier.URI, "NOTIFY_URI")
+	if c.GRPC.Enabled {
+		required(c.GRPC.Port, "GRPC_PORT")
+	}
+
+	positive(c.HTTP.ReadTimeout, "HTTP_READ_TIMEOUT")
+	positive(c.HTTP.WriteTimeout, "HTTP_WRITE_TIMEOUT")
+	positive(c.Authorizer.Timeout, "AUTHORIZER_TIMEOUT")
+	positive(c.Notifier.Timeout, "NOTIFY_TIMEOUT")
+	positive(c.Health.CheckTimeout, "HEALTH_CHECK_TIMEOUT")
+	positive(c.ShutdownTimeout, "SHUTDOWN_TIMEOUT")
+
+	if c.Health.CacheTTL < 0 {
+		errs = append(errs, fmt.Errorf("HEALTH_CACHE_TTL must not be negative"))
+	}
+	if c.Authorizer.RetryAttempts <= 0 {
+		errs = append(errs, fmt.Errorf("AUTHORIZER_RETRY_ATTEMPTS must be positive"))
+	}
+	if c.Notifier.RetryAttempts <= 0 {
+		errs = append(errs, fmt.Errorf("NOTIFY_RETRY_ATTEMPTS must be positive"))
+	}
+
+	switch c.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		required(c.Tracing.Endpoint, "TRACING_OTLP_ENDPOINT")
+	default:
+		errs = append(errs, fmt.Errorf("TRACING_EXPORTER must be none, stdout or otlp"))
+	}
+	required(c.Tracing.ServiceName, "TRACING_SERVICE_NAME")
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, fmt.Errorf("TRACING_SAMPLE_RATIO must be between 0 and 1"))
+	}
+
+	if c.EscrowAccountID != "" {
+		if _, err := uuid.Parse(c.EscrowAccountID); err != nil {
+			errs = append(errs, fmt.Errorf("ESCROW_ACCOUNT_ID must be a uuid"))
+		}
+	}
+
+	return errs
+}
+
+// Print writes the configuration as YAML with the secrets redacted
+func (c Config) Print(w io.Writer) error {
+	var root = yaml.MapSlice{}
+	for _, f := range c.fields() {
+		var value interface{} = f.value.Interface()
+		switch {
+		case f.secret:
+			value = redact(f.value.String())
+		case f.value.Type() == durationType:
+			value = f.value.Interface().(time.Duration).String()
+		}
+
+		root = insert(root, strings.Split(f.path, "."), value)
+	}
+
+	out, err := yaml.Marshal(root)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(out)
+	return err
+}
+
+// fields returns the leaves of the configuration in the order they are declared
+func (c *Config) fields() []field {
+	return walk(reflect.ValueOf(c).Elem(), "", "")
+}
+
+func walk(v reflect.Value, path string, envPrefix string) []field {
+	var fields []field
+	for i := 0; i < v.NumField(); i++ {
+		var (
+			t    = v.Type().Field(i)
+			name = strings.Split(t.Tag.Get("yaml"), ",")[0]
+			env  = t.Tag.Get("env")
+		)
+		if path != "" {
+			name = path + "." + name
+		}
+		if envPrefix != "" {
+			env = envPrefix + "_" + env
+		}
+
+		if t.Type.Kind() == reflect.Struct {
+			fields = append(fields, walk(v.Field(i), name, env)...)
+			continue
+		}
+
+		fields = append(fields, field{
+			path:   name,
+			env:    env,
+			secret: t.Tag.Get("secret") == "true",
+			value:  v.Field(i),
+		})
+	}
+
+	return fields
+}
+
+// set parses the value of an environment variable into a field
+func set(v reflect.Value, value string) error {
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+
+		v.SetBool(b)
+	case reflect.Int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+
+		v.SetInt(int64(n))
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+
+	return nil
+}
+
+// redact hides a secret, only the password of a URI is hidden so the host is still shown
+func redact(value string) string {
+	if value == "" {
+		return ""
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" || u.User == nil {
+		return redacted
+	}
+
+	if _, ok := u.User.Password(); !ok {
+		return value
+	}
+
+	// the password is replaced after encoding the URI, which would escape the redaction
+	u.User = url.UserPassword(u.User.Username(), "password")
+	return strings.Replace(u.String(), ":password@", ":"+redacted+"@", 1)
+}
+
+// insert sets the value at the path of nested maps, keeping the order of the keys
+func insert(m yaml.MapSlice, path []string, value interface{}) yaml.MapSlice {
+	if len(path) == 1 {
+		return append(m, yaml.MapItem{Key: path[0], Value: value})
+	}
+
+	for i, item := range m {
+		if item.Key == path[0] {
+			m[i].Value = insert(item.Value.(yaml.MapSlice), path[1:], value)
+			return m
+		}
+	}
+
+	return append(m, yaml.MapItem{Key: path[0], Value: insert(yaml.MapSlice{}, path[1:], value)})
+}
+
+// Error returns the messages of the errors
+func (e Errors) Error() string {
+	var messages = make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return "invalid configuration: " + strings.Join(messages, "; ")
+}
@@ -0,0 +1,28 @@
+package infrastructure
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/config"
+)
+
+// ConfigCommand defines the command showing the configuration in effect
+type ConfigCommand struct {
+	out    io.Writer
+	config config.Config
+}
+
+// NewConfigCommand creates new ConfigCommand
+func NewConfigCommand(out io.Writer, cfg config.Config) ConfigCommand {
+	return ConfigCommand{out: out, config: cfg}
+}
+
+// Run executes the config subcommand given by args
+func (c ConfigCommand) Run(args []string) error {
+	if len(args) != 1 || args[0] != "print" {
+		return fmt.Errorf("usage: config print")
+	}
+
+	return c.config.Print(c.out)
+}
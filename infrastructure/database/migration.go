@@ -0,0 +1,420 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	migrationsCollection     = "schema_migrations"
+	migrationsLockCollection = "schema_migrations_lock"
+	migrationsLockID         = "lock"
+)
+
+var (
+	ErrMigrationLocked = errors.New("migrations are locked by another process")
+
+	ErrMigrationLockLost = errors.New("migrations lock lost to another process")
+
+	ErrNoMigrationToRollback = errors.New("no migration to rollback")
+
+	ErrUnknownMigration = errors.New("applied migration is unknown to this version")
+)
+
+type (
+	// MigrationFunc defines a step executed against the database by a migration
+	MigrationFunc func(context.Context, *mongo.Database) error
+
+	// Migration defines a versioned change of the database schema or data
+	Migration struct {
+		Version     int64
+		Description string
+		Up          MigrationFunc
+		Down        MigrationFunc
+	}
+
+	// MigrationStatus defines whether a migration has been applied
+	MigrationStatus struct {
+		Version     int64
+		Description string
+		Applied     bool
+		AppliedAt   time.Time
+	}
+
+	// Migrator applies and rolls back migrations, tracking them in the schema_migrations collection
+	Migrator struct {
+		handler    *MongoHandler
+		migrations []Migration
+		lock       migrationLock
+		owner      string
+		lockTTL    time.Duration
+		lockWait   time.Duration
+	}
+
+	// migrationLock keeps a single process migrating at a time, the lock expires lockTTL after being acquired or
+	// renewed so that a process that died doesn't keep it forever
+	migrationLock interface {
+		acquire(ctx context.Context, owner string, ttl time.Duration) error
+		renew(ctx context.Context, owner string, ttl time.Duration) error
+		release(owner string)
+	}
+
+	// mongoMigrationLock keeps the lock in the schema_migrations_lock collection
+	mongoMigrationLock struct {
+		handler *MongoHandler
+	}
+
+	// Bson data
+	migrationBSON struct {
+		Version     int64     `bson:"version"`
+		Description string    `bson:"description"`
+		AppliedAt   time.Time `bson:"applied_at"`
+	}
+)
+
+// NewMigrator creates new Migrator with its dependencies
+func NewMigrator(handler *MongoHandler, migrations []Migration) *Migrator {
+	hostname, _ := os.Hostname()
+
+	sorted := make([]Migration, len(migrations))
+	copy(sorted, migrations)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Version < sorted[j].Version
+	})
+
+	return &Migrator{
+		handler:    handler,
+		migrations: sorted,
+		lock:       mongoMigrationLock{handler: handler},
+		owner:      fmt.Sprintf("%s-%s", hostname, uuid.New().String()),
+		lockTTL:    5 * time.Minute,
+		lockWait:   2 * time.Minute,
+	}
+}
+
+// Up applies all pending migrations in version order and returns the ones applied
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+
+	err := m.withLock(ctx, func(ctx context.Context) error {
+		history, err := m.history(ctx)
+		if err != nil {
+			return err
+		}
+
+		pending, err := pendingMigrations(m.migrations, history)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range pending {
+			if err := migration.Up(ctx, m.handler.Db()); err != nil {
+				return fmt.Errorf("migration %d (%s) failed: %w", migration.Version, migration.Description, err)
+			}
+
+			if _, err := m.handler.Db().Collection(migrationsCollection).InsertOne(ctx, migrationBSON{
+				Version:     migration.Version,
+				Description: migration.Description,
+				AppliedAt:   time.Now(),
+			}); err != nil {
+				return err
+			}
+
+			applied = append(applied, migration)
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down rolls back the last applied migration
+func (m *Migrator) Down(ctx context.Context) (Migration, error) {
+	var rolledBack Migration
+
+	err := m.withLock(ctx, func(ctx context.Context) error {
+		history, err := m.history(ctx)
+		if err != nil {
+			return err
+		}
+
+		migration, err := lastAppliedMigration(m.migrations, history)
+		if err != nil {
+			return err
+		}
+
+		if migration.Down != nil {
+			if err := migration.Down(ctx, m.handler.Db()); err != nil {
+				return fmt.Errorf("rollback of migration %d (%s) failed: %w", migration.Version, migration.Description, err)
+			}
+		}
+
+		if _, err := m.handler.Db().Collection(migrationsCollection).DeleteOne(
+			ctx,
+			bson.M{"version": migration.Version},
+		); err != nil {
+			return err
+		}
+
+		rolledBack = migration
+
+		return nil
+	})
+
+	return rolledBack, err
+}
+
+// Status returns every known migration and whether it has been applied
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	history, err := m.history(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []MigrationStatus
+	for _, migration := range m.migrations {
+		appliedAt, ok := history[migration.Version]
+		statuses = append(statuses, MigrationStatus{
+			Version:     migration.Version,
+			Description: migration.Description,
+			Applied:     ok,
+			AppliedAt:   appliedAt,
+		})
+	}
+
+	return statuses, nil
+}
+
+func (m *Migrator) history(ctx context.Context) (map[int64]time.Time, error) {
+	cursor, err := m.handler.Db().Collection(migrationsCollection).Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var history = make(map[int64]time.Time)
+	for cursor.Next(ctx) {
+		var migration migrationBSON
+		if err := cursor.Decode(&migration); err != nil {
+			return nil, err
+		}
+
+		history[migration.Version] = migration.AppliedAt
+	}
+
+	return history, cursor.Err()
+}
+
+// withLock runs fn while holding the migrations lock, waiting for another process to release it if needed. The
+// lock is renewed every third of its TTL while fn runs, however long the migrations take, and fn is cancelled
+// when the lock is lost so that two processes never migrate at the same time
+func (m *Migrator) withLock(ctx context.Context, fn func(context.Context) error) error {
+	if err := m.waitLock(ctx); err != nil {
+		return err
+	}
+	defer m.lock.release(m.owner)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		lost    = make(chan error, 1)
+		renewed = make(chan struct{})
+	)
+	go func() {
+		defer close(renewed)
+		if err := m.keepLock(ctx); err != nil {
+			lost <- err
+			cancel()
+		}
+	}()
+
+	err := fn(ctx)
+	cancel()
+	<-renewed
+
+	select {
+	case lockErr := <-lost:
+		if errors.Is(lockErr, ErrMigrationLockLost) {
+			return lockErr
+		}
+
+		return fmt.Errorf("%w: %v", ErrMigrationLockLost, lockErr)
+	default:
+		return err
+	}
+}
+
+// waitLock acquires the migrations lock, trying again every second until lockWait passes
+func (m *Migrator) waitLock(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, m.lockWait)
+	defer cancel()
+
+	for {
+		err := m.lock.acquire(ctx, m.owner, m.lockTTL)
+		if err == nil {
+			return nil
+		}
+
+		if err != ErrMigrationLocked {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ErrMigrationLocked
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// keepLock renews the migrations lock until ctx is done, returning the error of a renewal that failed
+func (m *Migrator) keepLock(ctx context.Context) error {
+	ticker := time.NewTicker(m.lockTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := m.lock.renew(ctx, m.owner, m.lockTTL); err != nil && ctx.Err() == nil {
+				return err
+			}
+		}
+	}
+}
+
+// acquire upserts the lock document only when it is free or expired, the unique _id rejects concurrent holders
+func (l mongoMigrationLock) acquire(ctx context.Context, owner string, ttl time.Duration) error {
+	var (
+		now    = time.Now()
+		filter = bson.M{"_id": migrationsLockID, "expires_at": bson.M{"$lt": now}}
+		update = bson.M{"$set": bson.M{"owner": owner, "expires_at": now.Add(ttl)}}
+	)
+
+	_, err := l.handler.Db().Collection(migrationsLockCollection).UpdateOne(
+		ctx,
+		filter,
+		update,
+		options.Update().SetUpsert(true),
+	)
+	if IsDuplicateKeyError(err) {
+		return ErrMigrationLocked
+	}
+
+	return err
+}
+
+// renew extends the lock held by owner, failing with ErrMigrationLockLost when another process took it
+func (l mongoMigrationLock) renew(ctx context.Context, owner string, ttl time.Duration) error {
+	result, err := l.handler.Db().Collection(migrationsLockCollection).UpdateOne(
+		ctx,
+		bson.M{"_id": migrationsLockID, "owner": owner},
+		bson.M{"$set": bson.M{"expires_at": time.Now().Add(ttl)}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return ErrMigrationLockLost
+	}
+
+	return nil
+}
+
+func (l mongoMigrationLock) release(owner string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, _ = l.handler.Db().Collection(migrationsLockCollection).DeleteOne(
+		ctx,
+		bson.M{"_id": migrationsLockID, "owner": owner},
+	)
+}
+
+// pendingMigrations returns the migrations not yet applied, failing when the database is ahead of the code
+func pendingMigrations(migrations []Migration, history map[int64]time.Time) ([]Migration, error) {
+	var known = make(map[int64]bool)
+	for _, migration := range migrations {
+		known[migration.Version] = true
+	}
+
+	for version := range history {
+		if !known[version] {
+			return nil, fmt.Errorf("%w: version %d", ErrUnknownMigration, version)
+		}
+	}
+
+	var pending []Migration
+	for _, migration := range migrations {
+		if _, ok := history[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+
+	return pending, nil
+}
+
+// lastAppliedMigration returns the applied migration with the highest version
+func lastAppliedMigration(migrations []Migration, history map[int64]time.Time) (Migration, error) {
+	for i := len(migrations) - 1; i >= 0; i-- {
+		if _, ok := history[migrations[i].Version]; ok {
+			return migrations[i], nil
+		}
+	}
+
+	return Migration{}, ErrNoMigrationToRollback
+}
+
+// IsDuplicateKeyError reports whether err was caused by a unique index violation
+func IsDuplicateKeyError(err error) bool {
+	return IsDuplicateKeyErrorOn(err, "")
+}
+
+// IsDuplicateKeyErrorOn reports whether err was caused by a violation of the unique index named index,
+// of any unique index when index is empty
+func IsDuplicateKeyErrorOn(err error, index string) bool {
+	const duplicateKeyCode = 11000
+
+	var violated = func(code int, message string) bool {
+		return code == duplicateKeyCode && (index == "" || strings.Contains(message, "index: "+index+" "))
+	}
+
+	var writeException mongo.WriteException
+	if errors.As(err, &writeException) {
+		for _, we := range writeException.WriteErrors {
+			if violated(we.Code, we.Message) {
+				return true
+			}
+		}
+	}
+
+	var bulkException mongo.BulkWriteException
+	if errors.As(err, &bulkException) {
+		for _, we := range bulkException.WriteErrors {
+			if violated(we.Code, we.Message) {
+				return true
+			}
+		}
+	}
+
+	var commandError mongo.CommandError
+	if errors.As(err, &commandError) {
+		return violated(int(commandError.Code), commandError.Message)
+	}
+
+	return false
+}
@@ -0,0 +1,301 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestPendingMigrations(t *testing.T) {
+	var migrations = []Migration{
+		{Version: 1, Description: "first"},
+		{Version: 2, Description: "second"},
+		{Version: 3, Description: "third"},
+	}
+
+	tests := []struct {
+		name    string
+		history map[int64]time.Time
+		want    []int64
+		wantErr error
+	}{
+		{
+			name:    "All migrations pending",
+			history: map[int64]time.Time{},
+			want:    []int64{1, 2, 3},
+		},
+		{
+			name:    "Some migrations applied",
+			history: map[int64]time.Time{1: time.Now()},
+			want:    []int64{2, 3},
+		},
+		{
+			name:    "All migrations applied",
+			history: map[int64]time.Time{1: time.Now(), 2: time.Now(), 3: time.Now()},
+			want:    nil,
+		},
+		{
+			name:    "Unknown applied migration",
+			history: map[int64]time.Time{4: time.Now()},
+			wantErr: ErrUnknownMigration,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pendingMigrations(migrations, tt.history)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			var versions []int64
+			for _, migration := range got {
+				versions = append(versions, migration.Version)
+			}
+
+			if !reflect.DeepEqual(versions, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, versions, tt.want)
+			}
+		})
+	}
+}
+
+func TestLastAppliedMigration(t *testing.T) {
+	var migrations = []Migration{
+		{Version: 1, Description: "first"},
+		{Version: 2, Description: "second"},
+	}
+
+	tests := []struct {
+		name    string
+		history map[int64]time.Time
+		want    int64
+		wantErr error
+	}{
+		{
+			name:    "Last applied migration",
+			history: map[int64]time.Time{1: time.Now(), 2: time.Now()},
+			want:    2,
+		},
+		{
+			name:    "Only first migration applied",
+			history: map[int64]time.Time{1: time.Now()},
+			want:    1,
+		},
+		{
+			name:    "No migration applied",
+			history: map[int64]time.Time{},
+			wantErr: ErrNoMigrationToRollback,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := lastAppliedMigration(migrations, tt.history)
+			if err != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if got.Version != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Version, tt.want)
+			}
+		})
+	}
+}
+
+func TestMigrations_VersionsAreUnique(t *testing.T) {
+	var seen = make(map[int64]bool)
+	for _, migration := range Migrations() {
+		if seen[migration.Version] {
+			t.Errorf("duplicated migration version %d", migration.Version)
+		}
+		seen[migration.Version] = true
+
+		if migration.Up == nil {
+			t.Errorf("migration %d has no Up step", migration.Version)
+		}
+	}
+}
+
+func TestIsDuplicateKeyErrorOn(t *testing.T) {
+	var duplicated = fmt.Errorf("error creating transfer: %w", mongo.WriteException{WriteErrors: mongo.WriteErrors{{
+		Code:    11000,
+		Message: `E11000 duplicate key error collection: transfers index: payee_external_reference_unique dup key: { payee: "0db298eb", external_reference: "order-1" }`,
+	}}})
+
+	tests := []struct {
+		name  string
+		err   error
+		index string
+		want  bool
+	}{
+		{
+			name:  "Violation of the index",
+			err:   duplicated,
+			index: "payee_external_reference_unique",
+			want:  true,
+		},
+		{
+			name:  "Violation of any index",
+			err:   duplicated,
+			index: "",
+			want:  true,
+		},
+		{
+			name:  "Violation of another index",
+			err:   duplicated,
+			index: "id_unique",
+			want:  false,
+		},
+		{
+			name:  "Violation of an index prefixed by the index",
+			err:   duplicated,
+			index: "payee_external_reference",
+			want:  false,
+		},
+		{
+			name:  "Not a duplicate key error",
+			err:   mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 121, Message: "Document failed validation"}}},
+			index: "",
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsDuplicateKeyErrorOn(tt.err, tt.index); got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+// stubMigrationLock keeps the lock in memory with the same expiry as the collection
+type stubMigrationLock struct {
+	mu        sync.Mutex
+	owner     string
+	expiresAt time.Time
+	renewErr  error
+	renewals  int
+}
+
+func (s *stubMigrationLock) acquire(_ context.Context, owner string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.owner != "" && time.Now().Before(s.expiresAt) {
+		return ErrMigrationLocked
+	}
+
+	s.owner, s.expiresAt = owner, time.Now().Add(ttl)
+	return nil
+}
+
+func (s *stubMigrationLock) renew(_ context.Context, owner string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.renewErr != nil {
+		return s.renewErr
+	}
+
+	if s.owner != owner {
+		return ErrMigrationLockLost
+	}
+
+	s.expiresAt = time.Now().Add(ttl)
+	s.renewals++
+	return nil
+}
+
+func (s *stubMigrationLock) release(owner string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.owner == owner {
+		s.owner = ""
+	}
+}
+
+func TestMigrator_withLock(t *testing.T) {
+	const ttl = 30 * time.Millisecond
+
+	tests := []struct {
+		name          string
+		renewErr      error
+		run           time.Duration
+		wantErr       error
+		wantCancelled bool
+	}{
+		{
+			name: "Lock renewed while the migrations outlast its TTL",
+			run:  5 * ttl,
+		},
+		{
+			name:          "Migrations cancelled when the lock is lost",
+			renewErr:      ErrMigrationLockLost,
+			run:           5 * ttl,
+			wantErr:       ErrMigrationLockLost,
+			wantCancelled: true,
+		},
+		{
+			name:          "Migrations cancelled when the lock can't be renewed",
+			renewErr:      errors.New("connection refused"),
+			run:           5 * ttl,
+			wantErr:       ErrMigrationLockLost,
+			wantCancelled: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				lock      = &stubMigrationLock{renewErr: tt.renewErr}
+				migrator  = &Migrator{lock: lock, owner: "first", lockTTL: ttl, lockWait: time.Second}
+				other     = &Migrator{lock: lock, owner: "second", lockTTL: ttl, lockWait: time.Second}
+				stolen    bool
+				cancelled bool
+			)
+
+			err := migrator.withLock(context.Background(), func(ctx context.Context) error {
+				deadline := time.After(tt.run)
+				for {
+					select {
+					case <-ctx.Done():
+						cancelled = true
+						return ctx.Err()
+					case <-deadline:
+						return nil
+					case <-time.After(ttl / 2):
+						if lock.acquire(ctx, other.owner, other.lockTTL) == nil {
+							stolen = true
+						}
+					}
+				}
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if cancelled != tt.wantCancelled {
+				t.Errorf("[TestCase '%s'] Got cancelled: '%v' | Want cancelled: '%v'", tt.name, cancelled, tt.wantCancelled)
+			}
+
+			if tt.renewErr == nil && stolen {
+				t.Errorf("[TestCase '%s'] Got lock taken by another process while renewed", tt.name)
+			}
+
+			if tt.renewErr == nil && lock.renewals == 0 {
+				t.Errorf("[TestCase '%s'] Got renewals: '%v' | Want renewals", tt.name, lock.renewals)
+			}
+
+			if tt.renewErr == nil && lock.owner != "" {
+				t.Errorf("[TestCase '%s'] Got owner: '%v' | Want lock released", tt.name, lock.owner)
+			}
+		})
+	}
+}
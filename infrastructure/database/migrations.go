@@ -0,0 +1,478 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Migrations returns every migration of the application, new migrations must be appended with a higher version
+func Migrations() []Migration {
+	return []Migration{
+		{
+			Version:     1,
+			Description: "create users indexes",
+			Up:          createUsersIndexes,
+			Down:        dropIndexes("users", "id_unique", "email_unique", "document_value_unique"),
+		},
+		{
+			Version:     2,
+			Description: "create transfers indexes",
+			Up:          createTransfersIndexes,
+			Down:        dropIndexes("transfers", "id_unique", "payer_created_at", "payee_created_at"),
+		},
+		{
+			Version:     3,
+			Description: "add users validator",
+			Up:          addUsersValidator,
+			Down:        removeValidator("users"),
+		},
+		{
+			Version:     4,
+			Description: "backfill users roles",
+			Up:          backfillUsersRoles,
+			Down:        noop,
+		},
+		{
+			Version:     5,
+			Description: "create events and user snapshots indexes",
+			Up:          createEventsIndexes,
+			Down:        dropEventsIndexes,
+		},
+		{
+			Version:     6,
+			Description: "convert transfers created_at to date and backfill currency",
+			Up:          convertTransfersCreatedAt,
+			Down:        noop,
+		},
+		{
+			Version:     7,
+			Description: "create reconciliation checkpoints index",
+			Up:          createReconciliationCheckpointsIndexes,
+			Down:        dropIndexes("reconciliation_checkpoints", "at"),
+		},
+		{
+			Version:     8,
+			Description: "create movements indexes",
+			Up:          createMovementsIndexes,
+			Down:        dropIndexes("movements", "id_unique", "external_reference_unique", "user_created_at", "user_reversed_at"),
+		},
+		{
+			Version:     9,
+			Description: "create scheduled transfers indexes",
+			Up:          createScheduledTransfersIndexes,
+			Down:        dropIndexes("scheduled_transfers", "id_unique", "status_execute_at"),
+		},
+		{
+			Version:     10,
+			Description: "create recurring transfers indexes",
+			Up:          createRecurringTransfersIndexes,
+			Down:        dropIndexes("recurring_transfers", "id_unique", "status_next_run_at"),
+		},
+		{
+			Version:     11,
+			Description: "create transfer batches indexes",
+			Up:          createTransferBatchesIndexes,
+			Down:        dropIndexes("transfer_batches", "id_unique"),
+		},
+		{
+			Version:     12,
+			Description: "create transfers fee account index",
+			Up:          createTransfersFeeAccountIndex,
+			Down:        dropIndexes("transfers", "fee_account_created_at"),
+		},
+		{
+			Version:     13,
+			Description: "create holds indexes",
+			Up:          createHoldsIndexes,
+			Down:        dropIndexes("holds", "id_unique", "status_expires_at"),
+		},
+		{
+			Version:     14,
+			Description: "create escrow transfers indexes",
+			Up:          createEscrowTransfersIndexes,
+			Down:        dropIndexes("escrow_transfers", "id_unique", "status_release_at"),
+		},
+		{
+			Version:     15,
+			Description: "create disputes indexes",
+			Up:          createDisputesIndexes,
+			Down:        dropIndexes("disputes", "id_unique", "transfer_unique"),
+		},
+		{
+			Version:     16,
+			Description: "create transfers external reference index",
+			Up:          createTransfersExternalReferenceIndex,
+			Down:        dropIndexes("transfers", "payee_external_reference_unique"),
+		},
+	}
+}
+
+func createUsersIndexes(ctx context.Context, db *mongo.Database) error {
+	// The init script indexed "document.type" as unique, allowing a single user per document type
+	if _, err := db.Collection("users").Indexes().DropOne(ctx, "document.type_1"); err != nil && !isNotFoundError(err) {
+		return err
+	}
+
+	_, err := db.Collection("users").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetName("id_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "email", Value: 1}},
+			Options: options.Index().SetName("email_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "document.value", Value: 1}},
+			Options: options.Index().SetName("document_value_unique").SetUnique(true),
+		},
+	})
+
+	return err
+}
+
+func createTransfersIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("transfers").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetName("id_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "payer", Value: 1}, {Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("payer_created_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "payee", Value: 1}, {Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("payee_created_at"),
+		},
+	})
+
+	return err
+}
+
+func addUsersValidator(ctx context.Context, db *mongo.Database) error {
+	if err := ensureCollection(ctx, db, "users"); err != nil {
+		return err
+	}
+
+	return db.RunCommand(ctx, bson.D{
+		{Key: "collMod", Value: "users"},
+		{Key: "validator", Value: bson.M{
+			"$jsonSchema": bson.M{
+				"bsonType": "object",
+				"required": bson.A{"id", "email", "document", "wallet", "type"},
+				"properties": bson.M{
+					"id":    bson.M{"bsonType": "string"},
+					"email": bson.M{"bsonType": "string"},
+					"type":  bson.M{"enum": bson.A{"COMMON", "MERCHANT"}},
+					"document": bson.M{
+						"bsonType": "object",
+						"required": bson.A{"type", "value"},
+					},
+					"wallet": bson.M{
+						"bsonType": "object",
+						"required": bson.A{"currency", "amount"},
+						"properties": bson.M{
+							"amount": bson.M{"bsonType": "long", "minimum": 0},
+						},
+					},
+				},
+			},
+		}},
+		{Key: "validationLevel", Value: "moderate"},
+	}).Err()
+}
+
+func backfillUsersRoles(ctx context.Context, db *mongo.Database) error {
+	if _, err := db.Collection("users").UpdateMany(
+		ctx,
+		bson.M{"type": "COMMON", "roles.can_transfer": bson.M{"$ne": true}},
+		bson.M{"$set": bson.M{"roles.can_transfer": true}},
+	); err != nil {
+		return err
+	}
+
+	_, err := db.Collection("users").UpdateMany(
+		ctx,
+		bson.M{"type": "MERCHANT", "roles.can_transfer": bson.M{"$ne": false}},
+		bson.M{"$set": bson.M{"roles.can_transfer": false}},
+	)
+
+	return err
+}
+
+func createEventsIndexes(ctx context.Context, db *mongo.Database) error {
+	if _, err := db.Collection("events").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "aggregate_id", Value: 1}, {Key: "sequence", Value: 1}},
+			Options: options.Index().SetName("aggregate_sequence_unique").SetUnique(true),
+		},
+	}); err != nil {
+		return err
+	}
+
+	_, err := db.Collection("user_snapshots").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "aggregate_id", Value: 1}, {Key: "sequence", Value: -1}},
+			Options: options.Index().SetName("aggregate_sequence_unique").SetUnique(true),
+		},
+	})
+
+	return err
+}
+
+func dropEventsIndexes(ctx context.Context, db *mongo.Database) error {
+	if err := dropIndexes("events", "aggregate_sequence_unique")(ctx, db); err != nil {
+		return err
+	}
+
+	return dropIndexes("user_snapshots", "aggregate_sequence_unique")(ctx, db)
+}
+
+// convertTransfersCreatedAt parses the created_at persisted with time.Time.String() so transfers can be sorted and filtered by date
+func convertTransfersCreatedAt(ctx context.Context, db *mongo.Database) error {
+	const layout = "2006-01-02 15:04:05.999999999 -0700 MST"
+
+	collection := db.Collection("transfers")
+
+	cursor, err := collection.Find(ctx, bson.M{"created_at": bson.M{"$type": "string"}})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID        interface{} `bson:"_id"`
+			CreatedAt string      `bson:"created_at"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return err
+		}
+
+		// Drop the monotonic clock reading, e.g. "m=+0.000000001"
+		value := doc.CreatedAt
+		if i := strings.Index(value, " m="); i >= 0 {
+			value = value[:i]
+		}
+
+		createdAt, err := time.Parse(layout, value)
+		if err != nil {
+			return fmt.Errorf("transfer %v: %w", doc.ID, err)
+		}
+
+		if _, err := collection.UpdateOne(
+			ctx,
+			bson.M{"_id": doc.ID},
+			bson.M{"$set": bson.M{"created_at": createdAt}},
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+
+	_, err = collection.UpdateMany(
+		ctx,
+		bson.M{"currency": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"currency": "BRL"}},
+	)
+
+	return err
+}
+
+func createReconciliationCheckpointsIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("reconciliation_checkpoints").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "at", Value: -1}},
+		Options: options.Index().SetName("at"),
+	})
+
+	return err
+}
+
+func createMovementsIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("movements").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetName("id_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "external_reference", Value: 1}},
+			Options: options.Index().SetName("external_reference_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("user_created_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "reversed_at", Value: 1}},
+			Options: options.Index().SetName("user_reversed_at").SetSparse(true),
+		},
+	})
+
+	return err
+}
+
+func createScheduledTransfersIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("scheduled_transfers").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetName("id_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "execute_at", Value: 1}},
+			Options: options.Index().SetName("status_execute_at"),
+		},
+	})
+
+	return err
+}
+
+func createRecurringTransfersIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("recurring_transfers").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetName("id_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "next_run_at", Value: 1}},
+			Options: options.Index().SetName("status_next_run_at"),
+		},
+	})
+
+	return err
+}
+
+func createTransferBatchesIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("transfer_batches").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "id", Value: 1}},
+		Options: options.Index().SetName("id_unique").SetUnique(true),
+	})
+
+	return err
+}
+
+func createTransfersFeeAccountIndex(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("transfers").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "fee_account", Value: 1}, {Key: "created_at", Value: 1}},
+		Options: options.Index().SetName("fee_account_created_at").SetSparse(true),
+	})
+
+	return err
+}
+
+func createHoldsIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("holds").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetName("id_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "expires_at", Value: 1}},
+			Options: options.Index().SetName("status_expires_at"),
+		},
+	})
+
+	return err
+}
+
+func createEscrowTransfersIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("escrow_transfers").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetName("id_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "release_at", Value: 1}},
+			Options: options.Index().SetName("status_release_at"),
+		},
+	})
+
+	return err
+}
+
+func createDisputesIndexes(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("disputes").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetName("id_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "transfer_id", Value: 1}},
+			Options: options.Index().SetName("transfer_unique").SetUnique(true),
+		},
+	})
+
+	return err
+}
+
+// createTransfersExternalReferenceIndex keeps the external reference unique for each payee, the transfers
+// without one are left out of the index
+func createTransfersExternalReferenceIndex(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("transfers").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "payee", Value: 1}, {Key: "external_reference", Value: 1}},
+		Options: options.Index().
+			SetName("payee_external_reference_unique").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"external_reference": bson.M{"$type": "string"}}),
+	})
+
+	return err
+}
+
+func dropIndexes(collection string, names ...string) MigrationFunc {
+	return func(ctx context.Context, db *mongo.Database) error {
+		for _, name := range names {
+			if _, err := db.Collection(collection).Indexes().DropOne(ctx, name); err != nil && !isNotFoundError(err) {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func removeValidator(collection string) MigrationFunc {
+	return func(ctx context.Context, db *mongo.Database) error {
+		return db.RunCommand(ctx, bson.D{
+			{Key: "collMod", Value: collection},
+			{Key: "validator", Value: bson.M{}},
+		}).Err()
+	}
+}
+
+// noop is used by data backfills, whose effects are kept on rollback
+func noop(_ context.Context, _ *mongo.Database) error {
+	return nil
+}
+
+func ensureCollection(ctx context.Context, db *mongo.Database, name string) error {
+	names, err := db.ListCollectionNames(ctx, bson.M{"name": name})
+	if err != nil {
+		return err
+	}
+
+	if len(names) > 0 {
+		return nil
+	}
+
+	return db.CreateCollection(ctx, name)
+}
+
+func isNotFoundError(err error) bool {
+	const (
+		namespaceNotFoundCode = 26
+		indexNotFoundCode     = 27
+	)
+
+	cmdErr, ok := err.(mongo.CommandError)
+	return ok && (cmdErr.Code == namespaceNotFoundCode || cmdErr.Code == indexNotFoundCode)
+}
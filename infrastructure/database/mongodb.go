@@ -2,42 +2,86 @@ package database
 
 import (
 	"context"
-	"log"
-	"os"
 	"time"
 
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/config"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
 )
 
-// MongoHandler defines the MongoDb handler
-type MongoHandler struct {
-	db     *mongo.Database
-	client *mongo.Client
-}
+type (
+	// MongoHandler defines the MongoDb handler
+	MongoHandler struct {
+		db       *mongo.Database
+		client   *mongo.Client
+		observer TransactionObserver
+	}
 
-// NewMongoHandler creates new MongoHandler
-func NewMongoHandler() *MongoHandler {
+	// TransactionObserver records the retries of the transactions
+	TransactionObserver interface {
+		TransactionRetried(retries int)
+	}
+
+	// MongoOption configures the client of the MongoHandler
+	MongoOption func(*options.ClientOptions)
+)
+
+// NewMongoHandler creates new MongoHandler connected to the database of the configuration
+func NewMongoHandler(cfg config.MongoDB, opts ...MongoOption) (*MongoHandler, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 	defer cancel()
 
-	clientOpts := options.Client().ApplyURI(os.Getenv("MONGODB_URI"))
+	clientOpts := options.Client().ApplyURI(cfg.URI)
+	for _, o := range opts {
+		o(clientOpts)
+	}
+
 	client, err := mongo.Connect(ctx, clientOpts)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	err = client.Ping(ctx, nil)
 	if err != nil {
-		log.Fatal(err)
+		client.Disconnect(context.Background())
+		return nil, err
 	}
 
 	return &MongoHandler{
-		db:     client.Database(os.Getenv("MONGODB_DATABASE")),
+		db:     client.Database(cfg.Database),
 		client: client,
+	}, nil
+}
+
+// WithObserver records the retries of the transactions with the observer
+func (m *MongoHandler) WithObserver(o TransactionObserver) *MongoHandler {
+	m.observer = o
+	return m
+}
+
+// TransactionRetried records the retries of a transaction after transient errors
+func (m *MongoHandler) TransactionRetried(retries int) {
+	if m.observer != nil && retries > 0 {
+		m.observer.TransactionRetried(retries)
 	}
 }
 
+// Name returns the name of the dependency checked by the readiness probe
+func (m *MongoHandler) Name() string {
+	return "mongodb"
+}
+
+// Check pings the primary of the replica set
+func (m *MongoHandler) Check(ctx context.Context) error {
+	return m.client.Ping(ctx, readpref.Primary())
+}
+
+// Close disconnects the client, waiting for the operations in progress until the deadline of the context
+func (m *MongoHandler) Close(ctx context.Context) error {
+	return m.client.Disconnect(ctx)
+}
+
 // Client returns the client property
 func (m *MongoHandler) Client() *mongo.Client {
 	return m.client
@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// commandTracer starts a span for each command sent by the repositories, ended once the reply is received
+type commandTracer struct {
+	tracer trace.Tracer
+	spans  sync.Map
+}
+
+// WithTracing traces the commands, each span is a child of the span of the context of the repository call
+func WithTracing(tracer trace.Tracer) MongoOption {
+	return func(opts *options.ClientOptions) {
+		opts.SetMonitor(newCommandMonitor(tracer))
+	}
+}
+
+func newCommandMonitor(tracer trace.Tracer) *event.CommandMonitor {
+	c := &commandTracer{tracer: tracer}
+
+	return &event.CommandMonitor{
+		Started:   c.started,
+		Succeeded: c.succeeded,
+		Failed:    c.failed,
+	}
+}
+
+func (c *commandTracer) started(ctx context.Context, evt *event.CommandStartedEvent) {
+	var (
+		name  = evt.CommandName
+		attrs = []attribute.KeyValue{
+			attribute.String("db.system", "mongodb"),
+			attribute.String("db.name", evt.DatabaseName),
+			attribute.String("db.operation", evt.CommandName),
+		}
+	)
+
+	// the value of the command is the collection for the commands of the repositories, as insert or find
+	if collection, ok := evt.Command.Lookup(evt.CommandName).StringValueOK(); ok {
+		name = fmt.Sprintf("%s %s", evt.CommandName, collection)
+		attrs = append(attrs, attribute.String("db.mongodb.collection", collection))
+	}
+
+	_, span := c.tracer.Start(
+		ctx,
+		"mongodb."+name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	c.spans.Store(evt.RequestID, span)
+}
+
+func (c *commandTracer) succeeded(_ context.Context, evt *event.CommandSucceededEvent) {
+	if span, ok := c.end(evt.RequestID); ok {
+		span.End()
+	}
+}
+
+func (c *commandTracer) failed(_ context.Context, evt *event.CommandFailedEvent) {
+	if span, ok := c.end(evt.RequestID); ok {
+		span.SetStatus(codes.Error, evt.Failure)
+		span.End()
+	}
+}
+
+// end returns the span of the command, forgotten once its reply is received
+func (c *commandTracer) end(requestID int64) (trace.Span, bool) {
+	value, ok := c.spans.Load(requestID)
+	if !ok {
+		return nil, false
+	}
+
+	c.spans.Delete(requestID)
+	return value.(trace.Span), true
+}
@@ -0,0 +1,81 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestCommandMonitor(t *testing.T) {
+	tests := []struct {
+		name           string
+		command        bson.D
+		failure        string
+		expectedName   string
+		expectedStatus codes.Code
+	}{
+		{
+			name:         "Command on a collection",
+			command:      bson.D{{Key: "insert", Value: "transfers"}},
+			expectedName: "mongodb.insert transfers",
+		},
+		{
+			name:         "Command without collection",
+			command:      bson.D{{Key: "ping", Value: 1}},
+			expectedName: "mongodb.ping",
+		},
+		{
+			name:           "Command failed",
+			command:        bson.D{{Key: "update", Value: "users"}},
+			failure:        "WriteConflict",
+			expectedName:   "mongodb.update users",
+			expectedStatus: codes.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				exporter = tracetest.NewInMemoryExporter()
+				provider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+				monitor  = newCommandMonitor(provider.Tracer("test"))
+				ctx      = context.Background()
+			)
+
+			command, err := bson.Marshal(tt.command)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			finished := event.CommandFinishedEvent{CommandName: tt.command[0].Key, RequestID: 1}
+			monitor.Started(ctx, &event.CommandStartedEvent{
+				Command:      command,
+				DatabaseName: "challenge",
+				CommandName:  tt.command[0].Key,
+				RequestID:    1,
+			})
+			if tt.failure != "" {
+				monitor.Failed(ctx, &event.CommandFailedEvent{CommandFinishedEvent: finished, Failure: tt.failure})
+			} else {
+				monitor.Succeeded(ctx, &event.CommandSucceededEvent{CommandFinishedEvent: finished})
+			}
+
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("[TestCase '%s'] Got spans: '%v' | Want spans: '%v'", tt.name, len(spans), 1)
+			}
+
+			if spans[0].Name != tt.expectedName {
+				t.Errorf("[TestCase '%s'] Got name: '%v' | Want name: '%v'", tt.name, spans[0].Name, tt.expectedName)
+			}
+
+			if spans[0].StatusCode != tt.expectedStatus {
+				t.Errorf("[TestCase '%s'] Got status: '%v' | Want status: '%v'", tt.name, spans[0].StatusCode, tt.expectedStatus)
+			}
+		})
+	}
+}
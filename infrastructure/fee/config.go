@@ -0,0 +1,122 @@
+package fee
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+const (
+	// Fee rule types of the configuration file
+	flatFee       = "FLAT"
+	percentageFee = "PERCENTAGE"
+	tieredFee     = "TIERED"
+)
+
+type (
+	scheduleConfig struct {
+		FeeAccountID string       `json:"fee_account_id"`
+		Rules        []ruleConfig `json:"rules"`
+	}
+
+	ruleConfig struct {
+		UserType   string       `json:"user_type"`
+		ChargedOn  string       `json:"charged_on"`
+		Type       string       `json:"type"`
+		Value      int64        `json:"value"`
+		Percentage float64      `json:"percentage"`
+		Tiers      []tierConfig `json:"tiers"`
+	}
+
+	tierConfig struct {
+		UpTo       int64   `json:"up_to"`
+		Value      int64   `json:"value"`
+		Percentage float64 `json:"percentage"`
+	}
+)
+
+// Load reads the fee schedule from the JSON file at path, without a path no fees are charged
+func Load(path string) (entity.FeeSchedule, error) {
+	if path == "" {
+		return entity.FeeSchedule{}, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return entity.FeeSchedule{}, err
+	}
+	defer file.Close()
+
+	return Parse(file)
+}
+
+// Parse decodes a fee schedule, each rule is a FLAT value, a PERCENTAGE of the transfer value or TIERED by
+// the transfer value, charged on SEND to the payer or on RECEIPT to the payee of the user type
+func Parse(r io.Reader) (entity.FeeSchedule, error) {
+	var config scheduleConfig
+	if err := json.NewDecoder(r).Decode(&config); err != nil {
+		return entity.FeeSchedule{}, err
+	}
+
+	var account vo.Uuid
+	if config.FeeAccountID != "" {
+		ID, err := vo.NewUuid(config.FeeAccountID)
+		if err != nil {
+			return entity.FeeSchedule{}, fmt.Errorf("fee account: %w", err)
+		}
+		account = ID
+	}
+
+	var rules = make([]entity.FeeRule, 0, len(config.Rules))
+	for n, rule := range config.Rules {
+		tiers, err := rule.tiers()
+		if err != nil {
+			return entity.FeeSchedule{}, fmt.Errorf("rule %d: %w", n+1, err)
+		}
+
+		feeRule, err := entity.NewFeeRule(
+			vo.TypeUser(strings.ToUpper(rule.UserType)),
+			entity.FeeCharge(strings.ToUpper(rule.ChargedOn)),
+			tiers,
+		)
+		if err != nil {
+			return entity.FeeSchedule{}, fmt.Errorf("rule %d: %w", n+1, err)
+		}
+
+		rules = append(rules, feeRule)
+	}
+
+	return entity.NewFeeSchedule(account, rules)
+}
+
+func (r ruleConfig) tiers() ([]entity.FeeTier, error) {
+	var configs []tierConfig
+	switch strings.ToUpper(r.Type) {
+	case flatFee:
+		configs = []tierConfig{{Value: r.Value}}
+	case percentageFee:
+		configs = []tierConfig{{Percentage: r.Percentage}}
+	case tieredFee:
+		configs = r.Tiers
+	default:
+		return nil, fmt.Errorf("invalid fee type %q, expected FLAT, PERCENTAGE or TIERED", r.Type)
+	}
+
+	var tiers = make([]entity.FeeTier, 0, len(configs))
+	for _, config := range configs {
+		tier, err := entity.NewFeeTier(config.UpTo, config.Value, int64(math.Round(config.Percentage*100)))
+		if err != nil {
+			return nil, err
+		}
+
+		tiers = append(tiers, tier)
+	}
+
+	return tiers, nil
+}
@@ -0,0 +1,103 @@
+package fee
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    string
+		value     int64
+		wantPayer int64
+		wantPayee int64
+		wantErr   error
+	}{
+		{
+			name:   "Without rules",
+			config: `{"rules":[]}`,
+			value:  1000,
+		},
+		{
+			name: "Flat, percentage and tiered rules",
+			config: `{
+				"fee_account_id": "00000000-0000-4000-8000-000000000001",
+				"rules": [
+					{"user_type": "merchant", "charged_on": "receipt", "type": "percentage", "percentage": 2.5},
+					{"user_type": "COMMON", "charged_on": "SEND", "type": "FLAT", "value": 10},
+					{"user_type": "COMMON", "charged_on": "SEND", "type": "TIERED", "tiers": [
+						{"up_to": 500, "value": 0},
+						{"value": 5, "percentage": 1}
+					]}
+				]
+			}`,
+			value:     1000,
+			wantPayer: 25,
+			wantPayee: 25,
+		},
+		{
+			name:    "Rules without fee account",
+			config:  `{"rules":[{"user_type":"COMMON","charged_on":"SEND","type":"FLAT","value":10}]}`,
+			wantErr: entity.ErrFeeAccountRequired,
+		},
+		{
+			name: "Invalid charge",
+			config: `{
+				"fee_account_id": "00000000-0000-4000-8000-000000000001",
+				"rules": [{"user_type":"COMMON","charged_on":"ALWAYS","type":"FLAT","value":10}]
+			}`,
+			wantErr: entity.ErrInvalidFeeCharge,
+		},
+		{
+			name: "Invalid tiers",
+			config: `{
+				"fee_account_id": "00000000-0000-4000-8000-000000000001",
+				"rules": [{"user_type":"COMMON","charged_on":"SEND","type":"TIERED","tiers":[{"value":1},{"up_to":10}]}]
+			}`,
+			wantErr: entity.ErrInvalidFeeTiers,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(strings.NewReader(tt.config))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			fee, err := got.Quote(vo.COMMON, vo.MERCHANT, vo.NewMoneyBRL(vo.NewAmountTest(tt.value)))
+			if err != nil {
+				t.Fatalf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, nil)
+			}
+
+			if fee.Payer().Amount().Value() != tt.wantPayer || fee.Payee().Amount().Value() != tt.wantPayee {
+				t.Errorf(
+					"[TestCase '%s'] Got: '%v/%v' | Want: '%v/%v'",
+					tt.name,
+					fee.Payer().Amount().Value(),
+					fee.Payee().Amount().Value(),
+					tt.wantPayer,
+					tt.wantPayee,
+				)
+			}
+		})
+	}
+}
+
+func TestParseInvalidType(t *testing.T) {
+	_, err := Parse(strings.NewReader(`{
+		"fee_account_id": "00000000-0000-4000-8000-000000000001",
+		"rules": [{"user_type":"COMMON","charged_on":"SEND","type":"DAILY"}]
+	}`))
+	if err == nil || !strings.Contains(err.Error(), "rule 1") {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Invalid fee type", err, "rule 1: invalid fee type")
+	}
+}
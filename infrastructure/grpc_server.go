@@ -0,0 +1,74 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/grpc/interceptor"
+	"github.com/GSabadini/golang-clean-architecture/adapter/grpc/pb"
+	"github.com/GSabadini/golang-clean-architecture/adapter/grpc/service"
+	adapterlogger "github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// GRPCServer define the gRPC API, wired with the same use cases as the HTTPServer
+type GRPCServer struct {
+	app    *HTTPServer
+	logger adapterlogger.Logger
+	server *grpc.Server
+}
+
+// NewGRPCServer creates new GRPCServer sharing the dependencies of the HTTPServer
+func NewGRPCServer(app *HTTPServer) *GRPCServer {
+	return &GRPCServer{
+		app:    app,
+		logger: app.logger,
+		server: grpc.NewServer(grpc.ChainUnaryInterceptor(
+			interceptor.NewCorrelationID().Execute,
+			interceptor.NewTracing(app.tracing.Tracer()).Execute,
+			interceptor.NewLogger(app.logger).Execute,
+			interceptor.NewRecovery(app.logger).Execute,
+		)),
+	}
+}
+
+// Start run the gRPC API at the port of the configuration, accepting requests until Stop is called
+func (g GRPCServer) Start() error {
+	pb.RegisterUserServiceServer(
+		g.server,
+		service.NewUserService(g.app.createUserUseCase(), g.app.findUserByIDUseCase(), g.logger),
+	)
+	pb.RegisterTransferServiceServer(
+		g.server,
+		service.NewTransferService(g.app.createTransferUseCase(g.app.notifier()), g.logger),
+	)
+	reflection.Register(g.server)
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%s", g.app.config.GRPC.Port))
+	if err != nil {
+		return err
+	}
+
+	g.logger.WithFields(adapterlogger.Fields{"port": g.app.config.GRPC.Port}).Infof("Starting gRPC Server")
+	return g.server.Serve(listener)
+}
+
+// Stop stops accepting requests and waits for the ones in progress, those still running at the deadline of
+// the context are cancelled
+func (g GRPCServer) Stop(ctx context.Context) error {
+	var done = make(chan struct{})
+	go func() {
+		g.server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		g.server.Stop()
+		return ctx.Err()
+	}
+}
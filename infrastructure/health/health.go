@@ -0,0 +1,165 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	StatusUp   = "UP"
+	StatusDown = "DOWN"
+)
+
+type (
+	// Checker defines a dependency of the application checked by the readiness probe
+	Checker interface {
+		Name() string
+		Check(context.Context) error
+	}
+
+	// Readiness defines the probe checking the dependencies registered, caching the report for a while so
+	// frequent probes do not overload them
+	Readiness struct {
+		mu        sync.Mutex
+		checkers  []Checker
+		timeout   time.Duration
+		ttl       time.Duration
+		report    ReadinessReport
+		checkedAt time.Time
+		now       func() time.Time
+	}
+
+	// ReadinessReport defines the status of the application, UP when every dependency is UP
+	ReadinessReport struct {
+		Status     string            `json:"status"`
+		CheckedAt  time.Time         `json:"checked_at"`
+		Components []ComponentStatus `json:"components"`
+	}
+
+	// ComponentStatus defines the status of a dependency and the latency of its check
+	ComponentStatus struct {
+		Name      string  `json:"name"`
+		Status    string  `json:"status"`
+		LatencyMs float64 `json:"latency_ms"`
+		Error     string  `json:"error,omitempty"`
+	}
+
+	checkerFunc struct {
+		name  string
+		check func(context.Context) error
+	}
+)
+
+// NewReadiness creates new Readiness giving each check up to timeout and caching the report for ttl
+func NewReadiness(timeout time.Duration, ttl time.Duration) *Readiness {
+	return &Readiness{
+		timeout: timeout,
+		ttl:     ttl,
+		now:     time.Now,
+	}
+}
+
+// Register adds a dependency checked by the probe
+func (r *Readiness) Register(c Checker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.checkers = append(r.checkers, c)
+	r.checkedAt = time.Time{}
+}
+
+// Check returns the report of the dependencies, checked concurrently unless the cached report is fresh
+func (r *Readiness) Check(ctx context.Context) ReadinessReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.checkedAt.IsZero() && r.now().Sub(r.checkedAt) < r.ttl {
+		return r.report
+	}
+
+	var (
+		components = make([]ComponentStatus, len(r.checkers))
+		wg         sync.WaitGroup
+	)
+	for i, c := range r.checkers {
+		wg.Add(1)
+		go func(i int, c Checker) {
+			defer wg.Done()
+			components[i] = r.check(ctx, c)
+		}(i, c)
+	}
+	wg.Wait()
+
+	var report = ReadinessReport{Status: StatusUp, CheckedAt: r.now().UTC(), Components: components}
+	for _, component := range components {
+		if component.Status != StatusUp {
+			report.Status = StatusDown
+		}
+	}
+
+	r.report, r.checkedAt = report, r.now()
+
+	return report
+}
+
+func (r *Readiness) check(ctx context.Context, c Checker) ComponentStatus {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var (
+		start  = r.now()
+		err    = c.Check(ctx)
+		status = ComponentStatus{
+			Name:      c.Name(),
+			Status:    StatusUp,
+			LatencyMs: float64(r.now().Sub(start).Microseconds()) / 1000,
+		}
+	)
+	if err != nil {
+		status.Status = StatusDown
+		status.Error = err.Error()
+	}
+
+	return status
+}
+
+// NewChecker creates new Checker of a dependency from its name and check function
+func NewChecker(name string, check func(context.Context) error) Checker {
+	return checkerFunc{name: name, check: check}
+}
+
+// Name returns the name property
+func (c checkerFunc) Name() string {
+	return c.name
+}
+
+// Check returns the error of the check function
+func (c checkerFunc) Check(ctx context.Context) error {
+	return c.check(ctx)
+}
+
+// NewHTTPChecker creates new Checker requesting the uri, the dependency is down when unreachable or answering
+// with a server error
+func NewHTTPChecker(name string, uri string, client *http.Client) Checker {
+	return NewChecker(name, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+		if err != nil {
+			return err
+		}
+
+		res, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("unexpected status code %d", res.StatusCode)
+		}
+
+		return nil
+	})
+}
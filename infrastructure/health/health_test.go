@@ -0,0 +1,166 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// countingChecker defines a dependency counting how many times it is checked
+type countingChecker struct {
+	name  string
+	err   error
+	delay time.Duration
+	calls int
+}
+
+func (c *countingChecker) Name() string {
+	return c.name
+}
+
+func (c *countingChecker) Check(ctx context.Context) error {
+	c.calls++
+
+	select {
+	case <-time.After(c.delay):
+		return c.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func TestReadiness_Check(t *testing.T) {
+	tests := []struct {
+		name             string
+		checkers         []Checker
+		expectedStatus   string
+		expectedStatuses []string
+		expectedErrors   []string
+	}{
+		{
+			name: "Every dependency up",
+			checkers: []Checker{
+				&countingChecker{name: "mongodb"},
+				&countingChecker{name: "rabbitmq"},
+			},
+			expectedStatus:   StatusUp,
+			expectedStatuses: []string{StatusUp, StatusUp},
+			expectedErrors:   []string{"", ""},
+		},
+		{
+			name: "Dependency down",
+			checkers: []Checker{
+				&countingChecker{name: "mongodb"},
+				&countingChecker{name: "rabbitmq", err: errors.New("channel closed")},
+			},
+			expectedStatus:   StatusDown,
+			expectedStatuses: []string{StatusUp, StatusDown},
+			expectedErrors:   []string{"", "channel closed"},
+		},
+		{
+			name: "Dependency timed out",
+			checkers: []Checker{
+				&countingChecker{name: "mongodb", delay: time.Second},
+				&countingChecker{name: "rabbitmq"},
+			},
+			expectedStatus:   StatusDown,
+			expectedStatuses: []string{StatusDown, StatusUp},
+			expectedErrors:   []string{context.DeadlineExceeded.Error(), ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var readiness = NewReadiness(20*time.Millisecond, time.Minute)
+			for _, c := range tt.checkers {
+				readiness.Register(c)
+			}
+
+			got := readiness.Check(context.Background())
+
+			if got.Status != tt.expectedStatus {
+				t.Errorf("[TestCase '%s'] Got status: '%v' | Want status: '%v'", tt.name, got.Status, tt.expectedStatus)
+			}
+
+			if len(got.Components) != len(tt.checkers) {
+				t.Fatalf("[TestCase '%s'] Got components: '%v' | Want components: '%v'", tt.name, len(got.Components), len(tt.checkers))
+			}
+
+			for i, component := range got.Components {
+				if component.Name != tt.checkers[i].Name() {
+					t.Errorf("[TestCase '%s'] Got name: '%v' | Want name: '%v'", tt.name, component.Name, tt.checkers[i].Name())
+				}
+
+				if component.Status != tt.expectedStatuses[i] {
+					t.Errorf("[TestCase '%s'] Got %s status: '%v' | Want %s status: '%v'", tt.name, component.Name, component.Status, component.Name, tt.expectedStatuses[i])
+				}
+
+				if component.Error != tt.expectedErrors[i] {
+					t.Errorf("[TestCase '%s'] Got %s error: '%v' | Want %s error: '%v'", tt.name, component.Name, component.Error, component.Name, tt.expectedErrors[i])
+				}
+			}
+		})
+	}
+}
+
+func TestReadiness_CheckCached(t *testing.T) {
+	var (
+		checker   = &countingChecker{name: "mongodb"}
+		now       = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+		readiness = NewReadiness(time.Second, 5*time.Second)
+	)
+	readiness.now = func() time.Time { return now }
+	readiness.Register(checker)
+
+	readiness.Check(context.Background())
+	now = now.Add(4 * time.Second)
+	readiness.Check(context.Background())
+
+	if checker.calls != 1 {
+		t.Errorf("Got checks within the ttl: '%v' | Want checks within the ttl: '%v'", checker.calls, 1)
+	}
+
+	now = now.Add(time.Second)
+	readiness.Check(context.Background())
+
+	if checker.calls != 2 {
+		t.Errorf("Got checks after the ttl: '%v' | Want checks after the ttl: '%v'", checker.calls, 2)
+	}
+}
+
+func TestHTTPChecker_Check(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		expectedErr bool
+	}{
+		{
+			name:   "Authorizer up",
+			status: http.StatusOK,
+		},
+		{
+			name:   "Authorizer refusing is still up",
+			status: http.StatusForbidden,
+		},
+		{
+			name:        "Authorizer down",
+			status:      http.StatusServiceUnavailable,
+			expectedErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			err := NewHTTPChecker("authorizer", server.URL, server.Client()).Check(context.Background())
+			if (err != nil) != tt.expectedErr {
+				t.Errorf("[TestCase '%s'] Got error: '%v' | Want error: '%v'", tt.name, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -15,10 +15,22 @@ type (
 		Execute(func() (interface{}, error)) (interface{}, error)
 	}
 
+	// StatefulBreaker is a Breaker reporting its state, closed, half-open or open.
+	StatefulBreaker interface {
+		State() string
+	}
+
+	// BreakerObserver records the state of a circuit breaker after each request.
+	BreakerObserver interface {
+		CircuitBreakerState(name string, state string)
+	}
+
 	// CircuitBreaker is the application http transport.
 	CircuitBreaker struct {
-		rt      http.RoundTripper
-		breaker Breaker
+		rt       http.RoundTripper
+		breaker  Breaker
+		name     string
+		observer BreakerObserver
 	}
 )
 
@@ -38,6 +50,13 @@ func NewCircuitBreaker(cb Breaker) *CircuitBreaker {
 	}
 }
 
+// WithObserver records the state of the breaker by its name, when the breaker is a StatefulBreaker.
+func (t *CircuitBreaker) WithObserver(name string, o BreakerObserver) *CircuitBreaker {
+	t.name = name
+	t.observer = o
+	return t
+}
+
 // RoundTrip decorates rt.RoundTrip with a circuit breaker.
 // An error is returned if the circuit breaker rejects the request.
 func (t *CircuitBreaker) RoundTrip(r *http.Request) (*http.Response, error) {
@@ -53,6 +72,7 @@ func (t *CircuitBreaker) RoundTrip(r *http.Request) (*http.Response, error) {
 
 		return res, err
 	})
+	t.observe()
 
 	if err != nil {
 		return nil, err
@@ -60,3 +80,13 @@ func (t *CircuitBreaker) RoundTrip(r *http.Request) (*http.Response, error) {
 
 	return res.(*http.Response), err
 }
+
+func (t *CircuitBreaker) observe() {
+	if t.observer == nil {
+		return
+	}
+
+	if breaker, ok := t.breaker.(StatefulBreaker); ok {
+		t.observer.CircuitBreakerState(t.name, breaker.State())
+	}
+}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"net/http"
 )
 
@@ -16,7 +17,7 @@ func NewClient(r *Request) *Client {
 	return &Client{r}
 }
 
-// Get executes a GET http request
-func (c *Client) Get(url string) (*http.Response, error) {
-	return c.req.Do(http.MethodGet, url, "application/json", nil)
+// Get executes a GET http request within the context
+func (c *Client) Get(ctx context.Context, url string) (*http.Response, error) {
+	return c.req.Do(ctx, http.MethodGet, url, "application/json", nil)
 }
@@ -6,6 +6,8 @@ import (
 	"io"
 	"net/http"
 	"time"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -31,23 +33,21 @@ func NewRequest(opts ...RequestOption) *Request {
 	return r
 }
 
-// Do is a convenient method for executing http requests.
-func (r *Request) Do(method, url, contentType string, body io.Reader) (*http.Response, error) {
-	req, err := http.NewRequest(method, url, body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request %v: ", err)
-	}
-
-	req.Header.Set("Content-Type", contentType)
-
+// Do is a convenient method for executing http requests, within the context.
+func (r *Request) Do(ctx context.Context, method, url, contentType string, body io.Reader) (*http.Response, error) {
 	if r.client.Timeout == 0 {
 		r.client.Timeout = defaultTimeout
 	}
 
-	ctx, cancel := context.WithTimeout(req.Context(), r.client.Timeout)
+	ctx, cancel := context.WithTimeout(ctx, r.client.Timeout)
 	defer cancel()
 
-	req = req.WithContext(ctx)
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request %v: ", err)
+	}
+
+	req.Header.Set("Content-Type", contentType)
 
 	return r.client.Do(req)
 }
@@ -66,6 +66,13 @@ func WithRetry(rt http.RoundTripper) RequestOption {
 	}
 }
 
+// WithTracing traces the requests, decorating the http.RoundTripper of the previous options.
+func WithTracing(tracer trace.Tracer) RequestOption {
+	return func(r *Request) {
+		r.client.Transport = NewTracing(tracer, r.client.Transport)
+	}
+}
+
 func WithTimeout(t time.Duration) RequestOption {
 	return func(r *Request) {
 		r.client.Timeout = t
@@ -19,6 +19,12 @@ type (
 		sleep       time.Duration
 		statusCodes []int
 		rt          http.RoundTripper
+		observer    RetryObserver
+	}
+
+	// RetryObserver records the attempts retried of the requests to a host.
+	RetryObserver interface {
+		RequestRetried(host string)
 	}
 
 	// Func is the function to be executed and eventually retried.
@@ -43,6 +49,12 @@ func NewRetry(attempts int, statusCode []int, sleep time.Duration) *Retry {
 	}
 }
 
+// WithObserver records every attempt retried with the observer.
+func (r *Retry) WithObserver(o RetryObserver) *Retry {
+	r.observer = o
+	return r
+}
+
 // RoundTrip decorates RoundTrip with a retry.
 func (r *Retry) RoundTrip(req *http.Request) (*http.Response, error) {
 	var res *http.Response
@@ -63,7 +75,13 @@ func (r *Retry) RoundTrip(req *http.Request) (*http.Response, error) {
 		return res, err
 	}
 
+	var attempt int
 	err = retry(func() error {
+		attempt++
+		if attempt > 1 && r.observer != nil {
+			r.observer.RequestRetried(req.URL.Host)
+		}
+
 		var err error
 		res, err = fn()
 		return err
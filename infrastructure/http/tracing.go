@@ -0,0 +1,67 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing is the http transport tracing the requests.
+type Tracing struct {
+	rt         http.RoundTripper
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+// NewTracing returns a new configured Tracing decorating rt.
+func NewTracing(tracer trace.Tracer, rt http.RoundTripper) *Tracing {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	return &Tracing{
+		rt:         rt,
+		tracer:     tracer,
+		propagator: propagation.TraceContext{},
+	}
+}
+
+// RoundTrip decorates rt.RoundTrip with a client span, child of the span of the context of the request, and
+// propagates it to the server in the W3C traceparent header.
+func (t *Tracing) RoundTrip(req *http.Request) (*http.Response, error) {
+	// the credentials of the URL are not kept in the span
+	url := *req.URL
+	url.User = nil
+
+	ctx, span := t.tracer.Start(
+		req.Context(),
+		fmt.Sprintf("HTTP %s %s", req.Method, req.URL.Host),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.method", req.Method),
+			attribute.String("http.url", url.String()),
+		),
+	)
+	defer span.End()
+
+	req = req.Clone(ctx)
+	t.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	res, err := t.rt.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return res, err
+	}
+
+	span.SetAttributes(attribute.Int("http.status_code", res.StatusCode))
+	if res.StatusCode >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(res.StatusCode))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,74 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracing_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         int
+		expectedStatus codes.Code
+	}{
+		{
+			name:   "Request traced",
+			status: http.StatusOK,
+		},
+		{
+			name:           "Request failed by a server error",
+			status:         http.StatusInternalServerError,
+			expectedStatus: codes.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var traceparent string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				traceparent = r.Header.Get("traceparent")
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			var (
+				exporter = tracetest.NewInMemoryExporter()
+				provider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+				tracer   = provider.Tracer("test")
+			)
+
+			ctx, parent := tracer.Start(context.Background(), "parent")
+			res, err := NewRequest(WithTracing(tracer)).Do(ctx, http.MethodGet, server.URL, "application/json", nil)
+			if err != nil {
+				t.Fatalf("[TestCase '%s'] Got error: '%v' | Want error: '%v'", tt.name, err, nil)
+			}
+			res.Body.Close()
+			parent.End()
+
+			spans := exporter.GetSpans()
+			if len(spans) != 2 {
+				t.Fatalf("[TestCase '%s'] Got spans: '%v' | Want spans: '%v'", tt.name, len(spans), 2)
+			}
+
+			client := spans[0]
+			if client.Parent.SpanID() != parent.SpanContext().SpanID() {
+				t.Errorf("[TestCase '%s'] Got parent: '%v' | Want parent: '%v'", tt.name, client.Parent.SpanID(), parent.SpanContext().SpanID())
+			}
+
+			want := "00-" + client.SpanContext.TraceID().String() + "-" + client.SpanContext.SpanID().String()
+			if !strings.HasPrefix(traceparent, want) {
+				t.Errorf("[TestCase '%s'] Got traceparent: '%v' | Want traceparent: '%v'", tt.name, traceparent, want)
+			}
+
+			if client.StatusCode != tt.expectedStatus {
+				t.Errorf("[TestCase '%s'] Got status: '%v' | Want status: '%v'", tt.name, client.StatusCode, tt.expectedStatus)
+			}
+		})
+	}
+}
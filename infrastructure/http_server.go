@@ -1,110 +1,717 @@
 package infrastructure
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
-	"os"
 	"time"
 
 	"github.com/GSabadini/golang-clean-architecture/adapter/api/handler"
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/middleware"
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/openapi"
 	adapterhttp "github.com/GSabadini/golang-clean-architecture/adapter/http"
 	adapterlogger "github.com/GSabadini/golang-clean-architecture/adapter/logger"
 	"github.com/GSabadini/golang-clean-architecture/adapter/presenter"
 	adapterqueue "github.com/GSabadini/golang-clean-architecture/adapter/queue"
 	"github.com/GSabadini/golang-clean-architecture/adapter/repository"
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/config"
 	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/fee"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/health"
 	infrahttp "github.com/GSabadini/golang-clean-architecture/infrastructure/http"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/lifecycle"
 	"github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/metrics"
 	"github.com/GSabadini/golang-clean-architecture/infrastructure/queue"
 	"github.com/GSabadini/golang-clean-architecture/infrastructure/router"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/tracing"
 	"github.com/GSabadini/golang-clean-architecture/usecase"
 )
 
+// transferBatchConcurrency is the number of items of a best-effort batch transferred at the same time
+const transferBatchConcurrency = 8
+
 // HTTPServer define an application structure
 type HTTPServer struct {
-	database *database.MongoHandler
-	logger   adapterlogger.Logger
-	router   router.Router
-	queue    *queue.RabbitMQHandler
+	config     config.Config
+	database   *database.MongoHandler
+	logger     adapterlogger.Logger
+	router     router.Router
+	queue      *queue.RabbitMQHandler
+	background *lifecycle.Tracker
+	readiness  *health.Readiness
+	metrics    *metrics.Prometheus
+	tracing    *tracing.Provider
+	fees       entity.FeeSchedule
+	escrow     vo.Uuid
 }
 
-// NewHTTPServer creates new HTTPServer with its dependencies
-func NewHTTPServer() *HTTPServer {
+// NewHTTPServer creates new HTTPServer with its dependencies, connecting to MongoDB and then to RabbitMQ, the
+// commands sent to MongoDB are traced
+func NewHTTPServer(cfg config.Config) (*HTTPServer, error) {
+	fees, err := fee.Load(cfg.FeeConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	escrow, err := newEscrowAccount(cfg.EscrowAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	prometheus := metrics.NewPrometheus()
+
+	log := logger.NewLogrus()
+
+	tracer, err := tracing.New(cfg.Tracing, log)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := database.NewMongoHandler(cfg.MongoDB, database.WithTracing(tracer.Tracer()))
+	if err != nil {
+		tracer.Shutdown(context.Background())
+		return nil, fmt.Errorf("connect to mongodb: %w", err)
+	}
+	db.WithObserver(prometheus)
+
+	rabbitmq, err := queue.NewRabbitMQHandler(cfg.RabbitMQ)
+	if err != nil {
+		db.Close(context.Background())
+		tracer.Shutdown(context.Background())
+		return nil, fmt.Errorf("connect to rabbitmq: %w", err)
+	}
+
 	return &HTTPServer{
-		database: database.NewMongoHandler(),
-		logger:   logger.NewLogrus(),
-		router:   router.NewMux(),
-		queue:    queue.NewRabbitMQHandler(),
+		config:     cfg,
+		database:   db,
+		logger:     log,
+		router:     router.NewMux(cfg.HTTP.ReadTimeout, cfg.HTTP.WriteTimeout),
+		queue:      rabbitmq,
+		background: &lifecycle.Tracker{},
+		readiness:  newReadiness(cfg, db, rabbitmq),
+		metrics:    prometheus,
+		tracing:    tracer,
+		fees:       fees,
+		escrow:     escrow,
+	}, nil
+}
+
+// newReadiness returns the readiness probe of MongoDB and RabbitMQ and, when configured, of the external authorizer
+func newReadiness(cfg config.Config, checkers ...health.Checker) *health.Readiness {
+	readiness := health.NewReadiness(cfg.Health.CheckTimeout, cfg.Health.CacheTTL)
+	for _, checker := range checkers {
+		readiness.Register(checker)
+	}
+
+	if cfg.Health.CheckAuthorizer {
+		readiness.Register(health.NewHTTPChecker("authorizer", cfg.Authorizer.URI, &http.Client{}))
+	}
+
+	return readiness
+}
+
+// newEscrowAccount returns the account keeping the funds of the escrow transfers, without it no escrow
+// transfers are created
+func newEscrowAccount(ID string) (vo.Uuid, error) {
+	if ID == "" {
+		return vo.Uuid{}, nil
+	}
+
+	return vo.NewUuid(ID)
+}
+
+// Start run the application, accepting requests until Stop is called
+func (a HTTPServer) Start() error {
+	spec := apiSpec()
+	a.router.USE(middleware.NewTracing(a.tracing.Tracer()).Execute)
+	a.router.USE(middleware.NewRequestLogger(a.logger).Execute)
+	a.router.USE(middleware.NewAccessLog(a.logger).Execute)
+	a.router.USE(middleware.NewMetrics(a.metrics).Execute)
+	a.router.USE(middleware.NewRecovery(a.logger).Execute)
+	if a.config.HTTP.OpenAPIValidation {
+		a.router.USE(middleware.NewRequestValidation(spec, a.logger).Execute)
+	}
+
+	a.routes(spec)
+
+	a.logger.WithFields(adapterlogger.Fields{"port": a.config.HTTP.Port}).Infof("Starting HTTP Server")
+	return a.router.SERVE(a.config.HTTP.Port)
+}
+
+// Stop stops accepting requests and waits for the ones in progress until the deadline of the context
+func (a HTTPServer) Stop(ctx context.Context) error {
+	return a.router.SHUTDOWN(ctx)
+}
+
+// Close waits for the work running in background, then closes the connections to RabbitMQ and MongoDB and
+// finally exports the spans not exported yet
+func (a HTTPServer) Close(ctx context.Context) error {
+	var errs lifecycle.Errors
+	if err := a.background.Wait(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("background work: %w", err))
+	}
+	if err := a.queue.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("close rabbitmq: %w", err))
+	}
+	if err := a.database.Close(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("close mongodb: %w", err))
+	}
+	if err := a.tracing.Shutdown(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("flush traces: %w", err))
 	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
 }
 
-// Start run the application
-func (a HTTPServer) Start() {
+// routes registers the routes of the API, each one documented by apiRoutes
+func (a HTTPServer) routes(spec openapi.Document) {
 	a.router.GET("/health", healthCheck)
+	a.router.GET("/health/live", healthCheck)
+	a.router.GET("/health/ready", a.readinessCheck())
+	a.router.GET("/metrics", a.metrics.Handler().ServeHTTP)
+	a.router.GET("/openapi.json", openAPIHandler(spec))
+	a.router.GET("/docs", docsHandler)
 
 	a.router.POST("/users", a.createUserHandler())
 	a.router.GET("/users/{user_id}", a.findUserByIDHandler())
+	a.router.GET("/users/{user_id}/events", a.findUserEventsHandler())
+	a.router.GET("/users/{user_id}/statement", a.getStatementHandler())
+	a.router.PUT("/admin/users/{user_id}/kyc-level", a.admin(a.updateKYCLevelHandler()))
 
+	a.router.GET("/transfers", a.searchTransfersHandler())
 	a.router.POST("/transfers", a.createTransferHandler())
+	a.router.POST("/transfers/split", a.createSplitTransferHandler())
+	a.router.GET("/transfers/quote", a.quoteTransferHandler())
+	a.router.GET("/scheduled-transfers/{scheduled_transfer_id}", a.findScheduledTransferHandler())
+	a.router.POST("/scheduled-transfers/{scheduled_transfer_id}/cancel", a.cancelScheduledTransferHandler())
+	a.router.POST("/recurring-transfers", a.createRecurringTransferHandler())
+	a.router.GET("/recurring-transfers/{recurring_transfer_id}", a.findRecurringTransferHandler())
+	a.router.PUT("/recurring-transfers/{recurring_transfer_id}", a.updateRecurringTransferHandler())
+	a.router.DELETE("/recurring-transfers/{recurring_transfer_id}", a.cancelRecurringTransferHandler())
+
+	a.router.POST("/transfer-batches", a.createTransferBatchHandler())
+	a.router.GET("/transfer-batches/{transfer_batch_id}", a.findTransferBatchHandler())
+
+	a.router.POST("/holds", a.createHoldHandler())
+	a.router.POST("/holds/{hold_id}/capture", a.captureHoldHandler())
+	a.router.POST("/holds/{hold_id}/void", a.voidHoldHandler())
+
+	a.router.POST("/escrow-transfers", a.createEscrowTransferHandler())
+	a.router.POST("/escrow-transfers/{escrow_transfer_id}/confirm", a.confirmEscrowTransferHandler())
+	a.router.POST("/escrow-transfers/{escrow_transfer_id}/dispute", a.disputeEscrowTransferHandler())
+	a.router.POST("/escrow-transfers/{escrow_transfer_id}/resolve", a.resolveEscrowTransferHandler())
+
+	a.router.POST("/transfers/{transfer_id}/disputes", a.openDisputeHandler())
+	a.router.GET("/disputes/{dispute_id}", a.findDisputeHandler())
+	a.router.POST("/disputes/{dispute_id}/evidence", a.addDisputeEvidenceHandler())
+	a.router.POST("/disputes/{dispute_id}/review", a.reviewDisputeHandler())
+	a.router.POST("/disputes/{dispute_id}/resolve", a.resolveDisputeHandler())
 
-	a.logger.WithFields(adapterlogger.Fields{"port": os.Getenv("APP_PORT")}).Infof("Starting HTTP Server")
-	a.router.SERVE(os.Getenv("APP_PORT"))
+	a.router.POST("/deposits", a.depositHandler())
+	a.router.POST("/withdrawals", a.withdrawHandler())
+	a.router.POST("/movements/{movement_id}/reverse", a.reverseMovementHandler())
+}
+
+// admin restricts the handler to the requests bearing ADMIN_TOKEN, without it the handler is never reached
+func (a HTTPServer) admin(h http.HandlerFunc) http.HandlerFunc {
+	return middleware.NewAdminAuth(a.config.AdminToken, a.logger).Execute(h).ServeHTTP
+}
+
+// migrate applies the pending migrations of the database
+func (a HTTPServer) migrate(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+	defer cancel()
+
+	applied, err := database.NewMigrator(a.database, database.Migrations()).Up(ctx)
+	for _, migration := range applied {
+		a.logger.WithFields(adapterlogger.Fields{
+			"version":     migration.Version,
+			"description": migration.Description,
+		}).Infof("migration applied")
+	}
+
+	return err
 }
 
 func (a HTTPServer) createTransferHandler() http.HandlerFunc {
-	authorizer := adapterhttp.NewAuthorizer(
-		infrahttp.NewClient(
-			infrahttp.NewRequest(
-				infrahttp.WithRetry(infrahttp.NewRetry(3, []int{http.StatusInternalServerError}, 400*time.Millisecond)),
-				infrahttp.WithTimeout(5*time.Second),
-			),
-		),
-		a.logger,
+	ucSchedule := usecase.NewScheduleTransferInteractor(
+		repository.NewScheduledTransferRepository(a.database),
+		repository.NewFindUserByIDUserRepository(a.database),
+		presenter.NewScheduledTransferPresenter(),
 	)
 
-	notifier := adapterhttp.NewNotifier(
-		infrahttp.NewClient(
-			infrahttp.NewRequest(
-				infrahttp.WithRetry(infrahttp.NewRetry(3, []int{http.StatusInternalServerError}, 400*time.Millisecond)),
-				infrahttp.WithTimeout(5*time.Second),
-			),
-		),
-		adapterqueue.NewProducer(a.queue.Channel(), a.queue.Queue().Name, a.logger),
-		a.logger,
+	return handler.NewCreateTransferHandler(a.createTransferUseCase(a.notifier()), ucSchedule, a.logger).Handle
+}
+
+func (a HTTPServer) createSplitTransferHandler() http.HandlerFunc {
+	events := repository.NewEventStoreRepository(a.database)
+
+	uc := usecase.NewCreateSplitTransferInteractor(
+		repository.NewEventSourcedTransferCreator(repository.NewCreateTransferRepository(a.database), events),
+		a.userWalletUpdater(events),
+		repository.NewFindUserByIDUserRepository(a.database),
+		repository.NewSumSentTransfersRepository(a.database),
+		repository.NewEventSourcedAuthorizer(a.authorizer(), events),
+		a.notifier(),
+		a.fees,
+		presenter.NewCreateSplitTransferPresenter(),
+	)
+
+	return handler.NewCreateSplitTransferHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) findScheduledTransferHandler() http.HandlerFunc {
+	uc := usecase.NewFindScheduledTransferInteractor(
+		repository.NewScheduledTransferRepository(a.database),
+		presenter.NewScheduledTransferPresenter())
+
+	return handler.NewFindScheduledTransferHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) cancelScheduledTransferHandler() http.HandlerFunc {
+	repo := repository.NewScheduledTransferRepository(a.database)
+	uc := usecase.NewCancelScheduledTransferInteractor(repo, repo, presenter.NewScheduledTransferPresenter())
+
+	return handler.NewCancelScheduledTransferHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) createRecurringTransferHandler() http.HandlerFunc {
+	uc := usecase.NewCreateRecurringTransferInteractor(
+		repository.NewRecurringTransferRepository(a.database),
+		repository.NewFindUserByIDUserRepository(a.database),
+		presenter.NewRecurringTransferPresenter(),
 	)
 
+	return handler.NewCreateRecurringTransferHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) findRecurringTransferHandler() http.HandlerFunc {
+	uc := usecase.NewFindRecurringTransferInteractor(
+		repository.NewRecurringTransferRepository(a.database),
+		presenter.NewRecurringTransferPresenter())
+
+	return handler.NewFindRecurringTransferHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) updateRecurringTransferHandler() http.HandlerFunc {
+	repo := repository.NewRecurringTransferRepository(a.database)
+	uc := usecase.NewUpdateRecurringTransferInteractor(repo, repo, presenter.NewRecurringTransferPresenter())
+
+	return handler.NewUpdateRecurringTransferHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) cancelRecurringTransferHandler() http.HandlerFunc {
+	repo := repository.NewRecurringTransferRepository(a.database)
+	uc := usecase.NewCancelRecurringTransferInteractor(repo, repo, presenter.NewRecurringTransferPresenter())
+
+	return handler.NewCancelRecurringTransferHandler(uc, a.logger).Handle
+}
+
+// createTransferUseCase returns the transfer use case shared by the HTTP and gRPC APIs, the batches and the scheduler worker
+func (a HTTPServer) createTransferUseCase(notifier usecase.Notifier) usecase.CreateTransferUseCase {
+	events := repository.NewEventStoreRepository(a.database)
+
 	uc := usecase.NewCreateTransferInteractor(
-		repository.NewCreateTransferRepository(a.database),
-		repository.NewUpdateUserWalletRepository(a.database),
+		repository.NewEventSourcedTransferCreator(repository.NewCreateTransferRepository(a.database), events),
+		a.userWalletUpdater(events),
 		repository.NewFindUserByIDUserRepository(a.database),
-		authorizer,
+		repository.NewSumSentTransfersRepository(a.database),
+		repository.NewEventSourcedAuthorizer(a.authorizer(), events),
 		notifier,
+		a.fees,
+		a.tracing,
 		presenter.NewCreateTransferPresenter(),
 	)
 
-	return handler.NewCreateTransferHandler(uc, a.logger).Handle
+	return usecase.NewMeasuredCreateTransfer(uc, a.metrics)
+}
+
+func (a HTTPServer) searchTransfersHandler() http.HandlerFunc {
+	uc := usecase.NewSearchTransfersInteractor(
+		repository.NewSearchTransfersRepository(a.database),
+		repository.NewFindUserByIDUserRepository(a.database),
+		presenter.NewSearchTransfersPresenter(),
+	)
+
+	return handler.NewSearchTransfersHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) quoteTransferHandler() http.HandlerFunc {
+	uc := usecase.NewQuoteTransferInteractor(
+		repository.NewFindUserByIDUserRepository(a.database),
+		a.fees,
+		presenter.NewQuoteTransferPresenter(),
+	)
+
+	return handler.NewQuoteTransferHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) createTransferBatchHandler() http.HandlerFunc {
+	repo := repository.NewTransferBatchRepository(a.database)
+	uc := usecase.NewCreateTransferBatchInteractor(
+		repo,
+		repository.NewFindUserByIDUserRepository(a.database),
+		presenter.NewTransferBatchPresenter(),
+	)
+	ucProcess := usecase.NewProcessTransferBatchInteractor(
+		repo,
+		repo,
+		repository.NewFindTransferByIDRepository(a.database),
+		a.createTransferUseCase(adapterhttp.NewNopNotifier()),
+		a.notifier(),
+		presenter.NewTransferBatchPresenter(),
+		transferBatchConcurrency,
+	)
+
+	return handler.NewCreateTransferBatchHandler(uc, ucProcess, a.background, a.logger).Handle
+}
+
+func (a HTTPServer) findTransferBatchHandler() http.HandlerFunc {
+	uc := usecase.NewFindTransferBatchInteractor(
+		repository.NewTransferBatchRepository(a.database),
+		presenter.NewTransferBatchPresenter())
+
+	return handler.NewFindTransferBatchHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) createHoldHandler() http.HandlerFunc {
+	uc := usecase.NewCreateHoldInteractor(
+		repository.NewHoldRepository(a.database),
+		repository.NewFindUserByIDUserRepository(a.database),
+		a.userHolder(repository.NewEventStoreRepository(a.database)),
+		presenter.NewHoldPresenter(),
+	)
+
+	return handler.NewCreateHoldHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) captureHoldHandler() http.HandlerFunc {
+	uc := usecase.NewCaptureHoldInteractor(
+		repository.NewHoldRepository(a.database),
+		repository.NewFindUserByIDUserRepository(a.database),
+		a.userHolder(repository.NewEventStoreRepository(a.database)),
+		a.createTransferUseCase(adapterhttp.NewNopNotifier()),
+		a.notifier(),
+		presenter.NewCaptureHoldPresenter(),
+	)
+
+	return handler.NewCaptureHoldHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) voidHoldHandler() http.HandlerFunc {
+	uc := usecase.NewVoidHoldInteractor(
+		repository.NewHoldRepository(a.database),
+		repository.NewFindUserByIDUserRepository(a.database),
+		a.userHolder(repository.NewEventStoreRepository(a.database)),
+		presenter.NewHoldPresenter(),
+	)
+
+	return handler.NewVoidHoldHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) createEscrowTransferHandler() http.HandlerFunc {
+	events := repository.NewEventStoreRepository(a.database)
+
+	uc := usecase.NewCreateEscrowTransferInteractor(
+		repository.NewEscrowTransferRepository(a.database),
+		repository.NewEventSourcedTransferCreator(repository.NewCreateTransferRepository(a.database), events),
+		a.userWalletUpdater(events),
+		repository.NewFindUserByIDUserRepository(a.database),
+		repository.NewSumSentTransfersRepository(a.database),
+		repository.NewEventSourcedAuthorizer(a.authorizer(), events),
+		a.escrow,
+		presenter.NewEscrowTransferPresenter(),
+	)
+
+	return handler.NewCreateEscrowTransferHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) confirmEscrowTransferHandler() http.HandlerFunc {
+	events := repository.NewEventStoreRepository(a.database)
+
+	uc := usecase.NewConfirmEscrowTransferInteractor(
+		repository.NewEscrowTransferRepository(a.database),
+		repository.NewEventSourcedTransferCreator(repository.NewCreateTransferRepository(a.database), events),
+		a.userWalletUpdater(events),
+		repository.NewFindUserByIDUserRepository(a.database),
+		a.notifier(),
+		presenter.NewEscrowTransferPresenter(),
+	)
+
+	return handler.NewConfirmEscrowTransferHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) disputeEscrowTransferHandler() http.HandlerFunc {
+	uc := usecase.NewDisputeEscrowTransferInteractor(
+		repository.NewEscrowTransferRepository(a.database),
+		presenter.NewEscrowTransferPresenter(),
+	)
+
+	return handler.NewDisputeEscrowTransferHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) resolveEscrowTransferHandler() http.HandlerFunc {
+	events := repository.NewEventStoreRepository(a.database)
+
+	uc := usecase.NewResolveEscrowTransferInteractor(
+		repository.NewEscrowTransferRepository(a.database),
+		repository.NewEventSourcedTransferCreator(repository.NewCreateTransferRepository(a.database), events),
+		a.userWalletUpdater(events),
+		repository.NewFindUserByIDUserRepository(a.database),
+		a.notifier(),
+		presenter.NewEscrowTransferPresenter(),
+	)
+
+	return handler.NewResolveEscrowTransferHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) openDisputeHandler() http.HandlerFunc {
+	uc := usecase.NewOpenDisputeInteractor(
+		repository.NewDisputeRepository(a.database),
+		repository.NewFindTransferByIDRepository(a.database),
+		repository.NewFindUserByIDUserRepository(a.database),
+		a.userHolder(repository.NewEventStoreRepository(a.database)),
+		a.disputeNotifier(),
+		presenter.NewDisputePresenter(),
+	)
+
+	return handler.NewOpenDisputeHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) findDisputeHandler() http.HandlerFunc {
+	uc := usecase.NewFindDisputeInteractor(
+		repository.NewDisputeRepository(a.database),
+		presenter.NewDisputePresenter(),
+	)
+
+	return handler.NewFindDisputeHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) addDisputeEvidenceHandler() http.HandlerFunc {
+	uc := usecase.NewAddDisputeEvidenceInteractor(
+		repository.NewDisputeRepository(a.database),
+		a.disputeNotifier(),
+		presenter.NewDisputePresenter(),
+	)
+
+	return handler.NewAddDisputeEvidenceHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) reviewDisputeHandler() http.HandlerFunc {
+	uc := usecase.NewReviewDisputeInteractor(
+		repository.NewDisputeRepository(a.database),
+		presenter.NewDisputePresenter(),
+	)
+
+	return handler.NewReviewDisputeHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) resolveDisputeHandler() http.HandlerFunc {
+	events := repository.NewEventStoreRepository(a.database)
+
+	uc := usecase.NewResolveDisputeInteractor(
+		repository.NewDisputeRepository(a.database),
+		repository.NewEventSourcedTransferCreator(repository.NewCreateTransferRepository(a.database), events),
+		repository.NewFindUserByIDUserRepository(a.database),
+		a.userWalletUpdater(events),
+		a.userHolder(events),
+		a.disputeNotifier(),
+		presenter.NewDisputePresenter(),
+	)
+
+	return handler.NewResolveDisputeHandler(uc, a.logger).Handle
 }
 
 func (a HTTPServer) createUserHandler() http.HandlerFunc {
-	uc := usecase.NewCreateUserInteractor(
-		repository.NewCreateUserRepository(a.database),
-		presenter.NewCreateUserPresenter())
+	return handler.NewCreateUserHandler(a.createUserUseCase(), a.logger).Handle
+}
 
-	return handler.NewCreateUserHandler(uc, a.logger).Handle
+// createUserUseCase returns the user creation use case shared by the HTTP and gRPC APIs
+func (a HTTPServer) createUserUseCase() usecase.CreateUserUseCase {
+	return usecase.NewCreateUserInteractor(
+		repository.NewEventSourcedUserCreator(
+			a.database,
+			repository.NewCreateUserRepository(a.database),
+			repository.NewEventStoreRepository(a.database),
+		),
+		presenter.NewCreateUserPresenter())
 }
 
 func (a HTTPServer) findUserByIDHandler() http.HandlerFunc {
-	uc := usecase.NewFindUserByIDInteractor(
+	return handler.NewFindUserByIDHandler(a.findUserByIDUseCase(), a.logger).Handle
+}
+
+// findUserByIDUseCase returns the user finder use case shared by the HTTP and gRPC APIs
+func (a HTTPServer) findUserByIDUseCase() usecase.FindUserByIDUseCase {
+	return usecase.NewFindUserByIDInteractor(
 		repository.NewFindUserByIDUserRepository(a.database),
 		presenter.NewFindUserByIDPresenter())
+}
+
+func (a HTTPServer) updateKYCLevelHandler() http.HandlerFunc {
+	uc := usecase.NewUpdateKYCLevelInteractor(
+		repository.NewFindUserByIDUserRepository(a.database),
+		repository.NewEventSourcedUserKYCUpdater(
+			repository.NewUpdateUserKYCLevelRepository(a.database),
+			repository.NewFindUserByIDUserRepository(a.database),
+			repository.NewEventStoreRepository(a.database),
+			repository.NewUserSnapshotRepository(a.database),
+		),
+		presenter.NewKYCLevelPresenter())
+
+	return handler.NewUpdateKYCLevelHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) findUserEventsHandler() http.HandlerFunc {
+	uc := usecase.NewFindUserEventsInteractor(
+		repository.NewFindUserByIDUserRepository(a.database),
+		repository.NewEventStoreRepository(a.database),
+		presenter.NewFindUserEventsPresenter())
+
+	return handler.NewFindUserEventsHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) getStatementHandler() http.HandlerFunc {
+	uc := usecase.NewGetStatementInteractor(
+		repository.NewFindUserByIDUserRepository(a.database),
+		repository.NewFindTransfersByUserIDRepository(a.database),
+		repository.NewFindMovementsRepository(a.database),
+		presenter.NewGetStatementPresenter())
+
+	return handler.NewGetStatementHandler(uc, a.logger).Handle
+}
 
-	return handler.NewFindUserByIDHandler(uc, a.logger).Handle
+func (a HTTPServer) depositHandler() http.HandlerFunc {
+	uc := usecase.NewDepositInteractor(
+		repository.NewCreateMovementRepository(a.database),
+		a.userWalletUpdater(repository.NewEventStoreRepository(a.database)),
+		repository.NewFindUserByIDUserRepository(a.database),
+		a.authorizer(),
+		presenter.NewMovementPresenter())
+
+	return handler.NewDepositHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) withdrawHandler() http.HandlerFunc {
+	uc := usecase.NewWithdrawInteractor(
+		repository.NewCreateMovementRepository(a.database),
+		a.userWalletUpdater(repository.NewEventStoreRepository(a.database)),
+		repository.NewFindUserByIDUserRepository(a.database),
+		a.authorizer(),
+		presenter.NewMovementPresenter())
+
+	return handler.NewWithdrawHandler(uc, a.logger).Handle
+}
+
+func (a HTTPServer) reverseMovementHandler() http.HandlerFunc {
+	uc := usecase.NewReverseMovementInteractor(
+		repository.NewFindMovementsRepository(a.database),
+		repository.NewReverseMovementRepository(a.database),
+		a.userWalletUpdater(repository.NewEventStoreRepository(a.database)),
+		repository.NewFindUserByIDUserRepository(a.database),
+		presenter.NewMovementPresenter())
+
+	return handler.NewReverseMovementHandler(uc, a.logger).Handle
+}
+
+// notifier returns the client notifying the payees of their transfers
+func (a HTTPServer) notifier() usecase.Notifier {
+	return adapterhttp.NewNotifier(
+		a.httpClient(a.config.Notifier),
+		a.config.Notifier.URI,
+		adapterqueue.NewProducer(a.queue.Channel(), a.queue.Queue().Name, a.tracing.Tracer(), a.logger),
+		a.metrics,
+		a.logger,
+	)
 }
 
+// disputeNotifier returns the client of the external notification service notifying the parties of the disputes
+func (a HTTPServer) disputeNotifier() usecase.DisputeNotifier {
+	return adapterhttp.NewDisputeNotifier(
+		a.httpClient(a.config.Notifier),
+		a.config.Notifier.URI,
+		adapterqueue.NewProducer(a.queue.Channel(), a.queue.Queue().Name, a.tracing.Tracer(), a.logger),
+		a.metrics,
+		a.logger,
+	)
+}
+
+// authorizer returns the client of the external authorizer of transfers, deposits and withdrawals
+func (a HTTPServer) authorizer() usecase.Authorizer {
+	return adapterhttp.NewAuthorizer(a.httpClient(a.config.Authorizer), a.config.Authorizer.URI, a.metrics, a.logger)
+}
+
+// httpClient returns the client of an external service, retrying its server errors within the span of the request
+func (a HTTPServer) httpClient(cfg config.Client) *infrahttp.Client {
+	retry := infrahttp.NewRetry(cfg.RetryAttempts, []int{http.StatusInternalServerError}, cfg.RetryBackoff)
+
+	return infrahttp.NewClient(
+		infrahttp.NewRequest(
+			infrahttp.WithRetry(retry.WithObserver(a.metrics)),
+			infrahttp.WithTracing(a.tracing.Tracer()),
+			infrahttp.WithTimeout(cfg.Timeout),
+		),
+	)
+}
+
+// userWalletUpdater returns the wallet updater recording every balance change in the event store
+func (a HTTPServer) userWalletUpdater(events entity.EventRepository) entity.UserRepositoryUpdater {
+	return repository.NewEventSourcedUserUpdater(
+		repository.NewUpdateUserWalletRepository(a.database),
+		repository.NewFindUserByIDUserRepository(a.database),
+		events,
+		repository.NewUserSnapshotRepository(a.database),
+	)
+}
+
+// userHolder returns the updater of the money held recording every change in the event store
+func (a HTTPServer) userHolder(events entity.EventRepository) entity.UserRepositoryHolder {
+	return repository.NewEventSourcedUserHolder(
+		repository.NewUpdateUserHeldRepository(a.database),
+		repository.NewFindUserByIDUserRepository(a.database),
+		events,
+		repository.NewUserSnapshotRepository(a.database),
+	)
+}
+
+// healthCheckResponse defines the body of the health check
+type healthCheckResponse struct {
+	Status string `json:"status"`
+}
+
+// healthCheck answers the liveness probe, the process is alive while it answers whatever its dependencies
 func healthCheck(w http.ResponseWriter, _ *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(struct {
-		Status string `json:"status"`
-	}{Status: http.StatusText(http.StatusOK)})
+	json.NewEncoder(w).Encode(healthCheckResponse{Status: http.StatusText(http.StatusOK)})
+}
+
+// readinessCheck answers the readiness probe with the status of each dependency, 503 Service Unavailable
+// when any of them is down
+func (a HTTPServer) readinessCheck() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		report := a.readiness.Check(r.Context())
+
+		status := http.StatusOK
+		if report.Status != health.StatusUp {
+			status = http.StatusServiceUnavailable
+			a.logger.WithFields(adapterlogger.Fields{
+				"key":         "readiness_check",
+				"components":  report.Components,
+				"http_status": status,
+			}).Errorf("dependencies not ready")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(report)
+	}
 }
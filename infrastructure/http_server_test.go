@@ -0,0 +1,85 @@
+package infrastructure
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/config"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/metrics"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/queue"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/tracing"
+)
+
+// routeRecorder defines a router keeping the routes registered instead of serving them
+type routeRecorder struct {
+	routes []string
+}
+
+func (r *routeRecorder) GET(uri string, _ func(w http.ResponseWriter, r *http.Request)) {
+	r.routes = append(r.routes, http.MethodGet+" "+uri)
+}
+
+func (r *routeRecorder) POST(uri string, _ func(w http.ResponseWriter, r *http.Request)) {
+	r.routes = append(r.routes, http.MethodPost+" "+uri)
+}
+
+func (r *routeRecorder) PUT(uri string, _ func(w http.ResponseWriter, r *http.Request)) {
+	r.routes = append(r.routes, http.MethodPut+" "+uri)
+}
+
+func (r *routeRecorder) DELETE(uri string, _ func(w http.ResponseWriter, r *http.Request)) {
+	r.routes = append(r.routes, http.MethodDelete+" "+uri)
+}
+
+func (r *routeRecorder) USE(_ func(http.Handler) http.Handler) {}
+
+func (r *routeRecorder) SERVE(_ string) error { return nil }
+
+func (r *routeRecorder) SHUTDOWN(_ context.Context) error { return nil }
+
+func TestHTTPServer_routesDocumented(t *testing.T) {
+	provider, err := tracing.New(config.Default().Tracing, logger.Dummy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		recorder = &routeRecorder{}
+		server   = HTTPServer{
+			logger:  logger.Dummy{},
+			router:  recorder,
+			queue:   &queue.RabbitMQHandler{},
+			metrics: metrics.NewPrometheus(),
+			tracing: provider,
+		}
+		spec = apiSpec()
+	)
+
+	server.routes(spec)
+
+	var registered = make(map[string]bool)
+	for _, route := range recorder.routes {
+		registered[route] = true
+
+		method, path := splitRoute(route)
+		if _, ok := spec.Operation(method, path); !ok {
+			t.Errorf("[TestCase '%s'] Route registered but missing from the OpenAPI document", route)
+		}
+	}
+
+	for path, item := range spec.Paths {
+		for method := range item {
+			if route := strings.ToUpper(method) + " " + path; !registered[route] {
+				t.Errorf("[TestCase '%s'] Route documented but not registered", route)
+			}
+		}
+	}
+}
+
+func splitRoute(route string) (string, string) {
+	parts := strings.SplitN(route, " ", 2)
+	return parts[0], parts[1]
+}
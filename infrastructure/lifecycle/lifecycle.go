@@ -0,0 +1,218 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+)
+
+type (
+	// Hook defines how a component of the application is started and stopped, every function is optional
+	Hook struct {
+		Name string
+		// Start prepares the component, returning once it is ready
+		Start func(context.Context) error
+		// Run blocks while the component works, such as a server accepting requests
+		Run func() error
+		// Stop releases the component before the deadline of the context
+		Stop func(context.Context) error
+	}
+
+	// Manager defines the lifecycle of the components of the application, started in the order they are
+	// appended and stopped in the reverse order
+	Manager struct {
+		hooks   []Hook
+		timeout time.Duration
+		log     logger.Logger
+	}
+
+	// Tracker defines the work running in background, waited for before the resources it uses are closed
+	Tracker struct {
+		mu      sync.Mutex
+		running int
+		idle    chan struct{}
+		ctx     context.Context
+		cancel  context.CancelFunc
+	}
+
+	// Errors defines the errors of the components failing to stop
+	Errors []error
+)
+
+// NewManager creates new Manager stopping the components within the timeout
+func NewManager(timeout time.Duration, log logger.Logger) *Manager {
+	return &Manager{
+		timeout: timeout,
+		log:     log,
+	}
+}
+
+// Append adds a component started after the ones already appended
+func (m *Manager) Append(hook Hook) {
+	m.hooks = append(m.hooks, hook)
+}
+
+// Run starts the components in order and blocks until the context is done or a component stops running,
+// then stops the components started. The error returned is the one that stopped the application, if any,
+// followed by the errors stopping the components
+func (m *Manager) Run(ctx context.Context) error {
+	var (
+		started int
+		done    = make(chan error, len(m.hooks))
+		err     error
+	)
+
+	for _, hook := range m.hooks {
+		if hook.Start != nil {
+			m.log.WithFields(logger.Fields{"component": hook.Name}).Infof("starting component")
+			if err = hook.Start(ctx); err != nil {
+				err = fmt.Errorf("start %s: %w", hook.Name, err)
+				break
+			}
+		}
+		started++
+
+		if hook.Run != nil {
+			go func(hook Hook) {
+				if err := hook.Run(); err != nil {
+					done <- fmt.Errorf("run %s: %w", hook.Name, err)
+					return
+				}
+
+				done <- nil
+			}(hook)
+		}
+	}
+
+	if err == nil {
+		select {
+		case <-ctx.Done():
+			m.log.Infof("shutting down")
+		case err = <-done:
+			m.log.Infof("component stopped running, shutting down")
+		}
+	}
+
+	var errs = Errors{}
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	errs = append(errs, m.stop(m.hooks[:started])...)
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// stop stops the components in the reverse order, all of them sharing the deadline of the timeout
+func (m *Manager) stop(hooks []Hook) Errors {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	var errs Errors
+	for i := len(hooks) - 1; i >= 0; i-- {
+		if hooks[i].Stop == nil {
+			continue
+		}
+
+		m.log.WithFields(logger.Fields{"component": hooks[i].Name}).Infof("stopping component")
+		if err := hooks[i].Stop(ctx); err != nil {
+			m.log.WithFields(logger.Fields{
+				"component": hooks[i].Name,
+				"error":     err.Error(),
+			}).Errorf("error stopping component")
+
+			errs = append(errs, fmt.Errorf("stop %s: %w", hooks[i].Name, err))
+		}
+	}
+
+	return errs
+}
+
+// Go runs f in background, tracked until it returns. The context given to f is canceled when Wait gives up
+// waiting, so the work stops before the resources it uses are closed
+func (t *Tracker) Go(f func(context.Context)) {
+	t.mu.Lock()
+	if t.ctx == nil {
+		t.ctx, t.cancel = context.WithCancel(context.Background())
+	}
+	if t.running == 0 {
+		t.idle = make(chan struct{})
+	}
+	t.running++
+	ctx := t.ctx
+	t.mu.Unlock()
+
+	go func() {
+		defer t.done()
+		f(ctx)
+	}()
+}
+
+// Wait blocks until the work running in background returns or the context is done, canceling the work
+// still running
+func (t *Tracker) Wait(ctx context.Context) error {
+	t.mu.Lock()
+	if t.running == 0 {
+		t.mu.Unlock()
+		return nil
+	}
+	idle, cancel := t.idle, t.cancel
+	t.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		cancel()
+		return ctx.Err()
+	}
+}
+
+func (t *Tracker) done() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.running--
+	if t.running == 0 {
+		close(t.idle)
+	}
+}
+
+// Error returns the messages of the errors
+func (e Errors) Error() string {
+	var messages = make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// SignalContext returns a context done on SIGINT or SIGTERM, or when cancel is called
+func SignalContext(parent context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		defer signal.Stop(signals)
+
+		select {
+		case <-signals:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, cancel
+}
@@ -0,0 +1,183 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+)
+
+// recorder defines the components recording the order they are started and stopped in
+type recorder struct {
+	calls []string
+}
+
+func (r *recorder) hook(name string, startErr error, run func() error) Hook {
+	return Hook{
+		Name: name,
+		Start: func(context.Context) error {
+			r.calls = append(r.calls, "start "+name)
+			return startErr
+		},
+		Run: run,
+		Stop: func(context.Context) error {
+			r.calls = append(r.calls, "stop "+name)
+			return nil
+		},
+	}
+}
+
+func TestManager_Run(t *testing.T) {
+	var errRun = errors.New("listen tcp :3001: bind: address already in use")
+
+	tests := []struct {
+		name          string
+		hooks         func(*recorder) []Hook
+		cancel        bool
+		expectedCalls []string
+		expectedErr   string
+	}{
+		{
+			name: "Stop components in reverse order on shutdown",
+			hooks: func(r *recorder) []Hook {
+				return []Hook{
+					r.hook("mongodb", nil, nil),
+					r.hook("rabbitmq", nil, nil),
+					r.hook("http server", nil, nil),
+				}
+			},
+			cancel: true,
+			expectedCalls: []string{
+				"start mongodb", "start rabbitmq", "start http server",
+				"stop http server", "stop rabbitmq", "stop mongodb",
+			},
+		},
+		{
+			name: "Stop only components started when a component fails to start",
+			hooks: func(r *recorder) []Hook {
+				return []Hook{
+					r.hook("mongodb", nil, nil),
+					r.hook("migrations", errors.New("dirty migration"), nil),
+					r.hook("http server", nil, nil),
+				}
+			},
+			expectedCalls: []string{"start mongodb", "start migrations", "stop mongodb"},
+			expectedErr:   "start migrations: dirty migration",
+		},
+		{
+			name: "Shutdown when a component stops running",
+			hooks: func(r *recorder) []Hook {
+				return []Hook{
+					r.hook("mongodb", nil, nil),
+					r.hook("http server", nil, func() error { return errRun }),
+				}
+			},
+			expectedCalls: []string{"start mongodb", "start http server", "stop http server", "stop mongodb"},
+			expectedErr:   "run http server: " + errRun.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				r           = &recorder{}
+				manager     = NewManager(time.Second, logger.Dummy{})
+				ctx, cancel = context.WithCancel(context.Background())
+			)
+			defer cancel()
+
+			for _, hook := range tt.hooks(r) {
+				manager.Append(hook)
+			}
+
+			if tt.cancel {
+				cancel()
+			}
+
+			err := manager.Run(ctx)
+			if (err != nil || tt.expectedErr != "") && (err == nil || err.Error() != tt.expectedErr) {
+				t.Errorf("[TestCase '%s'] Got error: '%v' | Want error: '%v'", tt.name, err, tt.expectedErr)
+			}
+
+			if !reflect.DeepEqual(r.calls, tt.expectedCalls) {
+				t.Errorf("[TestCase '%s'] Got calls: '%v' | Want calls: '%v'", tt.name, r.calls, tt.expectedCalls)
+			}
+		})
+	}
+}
+
+func TestManager_RunStopTimeout(t *testing.T) {
+	var manager = NewManager(10*time.Millisecond, logger.Dummy{})
+	manager.Append(Hook{
+		Name: "background work",
+		Stop: (&Tracker{}).Wait,
+	})
+
+	var tracker = &Tracker{}
+	tracker.Go(func(context.Context) { time.Sleep(time.Second) })
+	manager.Append(Hook{
+		Name: "transfer batches",
+		Stop: tracker.Wait,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var err = manager.Run(ctx)
+
+	var errs Errors
+	if !errors.As(err, &errs) || len(errs) != 1 || !errors.Is(errs[0], context.DeadlineExceeded) {
+		t.Errorf("Got error: '%v' | Want error: '%v'", err, "stop transfer batches: context deadline exceeded")
+	}
+}
+
+func TestTracker_Wait(t *testing.T) {
+	var (
+		tracker  = &Tracker{}
+		finished = make(chan struct{})
+		release  = make(chan struct{})
+	)
+
+	tracker.Go(func(context.Context) {
+		<-release
+		close(finished)
+	})
+	close(release)
+
+	if err := tracker.Wait(context.Background()); err != nil {
+		t.Fatalf("Got error: '%v' | Want error: '%v'", err, nil)
+	}
+
+	select {
+	case <-finished:
+	default:
+		t.Error("Wait returned before the work running in background")
+	}
+}
+
+func TestTracker_WaitTimeout(t *testing.T) {
+	var (
+		tracker  = &Tracker{}
+		canceled = make(chan struct{})
+	)
+
+	tracker.Go(func(ctx context.Context) {
+		<-ctx.Done()
+		close(canceled)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := tracker.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Got error: '%v' | Want error: '%v'", err, context.DeadlineExceeded)
+	}
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Error("Wait gave up without canceling the work running in background")
+	}
+}
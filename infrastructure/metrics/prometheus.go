@@ -0,0 +1,151 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// circuitBreakerStates are the values of the circuit breaker state gauge
+var circuitBreakerStates = map[string]float64{
+	"closed":    0,
+	"half-open": 1,
+	"open":      2,
+}
+
+// Prometheus defines the metrics of the application exposed to Prometheus, it implements the metrics ports of
+// the use cases and the observers of the infrastructure
+type Prometheus struct {
+	registry *prometheus.Registry
+
+	httpRequests        *prometheus.CounterVec
+	httpRequestDuration *prometheus.HistogramVec
+	transfers           *prometheus.CounterVec
+	transferAmount      *prometheus.CounterVec
+	authorizerDuration  *prometheus.HistogramVec
+	authorizerDenials   prometheus.Counter
+	notifications       *prometheus.CounterVec
+	httpClientRetries   *prometheus.CounterVec
+	circuitBreakerState *prometheus.GaugeVec
+	transactionRetries  prometheus.Counter
+}
+
+// NewPrometheus creates new Prometheus with its collectors registered, along with the ones of the Go runtime
+// and of the process
+func NewPrometheus() *Prometheus {
+	p := &Prometheus{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Requests to the HTTP API by route template, method and status code.",
+		}, []string{"route", "method", "status"}),
+		httpRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Latency of the requests to the HTTP API by route template, method and status code.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"route", "method", "status"}),
+		transfers: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "transfers_total",
+			Help: "Transfers by outcome, succeeded or the code of the error.",
+		}, []string{"outcome"}),
+		transferAmount: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "transfer_amount_cents_total",
+			Help: "Value of the transfers in cents by outcome and currency.",
+		}, []string{"outcome", "currency"}),
+		authorizerDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "authorizer_request_duration_seconds",
+			Help:    "Latency of the external authorizer by outcome, authorized, denied or unavailable.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"outcome"}),
+		authorizerDenials: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "authorizer_denials_total",
+			Help: "Authorizations denied by the external authorizer.",
+		}),
+		notifications: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "notifications_total",
+			Help: "Notifications of the payees by outcome, sent, failed or queued to be sent again.",
+		}, []string{"outcome"}),
+		httpClientRetries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_client_retries_total",
+			Help: "Attempts retried of the requests to the external services by host.",
+		}, []string{"host"}),
+		circuitBreakerState: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "circuit_breaker_state",
+			Help: "State of the circuit breakers by name, 0 closed, 1 half-open and 2 open.",
+		}, []string{"name"}),
+		transactionRetries: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "mongodb_transaction_retries_total",
+			Help: "MongoDB transactions retried after a transient error.",
+		}),
+	}
+
+	p.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		p.httpRequests,
+		p.httpRequestDuration,
+		p.transfers,
+		p.transferAmount,
+		p.authorizerDuration,
+		p.authorizerDenials,
+		p.notifications,
+		p.httpClientRetries,
+		p.circuitBreakerState,
+		p.transactionRetries,
+	)
+
+	return p
+}
+
+// Handler returns the handler exposing the metrics in the Prometheus text format
+func (p *Prometheus) Handler() http.Handler {
+	return promhttp.HandlerFor(p.registry, promhttp.HandlerOpts{})
+}
+
+// RequestCompleted records a request to the HTTP API
+func (p *Prometheus) RequestCompleted(route string, method string, status int, latency time.Duration) {
+	code := strconv.Itoa(status)
+	p.httpRequests.WithLabelValues(route, method, code).Inc()
+	p.httpRequestDuration.WithLabelValues(route, method, code).Observe(latency.Seconds())
+}
+
+// TransferCreated records a transfer with its value in cents
+func (p *Prometheus) TransferCreated(outcome string, currency string, value int64) {
+	p.transfers.WithLabelValues(outcome).Inc()
+	p.transferAmount.WithLabelValues(outcome, currency).Add(float64(value))
+}
+
+// AuthorizationCompleted records an authorization with the latency of the authorizer
+func (p *Prometheus) AuthorizationCompleted(outcome string, latency time.Duration) {
+	p.authorizerDuration.WithLabelValues(outcome).Observe(latency.Seconds())
+	if outcome == usecase.AuthorizationDenied {
+		p.authorizerDenials.Inc()
+	}
+}
+
+// NotificationCompleted records a notification
+func (p *Prometheus) NotificationCompleted(outcome string) {
+	p.notifications.WithLabelValues(outcome).Inc()
+}
+
+// RequestRetried records an attempt retried of a request to an external service
+func (p *Prometheus) RequestRetried(host string) {
+	p.httpClientRetries.WithLabelValues(host).Inc()
+}
+
+// CircuitBreakerState records the state of a circuit breaker, closed, half-open or open
+func (p *Prometheus) CircuitBreakerState(name string, state string) {
+	if value, ok := circuitBreakerStates[state]; ok {
+		p.circuitBreakerState.WithLabelValues(name).Set(value)
+	}
+}
+
+// TransactionRetried records the retries of a MongoDB transaction
+func (p *Prometheus) TransactionRetried(retries int) {
+	p.transactionRetries.Add(float64(retries))
+}
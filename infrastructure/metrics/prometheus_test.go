@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestPrometheus_Record(t *testing.T) {
+	var p = NewPrometheus()
+
+	p.RequestCompleted("/users/{user_id}", http.MethodGet, http.StatusOK, 10*time.Millisecond)
+	p.RequestCompleted("/users/{user_id}", http.MethodGet, http.StatusOK, 20*time.Millisecond)
+	p.TransferCreated(usecase.TransferSucceeded, "BRL", 100)
+	p.TransferCreated(usecase.TransferSucceeded, "BRL", 250)
+	p.TransferCreated("user_insufficient_balance", "BRL", 1000)
+	p.AuthorizationCompleted(usecase.AuthorizationGranted, 30*time.Millisecond)
+	p.AuthorizationCompleted(usecase.AuthorizationDenied, 30*time.Millisecond)
+	p.NotificationCompleted(usecase.NotificationFailed)
+	p.NotificationCompleted(usecase.NotificationQueued)
+	p.RequestRetried("run.mocky.io")
+	p.CircuitBreakerState("authorizer", "open")
+	p.CircuitBreakerState("authorizer", "unknown")
+	p.TransactionRetried(2)
+
+	tests := []struct {
+		name     string
+		got      float64
+		expected float64
+	}{
+		{name: "http_requests_total", got: testutil.ToFloat64(p.httpRequests.WithLabelValues("/users/{user_id}", "GET", "200")), expected: 2},
+		{name: "transfers_total succeeded", got: testutil.ToFloat64(p.transfers.WithLabelValues("succeeded")), expected: 2},
+		{name: "transfer_amount_cents_total succeeded", got: testutil.ToFloat64(p.transferAmount.WithLabelValues("succeeded", "BRL")), expected: 350},
+		{name: "transfers_total failed", got: testutil.ToFloat64(p.transfers.WithLabelValues("user_insufficient_balance")), expected: 1},
+		{name: "authorizer_denials_total", got: testutil.ToFloat64(p.authorizerDenials), expected: 1},
+		{name: "notifications_total queued", got: testutil.ToFloat64(p.notifications.WithLabelValues("queued")), expected: 1},
+		{name: "http_client_retries_total", got: testutil.ToFloat64(p.httpClientRetries.WithLabelValues("run.mocky.io")), expected: 1},
+		{name: "circuit_breaker_state", got: testutil.ToFloat64(p.circuitBreakerState.WithLabelValues("authorizer")), expected: 2},
+		{name: "mongodb_transaction_retries_total", got: testutil.ToFloat64(p.transactionRetries), expected: 2},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.expected {
+			t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, tt.got, tt.expected)
+		}
+	}
+}
+
+func TestPrometheus_Handler(t *testing.T) {
+	var p = NewPrometheus()
+	p.TransferCreated(usecase.TransferSucceeded, "BRL", 100)
+
+	server := httptest.NewServer(p.Handler())
+	defer server.Close()
+
+	res, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{`transfers_total{outcome="succeeded"} 1`, "go_goroutines"} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("Got metrics: '%s' | Want metric: '%s'", body, want)
+		}
+	}
+}
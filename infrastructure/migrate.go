@@ -0,0 +1,87 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/config"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+)
+
+// MigrateCommand defines the migrate up/down/status command
+type MigrateCommand struct {
+	out    io.Writer
+	config config.Config
+}
+
+// NewMigrateCommand creates new MigrateCommand
+func NewMigrateCommand(out io.Writer, cfg config.Config) MigrateCommand {
+	return MigrateCommand{out: out, config: cfg}
+}
+
+// Run executes the migrate subcommand given by args
+func (m MigrateCommand) Run(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: migrate up|down|status")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	handler, err := database.NewMongoHandler(m.config.MongoDB)
+	if err != nil {
+		return err
+	}
+	defer handler.Close(context.Background())
+
+	migrator := database.NewMigrator(handler, database.Migrations())
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			fmt.Fprintf(m.out, "applied %d %s\n", migration.Version, migration.Description)
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(applied) == 0 {
+			fmt.Fprintln(m.out, "no pending migrations")
+		}
+
+		return nil
+	case "down":
+		migration, err := migrator.Down(ctx)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(m.out, "rolled back %d %s\n", migration.Version, migration.Description)
+
+		return nil
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(m.out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tDESCRIPTION\tAPPLIED AT")
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.Applied {
+				appliedAt = status.AppliedAt.Format(time.RFC3339)
+			}
+
+			fmt.Fprintf(w, "%d\t%s\t%s\n", status.Version, status.Description, appliedAt)
+		}
+
+		return w.Flush()
+	}
+
+	return fmt.Errorf("unknown migrate subcommand %q", args[0])
+}
@@ -0,0 +1,244 @@
+package infrastructure
+
+import (
+	"net/http"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/handler"
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/openapi"
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/response"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/health"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+// docsPage renders the OpenAPI document served at /openapi.json
+const docsPage = `<!DOCTYPE html>
+<html>
+<head>
+	<title>golang-clean-architecture API</title>
+	<meta charset="utf-8"/>
+</head>
+<body>
+	<redoc spec-url="/openapi.json"></redoc>
+	<script src="https://cdn.jsdelivr.net/npm/redoc@2.0.0/bundles/redoc.standalone.js"></script>
+</body>
+</html>
+`
+
+// apiSpec returns the OpenAPI document of the routes of the HTTPServer, derived from the types of the requests
+// of the handlers and of the outputs of the use cases
+func apiSpec() openapi.Document {
+	return openapi.NewDocument(
+		openapi.Info{
+			Title:       "golang-clean-architecture",
+			Description: "Users, wallets and transfers of money between them",
+			Version:     "1.0.0",
+		},
+		apiRoutes(),
+		response.Error{},
+		response.Problem{},
+	)
+}
+
+func apiRoutes() []openapi.Route {
+	var (
+		uuid     = &openapi.Schema{Type: "string", Format: "uuid"}
+		dateTime = &openapi.Schema{Type: "string", Format: "date-time"}
+		integer  = &openapi.Schema{Type: "integer", Format: "int64"}
+		text     = &openapi.Schema{Type: "string"}
+	)
+
+	return []openapi.Route{
+		{
+			Method: http.MethodGet, Path: "/health", OperationID: "healthCheck", Summary: "Health of the API", Tag: "health",
+			Status: http.StatusOK, Response: healthCheckResponse{},
+		},
+		{
+			Method: http.MethodGet, Path: "/health/live", OperationID: "livenessCheck", Summary: "Liveness probe of the API", Tag: "health",
+			Status: http.StatusOK, Response: healthCheckResponse{},
+		},
+		{
+			Method: http.MethodGet, Path: "/health/ready", OperationID: "readinessCheck", Summary: "Readiness probe checking the dependencies of the API", Tag: "health",
+			Status: http.StatusOK, Response: health.ReadinessReport{},
+			OtherResponses: map[int]interface{}{http.StatusServiceUnavailable: health.ReadinessReport{}},
+		},
+		{
+			Method: http.MethodGet, Path: "/metrics", OperationID: "getMetrics", Summary: "Metrics in the Prometheus text format", Tag: "health",
+			Status: http.StatusOK, Produces: []string{"text/plain"},
+		},
+		{
+			Method: http.MethodGet, Path: "/openapi.json", OperationID: "getOpenAPI", Summary: "OpenAPI document of the API", Tag: "docs",
+			Status: http.StatusOK, Response: map[string]interface{}{},
+		},
+		{
+			Method: http.MethodGet, Path: "/docs", OperationID: "getDocs", Summary: "Documentation page of the API", Tag: "docs",
+			Status: http.StatusOK, Produces: []string{"text/html"},
+		},
+
+		{
+			Method: http.MethodPost, Path: "/users", OperationID: "createUser", Summary: "Create a user with an empty wallet", Tag: "users",
+			Request: handler.CreateUserRequest{}, Status: http.StatusCreated, Response: usecase.CreateUserOutput{},
+		},
+		{
+			Method: http.MethodGet, Path: "/users/{user_id}", OperationID: "findUserByID", Summary: "Find a user", Tag: "users",
+			Status: http.StatusOK, Response: usecase.FindUserByIDOutput{},
+		},
+		{
+			Method: http.MethodGet, Path: "/users/{user_id}/events", OperationID: "findUserEvents", Summary: "Events of the wallet of a user", Tag: "users",
+			Status: http.StatusOK, Response: usecase.FindUserEventsOutput{},
+		},
+		{
+			Method: http.MethodGet, Path: "/users/{user_id}/statement", OperationID: "getStatement", Summary: "Statement of a user in a period", Tag: "users",
+			Query: []openapi.Parameter{
+				{Name: "from", Schema: dateTime},
+				{Name: "to", Schema: dateTime},
+				{Name: "format", Schema: &openapi.Schema{Type: "string", Enum: []string{"json", "csv", "txt"}}},
+			},
+			Status: http.StatusOK, Response: usecase.GetStatementOutput{}, Produces: []string{"text/csv", "text/plain"},
+		},
+		{
+			Method: http.MethodPut, Path: "/admin/users/{user_id}/kyc-level", OperationID: "updateKYCLevel", Summary: "Update the KYC level of a user", Tag: "users",
+			Request: handler.UpdateKYCLevelRequest{}, Status: http.StatusOK, Response: usecase.KYCLevelOutput{},
+		},
+
+		{
+			Method: http.MethodGet, Path: "/transfers", OperationID: "searchTransfers", Summary: "Search the transfers of a user", Tag: "transfers",
+			Query: []openapi.Parameter{
+				{Name: "user_id", Required: true, Schema: uuid},
+				{Name: "external_reference", Schema: text},
+				{Name: "q", Schema: text},
+				{Name: "limit", Schema: integer},
+			},
+			Status: http.StatusOK, Response: usecase.SearchTransfersOutput{},
+		},
+		{
+			Method: http.MethodPost, Path: "/transfers", OperationID: "createTransfer", Summary: "Create a transfer, or schedule it with execute_at", Tag: "transfers",
+			Request: handler.CreateTransferRequest{}, Status: http.StatusCreated, Response: usecase.CreateTransferOutput{},
+			OtherResponses: map[int]interface{}{http.StatusAccepted: usecase.ScheduledTransferOutput{}},
+		},
+		{
+			Method: http.MethodPost, Path: "/transfers/split", OperationID: "createSplitTransfer", Summary: "Create a transfer split between payees", Tag: "transfers",
+			Request: handler.CreateSplitTransferRequest{}, Status: http.StatusCreated, Response: usecase.CreateSplitTransferOutput{},
+		},
+		{
+			Method: http.MethodGet, Path: "/transfers/quote", OperationID: "quoteTransfer", Summary: "Quote the fees of a transfer", Tag: "transfers",
+			Query: []openapi.Parameter{
+				{Name: "payer_id", Required: true, Schema: uuid},
+				{Name: "payee_id", Required: true, Schema: uuid},
+				{Name: "value", Required: true, Schema: integer},
+			},
+			Status: http.StatusOK, Response: usecase.QuoteTransferOutput{},
+		},
+		{
+			Method: http.MethodGet, Path: "/scheduled-transfers/{scheduled_transfer_id}", OperationID: "findScheduledTransfer", Summary: "Find a scheduled transfer", Tag: "transfers",
+			Status: http.StatusOK, Response: usecase.ScheduledTransferOutput{},
+		},
+		{
+			Method: http.MethodPost, Path: "/scheduled-transfers/{scheduled_transfer_id}/cancel", OperationID: "cancelScheduledTransfer", Summary: "Cancel a scheduled transfer", Tag: "transfers",
+			Status: http.StatusOK, Response: usecase.ScheduledTransferOutput{},
+		},
+		{
+			Method: http.MethodPost, Path: "/recurring-transfers", OperationID: "createRecurringTransfer", Summary: "Create a recurring transfer", Tag: "transfers",
+			Request: handler.CreateRecurringTransferRequest{}, Status: http.StatusCreated, Response: usecase.RecurringTransferOutput{},
+		},
+		{
+			Method: http.MethodGet, Path: "/recurring-transfers/{recurring_transfer_id}", OperationID: "findRecurringTransfer", Summary: "Find a recurring transfer", Tag: "transfers",
+			Status: http.StatusOK, Response: usecase.RecurringTransferOutput{},
+		},
+		{
+			Method: http.MethodPut, Path: "/recurring-transfers/{recurring_transfer_id}", OperationID: "updateRecurringTransfer", Summary: "Update a recurring transfer", Tag: "transfers",
+			Request: handler.UpdateRecurringTransferRequest{}, Status: http.StatusOK, Response: usecase.RecurringTransferOutput{},
+		},
+		{
+			Method: http.MethodDelete, Path: "/recurring-transfers/{recurring_transfer_id}", OperationID: "cancelRecurringTransfer", Summary: "Cancel a recurring transfer", Tag: "transfers",
+			Status: http.StatusOK, Response: usecase.RecurringTransferOutput{},
+		},
+
+		{
+			Method: http.MethodPost, Path: "/transfer-batches", OperationID: "createTransferBatch", Summary: "Create a batch of transfers, from JSON or CSV", Tag: "transfer batches",
+			Query: []openapi.Parameter{
+				{Name: "payer_id", Schema: uuid},
+				{Name: "mode", Schema: &openapi.Schema{Type: "string", Enum: []string{"ALL_OR_NOTHING", "BEST_EFFORT"}}},
+			},
+			Request: handler.CreateTransferBatchRequest{}, Consumes: []string{"text/csv"}, Status: http.StatusAccepted, Response: usecase.TransferBatchOutput{},
+		},
+		{
+			Method: http.MethodGet, Path: "/transfer-batches/{transfer_batch_id}", OperationID: "findTransferBatch", Summary: "Find a batch of transfers", Tag: "transfer batches",
+			Status: http.StatusOK, Response: usecase.TransferBatchOutput{},
+		},
+
+		{
+			Method: http.MethodPost, Path: "/holds", OperationID: "createHold", Summary: "Hold a value of the payer for a payee", Tag: "holds",
+			Request: handler.CreateHoldRequest{}, Status: http.StatusCreated, Response: usecase.HoldOutput{},
+		},
+		{
+			Method: http.MethodPost, Path: "/holds/{hold_id}/capture", OperationID: "captureHold", Summary: "Capture a hold into a transfer", Tag: "holds",
+			Request: handler.CaptureHoldRequest{}, Status: http.StatusOK, Response: usecase.HoldOutput{},
+		},
+		{
+			Method: http.MethodPost, Path: "/holds/{hold_id}/void", OperationID: "voidHold", Summary: "Void a hold", Tag: "holds",
+			Status: http.StatusOK, Response: usecase.HoldOutput{},
+		},
+
+		{
+			Method: http.MethodPost, Path: "/escrow-transfers", OperationID: "createEscrowTransfer", Summary: "Create an escrow transfer", Tag: "escrow transfers",
+			Request: handler.CreateEscrowTransferRequest{}, Status: http.StatusCreated, Response: usecase.EscrowTransferOutput{},
+		},
+		{
+			Method: http.MethodPost, Path: "/escrow-transfers/{escrow_transfer_id}/confirm", OperationID: "confirmEscrowTransfer", Summary: "Confirm an escrow transfer", Tag: "escrow transfers",
+			Status: http.StatusOK, Response: usecase.EscrowTransferOutput{},
+		},
+		{
+			Method: http.MethodPost, Path: "/escrow-transfers/{escrow_transfer_id}/dispute", OperationID: "disputeEscrowTransfer", Summary: "Dispute an escrow transfer", Tag: "escrow transfers",
+			Request: handler.DisputeEscrowTransferRequest{}, Status: http.StatusOK, Response: usecase.EscrowTransferOutput{},
+		},
+		{
+			Method: http.MethodPost, Path: "/escrow-transfers/{escrow_transfer_id}/resolve", OperationID: "resolveEscrowTransfer", Summary: "Resolve a disputed escrow transfer", Tag: "escrow transfers",
+			Request: handler.ResolveEscrowTransferRequest{}, Status: http.StatusOK, Response: usecase.EscrowTransferOutput{},
+		},
+
+		{
+			Method: http.MethodPost, Path: "/transfers/{transfer_id}/disputes", OperationID: "openDispute", Summary: "Open a dispute of a transfer", Tag: "disputes",
+			Request: handler.OpenDisputeRequest{}, Status: http.StatusCreated, Response: usecase.DisputeOutput{},
+		},
+		{
+			Method: http.MethodGet, Path: "/disputes/{dispute_id}", OperationID: "findDispute", Summary: "Find a dispute", Tag: "disputes",
+			Status: http.StatusOK, Response: usecase.DisputeOutput{},
+		},
+		{
+			Method: http.MethodPost, Path: "/disputes/{dispute_id}/evidence", OperationID: "addDisputeEvidence", Summary: "Add evidence to a dispute", Tag: "disputes",
+			Request: handler.AddDisputeEvidenceRequest{}, Status: http.StatusCreated, Response: usecase.DisputeOutput{},
+		},
+		{
+			Method: http.MethodPost, Path: "/disputes/{dispute_id}/review", OperationID: "reviewDispute", Summary: "Start the review of a dispute", Tag: "disputes",
+			Status: http.StatusOK, Response: usecase.DisputeOutput{},
+		},
+		{
+			Method: http.MethodPost, Path: "/disputes/{dispute_id}/resolve", OperationID: "resolveDispute", Summary: "Resolve a dispute", Tag: "disputes",
+			Request: handler.ResolveDisputeRequest{}, Status: http.StatusOK, Response: usecase.DisputeOutput{},
+		},
+
+		{
+			Method: http.MethodPost, Path: "/deposits", OperationID: "deposit", Summary: "Deposit into a wallet", Tag: "movements",
+			Request: handler.CreateMovementRequest{}, Status: http.StatusCreated, Response: usecase.MovementOutput{},
+		},
+		{
+			Method: http.MethodPost, Path: "/withdrawals", OperationID: "withdraw", Summary: "Withdraw from a wallet", Tag: "movements",
+			Request: handler.CreateMovementRequest{}, Status: http.StatusCreated, Response: usecase.MovementOutput{},
+		},
+		{
+			Method: http.MethodPost, Path: "/movements/{movement_id}/reverse", OperationID: "reverseMovement", Summary: "Reverse a deposit or a withdrawal", Tag: "movements",
+			Status: http.StatusOK, Response: usecase.MovementOutput{},
+		},
+	}
+}
+
+// openAPIHandler returns the handler serving the OpenAPI document
+func openAPIHandler(doc openapi.Document) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		response.NewSuccess(doc, http.StatusOK).Send(w)
+	}
+}
+
+func docsHandler(w http.ResponseWriter, _ *http.Request) {
+	response.NewFile([]byte(docsPage), "text/html; charset=utf-8", http.StatusOK).Send(w)
+}
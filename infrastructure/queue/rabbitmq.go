@@ -1,29 +1,37 @@
 package queue
 
 import (
-	"log"
-	"os"
+	"context"
+	"errors"
 
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/config"
 	"github.com/streadway/amqp"
 )
 
 // RabbitMQHandler defines the RabbitMQ handler
 type RabbitMQHandler struct {
-	conn    *amqp.Connection
-	queue   amqp.Queue
-	channel *amqp.Channel
+	conn          *amqp.Connection
+	queue         amqp.Queue
+	channel       *amqp.Channel
+	channelClosed chan struct{}
 }
 
-// NewRabbitMQHandler creates new RabbitMQHandler
-func NewRabbitMQHandler() *RabbitMQHandler {
-	conn, err := amqp.Dial(os.Getenv("RABBITMQ_URI"))
+var (
+	errConnectionClosed = errors.New("connection closed")
+	errChannelClosed    = errors.New("channel closed")
+)
+
+// NewRabbitMQHandler creates new RabbitMQHandler connected to the broker of the configuration
+func NewRabbitMQHandler(cfg config.RabbitMQ) (*RabbitMQHandler, error) {
+	conn, err := amqp.Dial(cfg.URI)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	channel, err := conn.Channel()
 	if err != nil {
-		log.Fatal(err)
+		conn.Close()
+		return nil, err
 	}
 
 	queue, err := channel.QueueDeclare(
@@ -35,14 +43,59 @@ func NewRabbitMQHandler() *RabbitMQHandler {
 		nil,
 	)
 	if err != nil {
-		log.Fatal(err)
+		conn.Close()
+		return nil, err
 	}
 
+	// the channel does not expose its state, it is marked closed once notified
+	var (
+		closed = make(chan struct{})
+		notify = channel.NotifyClose(make(chan *amqp.Error, 1))
+	)
+	go func() {
+		<-notify
+		close(closed)
+	}()
+
 	return &RabbitMQHandler{
-		conn:    conn,
-		queue:   queue,
-		channel: channel,
+		conn:          conn,
+		queue:         queue,
+		channel:       channel,
+		channelClosed: closed,
+	}, nil
+}
+
+// Name returns the name of the dependency checked by the readiness probe
+func (r RabbitMQHandler) Name() string {
+	return "rabbitmq"
+}
+
+// Check returns an error once the connection or the channel publishing the notifications is closed
+func (r RabbitMQHandler) Check(_ context.Context) error {
+	if r.conn.IsClosed() {
+		return errConnectionClosed
 	}
+
+	select {
+	case <-r.channelClosed:
+		return errChannelClosed
+	default:
+		return nil
+	}
+}
+
+// Close closes the channel and then the connection
+func (r RabbitMQHandler) Close() error {
+	if err := r.channel.Close(); err != nil && err != amqp.ErrClosed {
+		r.conn.Close()
+		return err
+	}
+
+	if err := r.conn.Close(); err != nil && err != amqp.ErrClosed {
+		return err
+	}
+
+	return nil
 }
 
 // Conn returns the conn property
@@ -0,0 +1,137 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	adapterlogger "github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/adapter/presenter"
+	"github.com/GSabadini/golang-clean-architecture/adapter/repository"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/config"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/database"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+// ReconcileCommand defines the command comparing the wallets with their transfer history
+type ReconcileCommand struct {
+	out    io.Writer
+	config config.Config
+	logger adapterlogger.Logger
+}
+
+// NewReconcileCommand creates new ReconcileCommand
+func NewReconcileCommand(out io.Writer, cfg config.Config) ReconcileCommand {
+	return ReconcileCommand{
+		out:    out,
+		config: cfg,
+		logger: logger.NewLogrus(),
+	}
+}
+
+// Run executes the reconciliation with the flags given by args
+func (r ReconcileCommand) Run(args []string) error {
+	var (
+		flags       = flag.NewFlagSet("reconcile", flag.ContinueOnError)
+		incremental = flags.Bool("incremental", false, "reconcile only the transfers since the latest checkpoint")
+		format      = flags.String("format", "json", "report format: json or csv")
+		output      = flags.String("output", "", "file to write the report, defaults to stdout")
+		alert       = flags.Bool("alert", false, "log an error for every mismatched wallet")
+	)
+	flags.SetOutput(r.out)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *format != "json" && *format != "csv" {
+		return fmt.Errorf("unknown report format %q", *format)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	handler, err := database.NewMongoHandler(r.config.MongoDB)
+	if err != nil {
+		return err
+	}
+	defer handler.Close(context.Background())
+
+	report, err := usecase.NewReconcileWalletsInteractor(
+		repository.NewFindWalletsRepository(handler),
+		repository.NewSumTransfersByUserRepository(handler),
+		repository.NewSumMovementsByUserRepository(handler),
+		repository.NewReconciliationCheckpointRepository(handler),
+		presenter.NewReconcileWalletsPresenter(),
+	).Execute(ctx, usecase.ReconcileWalletsInput{
+		Incremental: *incremental,
+		RunAt:       time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if *alert {
+		for _, mismatch := range report.Mismatches {
+			r.logger.WithFields(adapterlogger.Fields{
+				"key":        "reconcile_wallets",
+				"user_id":    mismatch.UserID,
+				"expected":   mismatch.Expected,
+				"actual":     mismatch.Actual,
+				"difference": mismatch.Difference,
+				"status":     mismatch.Status,
+			}).Errorf("wallet balance does not match its transfer history")
+		}
+	}
+
+	var out = r.out
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		out = file
+	}
+
+	if *format == "csv" {
+		return reconcileCSV(out, report)
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(report)
+}
+
+func reconcileCSV(out io.Writer, report usecase.ReconcileWalletsOutput) error {
+	w := csv.NewWriter(out)
+
+	if err := w.Write([]string{"user_id", "currency", "expected", "actual", "difference", "status"}); err != nil {
+		return err
+	}
+
+	for _, mismatch := range report.Mismatches {
+		if err := w.Write([]string{
+			mismatch.UserID,
+			mismatch.Currency,
+			strconv.FormatInt(mismatch.Expected, 10),
+			strconv.FormatInt(mismatch.Actual, 10),
+			strconv.FormatInt(mismatch.Difference, 10),
+			mismatch.Status,
+		}); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+
+	return w.Error()
+}
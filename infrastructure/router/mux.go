@@ -1,8 +1,8 @@
 package router
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"net/http"
 	"time"
 
@@ -11,13 +11,25 @@ import (
 )
 
 type Mux struct {
-	router *mux.Router
+	router      *mux.Router
+	server      *http.Server
+	middlewares []mux.MiddlewareFunc
 }
 
-func NewMux() *Mux {
-	return &Mux{
-		router: mux.NewRouter(),
+func NewMux(readTimeout time.Duration, writeTimeout time.Duration) *Mux {
+	router := mux.NewRouter()
+
+	m := &Mux{
+		router: router,
+		server: &http.Server{
+			ReadTimeout:  readTimeout,
+			WriteTimeout: writeTimeout,
+			Handler:      router,
+		},
 	}
+	m.USE(middleware.NewCorrelationID().Execute)
+
+	return m
 }
 
 func (m *Mux) GET(uri string, f func(w http.ResponseWriter, r *http.Request)) {
@@ -28,15 +40,47 @@ func (m *Mux) POST(uri string, f func(w http.ResponseWriter, r *http.Request)) {
 	m.router.HandleFunc(uri, f).Methods(http.MethodPost)
 }
 
-func (m *Mux) SERVE(port string) {
-	m.router.Use(middleware.NewCorrelationID().Execute)
+func (m *Mux) PUT(uri string, f func(w http.ResponseWriter, r *http.Request)) {
+	m.router.HandleFunc(uri, f).Methods(http.MethodPut)
+}
+
+func (m *Mux) DELETE(uri string, f func(w http.ResponseWriter, r *http.Request)) {
+	m.router.HandleFunc(uri, f).Methods(http.MethodDelete)
+}
+
+// USE adds a middleware run after the correlation id is defined, on the routes matched and on the requests
+// answered with 404 Not Found or 405 Method Not Allowed, which gorilla serves without the middlewares
+func (m *Mux) USE(mwf func(http.Handler) http.Handler) {
+	m.router.Use(mwf)
+
+	m.middlewares = append(m.middlewares, mwf)
+	m.router.NotFoundHandler = m.chain(http.NotFoundHandler())
+	m.router.MethodNotAllowedHandler = m.chain(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}))
+}
 
-	server := &http.Server{
-		ReadTimeout:  15 * time.Second,
-		WriteTimeout: 15 * time.Second,
-		Addr:         fmt.Sprintf(":%s", port),
-		Handler:      m.router,
+// chain wraps the handler with the middlewares, the first one added running first
+func (m *Mux) chain(h http.Handler) http.Handler {
+	for i := len(m.middlewares) - 1; i >= 0; i-- {
+		h = m.middlewares[i](h)
 	}
 
-	log.Fatal(server.ListenAndServe())
+	return h
+}
+
+// SERVE accepts requests at the port until SHUTDOWN is called
+func (m *Mux) SERVE(port string) error {
+	m.server.Addr = fmt.Sprintf(":%s", port)
+
+	if err := m.server.ListenAndServe(); err != http.ErrServerClosed {
+		return err
+	}
+
+	return nil
+}
+
+// SHUTDOWN stops accepting requests and waits for the ones in progress until the deadline of the context
+func (m *Mux) SHUTDOWN(ctx context.Context) error {
+	return m.server.Shutdown(ctx)
 }
@@ -0,0 +1,83 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/api/middleware"
+)
+
+type spyHTTPMetrics struct {
+	route  string
+	status int
+	calls  int
+}
+
+func (s *spyHTTPMetrics) RequestCompleted(route string, _ string, status int, _ time.Duration) {
+	s.route, s.status = route, status
+	s.calls++
+}
+
+func TestMux_USE(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		uri            string
+		expectedRoute  string
+		expectedStatus int
+	}{
+		{
+			name:           "Route matched",
+			method:         http.MethodGet,
+			uri:            "/users/0db298eb-c8e7-4829-84b7-c1036b4f0791",
+			expectedRoute:  "/users/{user_id}",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Route not found",
+			method:         http.MethodGet,
+			uri:            "/unknown",
+			expectedRoute:  "unmatched",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Method not allowed",
+			method:         http.MethodDelete,
+			uri:            "/users/0db298eb-c8e7-4829-84b7-c1036b4f0791",
+			expectedRoute:  "unmatched",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				spy = &spyHTTPMetrics{}
+				m   = NewMux(time.Second, time.Second)
+			)
+			m.USE(middleware.NewMetrics(spy).Execute)
+			m.GET("/users/{user_id}", func(w http.ResponseWriter, _ *http.Request) {})
+
+			req, err := http.NewRequest(tt.method, tt.uri, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			m.server.Handler.ServeHTTP(rr, req)
+
+			if spy.calls != 1 {
+				t.Fatalf("[TestCase '%s'] Got requests recorded: '%v' | Want requests recorded: '%v'", tt.name, spy.calls, 1)
+			}
+
+			if spy.route != tt.expectedRoute || spy.status != tt.expectedStatus || rr.Code != tt.expectedStatus {
+				t.Errorf("[TestCase '%s'] Got: '%v %v' | Want: '%v %v'", tt.name, spy.route, spy.status, tt.expectedRoute, tt.expectedStatus)
+			}
+
+			if rr.Header().Get("X-Correlation-ID") == "" {
+				t.Errorf("[TestCase '%s'] Got correlation id: '%v' | Want a correlation id", tt.name, rr.Header().Get("X-Correlation-ID"))
+			}
+		})
+	}
+}
@@ -1,9 +1,16 @@
 package router
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+)
 
 type Router interface {
 	GET(uri string, f func(w http.ResponseWriter, r *http.Request))
 	POST(uri string, f func(w http.ResponseWriter, r *http.Request))
-	SERVE(port string)
+	PUT(uri string, f func(w http.ResponseWriter, r *http.Request))
+	DELETE(uri string, f func(w http.ResponseWriter, r *http.Request))
+	USE(mwf func(http.Handler) http.Handler)
+	SERVE(port string) error
+	SHUTDOWN(ctx context.Context) error
 }
@@ -0,0 +1,291 @@
+package infrastructure
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+
+	adapterhttp "github.com/GSabadini/golang-clean-architecture/adapter/http"
+	adapterlogger "github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/adapter/presenter"
+	"github.com/GSabadini/golang-clean-architecture/adapter/repository"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/config"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/lifecycle"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+)
+
+// SchedulerCommand defines the worker executing the due scheduled transfers and recurring transfer occurrences,
+// expiring the stale holds, releasing the escrow transfers past their release date and resuming the transfer
+// batches left unfinished
+type SchedulerCommand struct {
+	out    io.Writer
+	config config.Config
+}
+
+// NewSchedulerCommand creates new SchedulerCommand
+func NewSchedulerCommand(out io.Writer, cfg config.Config) SchedulerCommand {
+	return SchedulerCommand{out: out, config: cfg}
+}
+
+// Run polls the due scheduled transfers with the flags given by args until the process is interrupted
+func (s SchedulerCommand) Run(args []string) error {
+	var (
+		flags    = flag.NewFlagSet("scheduler", flag.ContinueOnError)
+		interval = flags.Duration("interval", 10*time.Second, "interval between the polls of due transfers")
+		batch    = flags.Int("batch", 50, "maximum number of transfers leased by poll")
+		lease    = flags.Duration("lease", time.Minute, "time a leased transfer is hidden from other workers")
+		once     = flags.Bool("once", false, "execute the due transfers a single time and exit")
+		backoff  = flags.Duration("retry-backoff", time.Minute, "delay before executing again a scheduled transfer whose error may go away, doubled at every attempt")
+		stale    = flags.Duration("batch-stale-after", 5*time.Minute, "time without progress after which a transfer batch is resumed")
+	)
+	flags.SetOutput(s.out)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *batch <= 0 || *interval <= 0 || *lease <= 0 || *backoff <= 0 || *stale <= 0 {
+		return fmt.Errorf("interval, batch, lease, retry-backoff and batch-stale-after must be positive")
+	}
+
+	app, err := NewHTTPServer(s.config)
+	if err != nil {
+		return err
+	}
+	defer app.Close(context.Background())
+
+	var (
+		owner = schedulerOwner()
+		uc    = usecase.NewExecuteScheduledTransfersInteractor(
+			repository.NewScheduledTransferRepository(app.database),
+			app.createTransferUseCase(app.notifier()),
+			presenter.NewExecuteScheduledTransfersPresenter(),
+		)
+		ucRecurring = usecase.NewExecuteRecurringTransfersInteractor(
+			repository.NewRecurringTransferRepository(app.database),
+			app.createTransferUseCase(app.notifier()),
+			presenter.NewExecuteRecurringTransfersPresenter(),
+		)
+		events  = repository.NewEventStoreRepository(app.database)
+		ucHolds = usecase.NewExpireHoldsInteractor(
+			repository.NewHoldRepository(app.database),
+			repository.NewFindUserByIDUserRepository(app.database),
+			app.userHolder(events),
+			presenter.NewExpireHoldsPresenter(),
+		)
+		ucEscrow = usecase.NewReleaseEscrowTransfersInteractor(
+			repository.NewEscrowTransferRepository(app.database),
+			repository.NewEventSourcedTransferCreator(repository.NewCreateTransferRepository(app.database), events),
+			app.userWalletUpdater(events),
+			repository.NewFindUserByIDUserRepository(app.database),
+			app.notifier(),
+			presenter.NewReleaseEscrowTransfersPresenter(),
+		)
+		ucBatches = usecase.NewResumeTransferBatchesInteractor(
+			repository.NewTransferBatchRepository(app.database),
+			repository.NewFindTransferByIDRepository(app.database),
+			app.createTransferUseCase(adapterhttp.NewNopNotifier()),
+			app.notifier(),
+			presenter.NewResumeTransferBatchesPresenter(),
+			transferBatchConcurrency,
+		)
+	)
+
+	ctx, stop := lifecycle.SignalContext(context.Background())
+	defer stop()
+
+	log := app.logger.WithFields(adapterlogger.Fields{"owner": owner})
+	ctx = adapterlogger.NewContext(ctx, log)
+
+	log.Infof("Starting scheduler")
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	for {
+		now := time.Now()
+		s.poll(ctx, app.logger, uc, usecase.ExecuteScheduledTransfersInput{
+			Now:          now,
+			Owner:        owner,
+			LeaseTTL:     *lease,
+			Limit:        *batch,
+			RetryBackoff: *backoff,
+		})
+		s.pollRecurring(ctx, app.logger, ucRecurring, usecase.ExecuteRecurringTransfersInput{
+			Now:      now,
+			Owner:    owner,
+			LeaseTTL: *lease,
+			Limit:    *batch,
+		})
+		s.pollHolds(ctx, app.logger, ucHolds, usecase.ExpireHoldsInput{
+			Now:   now,
+			Limit: *batch,
+		})
+		s.pollEscrow(ctx, app.logger, ucEscrow, usecase.ReleaseEscrowTransfersInput{
+			Now:   now,
+			Limit: *batch,
+		})
+		s.pollBatches(ctx, app.logger, ucBatches, usecase.ResumeTransferBatchesInput{
+			Now:        now,
+			StaleAfter: *stale,
+			Limit:      *batch,
+		})
+
+		if *once {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			app.logger.Infof("Stopping scheduler")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s SchedulerCommand) poll(
+	ctx context.Context,
+	log adapterlogger.Logger,
+	uc usecase.ExecuteScheduledTransfersUseCase,
+	input usecase.ExecuteScheduledTransfersInput,
+) {
+	output, err := uc.Execute(ctx, input)
+	for _, failure := range output.Failures {
+		log.WithFields(adapterlogger.Fields{
+			"key":                   "execute_scheduled_transfers",
+			"scheduled_transfer_id": failure.ID,
+			"error":                 failure.Reason,
+		}).Errorf("scheduled transfer failed")
+	}
+
+	if err != nil {
+		log.WithFields(adapterlogger.Fields{
+			"key":   "execute_scheduled_transfers",
+			"error": err.Error(),
+		}).Errorf("error executing scheduled transfers")
+	}
+
+	if output.Executed+output.Retried+output.Failed > 0 {
+		log.WithFields(adapterlogger.Fields{
+			"key":      "execute_scheduled_transfers",
+			"executed": output.Executed,
+			"retried":  output.Retried,
+			"failed":   output.Failed,
+		}).Infof("scheduled transfers executed")
+	}
+}
+
+func (s SchedulerCommand) pollRecurring(
+	ctx context.Context,
+	log adapterlogger.Logger,
+	uc usecase.ExecuteRecurringTransfersUseCase,
+	input usecase.ExecuteRecurringTransfersInput,
+) {
+	output, err := uc.Execute(ctx, input)
+	for _, ID := range output.Paused {
+		log.WithFields(adapterlogger.Fields{
+			"key":                   "execute_recurring_transfers",
+			"recurring_transfer_id": ID,
+		}).Errorf("recurring transfer paused after consecutive failures")
+	}
+
+	if err != nil {
+		log.WithFields(adapterlogger.Fields{
+			"key":   "execute_recurring_transfers",
+			"error": err.Error(),
+		}).Errorf("error executing recurring transfers")
+		return
+	}
+
+	if output.Executed+output.Failed > 0 {
+		log.WithFields(adapterlogger.Fields{
+			"key":      "execute_recurring_transfers",
+			"executed": output.Executed,
+			"failed":   output.Failed,
+		}).Infof("recurring transfer occurrences executed")
+	}
+}
+
+func (s SchedulerCommand) pollHolds(
+	ctx context.Context,
+	log adapterlogger.Logger,
+	uc usecase.ExpireHoldsUseCase,
+	input usecase.ExpireHoldsInput,
+) {
+	output, err := uc.Execute(ctx, input)
+	if output.Expired > 0 {
+		log.WithFields(adapterlogger.Fields{
+			"key":     "expire_holds",
+			"expired": output.Expired,
+			"ids":     output.IDs,
+		}).Infof("stale holds expired")
+	}
+
+	if err != nil {
+		log.WithFields(adapterlogger.Fields{
+			"key":   "expire_holds",
+			"error": err.Error(),
+		}).Errorf("error expiring holds")
+	}
+}
+
+func (s SchedulerCommand) pollEscrow(
+	ctx context.Context,
+	log adapterlogger.Logger,
+	uc usecase.ReleaseEscrowTransfersUseCase,
+	input usecase.ReleaseEscrowTransfersInput,
+) {
+	output, err := uc.Execute(ctx, input)
+	if output.Released > 0 {
+		log.WithFields(adapterlogger.Fields{
+			"key":      "release_escrow_transfers",
+			"released": output.Released,
+			"ids":      output.IDs,
+		}).Infof("escrow transfers released on timeout")
+	}
+
+	if err != nil {
+		log.WithFields(adapterlogger.Fields{
+			"key":   "release_escrow_transfers",
+			"error": err.Error(),
+		}).Errorf("error releasing escrow transfers")
+	}
+}
+
+func (s SchedulerCommand) pollBatches(
+	ctx context.Context,
+	log adapterlogger.Logger,
+	uc usecase.ResumeTransferBatchesUseCase,
+	input usecase.ResumeTransferBatchesInput,
+) {
+	output, err := uc.Execute(ctx, input)
+	if output.Resumed > 0 {
+		log.WithFields(adapterlogger.Fields{
+			"key":     "resume_transfer_batches",
+			"resumed": output.Resumed,
+			"ids":     output.IDs,
+		}).Infof("stale transfer batches resumed")
+	}
+
+	if err != nil {
+		log.WithFields(adapterlogger.Fields{
+			"key":   "resume_transfer_batches",
+			"error": err.Error(),
+		}).Errorf("error resuming transfer batches")
+	}
+}
+
+// schedulerOwner identifies the worker holding the leases, unique even for workers on the same host
+func schedulerOwner() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "scheduler"
+	}
+
+	return fmt.Sprintf("%s-%s", host, uuid.New().String())
+}
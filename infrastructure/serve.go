@@ -0,0 +1,52 @@
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/config"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/lifecycle"
+)
+
+// Serve runs the HTTP API, and the gRPC API when enabled, until SIGINT or SIGTERM. On shutdown the servers
+// stop accepting requests and drain the ones in progress, then the background work is waited for and the
+// connections to RabbitMQ and MongoDB are closed, all within the shutdown timeout
+func Serve(cfg config.Config) error {
+	app, err := NewHTTPServer(cfg)
+	if err != nil {
+		return err
+	}
+
+	var manager = lifecycle.NewManager(cfg.ShutdownTimeout, app.logger)
+
+	manager.Append(lifecycle.Hook{
+		Name: "resources",
+		Stop: app.Close,
+	})
+
+	if cfg.MigrateOnStart {
+		manager.Append(lifecycle.Hook{
+			Name:  "migrations",
+			Start: app.migrate,
+		})
+	}
+
+	if cfg.GRPC.Enabled {
+		grpcServer := NewGRPCServer(app)
+		manager.Append(lifecycle.Hook{
+			Name: "grpc server",
+			Run:  grpcServer.Start,
+			Stop: grpcServer.Stop,
+		})
+	}
+
+	manager.Append(lifecycle.Hook{
+		Name: "http server",
+		Run:  app.Start,
+		Stop: app.Stop,
+	})
+
+	ctx, cancel := lifecycle.SignalContext(context.Background())
+	defer cancel()
+
+	return manager.Run(ctx)
+}
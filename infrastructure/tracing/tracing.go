@@ -0,0 +1,136 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/GSabadini/golang-clean-architecture/adapter/logger"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/config"
+	"github.com/GSabadini/golang-clean-architecture/usecase"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/exporters/stdout"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName names the tracer of the spans created by the application
+const instrumentationName = "github.com/GSabadini/golang-clean-architecture"
+
+type (
+	// Provider creates the spans of the application and exports them with the exporter of the configuration
+	Provider struct {
+		provider  *sdktrace.TracerProvider
+		tracer    trace.Tracer
+		exporting bool
+	}
+
+	// span adapts a span of OpenTelemetry to the Span port of the use cases
+	span struct {
+		span trace.Span
+	}
+
+	// errorHandler logs the errors of OpenTelemetry, as the spans failed to be exported
+	errorHandler struct {
+		log logger.Logger
+	}
+)
+
+// New creates new Provider exporting to the exporter of the configuration, none exports nothing but still
+// generates the trace ids propagated to the outbound requests and logged. It is registered as the global
+// provider, with the W3C trace context as the propagator and log as the handler of its errors
+func New(cfg config.Tracing, log logger.Logger) (*Provider, error) {
+	otel.SetErrorHandler(errorHandler{log: log})
+
+	return newProvider(cfg, os.Stdout)
+}
+
+func newProvider(cfg config.Tracing, w io.Writer) (*Provider, error) {
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.ServiceNameKey.String(cfg.ServiceName))),
+	}
+
+	switch cfg.Exporter {
+	case "none":
+	case "stdout":
+		exporter, err := stdout.NewExporter(stdout.WithWriter(w), stdout.WithoutMetricExport())
+		if err != nil {
+			return nil, fmt.Errorf("create stdout exporter: %w", err)
+		}
+
+		opts = append(opts, sdktrace.WithSyncer(exporter))
+	case "otlp":
+		driverOpts := []otlpgrpc.Option{otlpgrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			driverOpts = append(driverOpts, otlpgrpc.WithInsecure())
+		}
+
+		// the exporter connects in background, an unreachable collector doesn't prevent the startup
+		exporter, err := otlp.NewExporter(context.Background(), otlpgrpc.NewDriver(driverOpts...))
+		if err != nil {
+			return nil, fmt.Errorf("create otlp exporter: %w", err)
+		}
+
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", cfg.Exporter)
+	}
+
+	provider := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return &Provider{
+		provider:  provider,
+		tracer:    provider.Tracer(instrumentationName),
+		exporting: cfg.Exporter != "none",
+	}, nil
+}
+
+// Tracer returns the tracer of the spans of the application
+func (p *Provider) Tracer() trace.Tracer {
+	return p.tracer
+}
+
+// Start starts a span of a use case, child of the span of the context
+func (p *Provider) Start(ctx context.Context, name string) (context.Context, usecase.Span) {
+	ctx, s := p.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindInternal))
+	return ctx, span{span: s}
+}
+
+// Shutdown exports the spans not exported yet and stops the exporter, until the deadline of the context
+func (p *Provider) Shutdown(ctx context.Context) error {
+	if !p.exporting {
+		return nil
+	}
+
+	return p.provider.Shutdown(ctx)
+}
+
+// RecordError marks the span as failed with err
+func (s span) RecordError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+// End completes the span
+func (s span) End() {
+	s.span.End()
+}
+
+// Handle logs err, the SDK also reports nil errors which are ignored
+func (h errorHandler) Handle(err error) {
+	if err == nil {
+		return
+	}
+
+	h.log.WithError(err).Errorf("tracing error")
+}
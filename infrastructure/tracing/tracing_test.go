@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/config"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/logger"
+	"go.opentelemetry.io/otel"
+)
+
+func TestProvider(t *testing.T) {
+	otel.SetErrorHandler(errorHandler{log: logger.Dummy{}})
+
+	tests := []struct {
+		name        string
+		exporter    string
+		fail        bool
+		expected    []string
+		expectedErr string
+	}{
+		{
+			name:     "Spans exported to stdout",
+			exporter: "stdout",
+			expected: []string{`"Name":"CreateTransfer.Execute"`, `"service.name"`},
+		},
+		{
+			name:     "Span failed exported with its error",
+			exporter: "stdout",
+			fail:     true,
+			expected: []string{`"StatusCode":"Error"`, `"StatusMessage":"insufficient balance"`},
+		},
+		{
+			name:     "Spans not exported",
+			exporter: "none",
+		},
+		{
+			name:        "Unknown exporter",
+			exporter:    "jaeger",
+			expectedErr: `unknown tracing exporter "jaeger"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				out bytes.Buffer
+				cfg = config.Default().Tracing
+			)
+			cfg.Exporter = tt.exporter
+
+			provider, err := newProvider(cfg, &out)
+			if (err != nil || tt.expectedErr != "") && (err == nil || err.Error() != tt.expectedErr) {
+				t.Fatalf("[TestCase '%s'] Got error: '%v' | Want error: '%v'", tt.name, err, tt.expectedErr)
+			}
+			if err != nil {
+				return
+			}
+
+			_, span := provider.Start(context.Background(), "CreateTransfer.Execute")
+			if tt.fail {
+				span.RecordError(errors.New("insufficient balance"))
+			}
+			span.End()
+
+			if err := provider.Shutdown(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, want := range tt.expected {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("[TestCase '%s'] Got output: '%s' | Want: '%s'", tt.name, out.String(), want)
+				}
+			}
+
+			if len(tt.expected) == 0 && out.Len() > 0 {
+				t.Errorf("[TestCase '%s'] Got output: '%s' | Want: ''", tt.name, out.String())
+			}
+		})
+	}
+}
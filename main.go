@@ -2,9 +2,27 @@ package main
 
 import (
 	_ "encoding/json"
+	"log"
+	"os"
+
 	"github.com/GSabadini/golang-clean-architecture/infrastructure"
+	"github.com/GSabadini/golang-clean-architecture/infrastructure/config"
 )
 
 func main() {
-	infrastructure.NewHTTPServer().Start()
+	cfg, err := config.Load(os.Getenv("CONFIG_PATH"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(os.Args) > 1 {
+		if err := infrastructure.RunCommand(cfg, os.Args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if err := infrastructure.Serve(cfg); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	AddDisputeEvidenceUseCase interface {
+		Execute(context.Context, AddDisputeEvidenceInput) (DisputeOutput, error)
+	}
+
+	// Input data
+	AddDisputeEvidenceInput struct {
+		ID       vo.Uuid
+		Evidence entity.Evidence
+	}
+
+	addDisputeEvidenceInteractor struct {
+		repoDispute entity.DisputeRepository
+		notifier    DisputeNotifier
+		pre         DisputePresenter
+	}
+)
+
+// NewAddDisputeEvidenceInteractor creates new addDisputeEvidenceInteractor with its dependencies
+func NewAddDisputeEvidenceInteractor(
+	repoDispute entity.DisputeRepository,
+	notifier DisputeNotifier,
+	pre DisputePresenter,
+) AddDisputeEvidenceUseCase {
+	return addDisputeEvidenceInteractor{
+		repoDispute: repoDispute,
+		notifier:    notifier,
+		pre:         pre,
+	}
+}
+
+// Execute orchestrates the use case, both parties are notified of the evidence
+func (a addDisputeEvidenceInteractor) Execute(ctx context.Context, i AddDisputeEvidenceInput) (DisputeOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	found, err := a.repoDispute.FindByID(ctx, i.ID)
+	if err != nil {
+		return a.pre.Output(entity.Dispute{}), err
+	}
+
+	dispute, err := found.AddEvidence(i.Evidence)
+	if err != nil {
+		return a.pre.Output(entity.Dispute{}), err
+	}
+
+	if err := a.repoDispute.AddEvidence(ctx, dispute, i.Evidence); err != nil {
+		return a.pre.Output(entity.Dispute{}), err
+	}
+
+	notifyDisputeParties(ctx, a.notifier, dispute, entity.DisputeEvidenceAddedNotice)
+
+	return a.pre.Output(dispute), nil
+}
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func TestAddDisputeEvidenceInteractor_Execute(t *testing.T) {
+	var (
+		createdAt   = time.Date(2020, 11, 10, 0, 0, 0, 0, time.UTC)
+		dispute     = entity.NewDispute(vo.NewUuidStaticTest(), newTestTransfer(70), "item not delivered", createdAt)
+		reviewed, _ = dispute.Review(createdAt)
+		lost, _     = dispute.Resolve(entity.DisputeLost, createdAt)
+		stranger    = escrowTestAccountID
+		unknown, _  = vo.NewUuid("2b4d6f8a-1c3e-4a5b-9d7f-0e2c4a6b8d10")
+		full        = func() entity.Dispute {
+			var full = dispute
+			for i := 0; i < entity.MaxDisputeEvidence; i++ {
+				evidence, _ := entity.NewEvidence(dispute.Payer(), map[string]string{"receipt": "https://files/receipt.pdf"}, createdAt)
+				full, _ = full.AddEvidence(evidence)
+			}
+
+			return full
+		}()
+	)
+
+	tests := []struct {
+		name         string
+		dispute      entity.Dispute
+		ID           vo.Uuid
+		submittedBy  vo.Uuid
+		updateErr    error
+		wantEvidence int
+		wantStatus   entity.DisputeStatus
+		wantErr      error
+	}{
+		{
+			name:         "Add evidence by the payer",
+			dispute:      dispute,
+			ID:           dispute.ID(),
+			submittedBy:  dispute.Payer(),
+			wantEvidence: 1,
+			wantStatus:   entity.DisputeOpen,
+		},
+		{
+			name:         "Add evidence by the payee",
+			dispute:      dispute,
+			ID:           dispute.ID(),
+			submittedBy:  dispute.Payee(),
+			wantEvidence: 1,
+			wantStatus:   entity.DisputeOpen,
+		},
+		{
+			name:         "Add evidence to a dispute under review",
+			dispute:      reviewed,
+			ID:           reviewed.ID(),
+			submittedBy:  dispute.Payee(),
+			wantEvidence: 1,
+			wantStatus:   entity.DisputeUnderReview,
+		},
+		{
+			name:        "Add evidence by someone else",
+			dispute:     dispute,
+			ID:          dispute.ID(),
+			submittedBy: stranger,
+			wantErr:     entity.ErrNotDisputeParty,
+		},
+		{
+			name:        "Add evidence to a resolved dispute",
+			dispute:     lost,
+			ID:          lost.ID(),
+			submittedBy: dispute.Payer(),
+			wantErr:     entity.ErrDisputeResolved,
+		},
+		{
+			name:        "Add evidence beyond the maximum",
+			dispute:     full,
+			ID:          full.ID(),
+			submittedBy: dispute.Payer(),
+			wantErr:     entity.ErrTooManyEvidence,
+		},
+		{
+			name:        "Add evidence to a dispute changed meanwhile",
+			dispute:     dispute,
+			ID:          dispute.ID(),
+			submittedBy: dispute.Payer(),
+			updateErr:   entity.ErrDisputeChanged,
+			wantErr:     entity.ErrDisputeChanged,
+		},
+		{
+			name:        "Add evidence to an unknown dispute",
+			dispute:     dispute,
+			ID:          unknown,
+			submittedBy: dispute.Payer(),
+			wantErr:     entity.ErrNotFoundDispute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				repo = &spyDisputeRepo{
+					disputes:  map[vo.Uuid]entity.Dispute{tt.dispute.ID(): tt.dispute},
+					updateErr: tt.updateErr,
+				}
+				notifier = &spyDisputeNotifier{}
+			)
+
+			evidence, err := entity.NewEvidence(tt.submittedBy, map[string]string{"receipt": "https://files/receipt.pdf"}, createdAt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := NewAddDisputeEvidenceInteractor(repo, notifier, stubDisputePresenter{}).Execute(
+				context.Background(),
+				AddDisputeEvidenceInput{ID: tt.ID, Evidence: evidence},
+			)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if err != nil {
+				if len(notifier.notices) != 0 {
+					t.Errorf("[TestCase '%s'] Got: '%v notices' | Want: '%v notices'", tt.name, len(notifier.notices), 0)
+				}
+				return
+			}
+
+			if len(got.Evidence) != tt.wantEvidence {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, len(got.Evidence), tt.wantEvidence)
+			}
+
+			assertDisputeNotices(t, tt.name, notifier.notices, entity.DisputeEvidenceAddedNotice, tt.wantStatus)
+		})
+	}
+}
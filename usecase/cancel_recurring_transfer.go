@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	CancelRecurringTransferUseCase interface {
+		Execute(context.Context, CancelRecurringTransferInput) (RecurringTransferOutput, error)
+	}
+
+	// Input data
+	CancelRecurringTransferInput struct {
+		ID         vo.Uuid
+		CanceledAt time.Time
+	}
+
+	cancelRecurringTransferInteractor struct {
+		repoFinder  entity.RecurringTransferRepositoryFinder
+		repoUpdater entity.RecurringTransferRepositoryUpdater
+		pre         RecurringTransferPresenter
+	}
+)
+
+// NewCancelRecurringTransferInteractor creates new cancelRecurringTransferInteractor with its dependencies
+func NewCancelRecurringTransferInteractor(
+	repoFinder entity.RecurringTransferRepositoryFinder,
+	repoUpdater entity.RecurringTransferRepositoryUpdater,
+	pre RecurringTransferPresenter,
+) CancelRecurringTransferUseCase {
+	return cancelRecurringTransferInteractor{
+		repoFinder:  repoFinder,
+		repoUpdater: repoUpdater,
+		pre:         pre,
+	}
+}
+
+// Execute orchestrates the use case, the transfers of the occurrences already executed are kept
+func (c cancelRecurringTransferInteractor) Execute(ctx context.Context, i CancelRecurringTransferInput) (RecurringTransferOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	recurring, err := c.repoFinder.FindByID(ctx, i.ID)
+	if err != nil {
+		return c.pre.Output(entity.RecurringTransfer{}), err
+	}
+
+	recurring, err = recurring.Cancel(i.CanceledAt)
+	if err != nil {
+		return c.pre.Output(entity.RecurringTransfer{}), err
+	}
+
+	if err := c.repoUpdater.Update(ctx, recurring); err != nil {
+		return c.pre.Output(entity.RecurringTransfer{}), err
+	}
+
+	return c.pre.Output(recurring), nil
+}
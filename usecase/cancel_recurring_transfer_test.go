@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func TestCancelRecurringTransferInteractor_Execute(t *testing.T) {
+	var newRecurringTransfer = func(status entity.RecurrenceStatus) entity.RecurringTransfer {
+		return entity.RestoreRecurringTransfer(
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewMoneyBRL(vo.NewAmountTest(100)),
+			entity.Monthly,
+			15,
+			time.Date(2021, 1, 15, 9, 0, 0, 0, time.UTC),
+			time.Time{},
+			0,
+			2,
+			0,
+			3,
+			"",
+			status,
+			time.Time{},
+			time.Time{},
+		)
+	}
+
+	tests := []struct {
+		name    string
+		repo    stubRecurringTransferRepo
+		want    RecurringTransferOutput
+		wantErr error
+	}{
+		{
+			name: "Cancel active recurring transfer",
+			repo: stubRecurringTransferRepo{result: newRecurringTransfer(entity.RecurrenceActive)},
+			want: RecurringTransferOutput{
+				ID:     "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:  100,
+				Status: "CANCELED",
+			},
+		},
+		{
+			name: "Cancel paused recurring transfer",
+			repo: stubRecurringTransferRepo{result: newRecurringTransfer(entity.RecurrencePaused)},
+			want: RecurringTransferOutput{
+				ID:     "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:  100,
+				Status: "CANCELED",
+			},
+		},
+		{
+			name:    "Cancel finished recurring transfer",
+			repo:    stubRecurringTransferRepo{result: newRecurringTransfer(entity.RecurrenceFinished)},
+			wantErr: entity.ErrRecurringTransferClosed,
+		},
+		{
+			name: "Cancel while executed by a worker",
+			repo: stubRecurringTransferRepo{
+				result:    newRecurringTransfer(entity.RecurrenceActive),
+				updateErr: entity.ErrRecurringTransferBusy,
+			},
+			wantErr: entity.ErrRecurringTransferBusy,
+		},
+		{
+			name:    "Cancel not found recurring transfer",
+			repo:    stubRecurringTransferRepo{findErr: entity.ErrNotFoundRecurringTransfer},
+			wantErr: entity.ErrNotFoundRecurringTransfer,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCancelRecurringTransferInteractor(
+				tt.repo,
+				tt.repo,
+				stubRecurringTransferPresenter{},
+			).Execute(context.TODO(), CancelRecurringTransferInput{
+				ID:         vo.NewUuidStaticTest(),
+				CanceledAt: time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC),
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr == nil && got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
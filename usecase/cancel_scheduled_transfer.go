@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	CancelScheduledTransferUseCase interface {
+		Execute(context.Context, CancelScheduledTransferInput) (ScheduledTransferOutput, error)
+	}
+
+	// Input data
+	CancelScheduledTransferInput struct {
+		ID         vo.Uuid
+		CanceledAt time.Time
+	}
+
+	cancelScheduledTransferInteractor struct {
+		repoFinder   entity.ScheduledTransferRepositoryFinder
+		repoCanceler entity.ScheduledTransferRepositoryCanceler
+		pre          ScheduledTransferPresenter
+	}
+)
+
+// NewCancelScheduledTransferInteractor creates new cancelScheduledTransferInteractor with its dependencies
+func NewCancelScheduledTransferInteractor(
+	repoFinder entity.ScheduledTransferRepositoryFinder,
+	repoCanceler entity.ScheduledTransferRepositoryCanceler,
+	pre ScheduledTransferPresenter,
+) CancelScheduledTransferUseCase {
+	return cancelScheduledTransferInteractor{
+		repoFinder:   repoFinder,
+		repoCanceler: repoCanceler,
+		pre:          pre,
+	}
+}
+
+// Execute orchestrates the use case
+func (c cancelScheduledTransferInteractor) Execute(ctx context.Context, i CancelScheduledTransferInput) (ScheduledTransferOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	scheduled, err := c.repoFinder.FindByID(ctx, i.ID)
+	if err != nil {
+		return c.pre.Output(entity.ScheduledTransfer{}), err
+	}
+
+	canceled, err := scheduled.Cancel(i.CanceledAt)
+	if err != nil {
+		return c.pre.Output(entity.ScheduledTransfer{}), err
+	}
+
+	if err := c.repoCanceler.Cancel(ctx, canceled); err != nil {
+		return c.pre.Output(entity.ScheduledTransfer{}), err
+	}
+
+	return c.pre.Output(canceled), nil
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	CaptureHoldUseCase interface {
+		Execute(context.Context, CaptureHoldInput) (HoldOutput, error)
+	}
+
+	// Input data
+	CaptureHoldInput struct {
+		ID vo.Uuid
+		// Value captured, the whole value held when nil
+		Value      *vo.Money
+		CapturedAt time.Time
+	}
+
+	// Output port
+	CaptureHoldPresenter interface {
+		Output(entity.Hold, CreateTransferOutput) HoldOutput
+	}
+
+	captureHoldInteractor struct {
+		repoHold       entity.HoldRepository
+		repoUserFinder entity.UserRepositoryFinder
+		repoUserHolder entity.UserRepositoryHolder
+		ucTransfer     CreateTransferUseCase
+		notifier       Notifier
+		pre            CaptureHoldPresenter
+	}
+)
+
+// NewCaptureHoldInteractor creates new captureHoldInteractor with its dependencies. The transfer use case must
+// not notify, the payee is notified by the interactor once the capture is committed
+func NewCaptureHoldInteractor(
+	repoHold entity.HoldRepository,
+	repoUserFinder entity.UserRepositoryFinder,
+	repoUserHolder entity.UserRepositoryHolder,
+	ucTransfer CreateTransferUseCase,
+	notifier Notifier,
+	pre CaptureHoldPresenter,
+) CaptureHoldUseCase {
+	return captureHoldInteractor{
+		repoHold:       repoHold,
+		repoUserFinder: repoUserFinder,
+		repoUserHolder: repoUserHolder,
+		ucTransfer:     ucTransfer,
+		notifier:       notifier,
+		pre:            pre,
+	}
+}
+
+// Execute orchestrates the use case, the whole hold is released and the captured value transferred in a
+// single transaction. The transfer has the ID of the hold
+func (c captureHoldInteractor) Execute(ctx context.Context, i CaptureHoldInput) (HoldOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var (
+		hold     entity.Hold
+		transfer CreateTransferOutput
+	)
+
+	err := c.repoHold.WithTransaction(ctx, func(sessCtx context.Context) error {
+		found, err := c.repoHold.FindByID(sessCtx, i.ID)
+		if err != nil {
+			return err
+		}
+
+		var value = found.Value()
+		if i.Value != nil {
+			value = *i.Value
+		}
+
+		hold, err = found.Capture(value, i.CapturedAt)
+		if err != nil {
+			return err
+		}
+
+		if err := c.repoHold.Close(sessCtx, hold); err != nil {
+			return err
+		}
+
+		if err := releaseHold(sessCtx, c.repoUserFinder, c.repoUserHolder, hold); err != nil {
+			return err
+		}
+
+		var t = hold.Transfer()
+		transfer, err = c.ucTransfer.Execute(sessCtx, CreateTransferInput{
+			ID:        t.ID(),
+			PayerID:   t.Payer(),
+			PayeeID:   t.Payee(),
+			Value:     t.Value(),
+			CreatedAt: t.CreatedAt(),
+		})
+
+		return err
+	})
+	if err != nil {
+		return c.pre.Output(entity.Hold{}, CreateTransferOutput{}), err
+	}
+
+	c.notifier.Notify(ctx, hold.Transfer())
+
+	return c.pre.Output(hold, transfer), nil
+}
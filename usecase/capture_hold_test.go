@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type stubCaptureHoldPresenter struct{}
+
+func (s stubCaptureHoldPresenter) Output(h entity.Hold, t CreateTransferOutput) HoldOutput {
+	return HoldOutput{Status: string(h.Status()), Captured: h.Captured().Amount().Value(), Transfer: &t}
+}
+
+func TestCaptureHoldInteractor_Execute(t *testing.T) {
+	var (
+		createdAt = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+		holdID, _ = vo.NewUuid("9c5e2b1a-4d3f-4e6a-8b7c-1f2e3d4c5b6a")
+		newHold   = func() entity.Hold {
+			hold, _ := entity.NewHold(
+				holdID,
+				payerTestID,
+				vo.NewUuidStaticTest(),
+				vo.NewMoneyBRL(vo.NewAmountTest(100)),
+				createdAt.Add(time.Hour),
+				createdAt,
+			)
+			return hold
+		}
+		voided, _ = newHold().Void(createdAt)
+		value     = func(v int64) *vo.Money {
+			money := vo.NewMoneyBRL(vo.NewAmountTest(v))
+			return &money
+		}
+	)
+
+	tests := []struct {
+		name         string
+		hold         entity.Hold
+		value        *vo.Money
+		wantWallets  spyWalletsUpdater
+		wantHeld     spyUserRepoHolder
+		wantNotified int
+		wantErr      error
+	}{
+		{
+			name:  "Capture the whole hold",
+			hold:  newHold(),
+			value: nil,
+			wantWallets: spyWalletsUpdater{
+				payerTestID:            0,
+				vo.NewUuidStaticTest(): 100,
+			},
+			wantHeld:     spyUserRepoHolder{payerTestID: 0},
+			wantNotified: 1,
+		},
+		{
+			name:  "Capture part of the hold releasing the rest",
+			hold:  newHold(),
+			value: value(60),
+			wantWallets: spyWalletsUpdater{
+				payerTestID:            40,
+				vo.NewUuidStaticTest(): 60,
+			},
+			wantHeld:     spyUserRepoHolder{payerTestID: 0},
+			wantNotified: 1,
+		},
+		{
+			name:        "Capture more than the hold",
+			hold:        newHold(),
+			value:       value(101),
+			wantWallets: spyWalletsUpdater{},
+			wantHeld:    spyUserRepoHolder{},
+			wantErr:     entity.ErrInvalidCaptureValue,
+		},
+		{
+			name:        "Capture a voided hold",
+			hold:        voided,
+			wantWallets: spyWalletsUpdater{},
+			wantHeld:    spyUserRepoHolder{},
+			wantErr:     entity.ErrHoldNotActive,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				repo  = &spyHoldRepo{holds: map[vo.Uuid]entity.Hold{holdID: tt.hold}}
+				users = newTestUsers(
+					newHeldTestUser(newCommonTestUser(payerTestID, 100), 100),
+					newMerchantTestUser(vo.NewUuidStaticTest(), 0),
+				)
+				holder   = spyUserRepoHolder{}
+				wallets  = spyWalletsUpdater{}
+				notifier = &spyNotifier{}
+			)
+
+			ucTransfer := NewCreateTransferInteractor(
+				stubTransferRepoCreator{},
+				wallets,
+				users,
+				stubTransferRepoSentSummarizer{},
+				stubAuthorizer{result: true},
+				stubNotifier{},
+				entity.FeeSchedule{},
+				&spyTracer{},
+				stubCreateTransferPresenter{},
+			)
+
+			uc := NewCaptureHoldInteractor(repo, users, holder, ucTransfer, notifier, stubCaptureHoldPresenter{})
+
+			got, err := uc.Execute(context.Background(), CaptureHoldInput{
+				ID:         holdID,
+				Value:      tt.value,
+				CapturedAt: createdAt.Add(time.Minute),
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if len(wallets) != len(tt.wantWallets) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, wallets, tt.wantWallets)
+			}
+			for ID, want := range tt.wantWallets {
+				if wallets[ID] != want {
+					t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, wallets, tt.wantWallets)
+				}
+			}
+
+			if len(holder) != len(tt.wantHeld) || holder[payerTestID] != tt.wantHeld[payerTestID] {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, holder, tt.wantHeld)
+			}
+
+			if err == nil && got.Status != string(entity.HoldCaptured) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Status, entity.HoldCaptured)
+			}
+
+			if notifier.notified != tt.wantNotified {
+				t.Errorf("[TestCase '%s'] Notified Got: '%v' | Want: '%v'", tt.name, notifier.notified, tt.wantNotified)
+			}
+		})
+	}
+}
+
+func TestVoidHoldInteractor_Execute(t *testing.T) {
+	var (
+		createdAt = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+		payer     = newHeldTestUser(newCommonTestUser(payerTestID, 100), 100)
+		hold, _   = entity.NewHold(
+			vo.NewUuidStaticTest(),
+			payer.ID(),
+			vo.NewUuidStaticTest(),
+			vo.NewMoneyBRL(vo.NewAmountTest(100)),
+			createdAt.Add(time.Hour),
+			createdAt,
+		)
+		repo   = &spyHoldRepo{holds: map[vo.Uuid]entity.Hold{hold.ID(): hold}}
+		holder = spyUserRepoHolder{}
+		users  = newTestUsers(payer, newMerchantTestUser(vo.NewUuidStaticTest(), 0))
+		uc     = NewVoidHoldInteractor(repo, users, holder, stubHoldPresenter{})
+	)
+
+	got, err := uc.Execute(context.Background(), VoidHoldInput{ID: hold.ID(), VoidedAt: createdAt})
+	if err != nil || got.Status != string(entity.HoldVoided) || holder[payer.ID()] != 0 || len(holder) != 1 {
+		t.Errorf("[TestCase '%s'] Got: '%v %v %v' | Want: '%v'", "Void active hold", got.Status, holder, err, entity.HoldVoided)
+	}
+
+	if _, err := uc.Execute(context.Background(), VoidHoldInput{ID: hold.ID(), VoidedAt: createdAt}); !errors.Is(err, entity.ErrHoldNotActive) {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Void voided hold", err, entity.ErrHoldNotActive)
+	}
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	ConfirmEscrowTransferUseCase interface {
+		Execute(context.Context, ConfirmEscrowTransferInput) (EscrowTransferOutput, error)
+	}
+
+	// Input data
+	ConfirmEscrowTransferInput struct {
+		ID          vo.Uuid
+		ConfirmedAt time.Time
+	}
+
+	confirmEscrowTransferInteractor struct {
+		repoEscrow          entity.EscrowTransferRepository
+		repoTransferCreator entity.TransferRepositoryCreator
+		repoUserUpdater     entity.UserRepositoryUpdater
+		repoUserFinder      entity.UserRepositoryFinder
+		notifier            Notifier
+		pre                 EscrowTransferPresenter
+	}
+)
+
+// NewConfirmEscrowTransferInteractor creates new confirmEscrowTransferInteractor with its dependencies
+func NewConfirmEscrowTransferInteractor(
+	repoEscrow entity.EscrowTransferRepository,
+	repoTransferCreator entity.TransferRepositoryCreator,
+	repoUserUpdater entity.UserRepositoryUpdater,
+	repoUserFinder entity.UserRepositoryFinder,
+	notifier Notifier,
+	pre EscrowTransferPresenter,
+) ConfirmEscrowTransferUseCase {
+	return confirmEscrowTransferInteractor{
+		repoEscrow:          repoEscrow,
+		repoTransferCreator: repoTransferCreator,
+		repoUserUpdater:     repoUserUpdater,
+		repoUserFinder:      repoUserFinder,
+		notifier:            notifier,
+		pre:                 pre,
+	}
+}
+
+// Execute orchestrates the use case, the confirmation of the delivery releases the funds to the payee
+func (c confirmEscrowTransferInteractor) Execute(ctx context.Context, i ConfirmEscrowTransferInput) (EscrowTransferOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var escrow entity.EscrowTransfer
+
+	err := c.repoEscrow.WithTransaction(ctx, func(sessCtx context.Context) error {
+		found, err := c.repoEscrow.FindByID(sessCtx, i.ID)
+		if err != nil {
+			return err
+		}
+
+		escrow, err = found.Confirm(i.ConfirmedAt)
+		if err != nil {
+			return err
+		}
+
+		return settleEscrowTransfer(
+			sessCtx,
+			c.repoEscrow,
+			c.repoTransferCreator,
+			c.repoUserFinder,
+			c.repoUserUpdater,
+			entity.EscrowHeld,
+			escrow,
+		)
+	})
+	if err != nil {
+		return c.pre.Output(entity.EscrowTransfer{}), err
+	}
+
+	c.notifier.Notify(ctx, escrow.Settlement())
+
+	return c.pre.Output(escrow), nil
+}
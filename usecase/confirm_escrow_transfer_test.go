@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func newEscrowTestTransfer(t *testing.T, createdAt time.Time) entity.EscrowTransfer {
+	escrow, err := entity.NewEscrowTransfer(
+		vo.NewUuidStaticTest(),
+		payerTestID,
+		vo.NewUuidStaticTest(),
+		escrowTestAccountID,
+		vo.NewMoneyBRL(vo.NewAmountTest(70)),
+		createdAt.Add(time.Hour),
+		createdAt,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return escrow
+}
+
+func TestConfirmEscrowTransferInteractor_Execute(t *testing.T) {
+	var (
+		createdAt   = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+		escrow      = newEscrowTestTransfer(t, createdAt)
+		disputed, _ = escrow.Dispute("item not delivered", createdAt)
+		accountID   = escrowTestAccountID
+		payeeID     = vo.NewUuidStaticTest()
+	)
+
+	tests := []struct {
+		name         string
+		escrow       entity.EscrowTransfer
+		payeeBalance int64
+		wantWallets  spyWalletsUpdater
+		wantErr      error
+	}{
+		{
+			name:        "Confirm escrow transfer releases the funds to the payee",
+			escrow:      escrow,
+			wantWallets: spyWalletsUpdater{accountID: 0, payeeID: 70},
+		},
+		{
+			name:        "Confirm disputed escrow transfer",
+			escrow:      disputed,
+			wantWallets: spyWalletsUpdater{},
+			wantErr:     entity.ErrEscrowTransferNotInEscrow,
+		},
+		{
+			name:         "Confirm escrow transfer above the wallet balance limit of the payee",
+			escrow:       escrow,
+			payeeBalance: entity.KYCBasic.Limits().WalletBalance() - 69,
+			wantWallets:  spyWalletsUpdater{},
+			wantErr:      entity.ErrKYCLimitExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				repo      = &spyEscrowTransferRepo{escrows: map[vo.Uuid]entity.EscrowTransfer{tt.escrow.ID(): tt.escrow}}
+				transfers = &spyTransferRepoCreator{}
+				wallets   = spyWalletsUpdater{}
+				users     = newTestUsers(
+					newCommonTestUser(payerTestID, 0),
+					newMerchantTestUser(payeeID, tt.payeeBalance),
+					newCommonTestUser(escrowTestAccountID, 70),
+				)
+			)
+
+			uc := NewConfirmEscrowTransferInteractor(
+				repo,
+				transfers,
+				wallets,
+				users,
+				stubNotifier{},
+				stubEscrowTransferPresenter{},
+			)
+
+			got, err := uc.Execute(context.Background(), ConfirmEscrowTransferInput{
+				ID:          tt.escrow.ID(),
+				ConfirmedAt: createdAt.Add(time.Minute),
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if len(wallets) != len(tt.wantWallets) || wallets[accountID] != tt.wantWallets[accountID] || wallets[payeeID] != tt.wantWallets[payeeID] {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, wallets, tt.wantWallets)
+			}
+
+			if err == nil && (got.Status != string(entity.EscrowReleased) || len(transfers.transfers) != 1) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Status, entity.EscrowReleased)
+			}
+		})
+	}
+}
+
+func TestDisputeEscrowTransferInteractor_Execute(t *testing.T) {
+	var (
+		createdAt = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+		escrow    = newEscrowTestTransfer(t, createdAt)
+		repo      = &spyEscrowTransferRepo{escrows: map[vo.Uuid]entity.EscrowTransfer{escrow.ID(): escrow}}
+		uc        = NewDisputeEscrowTransferInteractor(repo, stubEscrowTransferPresenter{})
+	)
+
+	got, err := uc.Execute(context.Background(), DisputeEscrowTransferInput{
+		ID:         escrow.ID(),
+		Reason:     "item not delivered",
+		DisputedAt: createdAt.Add(time.Minute),
+	})
+	if err != nil || got.Status != string(entity.EscrowDisputed) {
+		t.Errorf("[TestCase '%s'] Got: '%v %v' | Want: '%v'", "Dispute escrow transfer", got.Status, err, entity.EscrowDisputed)
+	}
+
+	_, err = uc.Execute(context.Background(), DisputeEscrowTransferInput{
+		ID:         escrow.ID(),
+		Reason:     "item not delivered",
+		DisputedAt: createdAt.Add(time.Minute),
+	})
+	if !errors.Is(err, entity.ErrEscrowTransferNotInEscrow) {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Dispute disputed escrow transfer", err, entity.ErrEscrowTransferNotInEscrow)
+	}
+}
+
+func TestResolveEscrowTransferInteractor_Execute(t *testing.T) {
+	var (
+		createdAt   = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+		escrow      = newEscrowTestTransfer(t, createdAt)
+		disputed, _ = escrow.Dispute("item not delivered", createdAt)
+		accountID   = escrowTestAccountID
+		payerID     = payerTestID
+		payeeID     = vo.NewUuidStaticTest()
+	)
+
+	tests := []struct {
+		name        string
+		escrow      entity.EscrowTransfer
+		resolution  entity.EscrowResolution
+		wantStatus  entity.TransferStatus
+		wantWallets spyWalletsUpdater
+		wantErr     error
+	}{
+		{
+			name:        "Resolve releasing to the payee",
+			escrow:      disputed,
+			resolution:  entity.EscrowReleaseToPayee,
+			wantStatus:  entity.EscrowReleased,
+			wantWallets: spyWalletsUpdater{accountID: 0, payeeID: 70},
+		},
+		{
+			name:        "Resolve refunding the payer",
+			escrow:      disputed,
+			resolution:  entity.EscrowRefundToPayer,
+			wantStatus:  entity.EscrowRefunded,
+			wantWallets: spyWalletsUpdater{accountID: 0, payerID: 70},
+		},
+		{
+			name:        "Resolve escrow transfer not disputed",
+			escrow:      escrow,
+			resolution:  entity.EscrowRefundToPayer,
+			wantWallets: spyWalletsUpdater{},
+			wantErr:     entity.ErrEscrowTransferNotDisputed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				repo    = &spyEscrowTransferRepo{escrows: map[vo.Uuid]entity.EscrowTransfer{tt.escrow.ID(): tt.escrow}}
+				wallets = spyWalletsUpdater{}
+			)
+
+			uc := NewResolveEscrowTransferInteractor(
+				repo,
+				&spyTransferRepoCreator{},
+				wallets,
+				newTestUsers(
+					newCommonTestUser(payerTestID, 0),
+					newMerchantTestUser(vo.NewUuidStaticTest(), 0),
+					newCommonTestUser(escrowTestAccountID, 70),
+				),
+				stubNotifier{},
+				stubEscrowTransferPresenter{},
+			)
+
+			got, err := uc.Execute(context.Background(), ResolveEscrowTransferInput{
+				ID:         tt.escrow.ID(),
+				Resolution: tt.resolution,
+				ResolvedAt: createdAt.Add(time.Minute),
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if len(wallets) != len(tt.wantWallets) || wallets[accountID] != tt.wantWallets[accountID] ||
+				wallets[payerID] != tt.wantWallets[payerID] || wallets[payeeID] != tt.wantWallets[payeeID] {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, wallets, tt.wantWallets)
+			}
+
+			if err == nil && got.Status != string(tt.wantStatus) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Status, tt.wantStatus)
+			}
+		})
+	}
+}
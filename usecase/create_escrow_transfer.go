@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	CreateEscrowTransferUseCase interface {
+		Execute(context.Context, CreateEscrowTransferInput) (EscrowTransferOutput, error)
+	}
+
+	// Input data
+	CreateEscrowTransferInput struct {
+		ID        vo.Uuid
+		PayerID   vo.Uuid
+		PayeeID   vo.Uuid
+		Value     vo.Money
+		ReleaseAt time.Time
+		CreatedAt time.Time
+	}
+
+	// Output port
+	EscrowTransferPresenter interface {
+		Output(entity.EscrowTransfer) EscrowTransferOutput
+	}
+
+	// Output data
+	EscrowTransferOutput struct {
+		ID            string `json:"id"`
+		PayerID       string `json:"payer"`
+		PayeeID       string `json:"payee"`
+		Value         int64  `json:"value"`
+		Status        string `json:"status"`
+		DisputeReason string `json:"dispute_reason,omitempty"`
+		ReleaseAt     string `json:"release_at"`
+		CreatedAt     string `json:"created_at"`
+		UpdatedAt     string `json:"updated_at"`
+	}
+
+	createEscrowTransferInteractor struct {
+		repoEscrow             entity.EscrowTransferRepository
+		repoTransferCreator    entity.TransferRepositoryCreator
+		repoUserUpdater        entity.UserRepositoryUpdater
+		repoUserFinder         entity.UserRepositoryFinder
+		repoTransferSummarizer entity.TransferRepositorySentSummarizer
+		authorizer             Authorizer
+		account                vo.Uuid
+		pre                    EscrowTransferPresenter
+	}
+)
+
+// NewCreateEscrowTransferInteractor creates new createEscrowTransferInteractor with its dependencies,
+// account is the escrow account keeping the funds until they are released
+func NewCreateEscrowTransferInteractor(
+	repoEscrow entity.EscrowTransferRepository,
+	repoTransferCreator entity.TransferRepositoryCreator,
+	repoUserUpdater entity.UserRepositoryUpdater,
+	repoUserFinder entity.UserRepositoryFinder,
+	repoTransferSummarizer entity.TransferRepositorySentSummarizer,
+	authorizer Authorizer,
+	account vo.Uuid,
+	pre EscrowTransferPresenter,
+) CreateEscrowTransferUseCase {
+	return createEscrowTransferInteractor{
+		repoEscrow:             repoEscrow,
+		repoTransferCreator:    repoTransferCreator,
+		repoUserUpdater:        repoUserUpdater,
+		repoUserFinder:         repoUserFinder,
+		repoTransferSummarizer: repoTransferSummarizer,
+		authorizer:             authorizer,
+		account:                account,
+		pre:                    pre,
+	}
+}
+
+// Execute orchestrates the use case, the payer is debited into the escrow account by a transfer with the ID
+// of the escrow transfer, approved by the authorizer, in the same transaction that creates the escrow transfer.
+// The payer is kept within the limits of an ordinary transfer and the payee must be able to receive the value,
+// the escrow account itself has no limits
+func (c createEscrowTransferInteractor) Execute(ctx context.Context, i CreateEscrowTransferInput) (EscrowTransferOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	escrow, err := entity.NewEscrowTransfer(i.ID, i.PayerID, i.PayeeID, c.account, i.Value, i.ReleaseAt, i.CreatedAt)
+	if err != nil {
+		return c.pre.Output(entity.EscrowTransfer{}), err
+	}
+
+	err = c.repoEscrow.WithTransaction(ctx, func(sessCtx context.Context) error {
+		payer, err := c.repoUserFinder.FindByID(sessCtx, escrow.Payer())
+		if err != nil {
+			return err
+		}
+
+		var funding = escrow.Funding()
+		if err := checkSendingLimits(sessCtx, c.repoTransferSummarizer, payer, funding.Debited(), funding.CreatedAt()); err != nil {
+			return err
+		}
+
+		payee, err := c.repoUserFinder.FindByID(sessCtx, escrow.Payee())
+		if err != nil {
+			return err
+		}
+
+		if err := payee.CanReceive(escrow.Value()); err != nil {
+			return err
+		}
+
+		if err := moveFunds(sessCtx, c.repoUserFinder, c.repoUserUpdater, funding); err != nil {
+			return err
+		}
+
+		if _, err := c.repoTransferCreator.Create(sessCtx, funding); err != nil {
+			return err
+		}
+
+		ok, err := c.authorizer.Authorized(sessCtx, funding)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return entity.ErrUnauthorizedTransfer
+		}
+
+		escrow, err = c.repoEscrow.Create(sessCtx, escrow)
+
+		return err
+	})
+	if err != nil {
+		return c.pre.Output(entity.EscrowTransfer{}), err
+	}
+
+	return c.pre.Output(escrow), nil
+}
+
+// settleEscrowTransfer moves an escrow transfer out of the status from and pays its value from the escrow
+// account to the payee, or back to the payer when refunded, in the transaction of ctx. The one paid must be
+// able to receive the value, otherwise the funds stay in escrow
+func settleEscrowTransfer(
+	ctx context.Context,
+	repoEscrow entity.EscrowTransferRepositoryUpdater,
+	repoTransferCreator entity.TransferRepositoryCreator,
+	repoUserFinder entity.UserRepositoryFinder,
+	repoUserUpdater entity.UserRepositoryUpdater,
+	from entity.TransferStatus,
+	escrow entity.EscrowTransfer,
+) error {
+	if err := repoEscrow.Update(ctx, from, escrow); err != nil {
+		return err
+	}
+
+	var settlement = escrow.Settlement()
+
+	recipient, err := repoUserFinder.FindByID(ctx, settlement.Payee())
+	if err != nil {
+		return err
+	}
+
+	if err := recipient.CanReceive(settlement.Credited()); err != nil {
+		return err
+	}
+
+	if err := moveFunds(ctx, repoUserFinder, repoUserUpdater, settlement); err != nil {
+		return err
+	}
+
+	_, err = repoTransferCreator.Create(ctx, settlement)
+
+	return err
+}
+
+// moveFunds debits the payer and credits the payee of a transfer without fees
+func moveFunds(
+	ctx context.Context,
+	repoUserFinder entity.UserRepositoryFinder,
+	repoUserUpdater entity.UserRepositoryUpdater,
+	transfer entity.Transfer,
+) error {
+	payer, err := repoUserFinder.FindByID(ctx, transfer.Payer())
+	if err != nil {
+		return err
+	}
+
+	payee, err := repoUserFinder.FindByID(ctx, transfer.Payee())
+	if err != nil {
+		return err
+	}
+
+	if err := payer.Withdraw(transfer.Value()); err != nil {
+		return err
+	}
+
+	payee.Deposit(transfer.Value())
+
+	if err := repoUserUpdater.UpdateWallet(ctx, transfer.Payer(), payer.Wallet().Money()); err != nil {
+		return err
+	}
+
+	return repoUserUpdater.UpdateWallet(ctx, transfer.Payee(), payee.Wallet().Money())
+}
@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type spyEscrowTransferRepo struct {
+	escrows   map[vo.Uuid]entity.EscrowTransfer
+	due       []entity.EscrowTransfer
+	updateErr error
+	updated   []entity.EscrowTransfer
+}
+
+func (s *spyEscrowTransferRepo) Create(_ context.Context, e entity.EscrowTransfer) (entity.EscrowTransfer, error) {
+	s.escrows[e.ID()] = e
+	return e, nil
+}
+
+func (s *spyEscrowTransferRepo) FindByID(_ context.Context, ID vo.Uuid) (entity.EscrowTransfer, error) {
+	escrow, ok := s.escrows[ID]
+	if !ok {
+		return entity.EscrowTransfer{}, entity.ErrNotFoundEscrowTransfer
+	}
+
+	return escrow, nil
+}
+
+func (s *spyEscrowTransferRepo) FindDue(_ context.Context, _ time.Time, _ int) ([]entity.EscrowTransfer, error) {
+	return s.due, nil
+}
+
+func (s *spyEscrowTransferRepo) Update(_ context.Context, _ entity.TransferStatus, e entity.EscrowTransfer) error {
+	if s.updateErr != nil {
+		return s.updateErr
+	}
+
+	s.escrows[e.ID()] = e
+	s.updated = append(s.updated, e)
+	return nil
+}
+
+func (s *spyEscrowTransferRepo) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+type spyTransferRepoCreator struct {
+	transfers []entity.Transfer
+}
+
+func (s *spyTransferRepoCreator) Create(_ context.Context, t entity.Transfer) (entity.Transfer, error) {
+	s.transfers = append(s.transfers, t)
+	return t, nil
+}
+
+func (s *spyTransferRepoCreator) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+type stubEscrowTransferPresenter struct{}
+
+func (s stubEscrowTransferPresenter) Output(e entity.EscrowTransfer) EscrowTransferOutput {
+	return EscrowTransferOutput{ID: e.ID().Value(), Status: string(e.Status())}
+}
+
+func TestCreateEscrowTransferInteractor_Execute(t *testing.T) {
+	var (
+		createdAt = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+		payerID   = payerTestID
+		accountID = escrowTestAccountID
+	)
+
+	tests := []struct {
+		name         string
+		value        int64
+		payee        string
+		authorized   bool
+		sentToday    int64
+		payeeBalance int64
+		wantWallets  spyWalletsUpdater
+		wantErr      error
+	}{
+		{
+			name:        "Create escrow transfer success",
+			value:       70,
+			payee:       "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+			authorized:  true,
+			wantWallets: spyWalletsUpdater{payerID: 30, accountID: 70},
+		},
+		{
+			name:        "Create escrow transfer above the balance",
+			value:       101,
+			payee:       "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+			authorized:  true,
+			wantWallets: spyWalletsUpdater{},
+			wantErr:     entity.ErrUserInsufficientBalance,
+		},
+		{
+			name:        "Create escrow transfer for an unknown payee",
+			value:       70,
+			payee:       "7a1f5e0c-2c6b-4f7a-9d3e-0c2b9a4c8e11",
+			authorized:  true,
+			wantWallets: spyWalletsUpdater{},
+			wantErr:     entity.ErrNotFoundUser,
+		},
+		{
+			name:        "Create escrow transfer not authorized",
+			value:       70,
+			payee:       "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+			wantWallets: spyWalletsUpdater{payerID: 30, accountID: 70},
+			wantErr:     entity.ErrUnauthorizedTransfer,
+		},
+		{
+			name:        "Create escrow transfer above the daily outgoing limit of the payer",
+			value:       70,
+			payee:       "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+			authorized:  true,
+			sentToday:   entity.KYCBasic.Limits().DailyOutgoing() - 69,
+			wantWallets: spyWalletsUpdater{},
+			wantErr:     entity.ErrKYCLimitExceeded,
+		},
+		{
+			name:         "Create escrow transfer above the wallet balance limit of the payee",
+			value:        70,
+			payee:        "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+			authorized:   true,
+			payeeBalance: entity.KYCBasic.Limits().WalletBalance() - 69,
+			wantWallets:  spyWalletsUpdater{},
+			wantErr:      entity.ErrKYCLimitExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				repo      = &spyEscrowTransferRepo{escrows: map[vo.Uuid]entity.EscrowTransfer{}}
+				transfers = &spyTransferRepoCreator{}
+				wallets   = spyWalletsUpdater{}
+				payee, _  = vo.NewUuid(tt.payee)
+				users     = newTestUsers(
+					newCommonTestUser(payerTestID, 100),
+					newMerchantTestUser(vo.NewUuidStaticTest(), tt.payeeBalance),
+					newCommonTestUser(escrowTestAccountID, 0),
+				)
+			)
+
+			uc := NewCreateEscrowTransferInteractor(
+				repo,
+				transfers,
+				wallets,
+				users,
+				stubTransferRepoSentSummarizer{result: tt.sentToday},
+				&spyAuthorizer{result: tt.authorized},
+				accountID,
+				stubEscrowTransferPresenter{},
+			)
+
+			got, err := uc.Execute(context.Background(), CreateEscrowTransferInput{
+				ID:        vo.NewUuidStaticTest(),
+				PayerID:   payerID,
+				PayeeID:   payee,
+				Value:     vo.NewMoneyBRL(vo.NewAmountTest(tt.value)),
+				ReleaseAt: createdAt.Add(entity.DefaultEscrowTimeout),
+				CreatedAt: createdAt,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if len(wallets) != len(tt.wantWallets) || wallets[payerID] != tt.wantWallets[payerID] || wallets[accountID] != tt.wantWallets[accountID] {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, wallets, tt.wantWallets)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.Status != string(entity.EscrowHeld) || len(repo.escrows) != 1 {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Status, entity.EscrowHeld)
+			}
+
+			if len(transfers.transfers) != 1 || transfers.transfers[0].Payee() != accountID {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, transfers.transfers, "funding transfer to the escrow account")
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	CreateHoldUseCase interface {
+		Execute(context.Context, CreateHoldInput) (HoldOutput, error)
+	}
+
+	// Input data
+	CreateHoldInput struct {
+		ID        vo.Uuid
+		PayerID   vo.Uuid
+		PayeeID   vo.Uuid
+		Value     vo.Money
+		ExpiresAt time.Time
+		CreatedAt time.Time
+	}
+
+	// Output port
+	HoldPresenter interface {
+		Output(entity.Hold) HoldOutput
+	}
+
+	// Output data
+	HoldOutput struct {
+		ID        string                `json:"id"`
+		PayerID   string                `json:"payer"`
+		PayeeID   string                `json:"payee"`
+		Value     int64                 `json:"value"`
+		Captured  int64                 `json:"captured"`
+		Status    string                `json:"status"`
+		Transfer  *CreateTransferOutput `json:"transfer,omitempty"`
+		ExpiresAt string                `json:"expires_at"`
+		CreatedAt string                `json:"created_at"`
+		UpdatedAt string                `json:"updated_at"`
+	}
+
+	createHoldInteractor struct {
+		repoHold       entity.HoldRepository
+		repoUserFinder entity.UserRepositoryFinder
+		repoUserHolder entity.UserRepositoryHolder
+		pre            HoldPresenter
+	}
+)
+
+// NewCreateHoldInteractor creates new createHoldInteractor with its dependencies
+func NewCreateHoldInteractor(
+	repoHold entity.HoldRepository,
+	repoUserFinder entity.UserRepositoryFinder,
+	repoUserHolder entity.UserRepositoryHolder,
+	pre HoldPresenter,
+) CreateHoldUseCase {
+	return createHoldInteractor{
+		repoHold:       repoHold,
+		repoUserFinder: repoUserFinder,
+		repoUserHolder: repoUserHolder,
+		pre:            pre,
+	}
+}
+
+// Execute orchestrates the use case, the funds are reserved on the available balance of the payer
+// in the same transaction that creates the hold
+func (c createHoldInteractor) Execute(ctx context.Context, i CreateHoldInput) (HoldOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	hold, err := entity.NewHold(i.ID, i.PayerID, i.PayeeID, i.Value, i.ExpiresAt, i.CreatedAt)
+	if err != nil {
+		return c.pre.Output(entity.Hold{}), err
+	}
+
+	err = c.repoHold.WithTransaction(ctx, func(sessCtx context.Context) error {
+		payer, err := c.repoUserFinder.FindByID(sessCtx, hold.Payer())
+		if err != nil {
+			return err
+		}
+
+		if err := payer.CanTransfer(); err != nil {
+			return entity.WrapError(entity.ErrUnauthorizedTransfer, err)
+		}
+
+		if _, err := c.repoUserFinder.FindByID(sessCtx, hold.Payee()); err != nil {
+			return err
+		}
+
+		if err := payer.Hold(hold.Value()); err != nil {
+			return err
+		}
+
+		if err := c.repoUserHolder.UpdateHeld(sessCtx, hold.Payer(), payer.Wallet().Held()); err != nil {
+			return err
+		}
+
+		hold, err = c.repoHold.Create(sessCtx, hold)
+
+		return err
+	})
+	if err != nil {
+		return c.pre.Output(entity.Hold{}), err
+	}
+
+	return c.pre.Output(hold), nil
+}
+
+// releaseHold returns the funds of a closed hold to the available balance of the payer
+func releaseHold(
+	ctx context.Context,
+	repoUserFinder entity.UserRepositoryFinder,
+	repoUserHolder entity.UserRepositoryHolder,
+	hold entity.Hold,
+) error {
+	payer, err := repoUserFinder.FindByID(ctx, hold.Payer())
+	if err != nil {
+		return err
+	}
+
+	payer.Release(hold.Value())
+
+	return repoUserHolder.UpdateHeld(ctx, hold.Payer(), payer.Wallet().Held())
+}
@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type spyHoldRepo struct {
+	holds     map[vo.Uuid]entity.Hold
+	expired   []entity.Hold
+	closeErr  error
+	closeErrs map[vo.Uuid]error
+	closed    []entity.Hold
+}
+
+func (s *spyHoldRepo) Create(_ context.Context, h entity.Hold) (entity.Hold, error) {
+	s.holds[h.ID()] = h
+	return h, nil
+}
+
+func (s *spyHoldRepo) FindByID(_ context.Context, ID vo.Uuid) (entity.Hold, error) {
+	hold, ok := s.holds[ID]
+	if !ok {
+		return entity.Hold{}, entity.ErrNotFoundHold
+	}
+
+	return hold, nil
+}
+
+func (s *spyHoldRepo) FindExpired(_ context.Context, _ time.Time, _ int) ([]entity.Hold, error) {
+	return s.expired, nil
+}
+
+func (s *spyHoldRepo) Close(_ context.Context, h entity.Hold) error {
+	if s.closeErr != nil {
+		return s.closeErr
+	}
+
+	if err := s.closeErrs[h.ID()]; err != nil {
+		return err
+	}
+
+	s.holds[h.ID()] = h
+	s.closed = append(s.closed, h)
+	return nil
+}
+
+func (s *spyHoldRepo) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+type spyUserRepoHolder map[vo.Uuid]int64
+
+func (s spyUserRepoHolder) UpdateHeld(_ context.Context, ID vo.Uuid, money vo.Money) error {
+	s[ID] = money.Amount().Value()
+	return nil
+}
+
+type stubHoldPresenter struct{}
+
+func (s stubHoldPresenter) Output(h entity.Hold) HoldOutput {
+	return HoldOutput{Status: string(h.Status()), Captured: h.Captured().Amount().Value()}
+}
+
+func TestCreateHoldInteractor_Execute(t *testing.T) {
+	var createdAt = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		payer     entity.User
+		value     int64
+		expiresAt time.Time
+		wantHeld  spyUserRepoHolder
+		wantErr   error
+	}{
+		{
+			name:      "Create hold success",
+			payer:     newHeldTestUser(newCommonTestUser(payerTestID, 100), 30),
+			value:     70,
+			expiresAt: createdAt.Add(time.Hour),
+			wantHeld:  spyUserRepoHolder{payerTestID: 100},
+		},
+		{
+			name:      "Create hold above the available balance",
+			payer:     newHeldTestUser(newCommonTestUser(payerTestID, 100), 30),
+			value:     71,
+			expiresAt: createdAt.Add(time.Hour),
+			wantHeld:  spyUserRepoHolder{},
+			wantErr:   entity.ErrUserInsufficientBalance,
+		},
+		{
+			name:      "Create hold already expired",
+			payer:     newCommonTestUser(payerTestID, 100),
+			value:     10,
+			expiresAt: createdAt,
+			wantHeld:  spyUserRepoHolder{},
+			wantErr:   entity.ErrInvalidHoldExpiration,
+		},
+		{
+			name:      "Create hold for an unknown payee",
+			payer:     newCommonTestUser(payerTestID, 100),
+			value:     10,
+			expiresAt: createdAt.Add(time.Hour),
+			wantHeld:  spyUserRepoHolder{},
+			wantErr:   entity.ErrNotFoundUser,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				repo   = &spyHoldRepo{holds: map[vo.Uuid]entity.Hold{}}
+				holder = spyUserRepoHolder{}
+				users  = newTestUsers(tt.payer, newMerchantTestUser(vo.NewUuidStaticTest(), 0))
+				payee  = vo.NewUuidStaticTest()
+			)
+			if errors.Is(tt.wantErr, entity.ErrNotFoundUser) {
+				payee, _ = vo.NewUuid("7a1f5e0c-2c6b-4f7a-9d3e-0c2b9a4c8e11")
+			}
+
+			uc := NewCreateHoldInteractor(repo, users, holder, stubHoldPresenter{})
+
+			got, err := uc.Execute(context.Background(), CreateHoldInput{
+				ID:        vo.NewUuidStaticTest(),
+				PayerID:   tt.payer.ID(),
+				PayeeID:   payee,
+				Value:     vo.NewMoneyBRL(vo.NewAmountTest(tt.value)),
+				ExpiresAt: tt.expiresAt,
+				CreatedAt: createdAt,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if len(holder) != len(tt.wantHeld) || holder[tt.payer.ID()] != tt.wantHeld[tt.payer.ID()] {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, holder, tt.wantHeld)
+			}
+
+			if err == nil && (got.Status != string(entity.HoldActive) || len(repo.holds) != 1) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Status, entity.HoldActive)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	CreateRecurringTransferUseCase interface {
+		Execute(context.Context, CreateRecurringTransferInput) (RecurringTransferOutput, error)
+	}
+
+	// Input data
+	CreateRecurringTransferInput struct {
+		ID          vo.Uuid
+		PayerID     vo.Uuid
+		PayeeID     vo.Uuid
+		Value       vo.Money
+		Frequency   entity.Frequency
+		DayOfMonth  int
+		StartAt     time.Time
+		EndAt       time.Time
+		Count       int
+		MaxFailures int
+		CreatedAt   time.Time
+	}
+
+	// Output port
+	RecurringTransferPresenter interface {
+		Output(entity.RecurringTransfer) RecurringTransferOutput
+	}
+
+	// Output data
+	RecurringTransferOutput struct {
+		ID                  string `json:"id"`
+		PayerID             string `json:"payer"`
+		PayeeID             string `json:"payee"`
+		Value               int64  `json:"value"`
+		Frequency           string `json:"frequency"`
+		DayOfMonth          int    `json:"day_of_month,omitempty"`
+		StartAt             string `json:"start_at"`
+		EndAt               string `json:"end_at,omitempty"`
+		Count               int    `json:"count,omitempty"`
+		Occurrences         int    `json:"occurrences"`
+		NextRunAt           string `json:"next_run_at,omitempty"`
+		ConsecutiveFailures int    `json:"consecutive_failures"`
+		MaxFailures         int    `json:"max_failures"`
+		LastFailureReason   string `json:"last_failure_reason,omitempty"`
+		Status              string `json:"status"`
+		CreatedAt           string `json:"created_at"`
+		UpdatedAt           string `json:"updated_at"`
+	}
+
+	createRecurringTransferInteractor struct {
+		repo           entity.RecurringTransferRepositoryCreator
+		repoUserFinder entity.UserRepositoryFinder
+		pre            RecurringTransferPresenter
+	}
+)
+
+// NewCreateRecurringTransferInteractor creates new createRecurringTransferInteractor with its dependencies
+func NewCreateRecurringTransferInteractor(
+	repo entity.RecurringTransferRepositoryCreator,
+	repoUserFinder entity.UserRepositoryFinder,
+	pre RecurringTransferPresenter,
+) CreateRecurringTransferUseCase {
+	return createRecurringTransferInteractor{
+		repo:           repo,
+		repoUserFinder: repoUserFinder,
+		pre:            pre,
+	}
+}
+
+// Execute orchestrates the use case, the users are validated now but the balance only at each occurrence
+func (c createRecurringTransferInteractor) Execute(ctx context.Context, i CreateRecurringTransferInput) (RecurringTransferOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	recurring, err := entity.NewRecurringTransfer(
+		i.ID,
+		i.PayerID,
+		i.PayeeID,
+		i.Value,
+		i.Frequency,
+		i.DayOfMonth,
+		i.StartAt,
+		i.EndAt,
+		i.Count,
+		i.MaxFailures,
+		i.CreatedAt,
+	)
+	if err != nil {
+		return c.pre.Output(entity.RecurringTransfer{}), err
+	}
+
+	payer, err := c.repoUserFinder.FindByID(ctx, i.PayerID)
+	if err != nil {
+		return c.pre.Output(entity.RecurringTransfer{}), err
+	}
+
+	if err := payer.CanTransfer(); err != nil {
+		return c.pre.Output(entity.RecurringTransfer{}), entity.WrapError(entity.ErrUnauthorizedTransfer, err)
+	}
+
+	if _, err := c.repoUserFinder.FindByID(ctx, i.PayeeID); err != nil {
+		return c.pre.Output(entity.RecurringTransfer{}), err
+	}
+
+	recurring, err = c.repo.Create(ctx, recurring)
+	if err != nil {
+		return c.pre.Output(entity.RecurringTransfer{}), err
+	}
+
+	return c.pre.Output(recurring), nil
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type spyRecurringTransferRepoCreator struct {
+	err     error
+	created entity.RecurringTransfer
+}
+
+func (s *spyRecurringTransferRepoCreator) Create(_ context.Context, r entity.RecurringTransfer) (entity.RecurringTransfer, error) {
+	s.created = r
+	return r, s.err
+}
+
+func TestCreateRecurringTransferInteractor_Execute(t *testing.T) {
+	var (
+		createdAt = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		merchant  = newMerchantTestUser(vo.NewUuidStaticTest(), 100)
+	)
+
+	tests := []struct {
+		name       string
+		frequency  entity.Frequency
+		dayOfMonth int
+		findPayer  func() (entity.User, error)
+		findPayee  func() (entity.User, error)
+		createErr  error
+		want       RecurringTransferOutput
+		wantErr    error
+	}{
+		{
+			name:      "Create recurring transfer success",
+			frequency: entity.Weekly,
+			findPayer: func() (entity.User, error) {
+				return newCommonTestUser(vo.NewUuidStaticTest(), 0), nil
+			},
+			findPayee: func() (entity.User, error) {
+				return merchant, nil
+			},
+			want: RecurringTransferOutput{
+				ID:     "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:  100,
+				Status: "ACTIVE",
+			},
+		},
+		{
+			name:      "Create recurring transfer with invalid frequency",
+			frequency: entity.Frequency("YEARLY"),
+			wantErr:   entity.ErrInvalidFrequency,
+		},
+		{
+			name:       "Create monthly recurring transfer with invalid day of month",
+			frequency:  entity.Monthly,
+			dayOfMonth: 32,
+			wantErr:    entity.ErrInvalidDayOfMonth,
+		},
+		{
+			name:      "Create recurring transfer from merchant",
+			frequency: entity.Weekly,
+			findPayer: func() (entity.User, error) {
+				return merchant, nil
+			},
+			wantErr: entity.ErrUnauthorizedTransfer,
+		},
+		{
+			name:      "Create recurring transfer to not found payee",
+			frequency: entity.Weekly,
+			findPayer: func() (entity.User, error) {
+				return newCommonTestUser(vo.NewUuidStaticTest(), 0), nil
+			},
+			findPayee: func() (entity.User, error) {
+				return entity.User{}, entity.ErrNotFoundUser
+			},
+			wantErr: entity.ErrNotFoundUser,
+		},
+		{
+			name:      "Create recurring transfer create error",
+			frequency: entity.Weekly,
+			findPayer: func() (entity.User, error) {
+				return newCommonTestUser(vo.NewUuidStaticTest(), 0), nil
+			},
+			findPayee: func() (entity.User, error) {
+				return merchant, nil
+			},
+			createErr: entity.ErrCreateRecurringTransfer,
+			wantErr:   entity.ErrCreateRecurringTransfer,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var repo = &spyRecurringTransferRepoCreator{err: tt.createErr}
+
+			got, err := NewCreateRecurringTransferInteractor(
+				repo,
+				&spyUserRepoFinder{findPayer: tt.findPayer, findPayee: tt.findPayee},
+				stubRecurringTransferPresenter{},
+			).Execute(context.TODO(), CreateRecurringTransferInput{
+				ID:          vo.NewUuidStaticTest(),
+				PayerID:     vo.NewUuidStaticTest(),
+				PayeeID:     vo.NewUuidStaticTest(),
+				Value:       vo.NewMoneyBRL(vo.NewAmountTest(100)),
+				Frequency:   tt.frequency,
+				DayOfMonth:  tt.dayOfMonth,
+				StartAt:     createdAt.Add(time.Hour),
+				Count:       3,
+				MaxFailures: 3,
+				CreatedAt:   createdAt,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+
+			if tt.wantErr == nil && repo.created.Frequency() != tt.frequency {
+				t.Errorf("[TestCase '%s'] Got frequency: '%v' | Want: '%v'", tt.name, repo.created.Frequency(), tt.frequency)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/pkg/errors"
+)
+
+type (
+	// Input port
+	CreateSplitTransferUseCase interface {
+		Execute(context.Context, CreateSplitTransferInput) (CreateSplitTransferOutput, error)
+	}
+
+	// Input data
+	CreateSplitTransferInput struct {
+		ID        vo.Uuid
+		PayerID   vo.Uuid
+		Value     vo.Money
+		Shares    []entity.SplitShare
+		CreatedAt time.Time
+	}
+
+	// Output port
+	CreateSplitTransferPresenter interface {
+		Output(entity.SplitTransfer) CreateSplitTransferOutput
+	}
+
+	// Output data
+	CreateSplitTransferOutput struct {
+		ID        string                 `json:"id"`
+		PayerID   string                 `json:"payer"`
+		Value     int64                  `json:"value"`
+		Transfers []CreateTransferOutput `json:"transfers"`
+		CreatedAt string                 `json:"created_at"`
+	}
+
+	createSplitTransferInteractor struct {
+		repoTransferCreator    entity.TransferRepositoryCreator
+		repoUserUpdater        entity.UserRepositoryUpdater
+		repoUserFinder         entity.UserRepositoryFinder
+		repoTransferSummarizer entity.TransferRepositorySentSummarizer
+		pre                    CreateSplitTransferPresenter
+		authorizer             Authorizer
+		notifier               Notifier
+		fees                   entity.FeeSchedule
+	}
+)
+
+// NewCreateSplitTransferInteractor creates new createSplitTransferInteractor with its dependencies
+func NewCreateSplitTransferInteractor(
+	repoTransferCreator entity.TransferRepositoryCreator,
+	repoUserUpdater entity.UserRepositoryUpdater,
+	repoUserFinder entity.UserRepositoryFinder,
+	repoTransferSummarizer entity.TransferRepositorySentSummarizer,
+	authorizer Authorizer,
+	notifier Notifier,
+	fees entity.FeeSchedule,
+	pre CreateSplitTransferPresenter,
+) CreateSplitTransferUseCase {
+	return createSplitTransferInteractor{
+		repoTransferCreator:    repoTransferCreator,
+		repoUserUpdater:        repoUserUpdater,
+		repoUserFinder:         repoUserFinder,
+		repoTransferSummarizer: repoTransferSummarizer,
+		authorizer:             authorizer,
+		notifier:               notifier,
+		fees:                   fees,
+		pre:                    pre,
+	}
+}
+
+// Execute debits the payer once and credits every payee in a single transaction, creating a transfer per
+// payee. Each transfer is charged the fees of the schedule as an ordinary transfer, added up and credited
+// once to the fee account. The limits of the payer cover the whole money debited and each payee is kept within
+// its own. The whole split is approved by a single call to the authorizer and each payee is notified
+func (c createSplitTransferInteractor) Execute(ctx context.Context, i CreateSplitTransferInput) (CreateSplitTransferOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	split, err := entity.NewSplitTransfer(i.ID, i.PayerID, i.Value, i.Shares, i.CreatedAt)
+	if err != nil {
+		return c.pre.Output(entity.SplitTransfer{}), err
+	}
+
+	err = c.repoTransferCreator.WithTransaction(ctx, func(sessCtx context.Context) error {
+		processed, err := c.process(sessCtx, split)
+		if err != nil {
+			return err
+		}
+		split = processed
+
+		for _, transfer := range split.Transfers() {
+			if _, err := c.repoTransferCreator.Create(sessCtx, transfer); err != nil {
+				return err
+			}
+		}
+
+		ok, err := c.authorizer.Authorized(sessCtx, split)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return entity.ErrUnauthorizedTransfer
+		}
+
+		return nil
+	})
+	if err != nil {
+		return c.pre.Output(entity.SplitTransfer{}), err
+	}
+
+	for _, transfer := range split.Transfers() {
+		c.notifier.Notify(ctx, transfer)
+	}
+
+	return c.pre.Output(split), nil
+}
+
+func (c createSplitTransferInteractor) process(ctx context.Context, split entity.SplitTransfer) (entity.SplitTransfer, error) {
+	payer, err := c.repoUserFinder.FindByID(ctx, split.Payer())
+	if err != nil {
+		return entity.SplitTransfer{}, err
+	}
+
+	var (
+		payees = make([]entity.User, 0, len(split.Transfers()))
+		fees   = make([]entity.Fee, 0, len(split.Transfers()))
+	)
+	for _, transfer := range split.Transfers() {
+		payee, err := c.repoUserFinder.FindByID(ctx, transfer.Payee())
+		if err != nil {
+			return entity.SplitTransfer{}, errors.Wrapf(err, "payee %s", transfer.Payee().Value())
+		}
+
+		fee, err := c.fees.Quote(payer.TypeUser(), payee.TypeUser(), transfer.Value())
+		if err != nil {
+			return entity.SplitTransfer{}, err
+		}
+
+		payees = append(payees, payee)
+		fees = append(fees, fee)
+	}
+	split = split.WithFees(fees)
+
+	if err := checkSendingLimits(ctx, c.repoTransferSummarizer, payer, split.Debited(), split.CreatedAt()); err != nil {
+		return entity.SplitTransfer{}, err
+	}
+
+	for idx, transfer := range split.Transfers() {
+		if err := payees[idx].CanReceive(transfer.Credited()); err != nil {
+			return entity.SplitTransfer{}, errors.Wrapf(err, "payee %s", transfer.Payee().Value())
+		}
+	}
+
+	if err := payer.Withdraw(split.Debited()); err != nil {
+		return entity.SplitTransfer{}, err
+	}
+
+	if err := c.repoUserUpdater.UpdateWallet(ctx, split.Payer(), payer.Wallet().Money()); err != nil {
+		return entity.SplitTransfer{}, err
+	}
+
+	for idx, transfer := range split.Transfers() {
+		payees[idx].Deposit(transfer.Credited())
+
+		if err := c.repoUserUpdater.UpdateWallet(ctx, transfer.Payee(), payees[idx].Wallet().Money()); err != nil {
+			return entity.SplitTransfer{}, err
+		}
+	}
+
+	if err := depositFee(ctx, c.repoUserFinder, c.repoUserUpdater, split.Fee()); err != nil {
+		return entity.SplitTransfer{}, err
+	}
+
+	return split, nil
+}
@@ -0,0 +1,322 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/google/uuid"
+)
+
+type spyAuthorizer struct {
+	result  bool
+	err     error
+	invoked int
+}
+
+func (s *spyAuthorizer) Authorized(_ context.Context, _ entity.Authorizable) (bool, error) {
+	s.invoked++
+	return s.result, s.err
+}
+
+type stubCreateSplitTransferPresenter struct{}
+
+func (s stubCreateSplitTransferPresenter) Output(split entity.SplitTransfer) CreateSplitTransferOutput {
+	return CreateSplitTransferOutput{
+		ID:        split.ID().Value(),
+		Value:     split.Value().Amount().Value(),
+		Transfers: make([]CreateTransferOutput, len(split.Transfers())),
+	}
+}
+
+func TestCreateSplitTransferInteractor_Execute(t *testing.T) {
+	var (
+		shares = func() []entity.SplitShare {
+			var shares []entity.SplitShare
+			for _, basisPoints := range []int64{9000, 1000} {
+				payeeID, _ := vo.NewUuid(uuid.New().String())
+				share, _ := entity.NewPercentageSplitShare(payeeID, basisPoints)
+				shares = append(shares, share)
+			}
+
+			return shares
+		}
+		merchant = newMerchantTestUser(vo.NewUuidStaticTest(), 0)
+	)
+
+	tests := []struct {
+		name         string
+		shares       []entity.SplitShare
+		findPayer    func() (entity.User, error)
+		findPayee    func() (entity.User, error)
+		sentToday    int64
+		authorizer   *spyAuthorizer
+		want         int
+		wantNotified int
+		wantErr      error
+	}{
+		{
+			name:   "Create split transfer success",
+			shares: shares(),
+			findPayer: func() (entity.User, error) {
+				return newCommonTestUser(vo.NewUuidStaticTest(), 100), nil
+			},
+			findPayee: func() (entity.User, error) {
+				return merchant, nil
+			},
+			authorizer:   &spyAuthorizer{result: true},
+			want:         2,
+			wantNotified: 2,
+		},
+		{
+			name:   "Create split transfer denied by the authorizer",
+			shares: shares(),
+			findPayer: func() (entity.User, error) {
+				return newCommonTestUser(vo.NewUuidStaticTest(), 100), nil
+			},
+			findPayee: func() (entity.User, error) {
+				return merchant, nil
+			},
+			authorizer: &spyAuthorizer{result: false},
+			wantErr:    entity.ErrUnauthorizedTransfer,
+		},
+		{
+			name:   "Create split transfer insufficient balance",
+			shares: shares(),
+			findPayer: func() (entity.User, error) {
+				return newCommonTestUser(vo.NewUuidStaticTest(), 99), nil
+			},
+			findPayee: func() (entity.User, error) {
+				return merchant, nil
+			},
+			authorizer: &spyAuthorizer{result: true},
+			wantErr:    entity.ErrUserInsufficientBalance,
+		},
+		{
+			name:   "Create split transfer from merchant",
+			shares: shares(),
+			findPayer: func() (entity.User, error) {
+				return merchant, nil
+			},
+			findPayee: func() (entity.User, error) {
+				return merchant, nil
+			},
+			authorizer: &spyAuthorizer{result: true},
+			wantErr:    vo.ErrNotAllowedTypeUser,
+		},
+		{
+			name:   "Create split transfer above the daily outgoing limit of the payer",
+			shares: shares(),
+			findPayer: func() (entity.User, error) {
+				return newCommonTestUser(vo.NewUuidStaticTest(), 100), nil
+			},
+			findPayee: func() (entity.User, error) {
+				return merchant, nil
+			},
+			sentToday:  entity.KYCBasic.Limits().DailyOutgoing() - 99,
+			authorizer: &spyAuthorizer{result: true},
+			wantErr:    entity.ErrKYCLimitExceeded,
+		},
+		{
+			name:   "Create split transfer above the wallet balance limit of a payee",
+			shares: shares(),
+			findPayer: func() (entity.User, error) {
+				return newCommonTestUser(vo.NewUuidStaticTest(), 100), nil
+			},
+			findPayee: func() (entity.User, error) {
+				return newMerchantTestUser(vo.NewUuidStaticTest(), entity.KYCBasic.Limits().WalletBalance()-50), nil
+			},
+			authorizer: &spyAuthorizer{result: true},
+			wantErr:    entity.ErrKYCLimitExceeded,
+		},
+		{
+			name:   "Create split transfer to not found payee",
+			shares: shares(),
+			findPayer: func() (entity.User, error) {
+				return newCommonTestUser(vo.NewUuidStaticTest(), 100), nil
+			},
+			findPayee: func() (entity.User, error) {
+				return entity.User{}, entity.ErrNotFoundUser
+			},
+			authorizer: &spyAuthorizer{result: true},
+			wantErr:    entity.ErrNotFoundUser,
+		},
+		{
+			name: "Create split transfer with the payer among the payees",
+			shares: func() []entity.SplitShare {
+				share, _ := entity.NewFixedSplitShare(vo.NewUuidStaticTest(), vo.NewMoneyBRL(vo.NewAmountTest(100)))
+				return []entity.SplitShare{share}
+			}(),
+			authorizer: &spyAuthorizer{result: true},
+			wantErr:    entity.ErrSplitPayerIsPayee,
+		},
+		{
+			name:       "Create split transfer without payees",
+			authorizer: &spyAuthorizer{result: true},
+			wantErr:    entity.ErrEmptySplit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var notifier = &spyNotifier{}
+			uc := NewCreateSplitTransferInteractor(
+				stubTransferRepoCreator{},
+				&spyUserRepoUpdater{},
+				&spyUserRepoFinder{findPayer: tt.findPayer, findPayee: tt.findPayee},
+				stubTransferRepoSentSummarizer{result: tt.sentToday},
+				tt.authorizer,
+				notifier,
+				entity.FeeSchedule{},
+				stubCreateSplitTransferPresenter{},
+			)
+
+			got, err := uc.Execute(context.TODO(), CreateSplitTransferInput{
+				ID:        vo.NewUuidStaticTest(),
+				PayerID:   vo.NewUuidStaticTest(),
+				Value:     vo.NewMoneyBRL(vo.NewAmountTest(100)),
+				Shares:    tt.shares,
+				CreatedAt: time.Time{},
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if len(got.Transfers) != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, len(got.Transfers), tt.want)
+			}
+
+			if tt.wantErr == nil && tt.authorizer.invoked != 1 {
+				t.Errorf("[TestCase '%s'] Authorizer calls Got: '%v' | Want: '%v'", tt.name, tt.authorizer.invoked, 1)
+			}
+
+			if notifier.notified != tt.wantNotified {
+				t.Errorf("[TestCase '%s'] Notified Got: '%v' | Want: '%v'", tt.name, notifier.notified, tt.wantNotified)
+			}
+		})
+	}
+}
+
+func TestCreateSplitTransferInteractor_ExecuteWithFees(t *testing.T) {
+	var (
+		newID = func(ID string) vo.Uuid {
+			uuid, _ := vo.NewUuid(ID)
+			return uuid
+		}
+		payerID   = newID("0db298eb-c8e7-4829-84b7-c1036b4f0791")
+		payeeID   = newID("7a1f5e0c-2c6b-4f7a-9d3e-0c2b9a4c8e11")
+		otherID   = newID("3c5d7e9f-1a2b-4c3d-8e4f-5a6b7c8d9e01")
+		accountID = newID("00000000-0000-4000-8000-000000000001")
+
+		newFees = func(rules ...entity.FeeRule) entity.FeeSchedule {
+			fees, _ := entity.NewFeeSchedule(accountID, rules)
+			return fees
+		}
+		newRule = func(userType vo.TypeUser, charge entity.FeeCharge, flat int64, basisPoints int64) entity.FeeRule {
+			tier, _ := entity.NewFeeTier(0, flat, basisPoints)
+			rule, _ := entity.NewFeeRule(userType, charge, []entity.FeeTier{tier})
+			return rule
+		}
+		newUsers = func() stubUserRepoByID {
+			return stubUserRepoByID{
+				payerID:   newCommonTestUser(payerID, 1000),
+				payeeID:   newMerchantTestUser(payeeID, 0),
+				otherID:   newMerchantTestUser(otherID, 0),
+				accountID: newMerchantTestUser(accountID, 0),
+			}
+		}
+		newShares = func(first int64, second int64) []entity.SplitShare {
+			firstShare, _ := entity.NewFixedSplitShare(payeeID, vo.NewMoneyBRL(vo.NewAmountTest(first)))
+			secondShare, _ := entity.NewFixedSplitShare(otherID, vo.NewMoneyBRL(vo.NewAmountTest(second)))
+			return []entity.SplitShare{firstShare, secondShare}
+		}
+	)
+
+	tests := []struct {
+		name        string
+		fees        entity.FeeSchedule
+		shares      []entity.SplitShare
+		value       int64
+		wantWallets spyWalletsUpdater
+		wantErr     error
+	}{
+		{
+			name:   "Split transfer without fees",
+			fees:   entity.FeeSchedule{},
+			shares: newShares(400, 100),
+			value:  500,
+			wantWallets: spyWalletsUpdater{
+				payerID: 500,
+				payeeID: 400,
+				otherID: 100,
+			},
+		},
+		{
+			name:   "Split transfer with flat fee paid by the payer for each part",
+			fees:   newFees(newRule(vo.COMMON, entity.FeeOnSend, 10, 0)),
+			shares: newShares(400, 100),
+			value:  500,
+			wantWallets: spyWalletsUpdater{
+				payerID:   480,
+				payeeID:   400,
+				otherID:   100,
+				accountID: 20,
+			},
+		},
+		{
+			name: "Split transfer with percentage fee paid by the merchants on receipt",
+			fees: newFees(
+				newRule(vo.COMMON, entity.FeeOnSend, 10, 0),
+				newRule(vo.MERCHANT, entity.FeeOnReceipt, 0, 250),
+			),
+			shares: newShares(400, 100),
+			value:  500,
+			wantWallets: spyWalletsUpdater{
+				payerID:   480,
+				payeeID:   390,
+				otherID:   97,
+				accountID: 33,
+			},
+		},
+		{
+			name:        "Split transfer whose fees exceed the balance of the payer",
+			fees:        newFees(newRule(vo.COMMON, entity.FeeOnSend, 10, 0)),
+			shares:      newShares(400, 600),
+			value:       1000,
+			wantWallets: spyWalletsUpdater{},
+			wantErr:     entity.ErrUserInsufficientBalance,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var wallets = spyWalletsUpdater{}
+
+			_, err := NewCreateSplitTransferInteractor(
+				stubTransferRepoCreator{},
+				wallets,
+				newUsers(),
+				stubTransferRepoSentSummarizer{},
+				&spyAuthorizer{result: true},
+				&spyNotifier{},
+				tt.fees,
+				stubCreateSplitTransferPresenter{},
+			).Execute(context.Background(), CreateSplitTransferInput{
+				ID:      vo.NewUuidStaticTest(),
+				PayerID: payerID,
+				Value:   vo.NewMoneyBRL(vo.NewAmountTest(tt.value)),
+				Shares:  tt.shares,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(wallets, tt.wantWallets) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, wallets, tt.wantWallets)
+			}
+		})
+	}
+}
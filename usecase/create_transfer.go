@@ -13,7 +13,7 @@ import (
 type (
 	// Authorizer port
 	Authorizer interface {
-		Authorized(context.Context, entity.Transfer) (bool, error)
+		Authorized(context.Context, entity.Authorizable) (bool, error)
 	}
 
 	// Notifier port
@@ -32,6 +32,7 @@ type (
 		PayerID   vo.Uuid
 		PayeeID   vo.Uuid
 		Value     vo.Money
+		Details   entity.TransferDetails
 		CreatedAt time.Time
 	}
 
@@ -42,20 +43,36 @@ type (
 
 	// Output data
 	CreateTransferOutput struct {
-		ID        string `json:"id"`
-		PayerID   string `json:"payer"`
-		PayeeID   string `json:"payee"`
-		Value     int64  `json:"value"`
-		CreatedAt string `json:"created_at"`
+		ID                string             `json:"id"`
+		PayerID           string             `json:"payer"`
+		PayeeID           string             `json:"payee"`
+		Value             int64              `json:"value"`
+		Fee               *TransferFeeOutput `json:"fee,omitempty"`
+		Description       string             `json:"description,omitempty"`
+		ExternalReference string             `json:"external_reference,omitempty"`
+		Metadata          map[string]string  `json:"metadata,omitempty"`
+		CreatedAt         string             `json:"created_at"`
+	}
+
+	// Output data
+	TransferFeeOutput struct {
+		PayerFee    int64 `json:"payer_fee"`
+		PayeeFee    int64 `json:"payee_fee"`
+		Total       int64 `json:"total"`
+		PayerDebit  int64 `json:"payer_debit"`
+		PayeeCredit int64 `json:"payee_credit"`
 	}
 
 	createTransferInteractor struct {
-		repoTransferCreator entity.TransferRepositoryCreator
-		repoUserUpdater     entity.UserRepositoryUpdater
-		repoUserFinder      entity.UserRepositoryFinder
-		pre                 CreateTransferPresenter
-		authorizer          Authorizer
-		notifier            Notifier
+		repoTransferCreator    entity.TransferRepositoryCreator
+		repoUserUpdater        entity.UserRepositoryUpdater
+		repoUserFinder         entity.UserRepositoryFinder
+		repoTransferSummarizer entity.TransferRepositorySentSummarizer
+		pre                    CreateTransferPresenter
+		authorizer             Authorizer
+		notifier               Notifier
+		fees                   entity.FeeSchedule
+		tracer                 Tracer
 	}
 )
 
@@ -64,22 +81,32 @@ func NewCreateTransferInteractor(
 	repoTransferCreator entity.TransferRepositoryCreator,
 	repoUserUpdater entity.UserRepositoryUpdater,
 	repoUserFinder entity.UserRepositoryFinder,
+	repoTransferSummarizer entity.TransferRepositorySentSummarizer,
 	authorizer Authorizer,
 	notifier Notifier,
+	fees entity.FeeSchedule,
+	tracer Tracer,
 	pre CreateTransferPresenter,
 ) CreateTransferUseCase {
 	return createTransferInteractor{
-		repoTransferCreator: repoTransferCreator,
-		repoUserUpdater:     repoUserUpdater,
-		repoUserFinder:      repoUserFinder,
-		authorizer:          authorizer,
-		notifier:            notifier,
-		pre:                 pre,
+		repoTransferCreator:    repoTransferCreator,
+		repoUserUpdater:        repoUserUpdater,
+		repoUserFinder:         repoUserFinder,
+		repoTransferSummarizer: repoTransferSummarizer,
+		authorizer:             authorizer,
+		notifier:               notifier,
+		fees:                   fees,
+		tracer:                 tracer,
+		pre:                    pre,
 	}
 }
 
-// Execute orchestrates the use case
+// Execute orchestrates the use case, the fees of the transfer are credited to the fee account in the same transaction
+// and the KYC levels of the payer and the payee limit the value sent and the balance received
 func (c createTransferInteractor) Execute(ctx context.Context, i CreateTransferInput) (CreateTransferOutput, error) {
+	ctx, span := c.tracer.Start(ctx, "CreateTransfer.Execute")
+	defer span.End()
+
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
@@ -89,29 +116,35 @@ func (c createTransferInteractor) Execute(ctx context.Context, i CreateTransferI
 	)
 
 	err = c.repoTransferCreator.WithTransaction(ctx, func(sessCtx context.Context) error {
-		if err = c.process(sessCtx, i.PayerID, i.PayeeID, i.Value); err != nil {
-			return err
-		}
-
-		transfer, err = c.repoTransferCreator.Create(sessCtx, entity.NewTransfer(
+		transfer, err = c.process(sessCtx, entity.NewTransfer(
 			i.ID,
 			i.PayerID,
 			i.PayeeID,
 			i.Value,
 			i.CreatedAt,
-		))
+		).WithDetails(i.Details))
+		if err != nil {
+			return err
+		}
+
+		transfer, err = c.repoTransferCreator.Create(sessCtx, transfer)
 		if err != nil {
 			return err
 		}
 
 		ok, err := c.authorizer.Authorized(sessCtx, transfer)
-		if err != nil || !ok {
+		if err != nil {
 			return err
 		}
 
+		if !ok {
+			return entity.ErrUnauthorizedTransfer
+		}
+
 		return nil
 	})
 	if err != nil {
+		span.RecordError(err)
 		return c.pre.Output(entity.Transfer{}), err
 	}
 
@@ -120,37 +153,84 @@ func (c createTransferInteractor) Execute(ctx context.Context, i CreateTransferI
 	return c.pre.Output(transfer), nil
 }
 
-func (c createTransferInteractor) process(ctx context.Context, payerID vo.Uuid, payeeID vo.Uuid, value vo.Money) error {
-	payer, err := c.repoUserFinder.FindByID(ctx, payerID)
+// process debits the payer and credits the payee and the fee account, traced apart from Execute so that the
+// time spent on the authorizer is told from the one spent on the wallets
+func (c createTransferInteractor) process(ctx context.Context, transfer entity.Transfer) (entity.Transfer, error) {
+	ctx, span := c.tracer.Start(ctx, "CreateTransfer.process")
+	defer span.End()
+
+	transfer, err := c.updateWallets(ctx, transfer)
+	if err != nil {
+		span.RecordError(err)
+	}
+
+	return transfer, err
+}
+
+// updateWallets checks the limits of the payer and the payee and moves the value between their wallets
+func (c createTransferInteractor) updateWallets(ctx context.Context, transfer entity.Transfer) (entity.Transfer, error) {
+	payer, err := c.repoUserFinder.FindByID(ctx, transfer.Payer())
 	if err != nil {
-		return err
+		return entity.Transfer{}, err
 	}
 
-	if err := payer.CanTransfer(); err != nil {
-		return errors.Wrap(err, entity.ErrUnauthorizedTransfer.Error())
+	payee, err := c.repoUserFinder.FindByID(ctx, transfer.Payee())
+	if err != nil {
+		return entity.Transfer{}, err
 	}
 
-	payee, err := c.repoUserFinder.FindByID(ctx, payeeID)
+	fee, err := c.fees.Quote(payer.TypeUser(), payee.TypeUser(), transfer.Value())
 	if err != nil {
-		return err
+		return entity.Transfer{}, err
 	}
+	transfer = transfer.WithFee(fee)
 
-	err = payer.Withdraw(value)
+	// The limits of the payer cover the money leaving its wallet, fees included
+	if err := checkTransferLimits(ctx, c.repoTransferSummarizer, payer, payee, transfer); err != nil {
+		return entity.Transfer{}, err
+	}
+
+	err = payer.Withdraw(transfer.Debited())
 	if err != nil {
-		return err
+		return entity.Transfer{}, err
 	}
 
-	payee.Deposit(value)
+	payee.Deposit(transfer.Credited())
 
-	err = c.repoUserUpdater.UpdateWallet(ctx, payerID, payer.Wallet().Money())
+	err = c.repoUserUpdater.UpdateWallet(ctx, transfer.Payer(), payer.Wallet().Money())
 	if err != nil {
-		return err
+		return entity.Transfer{}, err
 	}
 
-	err = c.repoUserUpdater.UpdateWallet(ctx, payeeID, payee.Wallet().Money())
+	err = c.repoUserUpdater.UpdateWallet(ctx, transfer.Payee(), payee.Wallet().Money())
 	if err != nil {
-		return err
+		return entity.Transfer{}, err
+	}
+
+	if err := depositFee(ctx, c.repoUserFinder, c.repoUserUpdater, fee); err != nil {
+		return entity.Transfer{}, err
 	}
 
-	return nil
+	return transfer, nil
+}
+
+// depositFee credits the fee to the fee account, nothing is done for a zero fee
+func depositFee(
+	ctx context.Context,
+	finder entity.UserRepositoryFinder,
+	updater entity.UserRepositoryUpdater,
+	fee entity.Fee,
+) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	account, err := finder.FindByID(ctx, fee.Account())
+	if err != nil {
+		return errors.Wrap(err, "fee account")
+	}
+
+	account.Deposit(fee.Total())
+
+	return updater.UpdateWallet(ctx, fee.Account(), account.Wallet().Money())
 }
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/pkg/errors"
+)
+
+type (
+	// Input port
+	CreateTransferBatchUseCase interface {
+		Execute(context.Context, CreateTransferBatchInput) (TransferBatchOutput, error)
+	}
+
+	// Input data
+	CreateTransferBatchInput struct {
+		ID        vo.Uuid
+		PayerID   vo.Uuid
+		Mode      entity.BatchMode
+		Items     []CreateTransferBatchItemInput
+		CreatedAt time.Time
+	}
+
+	// Input data
+	CreateTransferBatchItemInput struct {
+		ID      vo.Uuid
+		PayeeID vo.Uuid
+		Value   vo.Money
+	}
+
+	// Output port
+	TransferBatchPresenter interface {
+		Output(entity.TransferBatch) TransferBatchOutput
+	}
+
+	// Output data
+	TransferBatchOutput struct {
+		ID             string                    `json:"id"`
+		PayerID        string                    `json:"payer"`
+		Mode           string                    `json:"mode"`
+		Status         string                    `json:"status"`
+		TotalItems     int                       `json:"total_items"`
+		ProcessedItems int                       `json:"processed_items"`
+		SucceededItems int                       `json:"succeeded_items"`
+		FailedItems    int                       `json:"failed_items"`
+		Items          []TransferBatchItemOutput `json:"items"`
+		CreatedAt      string                    `json:"created_at"`
+		UpdatedAt      string                    `json:"updated_at"`
+	}
+
+	// Output data
+	TransferBatchItemOutput struct {
+		ID            string `json:"id"`
+		PayeeID       string `json:"payee"`
+		Value         int64  `json:"value"`
+		Status        string `json:"status"`
+		FailureReason string `json:"failure_reason,omitempty"`
+	}
+
+	createTransferBatchInteractor struct {
+		repo           entity.TransferBatchRepositoryCreator
+		repoUserFinder entity.UserRepositoryFinder
+		pre            TransferBatchPresenter
+	}
+)
+
+// NewCreateTransferBatchInteractor creates new createTransferBatchInteractor with its dependencies
+func NewCreateTransferBatchInteractor(
+	repo entity.TransferBatchRepositoryCreator,
+	repoUserFinder entity.UserRepositoryFinder,
+	pre TransferBatchPresenter,
+) CreateTransferBatchUseCase {
+	return createTransferBatchInteractor{
+		repo:           repo,
+		repoUserFinder: repoUserFinder,
+		pre:            pre,
+	}
+}
+
+// Execute validates the batch up front and stores it pending, the transfers are executed by ProcessTransferBatchUseCase.
+// An all-or-nothing batch is also rejected when the payer cannot cover its total
+func (c createTransferBatchInteractor) Execute(ctx context.Context, i CreateTransferBatchInput) (TransferBatchOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var items = make([]entity.TransferBatchItem, 0, len(i.Items))
+	for _, item := range i.Items {
+		items = append(items, entity.NewTransferBatchItem(item.ID, item.PayeeID, item.Value))
+	}
+
+	batch, err := entity.NewTransferBatch(i.ID, i.PayerID, i.Mode, items, i.CreatedAt)
+	if err != nil {
+		return c.pre.Output(entity.TransferBatch{}), err
+	}
+
+	payer, err := c.repoUserFinder.FindByID(ctx, i.PayerID)
+	if err != nil {
+		return c.pre.Output(entity.TransferBatch{}), err
+	}
+
+	if err := payer.CanTransfer(); err != nil {
+		return c.pre.Output(entity.TransferBatch{}), entity.WrapError(entity.ErrUnauthorizedTransfer, err)
+	}
+
+	if batch.Mode() == entity.BatchAllOrNothing {
+		if err := payer.Withdraw(batch.Total()); err != nil {
+			return c.pre.Output(entity.TransferBatch{}), err
+		}
+	}
+
+	var found = make(map[vo.Uuid]bool)
+	for _, item := range items {
+		if found[item.Payee()] {
+			continue
+		}
+
+		if _, err := c.repoUserFinder.FindByID(ctx, item.Payee()); err != nil {
+			return c.pre.Output(entity.TransferBatch{}), errors.Wrapf(err, "payee %s", item.Payee().Value())
+		}
+		found[item.Payee()] = true
+	}
+
+	batch, err = c.repo.Create(ctx, batch)
+	if err != nil {
+		return c.pre.Output(entity.TransferBatch{}), err
+	}
+
+	return c.pre.Output(batch), nil
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type stubTransferBatchRepoCreator struct {
+	err error
+}
+
+func (s stubTransferBatchRepoCreator) Create(_ context.Context, b entity.TransferBatch) (entity.TransferBatch, error) {
+	return b, s.err
+}
+
+func TestCreateTransferBatchInteractor_Execute(t *testing.T) {
+	var (
+		items = []CreateTransferBatchItemInput{
+			{ID: vo.NewUuidStaticTest(), PayeeID: vo.NewUuidStaticTest(), Value: vo.NewMoneyBRL(vo.NewAmountTest(100))},
+			{ID: vo.NewUuidStaticTest(), PayeeID: vo.NewUuidStaticTest(), Value: vo.NewMoneyBRL(vo.NewAmountTest(150))},
+		}
+		merchant = newMerchantTestUser(vo.NewUuidStaticTest(), 100)
+	)
+
+	tests := []struct {
+		name      string
+		mode      entity.BatchMode
+		items     []CreateTransferBatchItemInput
+		findPayer func() (entity.User, error)
+		findPayee func() (entity.User, error)
+		createErr error
+		want      TransferBatchOutput
+		wantErr   error
+	}{
+		{
+			name:  "Create best effort batch beyond the payer balance",
+			mode:  entity.BatchBestEffort,
+			items: items,
+			findPayer: func() (entity.User, error) {
+				return newCommonTestUser(vo.NewUuidStaticTest(), 200), nil
+			},
+			findPayee: func() (entity.User, error) {
+				return merchant, nil
+			},
+			want: TransferBatchOutput{Status: string(entity.BatchPending), TotalItems: 2},
+		},
+		{
+			name:  "Create all or nothing batch",
+			mode:  entity.BatchAllOrNothing,
+			items: items,
+			findPayer: func() (entity.User, error) {
+				return newCommonTestUser(vo.NewUuidStaticTest(), 250), nil
+			},
+			findPayee: func() (entity.User, error) {
+				return merchant, nil
+			},
+			want: TransferBatchOutput{Status: string(entity.BatchPending), TotalItems: 2},
+		},
+		{
+			name:  "All or nothing batch beyond the payer balance",
+			mode:  entity.BatchAllOrNothing,
+			items: items,
+			findPayer: func() (entity.User, error) {
+				return newCommonTestUser(vo.NewUuidStaticTest(), 200), nil
+			},
+			wantErr: entity.ErrUserInsufficientBalance,
+		},
+		{
+			name:    "Batch without items",
+			mode:    entity.BatchBestEffort,
+			wantErr: entity.ErrEmptyTransferBatch,
+		},
+		{
+			name:    "Invalid mode",
+			mode:    entity.BatchMode("SOMETIMES"),
+			items:   items,
+			wantErr: entity.ErrInvalidBatchMode,
+		},
+		{
+			name:  "Batch from merchant",
+			mode:  entity.BatchBestEffort,
+			items: items,
+			findPayer: func() (entity.User, error) {
+				return merchant, nil
+			},
+			wantErr: vo.ErrNotAllowedTypeUser,
+		},
+		{
+			name:  "Batch to not found payee",
+			mode:  entity.BatchBestEffort,
+			items: items,
+			findPayer: func() (entity.User, error) {
+				return newCommonTestUser(vo.NewUuidStaticTest(), 200), nil
+			},
+			findPayee: func() (entity.User, error) {
+				return entity.User{}, entity.ErrNotFoundUser
+			},
+			wantErr: entity.ErrNotFoundUser,
+		},
+		{
+			name:  "Batch create error",
+			mode:  entity.BatchBestEffort,
+			items: items,
+			findPayer: func() (entity.User, error) {
+				return newCommonTestUser(vo.NewUuidStaticTest(), 200), nil
+			},
+			findPayee: func() (entity.User, error) {
+				return merchant, nil
+			},
+			createErr: entity.ErrCreateTransferBatch,
+			wantErr:   entity.ErrCreateTransferBatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewCreateTransferBatchInteractor(
+				stubTransferBatchRepoCreator{err: tt.createErr},
+				&spyUserRepoFinder{findPayer: tt.findPayer, findPayee: tt.findPayee},
+				stubTransferBatchPresenter{},
+			)
+
+			got, err := uc.Execute(context.TODO(), CreateTransferBatchInput{
+				ID:        vo.NewUuidStaticTest(),
+				PayerID:   vo.NewUuidStaticTest(),
+				Mode:      tt.mode,
+				Items:     tt.items,
+				CreatedAt: time.Time{},
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if got.Status != tt.want.Status || got.TotalItems != tt.want.TotalItems {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -60,12 +60,21 @@ func (f *spyUserRepoFinder) FindByID(_ context.Context, _ vo.Uuid) (entity.User,
 	return f.findPayer()
 }
 
+type stubTransferRepoSentSummarizer struct {
+	result int64
+	err    error
+}
+
+func (s stubTransferRepoSentSummarizer) SumSent(_ context.Context, _ vo.Uuid, _ time.Time) (int64, error) {
+	return s.result, s.err
+}
+
 type stubAuthorizer struct {
 	result bool
 	err    error
 }
 
-func (s stubAuthorizer) Authorized(_ context.Context, _ entity.Transfer) (bool, error) {
+func (s stubAuthorizer) Authorized(_ context.Context, _ entity.Authorizable) (bool, error) {
 	return s.result, s.err
 }
 
@@ -81,6 +90,27 @@ func (s stubCreateTransferPresenter) Output(_ entity.Transfer) CreateTransferOut
 	return s.result
 }
 
+type spyTracer struct {
+	started []string
+	failed  []string
+}
+
+func (s *spyTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	s.started = append(s.started, name)
+	return ctx, spySpan{tracer: s, name: name}
+}
+
+type spySpan struct {
+	tracer *spyTracer
+	name   string
+}
+
+func (s spySpan) RecordError(_ error) {
+	s.tracer.failed = append(s.tracer.failed, s.name)
+}
+
+func (s spySpan) End() {}
+
 func Test_createTransferInteractor_Execute(t *testing.T) {
 	type fields struct {
 		repoTransferCreator entity.TransferRepositoryCreator
@@ -89,6 +119,7 @@ func Test_createTransferInteractor_Execute(t *testing.T) {
 		pre                 CreateTransferPresenter
 		authorizer          Authorizer
 		notifier            Notifier
+		fees                entity.FeeSchedule
 	}
 	type args struct {
 		i CreateTransferInput
@@ -605,8 +636,11 @@ func Test_createTransferInteractor_Execute(t *testing.T) {
 				tt.fields.repoTransferCreator,
 				tt.fields.repoUserUpdater,
 				tt.fields.repoUserFinder,
+				stubTransferRepoSentSummarizer{},
 				tt.fields.authorizer,
 				tt.fields.notifier,
+				tt.fields.fees,
+				&spyTracer{},
 				tt.fields.pre,
 			)
 
@@ -622,3 +656,422 @@ func Test_createTransferInteractor_Execute(t *testing.T) {
 		})
 	}
 }
+
+type stubUserRepoByID map[vo.Uuid]entity.User
+
+func (s stubUserRepoByID) FindByID(_ context.Context, ID vo.Uuid) (entity.User, error) {
+	user, ok := s[ID]
+	if !ok {
+		return entity.User{}, entity.ErrNotFoundUser
+	}
+
+	return user, nil
+}
+
+type spyWalletsUpdater map[vo.Uuid]int64
+
+func (s spyWalletsUpdater) UpdateWallet(_ context.Context, ID vo.Uuid, money vo.Money) error {
+	s[ID] = money.Amount().Value()
+	return nil
+}
+
+func Test_createTransferInteractor_ExecuteWithFees(t *testing.T) {
+	var (
+		newID = func(ID string) vo.Uuid {
+			uuid, _ := vo.NewUuid(ID)
+			return uuid
+		}
+		payerID   = newID("0db298eb-c8e7-4829-84b7-c1036b4f0791")
+		payeeID   = newID("7a1f5e0c-2c6b-4f7a-9d3e-0c2b9a4c8e11")
+		accountID = newID("00000000-0000-4000-8000-000000000001")
+
+		newFees = func(rules ...entity.FeeRule) entity.FeeSchedule {
+			fees, _ := entity.NewFeeSchedule(accountID, rules)
+			return fees
+		}
+		newRule = func(userType vo.TypeUser, charge entity.FeeCharge, flat int64, basisPoints int64) entity.FeeRule {
+			tier, _ := entity.NewFeeTier(0, flat, basisPoints)
+			rule, _ := entity.NewFeeRule(userType, charge, []entity.FeeTier{tier})
+			return rule
+		}
+		newUsers = func() stubUserRepoByID {
+			return stubUserRepoByID{
+				payerID: entity.NewCommonUser(
+					payerID,
+					vo.NewFullName("Test testing"),
+					vo.NewEmailTest("test@testing.com"),
+					vo.NewPassword("passw"),
+					vo.NewDocumentTest(vo.CPF, "07091054954"),
+					vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(1000))),
+					time.Time{},
+				),
+				payeeID: entity.NewMerchantUser(
+					payeeID,
+					vo.NewFullName("Merchant user"),
+					vo.NewEmailTest("test@testing.com"),
+					vo.NewPassword("passw"),
+					vo.NewDocumentTest(vo.CNPJ, "20.770.438/0001-66"),
+					vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(0))),
+					time.Time{},
+				),
+				accountID: entity.NewMerchantUser(
+					accountID,
+					vo.NewFullName("Fee account"),
+					vo.NewEmailTest("fees@testing.com"),
+					vo.NewPassword("passw"),
+					vo.NewDocumentTest(vo.CNPJ, "20.770.438/0001-66"),
+					vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(0))),
+					time.Time{},
+				),
+			}
+		}
+	)
+
+	tests := []struct {
+		name        string
+		fees        entity.FeeSchedule
+		users       stubUserRepoByID
+		value       int64
+		wantWallets spyWalletsUpdater
+		wantErr     error
+	}{
+		{
+			name:  "Transfer without fees",
+			fees:  entity.FeeSchedule{},
+			users: newUsers(),
+			value: 500,
+			wantWallets: spyWalletsUpdater{
+				payerID: 500,
+				payeeID: 500,
+			},
+		},
+		{
+			name:  "Transfer with flat fee paid by the payer",
+			fees:  newFees(newRule(vo.COMMON, entity.FeeOnSend, 10, 0)),
+			users: newUsers(),
+			value: 500,
+			wantWallets: spyWalletsUpdater{
+				payerID:   490,
+				payeeID:   500,
+				accountID: 10,
+			},
+		},
+		{
+			name: "Transfer with percentage fee paid by the merchant on receipt",
+			fees: newFees(
+				newRule(vo.COMMON, entity.FeeOnSend, 10, 0),
+				newRule(vo.MERCHANT, entity.FeeOnReceipt, 0, 250),
+			),
+			users: newUsers(),
+			value: 500,
+			wantWallets: spyWalletsUpdater{
+				payerID:   490,
+				payeeID:   487,
+				accountID: 23,
+			},
+		},
+		{
+			name:        "Transfer whose fee exceeds the balance of the payer",
+			fees:        newFees(newRule(vo.COMMON, entity.FeeOnSend, 10, 0)),
+			users:       newUsers(),
+			value:       1000,
+			wantWallets: spyWalletsUpdater{},
+			wantErr:     entity.ErrUserInsufficientBalance,
+		},
+		{
+			name: "Transfer without the fee account",
+			fees: newFees(newRule(vo.COMMON, entity.FeeOnSend, 10, 0)),
+			users: func() stubUserRepoByID {
+				users := newUsers()
+				delete(users, accountID)
+				return users
+			}(),
+			value: 500,
+			wantWallets: spyWalletsUpdater{
+				payerID: 490,
+				payeeID: 500,
+			},
+			wantErr: entity.ErrNotFoundUser,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var wallets = spyWalletsUpdater{}
+
+			c := NewCreateTransferInteractor(
+				stubTransferRepoCreator{},
+				wallets,
+				tt.users,
+				stubTransferRepoSentSummarizer{},
+				stubAuthorizer{result: true},
+				stubNotifier{},
+				tt.fees,
+				&spyTracer{},
+				stubCreateTransferPresenter{},
+			)
+
+			_, err := c.Execute(context.Background(), CreateTransferInput{
+				ID:      vo.NewUuidStaticTest(),
+				PayerID: payerID,
+				PayeeID: payeeID,
+				Value:   vo.NewMoneyBRL(vo.NewAmountTest(tt.value)),
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(wallets, tt.wantWallets) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, wallets, tt.wantWallets)
+			}
+		})
+	}
+}
+
+func Test_createTransferInteractor_ExecuteWithKYCLimits(t *testing.T) {
+	var (
+		newID = func(ID string) vo.Uuid {
+			uuid, _ := vo.NewUuid(ID)
+			return uuid
+		}
+		payerID   = newID("0db298eb-c8e7-4829-84b7-c1036b4f0791")
+		payeeID   = newID("7a1f5e0c-2c6b-4f7a-9d3e-0c2b9a4c8e11")
+		accountID = newID("00000000-0000-4000-8000-000000000001")
+
+		flatFee = func(flat int64) entity.FeeSchedule {
+			tier, _ := entity.NewFeeTier(0, flat, 0)
+			rule, _ := entity.NewFeeRule(vo.COMMON, entity.FeeOnSend, []entity.FeeTier{tier})
+			fees, _ := entity.NewFeeSchedule(accountID, []entity.FeeRule{rule})
+			return fees
+		}
+
+		newUsers = func(payerLevel entity.KYCLevel, payeeBalance int64) stubUserRepoByID {
+			return stubUserRepoByID{
+				payerID: entity.NewCommonUser(
+					payerID,
+					vo.NewFullName("Test testing"),
+					vo.NewEmailTest("test@testing.com"),
+					vo.NewPassword("passw"),
+					vo.NewDocumentTest(vo.CPF, "07091054954"),
+					vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(1000000))),
+					time.Time{},
+				).WithKYCLevel(payerLevel),
+				payeeID: entity.NewMerchantUser(
+					payeeID,
+					vo.NewFullName("Merchant user"),
+					vo.NewEmailTest("test@testing.com"),
+					vo.NewPassword("passw"),
+					vo.NewDocumentTest(vo.CNPJ, "20.770.438/0001-66"),
+					vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(payeeBalance))),
+					time.Time{},
+				),
+			}
+		}
+	)
+
+	tests := []struct {
+		name          string
+		users         stubUserRepoByID
+		fees          entity.FeeSchedule
+		sentToday     int64
+		value         int64
+		wantLimit     entity.KYCLimit
+		wantRemaining int64
+	}{
+		{
+			name:  "Transfer within the limits of the KYC level",
+			users: newUsers(entity.KYCBasic, 0),
+			value: 50000,
+		},
+		{
+			name:          "Transfer above the single transfer limit",
+			users:         newUsers(entity.KYCBasic, 0),
+			value:         150000,
+			wantLimit:     entity.SingleTransferLimit,
+			wantRemaining: 100000,
+		},
+		{
+			name:          "Transfer above the daily outgoing limit",
+			users:         newUsers(entity.KYCBasic, 0),
+			sentToday:     150000,
+			value:         60000,
+			wantLimit:     entity.DailyOutgoingLimit,
+			wantRemaining: 50000,
+		},
+		{
+			name:          "Transfer whose fee takes it above the single transfer limit",
+			users:         newUsers(entity.KYCBasic, 0),
+			fees:          flatFee(10),
+			value:         100000,
+			wantLimit:     entity.SingleTransferLimit,
+			wantRemaining: 100000,
+		},
+		{
+			name:          "Transfer whose fee takes it above the daily outgoing limit",
+			users:         newUsers(entity.KYCBasic, 0),
+			fees:          flatFee(10),
+			sentToday:     150000,
+			value:         50000,
+			wantLimit:     entity.DailyOutgoingLimit,
+			wantRemaining: 50000,
+		},
+		{
+			name:          "Transfer above the wallet balance limit of the payee",
+			users:         newUsers(entity.KYCBasic, 480000),
+			value:         30000,
+			wantLimit:     entity.WalletBalanceLimit,
+			wantRemaining: 20000,
+		},
+		{
+			name:  "Transfer within the limits of an upgraded KYC level",
+			users: newUsers(entity.KYCVerified, 0),
+			value: 150000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCreateTransferInteractor(
+				stubTransferRepoCreator{},
+				spyWalletsUpdater{},
+				tt.users,
+				stubTransferRepoSentSummarizer{result: tt.sentToday},
+				stubAuthorizer{result: true},
+				stubNotifier{},
+				tt.fees,
+				&spyTracer{},
+				stubCreateTransferPresenter{},
+			)
+
+			_, err := c.Execute(context.Background(), CreateTransferInput{
+				ID:      vo.NewUuidStaticTest(),
+				PayerID: payerID,
+				PayeeID: payeeID,
+				Value:   vo.NewMoneyBRL(vo.NewAmountTest(tt.value)),
+			})
+			if tt.wantLimit == "" {
+				if err != nil {
+					t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, nil)
+				}
+				return
+			}
+
+			var limitErr entity.KYCLimitError
+			if !errors.Is(err, entity.ErrKYCLimitExceeded) || !errors.As(err, &limitErr) {
+				t.Fatalf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, entity.ErrKYCLimitExceeded)
+			}
+
+			if limitErr.Limit() != tt.wantLimit || limitErr.Remaining() != tt.wantRemaining {
+				t.Errorf(
+					"[TestCase '%s'] Got: '%v, %v' | Want: '%v, %v'",
+					tt.name,
+					limitErr.Limit(),
+					limitErr.Remaining(),
+					tt.wantLimit,
+					tt.wantRemaining,
+				)
+			}
+		})
+	}
+}
+
+func Test_createTransferInteractor_ExecuteUnauthorized(t *testing.T) {
+	var (
+		newID = func(ID string) vo.Uuid {
+			uuid, _ := vo.NewUuid(ID)
+			return uuid
+		}
+		commonID   = newID("0db298eb-c8e7-4829-84b7-c1036b4f0791")
+		merchantID = newID("7a1f5e0c-2c6b-4f7a-9d3e-0c2b9a4c8e11")
+
+		users = stubUserRepoByID{
+			commonID: entity.NewCommonUser(
+				commonID,
+				vo.NewFullName("Test testing"),
+				vo.NewEmailTest("test@testing.com"),
+				vo.NewPassword("passw"),
+				vo.NewDocumentTest(vo.CPF, "07091054954"),
+				vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(1000))),
+				time.Time{},
+			),
+			merchantID: entity.NewMerchantUser(
+				merchantID,
+				vo.NewFullName("Merchant user"),
+				vo.NewEmailTest("test@testing.com"),
+				vo.NewPassword("passw"),
+				vo.NewDocumentTest(vo.CNPJ, "20.770.438/0001-66"),
+				vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(1000))),
+				time.Time{},
+			),
+		}
+	)
+
+	tests := []struct {
+		name       string
+		payerID    vo.Uuid
+		payeeID    vo.Uuid
+		authorizer Authorizer
+		wantErrs   []error
+		wantFailed []string
+	}{
+		{
+			name:       "Transfer denied by the authorizer",
+			payerID:    commonID,
+			payeeID:    merchantID,
+			authorizer: stubAuthorizer{result: false},
+			wantErrs:   []error{entity.ErrUnauthorizedTransfer},
+			wantFailed: []string{"CreateTransfer.Execute"},
+		},
+		{
+			name:       "Transfer with the authorizer unavailable",
+			payerID:    commonID,
+			payeeID:    merchantID,
+			authorizer: stubAuthorizer{err: entity.WrapError(entity.ErrAuthorizerUnavailable, errors.New("timeout"))},
+			wantErrs:   []error{entity.ErrAuthorizerUnavailable},
+			wantFailed: []string{"CreateTransfer.Execute"},
+		},
+		{
+			name:       "Transfer by a merchant keeps the cause",
+			payerID:    merchantID,
+			payeeID:    commonID,
+			authorizer: stubAuthorizer{result: true},
+			wantErrs:   []error{entity.ErrUnauthorizedTransfer, vo.ErrNotAllowedTypeUser},
+			wantFailed: []string{"CreateTransfer.process", "CreateTransfer.Execute"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracer := &spyTracer{}
+			c := NewCreateTransferInteractor(
+				stubTransferRepoCreator{},
+				spyWalletsUpdater{},
+				users,
+				stubTransferRepoSentSummarizer{},
+				tt.authorizer,
+				stubNotifier{},
+				entity.FeeSchedule{},
+				tracer,
+				stubCreateTransferPresenter{},
+			)
+
+			_, err := c.Execute(context.Background(), CreateTransferInput{
+				ID:      vo.NewUuidStaticTest(),
+				PayerID: tt.payerID,
+				PayeeID: tt.payeeID,
+				Value:   vo.NewMoneyBRL(vo.NewAmountTest(100)),
+			})
+			for _, wantErr := range tt.wantErrs {
+				if !errors.Is(err, wantErr) {
+					t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, wantErr)
+				}
+			}
+
+			wantStarted := []string{"CreateTransfer.Execute", "CreateTransfer.process"}
+			if !reflect.DeepEqual(tracer.started, wantStarted) {
+				t.Errorf("[TestCase '%s'] Got spans: '%v' | Want: '%v'", tt.name, tracer.started, wantStarted)
+			}
+
+			if !reflect.DeepEqual(tracer.failed, tt.wantFailed) {
+				t.Errorf("[TestCase '%s'] Got failed spans: '%v' | Want: '%v'", tt.name, tracer.failed, tt.wantFailed)
+			}
+		})
+	}
+}
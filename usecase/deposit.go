@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	DepositUseCase interface {
+		Execute(context.Context, DepositInput) (MovementOutput, error)
+	}
+
+	// Input data
+	DepositInput struct {
+		ID                vo.Uuid
+		UserID            vo.Uuid
+		Value             vo.Money
+		ExternalReference string
+		CreatedAt         time.Time
+	}
+
+	// Output port
+	MovementPresenter interface {
+		Output(entity.Movement) MovementOutput
+	}
+
+	// Output data
+	MovementOutput struct {
+		ID                string `json:"id"`
+		UserID            string `json:"user_id"`
+		Type              string `json:"type"`
+		Currency          string `json:"currency"`
+		Value             int64  `json:"value"`
+		ExternalReference string `json:"external_reference"`
+		Status            string `json:"status"`
+		CreatedAt         string `json:"created_at"`
+		ReversedAt        string `json:"reversed_at,omitempty"`
+	}
+
+	depositInteractor struct {
+		movementInteractor
+	}
+
+	movementInteractor struct {
+		repoMovementCreator entity.MovementRepositoryCreator
+		repoUserUpdater     entity.UserRepositoryUpdater
+		repoUserFinder      entity.UserRepositoryFinder
+		authorizer          Authorizer
+		pre                 MovementPresenter
+	}
+)
+
+// NewDepositInteractor creates new depositInteractor with its dependencies
+func NewDepositInteractor(
+	repoMovementCreator entity.MovementRepositoryCreator,
+	repoUserUpdater entity.UserRepositoryUpdater,
+	repoUserFinder entity.UserRepositoryFinder,
+	authorizer Authorizer,
+	pre MovementPresenter,
+) DepositUseCase {
+	return depositInteractor{
+		movementInteractor: movementInteractor{
+			repoMovementCreator: repoMovementCreator,
+			repoUserUpdater:     repoUserUpdater,
+			repoUserFinder:      repoUserFinder,
+			authorizer:          authorizer,
+			pre:                 pre,
+		},
+	}
+}
+
+// Execute orchestrates the use case
+func (d depositInteractor) Execute(ctx context.Context, i DepositInput) (MovementOutput, error) {
+	return d.create(ctx, i.ID, i.UserID, entity.Deposit, i.Value, i.ExternalReference, i.CreatedAt)
+}
+
+// create applies the movement to the wallet of the user, persisting both only when the authorizer approves it
+func (m movementInteractor) create(
+	ctx context.Context,
+	ID vo.Uuid,
+	userID vo.Uuid,
+	movementType entity.MovementType,
+	value vo.Money,
+	externalReference string,
+	createdAt time.Time,
+) (MovementOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	movement, err := entity.NewMovement(ID, userID, movementType, value, externalReference, createdAt)
+	if err != nil {
+		return m.pre.Output(entity.Movement{}), err
+	}
+
+	err = m.repoMovementCreator.WithTransaction(ctx, func(sessCtx context.Context) error {
+		user, err := m.repoUserFinder.FindByID(sessCtx, userID)
+		if err != nil {
+			return err
+		}
+
+		if err := movement.Apply(user); err != nil {
+			return err
+		}
+
+		if err := m.repoUserUpdater.UpdateWallet(sessCtx, userID, user.Wallet().Money()); err != nil {
+			return err
+		}
+
+		movement, err = m.repoMovementCreator.Create(sessCtx, movement)
+		if err != nil {
+			return err
+		}
+
+		ok, err := m.authorizer.Authorized(sessCtx, movement)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return entity.ErrUnauthorizedMovement
+		}
+
+		return nil
+	})
+	if err != nil {
+		return m.pre.Output(entity.Movement{}), err
+	}
+
+	return m.pre.Output(movement), nil
+}
@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type stubMovementRepoCreator struct {
+	err error
+}
+
+func (s stubMovementRepoCreator) Create(_ context.Context, m entity.Movement) (entity.Movement, error) {
+	return m, s.err
+}
+
+func (s stubMovementRepoCreator) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+type spyWalletUpdater struct {
+	money   vo.Money
+	invoked bool
+}
+
+func (s *spyWalletUpdater) UpdateWallet(_ context.Context, _ vo.Uuid, money vo.Money) error {
+	s.money = money
+	s.invoked = true
+	return nil
+}
+
+type stubMovementPresenter struct{}
+
+func (s stubMovementPresenter) Output(m entity.Movement) MovementOutput {
+	return MovementOutput{
+		Type:   string(m.Type()),
+		Status: string(m.Status()),
+	}
+}
+
+func TestDepositInteractor_Execute(t *testing.T) {
+	tests := []struct {
+		name              string
+		repoCreator       entity.MovementRepositoryCreator
+		repoUserFinder    entity.UserRepositoryFinder
+		authorizer        Authorizer
+		externalReference string
+		wantBalance       int64
+		wantUpdated       bool
+		wantErr           error
+	}{
+		{
+			name:              "Deposit success",
+			repoCreator:       stubMovementRepoCreator{},
+			repoUserFinder:    stubUserRepoFinder{result: newCommonTestUser(vo.NewUuidStaticTest(), 0)},
+			authorizer:        stubAuthorizer{result: true},
+			externalReference: "bank-slip-0001",
+			wantBalance:       100,
+			wantUpdated:       true,
+		},
+		{
+			name:              "Deposit duplicated external reference",
+			repoCreator:       stubMovementRepoCreator{err: entity.ErrDuplicateExternalReference},
+			repoUserFinder:    stubUserRepoFinder{result: newCommonTestUser(vo.NewUuidStaticTest(), 0)},
+			authorizer:        stubAuthorizer{result: true},
+			externalReference: "bank-slip-0001",
+			wantBalance:       100,
+			wantUpdated:       true,
+			wantErr:           entity.ErrDuplicateExternalReference,
+		},
+		{
+			name:              "Deposit not authorized",
+			repoCreator:       stubMovementRepoCreator{},
+			repoUserFinder:    stubUserRepoFinder{result: newCommonTestUser(vo.NewUuidStaticTest(), 0)},
+			authorizer:        stubAuthorizer{result: false},
+			externalReference: "bank-slip-0001",
+			wantBalance:       100,
+			wantUpdated:       true,
+			wantErr:           entity.ErrUnauthorizedMovement,
+		},
+		{
+			name:              "Deposit user not found",
+			repoCreator:       stubMovementRepoCreator{},
+			repoUserFinder:    stubUserRepoFinder{err: entity.ErrNotFoundUser},
+			authorizer:        stubAuthorizer{result: true},
+			externalReference: "bank-slip-0001",
+			wantErr:           entity.ErrNotFoundUser,
+		},
+		{
+			name:              "Deposit above the wallet balance limit of the KYC level",
+			repoCreator:       stubMovementRepoCreator{},
+			repoUserFinder:    stubUserRepoFinder{result: newCommonTestUser(vo.NewUuidStaticTest(), 499950)},
+			authorizer:        stubAuthorizer{result: true},
+			externalReference: "bank-slip-0001",
+			wantErr:           entity.ErrKYCLimitExceeded,
+		},
+		{
+			name:           "Deposit without external reference",
+			repoCreator:    stubMovementRepoCreator{},
+			repoUserFinder: stubUserRepoFinder{result: newCommonTestUser(vo.NewUuidStaticTest(), 0)},
+			authorizer:     stubAuthorizer{result: true},
+			wantErr:        entity.ErrInvalidExternalReference,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updater = &spyWalletUpdater{}
+
+			uc := NewDepositInteractor(tt.repoCreator, updater, tt.repoUserFinder, tt.authorizer, stubMovementPresenter{})
+
+			got, err := uc.Execute(context.TODO(), DepositInput{
+				ID:                vo.NewUuidStaticTest(),
+				UserID:            vo.NewUuidStaticTest(),
+				Value:             vo.NewMoneyBRL(vo.NewAmountTest(100)),
+				ExternalReference: tt.externalReference,
+				CreatedAt:         time.Now(),
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if updater.invoked != tt.wantUpdated {
+				t.Errorf("[TestCase '%s'] Got updated: '%v' | Want: '%v'", tt.name, updater.invoked, tt.wantUpdated)
+			}
+
+			if updater.money.Amount().Value() != tt.wantBalance {
+				t.Errorf("[TestCase '%s'] Got balance: '%v' | Want: '%v'", tt.name, updater.money.Amount().Value(), tt.wantBalance)
+			}
+
+			if tt.wantErr == nil && got.Type != string(entity.Deposit) {
+				t.Errorf("[TestCase '%s'] Got type: '%v' | Want: '%v'", tt.name, got.Type, entity.Deposit)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	DisputeEscrowTransferUseCase interface {
+		Execute(context.Context, DisputeEscrowTransferInput) (EscrowTransferOutput, error)
+	}
+
+	// Input data
+	DisputeEscrowTransferInput struct {
+		ID         vo.Uuid
+		Reason     string
+		DisputedAt time.Time
+	}
+
+	disputeEscrowTransferInteractor struct {
+		repoEscrow entity.EscrowTransferRepository
+		pre        EscrowTransferPresenter
+	}
+)
+
+// NewDisputeEscrowTransferInteractor creates new disputeEscrowTransferInteractor with its dependencies
+func NewDisputeEscrowTransferInteractor(
+	repoEscrow entity.EscrowTransferRepository,
+	pre EscrowTransferPresenter,
+) DisputeEscrowTransferUseCase {
+	return disputeEscrowTransferInteractor{
+		repoEscrow: repoEscrow,
+		pre:        pre,
+	}
+}
+
+// Execute orchestrates the use case, a disputed escrow transfer keeps its funds in escrow and is no longer
+// released by the timeout
+func (d disputeEscrowTransferInteractor) Execute(ctx context.Context, i DisputeEscrowTransferInput) (EscrowTransferOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	found, err := d.repoEscrow.FindByID(ctx, i.ID)
+	if err != nil {
+		return d.pre.Output(entity.EscrowTransfer{}), err
+	}
+
+	escrow, err := found.Dispute(i.Reason, i.DisputedAt)
+	if err != nil {
+		return d.pre.Output(entity.EscrowTransfer{}), err
+	}
+
+	if err := d.repoEscrow.Update(ctx, entity.EscrowHeld, escrow); err != nil {
+		return d.pre.Output(entity.EscrowTransfer{}), err
+	}
+
+	return d.pre.Output(escrow), nil
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+)
+
+type (
+	// Input port
+	ExecuteRecurringTransfersUseCase interface {
+		Execute(context.Context, ExecuteRecurringTransfersInput) (ExecuteRecurringTransfersOutput, error)
+	}
+
+	// Input data
+	ExecuteRecurringTransfersInput struct {
+		Now      time.Time
+		Owner    string
+		LeaseTTL time.Duration
+		Limit    int
+	}
+
+	// Output port
+	ExecuteRecurringTransfersPresenter interface {
+		Output(ExecuteRecurringTransfersResult) ExecuteRecurringTransfersOutput
+	}
+
+	// ExecuteRecurringTransfersResult defines the result of a worker run over the due recurring transfers
+	ExecuteRecurringTransfersResult struct {
+		Executed  int
+		Failed    int
+		Processed []entity.RecurringTransfer
+	}
+
+	// Output data
+	ExecuteRecurringTransfersOutput struct {
+		Executed int      `json:"executed"`
+		Failed   int      `json:"failed"`
+		Paused   []string `json:"paused"`
+	}
+
+	executeRecurringTransfersInteractor struct {
+		repo       entity.RecurringTransferRepositoryLeaser
+		ucTransfer CreateTransferUseCase
+		pre        ExecuteRecurringTransfersPresenter
+	}
+)
+
+// NewExecuteRecurringTransfersInteractor creates new executeRecurringTransfersInteractor with its dependencies
+func NewExecuteRecurringTransfersInteractor(
+	repo entity.RecurringTransferRepositoryLeaser,
+	ucTransfer CreateTransferUseCase,
+	pre ExecuteRecurringTransfersPresenter,
+) ExecuteRecurringTransfersUseCase {
+	return executeRecurringTransfersInteractor{
+		repo:       repo,
+		ucTransfer: ucTransfer,
+		pre:        pre,
+	}
+}
+
+// Execute leases the recurring transfers with due occurrences and runs every occurrence missed until now
+// as an ordinary transfer. The transfer ID is derived from the occurrence, so an occurrence executed by a
+// worker that lost its lease is recognized as executed instead of transferring the value again
+func (e executeRecurringTransfersInteractor) Execute(ctx context.Context, i ExecuteRecurringTransfersInput) (ExecuteRecurringTransfersOutput, error) {
+	due, err := e.repo.LeaseDue(ctx, i.Now, i.Owner, i.LeaseTTL, i.Limit)
+	if err != nil {
+		return e.pre.Output(ExecuteRecurringTransfersResult{}), err
+	}
+
+	var result ExecuteRecurringTransfersResult
+	for _, recurring := range due {
+		for recurring.Due(i.Now) {
+			_, err := e.ucTransfer.Execute(ctx, CreateTransferInput{
+				ID:        recurring.OccurrenceID(),
+				PayerID:   recurring.Payer(),
+				PayeeID:   recurring.Payee(),
+				Value:     recurring.Value(),
+				CreatedAt: time.Now(),
+			})
+			if err != nil && !errors.Is(err, entity.ErrDuplicateTransfer) {
+				recurring = recurring.Failed(err, time.Now())
+				result.Failed++
+				continue
+			}
+
+			recurring = recurring.Succeeded(time.Now())
+			result.Executed++
+		}
+
+		if err := e.repo.Complete(ctx, i.Owner, recurring); err != nil {
+			return e.pre.Output(result), err
+		}
+
+		result.Processed = append(result.Processed, recurring)
+	}
+
+	return e.pre.Output(result), nil
+}
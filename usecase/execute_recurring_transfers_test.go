@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type spyRecurringTransferRepoLeaser struct {
+	due         []entity.RecurringTransfer
+	leaseErr    error
+	completeErr error
+	completed   []entity.RecurringTransfer
+}
+
+func (s *spyRecurringTransferRepoLeaser) LeaseDue(_ context.Context, _ time.Time, _ string, _ time.Duration, _ int) ([]entity.RecurringTransfer, error) {
+	return s.due, s.leaseErr
+}
+
+func (s *spyRecurringTransferRepoLeaser) Complete(_ context.Context, _ string, r entity.RecurringTransfer) error {
+	s.completed = append(s.completed, r)
+	return s.completeErr
+}
+
+type spyCreateTransferUseCase struct {
+	errs    []error
+	created []string
+}
+
+func (s *spyCreateTransferUseCase) Execute(_ context.Context, i CreateTransferInput) (CreateTransferOutput, error) {
+	var err error
+	if len(s.created) < len(s.errs) {
+		err = s.errs[len(s.created)]
+	}
+	s.created = append(s.created, i.ID.Value())
+
+	return CreateTransferOutput{ID: i.ID.Value()}, err
+}
+
+type stubExecuteRecurringTransfersPresenter struct{}
+
+func (s stubExecuteRecurringTransfersPresenter) Output(r ExecuteRecurringTransfersResult) ExecuteRecurringTransfersOutput {
+	var output = ExecuteRecurringTransfersOutput{Executed: r.Executed, Failed: r.Failed}
+	for _, t := range r.Processed {
+		if t.Status() == entity.RecurrencePaused {
+			output.Paused = append(output.Paused, t.ID().Value())
+		}
+	}
+
+	return output
+}
+
+func TestExecuteRecurringTransfersInteractor_Execute(t *testing.T) {
+	var (
+		startAt   = time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC)
+		now       = startAt.AddDate(0, 0, 2).Add(time.Hour)
+		recurring = func(count int) entity.RecurringTransfer {
+			return entity.RestoreRecurringTransfer(
+				vo.NewUuidStaticTest(),
+				vo.NewUuidStaticTest(),
+				vo.NewUuidStaticTest(),
+				vo.NewMoneyBRL(vo.NewAmountTest(100)),
+				entity.Daily,
+				0,
+				startAt,
+				time.Time{},
+				count,
+				0,
+				0,
+				2,
+				"",
+				entity.RecurrenceActive,
+				time.Time{},
+				time.Time{},
+			)
+		}
+	)
+
+	tests := []struct {
+		name            string
+		repo            *spyRecurringTransferRepoLeaser
+		transferErrs    []error
+		want            ExecuteRecurringTransfersOutput
+		wantTransfers   int
+		wantOccurrences int
+		wantStatus      entity.RecurrenceStatus
+		wantErr         error
+	}{
+		{
+			name:            "Catch up missed occurrences",
+			repo:            &spyRecurringTransferRepoLeaser{due: []entity.RecurringTransfer{recurring(0)}},
+			want:            ExecuteRecurringTransfersOutput{Executed: 3},
+			wantTransfers:   3,
+			wantOccurrences: 3,
+			wantStatus:      entity.RecurrenceActive,
+		},
+		{
+			name:            "Catch up until the count",
+			repo:            &spyRecurringTransferRepoLeaser{due: []entity.RecurringTransfer{recurring(2)}},
+			want:            ExecuteRecurringTransfersOutput{Executed: 2},
+			wantTransfers:   2,
+			wantOccurrences: 2,
+			wantStatus:      entity.RecurrenceFinished,
+		},
+		{
+			name:            "Occurrence already executed by another worker",
+			repo:            &spyRecurringTransferRepoLeaser{due: []entity.RecurringTransfer{recurring(0)}},
+			transferErrs:    []error{entity.ErrDuplicateTransfer},
+			want:            ExecuteRecurringTransfersOutput{Executed: 3},
+			wantTransfers:   3,
+			wantOccurrences: 3,
+			wantStatus:      entity.RecurrenceActive,
+		},
+		{
+			name:         "Paused after consecutive failures",
+			repo:         &spyRecurringTransferRepoLeaser{due: []entity.RecurringTransfer{recurring(0)}},
+			transferErrs: []error{entity.ErrUserInsufficientBalance, entity.ErrUserInsufficientBalance},
+			want: ExecuteRecurringTransfersOutput{
+				Failed: 2,
+				Paused: []string{vo.NewUuidStaticTest().Value()},
+			},
+			wantTransfers:   2,
+			wantOccurrences: 2,
+			wantStatus:      entity.RecurrencePaused,
+		},
+		{
+			name:    "Lease error",
+			repo:    &spyRecurringTransferRepoLeaser{leaseErr: entity.ErrLeaseRecurringTransfers},
+			wantErr: entity.ErrLeaseRecurringTransfers,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ucTransfer = &spyCreateTransferUseCase{errs: tt.transferErrs}
+
+			got, err := NewExecuteRecurringTransfersInteractor(
+				tt.repo,
+				ucTransfer,
+				stubExecuteRecurringTransfersPresenter{},
+			).Execute(context.TODO(), ExecuteRecurringTransfersInput{
+				Now:      now,
+				Owner:    "worker",
+				LeaseTTL: time.Minute,
+				Limit:    10,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+
+			if len(ucTransfer.created) != tt.wantTransfers {
+				t.Errorf("[TestCase '%s'] Got transfers: '%v' | Want: '%v'", tt.name, len(ucTransfer.created), tt.wantTransfers)
+			}
+
+			var seen = make(map[string]bool)
+			for _, ID := range ucTransfer.created {
+				if seen[ID] {
+					t.Errorf("[TestCase '%s'] Got repeated transfer ID: '%v'", tt.name, ID)
+				}
+				seen[ID] = true
+			}
+
+			completed := tt.repo.completed[0]
+			if completed.Occurrences() != tt.wantOccurrences || completed.Status() != tt.wantStatus {
+				t.Errorf(
+					"[TestCase '%s'] Got: '%v' '%v' | Want: '%v' '%v'",
+					tt.name,
+					completed.Occurrences(),
+					completed.Status(),
+					tt.wantOccurrences,
+					tt.wantStatus,
+				)
+			}
+		})
+	}
+}
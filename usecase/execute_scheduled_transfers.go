@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+type (
+	// Input port
+	ExecuteScheduledTransfersUseCase interface {
+		Execute(context.Context, ExecuteScheduledTransfersInput) (ExecuteScheduledTransfersOutput, error)
+	}
+
+	// Input data
+	ExecuteScheduledTransfersInput struct {
+		Now          time.Time
+		Owner        string
+		LeaseTTL     time.Duration
+		Limit        int
+		RetryBackoff time.Duration
+	}
+
+	// Output port
+	ExecuteScheduledTransfersPresenter interface {
+		Output([]entity.ScheduledTransfer) ExecuteScheduledTransfersOutput
+	}
+
+	// Output data
+	ExecuteScheduledTransfersOutput struct {
+		Executed int                                      `json:"executed"`
+		Retried  int                                      `json:"retried"`
+		Failed   int                                      `json:"failed"`
+		Failures []ExecuteScheduledTransfersFailureOutput `json:"failures"`
+	}
+
+	// Output data
+	ExecuteScheduledTransfersFailureOutput struct {
+		ID     string `json:"id"`
+		Reason string `json:"reason"`
+	}
+
+	executeScheduledTransfersInteractor struct {
+		repo       entity.ScheduledTransferRepositoryLeaser
+		ucTransfer CreateTransferUseCase
+		pre        ExecuteScheduledTransfersPresenter
+	}
+)
+
+// NewExecuteScheduledTransfersInteractor creates new executeScheduledTransfersInteractor with its dependencies
+func NewExecuteScheduledTransfersInteractor(
+	repo entity.ScheduledTransferRepositoryLeaser,
+	ucTransfer CreateTransferUseCase,
+	pre ExecuteScheduledTransfersPresenter,
+) ExecuteScheduledTransfersUseCase {
+	return executeScheduledTransfersInteractor{
+		repo:       repo,
+		ucTransfer: ucTransfer,
+		pre:        pre,
+	}
+}
+
+// Execute leases the due scheduled transfers and runs each one as an ordinary transfer. The transfer reuses
+// the ID of the scheduled transfer, so a transfer executed by a worker that lost its lease cannot be created twice.
+// A transfer refused by the domain fails, one that ended in an error that may go away is retried after a backoff.
+// A transfer that could not be completed keeps its lease until it expires without holding back the others, the
+// error returned counts them
+func (e executeScheduledTransfersInteractor) Execute(ctx context.Context, i ExecuteScheduledTransfersInput) (ExecuteScheduledTransfersOutput, error) {
+	due, err := e.repo.LeaseDue(ctx, i.Now, i.Owner, i.LeaseTTL, i.Limit)
+	if err != nil {
+		return e.pre.Output(nil), err
+	}
+
+	var (
+		completed   []entity.ScheduledTransfer
+		uncompleted int
+		completeErr error
+	)
+	for _, scheduled := range due {
+		_, err := e.ucTransfer.Execute(ctx, CreateTransferInput{
+			ID:        scheduled.ID(),
+			PayerID:   scheduled.Payer(),
+			PayeeID:   scheduled.Payee(),
+			Value:     scheduled.Value(),
+			Details:   scheduled.Details(),
+			CreatedAt: time.Now(),
+		})
+		switch {
+		// The transfer already exists when a previous worker executed it but lost the lease before completing
+		case err == nil, errors.Is(err, entity.ErrDuplicateTransfer):
+			scheduled = scheduled.Executed(time.Now())
+		case entity.IsTransient(err):
+			scheduled = scheduled.Retry(err, time.Now(), i.RetryBackoff)
+		default:
+			scheduled = scheduled.Failed(err, time.Now())
+		}
+
+		if err := e.repo.Complete(ctx, i.Owner, scheduled); err != nil {
+			uncompleted++
+			completeErr = err
+			continue
+		}
+
+		completed = append(completed, scheduled)
+	}
+
+	if completeErr != nil {
+		return e.pre.Output(completed), pkgerrors.Wrapf(completeErr, "%d scheduled transfers not completed", uncompleted)
+	}
+
+	return e.pre.Output(completed), nil
+}
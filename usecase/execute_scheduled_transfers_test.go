@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type spyScheduledTransferRepoLeaser struct {
+	due         []entity.ScheduledTransfer
+	leaseErr    error
+	completeErr error
+	completed   []entity.ScheduledTransfer
+}
+
+func (s *spyScheduledTransferRepoLeaser) LeaseDue(_ context.Context, _ time.Time, _ string, _ time.Duration, _ int) ([]entity.ScheduledTransfer, error) {
+	return s.due, s.leaseErr
+}
+
+func (s *spyScheduledTransferRepoLeaser) Complete(_ context.Context, _ string, t entity.ScheduledTransfer) error {
+	s.completed = append(s.completed, t)
+	return s.completeErr
+}
+
+type stubCreateTransferUseCase struct {
+	errs map[string]error
+}
+
+func (s stubCreateTransferUseCase) Execute(_ context.Context, i CreateTransferInput) (CreateTransferOutput, error) {
+	return CreateTransferOutput{ID: i.ID.Value()}, s.errs[i.ID.Value()]
+}
+
+type stubExecuteScheduledTransfersPresenter struct{}
+
+func (s stubExecuteScheduledTransfersPresenter) Output(transfers []entity.ScheduledTransfer) ExecuteScheduledTransfersOutput {
+	var output ExecuteScheduledTransfersOutput
+	for _, t := range transfers {
+		switch t.Status() {
+		case entity.TransferFailed:
+			output.Failed++
+		case entity.TransferScheduled:
+			output.Retried++
+		default:
+			output.Executed++
+		}
+	}
+
+	return output
+}
+
+func TestExecuteScheduledTransfersInteractor_Execute(t *testing.T) {
+	var newScheduledTransfer = func(id string) entity.ScheduledTransfer {
+		uuid, _ := vo.NewUuid(id)
+		return entity.RestoreScheduledTransfer(
+			uuid,
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewMoneyBRL(vo.NewAmountTest(100)),
+			time.Time{},
+			entity.TransferScheduled,
+			"",
+			time.Time{},
+			time.Time{},
+		)
+	}
+
+	var (
+		first  = newScheduledTransfer("0db298eb-c8e7-4829-84b7-c1036b4f0791")
+		second = newScheduledTransfer("0db298eb-c8e7-4829-84b7-c1036b4f0792")
+	)
+
+	tests := []struct {
+		name         string
+		repo         *spyScheduledTransferRepoLeaser
+		transferErrs map[string]error
+		want         ExecuteScheduledTransfersOutput
+		wantStatus   []entity.TransferStatus
+		wantErr      error
+	}{
+		{
+			name: "Execute due transfers",
+			repo: &spyScheduledTransferRepoLeaser{due: []entity.ScheduledTransfer{first, second}},
+			transferErrs: map[string]error{
+				second.ID().Value(): entity.ErrUserInsufficientBalance,
+			},
+			want:       ExecuteScheduledTransfersOutput{Executed: 1, Failed: 1},
+			wantStatus: []entity.TransferStatus{entity.TransferExecuted, entity.TransferFailed},
+		},
+		{
+			name: "Execute retrying transfers failed by unavailable services",
+			repo: &spyScheduledTransferRepoLeaser{due: []entity.ScheduledTransfer{first, second}},
+			transferErrs: map[string]error{
+				first.ID().Value():  entity.ErrAuthorizerUnavailable,
+				second.ID().Value(): entity.WrapError(entity.ErrCreateTransfer, errors.New("connection reset")),
+			},
+			want:       ExecuteScheduledTransfersOutput{Retried: 2},
+			wantStatus: []entity.TransferStatus{entity.TransferScheduled, entity.TransferScheduled},
+		},
+		{
+			name: "Execute failing transfers after the last attempt",
+			repo: &spyScheduledTransferRepoLeaser{
+				due: []entity.ScheduledTransfer{first.WithAttempts(entity.MaxScheduledTransferAttempts - 1)},
+			},
+			transferErrs: map[string]error{
+				first.ID().Value(): entity.ErrAuthorizerUnavailable,
+			},
+			want:       ExecuteScheduledTransfersOutput{Failed: 1},
+			wantStatus: []entity.TransferStatus{entity.TransferFailed},
+		},
+		{
+			name: "Execute without due transfers",
+			repo: &spyScheduledTransferRepoLeaser{},
+		},
+		{
+			name:    "Execute lease error",
+			repo:    &spyScheduledTransferRepoLeaser{leaseErr: entity.ErrLeaseScheduledTransfers},
+			wantErr: entity.ErrLeaseScheduledTransfers,
+		},
+		{
+			name: "Execute lease lost or canceled before completing",
+			repo: &spyScheduledTransferRepoLeaser{
+				due:         []entity.ScheduledTransfer{first, second},
+				completeErr: entity.ErrScheduledTransferChanged,
+			},
+			wantStatus: []entity.TransferStatus{entity.TransferExecuted, entity.TransferExecuted},
+			wantErr:    entity.ErrScheduledTransferChanged,
+		},
+		{
+			name: "Execute completion error",
+			repo: &spyScheduledTransferRepoLeaser{
+				due:         []entity.ScheduledTransfer{first, second},
+				completeErr: entity.ErrUpdateScheduledTransfer,
+			},
+			wantStatus: []entity.TransferStatus{entity.TransferExecuted, entity.TransferExecuted},
+			wantErr:    entity.ErrUpdateScheduledTransfer,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewExecuteScheduledTransfersInteractor(
+				tt.repo,
+				stubCreateTransferUseCase{errs: tt.transferErrs},
+				stubExecuteScheduledTransfersPresenter{},
+			)
+
+			got, err := uc.Execute(context.TODO(), ExecuteScheduledTransfersInput{
+				Now:          time.Now(),
+				Owner:        "worker",
+				LeaseTTL:     time.Minute,
+				Limit:        10,
+				RetryBackoff: time.Minute,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+
+			var status []entity.TransferStatus
+			for _, completed := range tt.repo.completed {
+				status = append(status, completed.Status())
+			}
+
+			if !reflect.DeepEqual(status, tt.wantStatus) {
+				t.Errorf("[TestCase '%s'] Got status: '%v' | Want: '%v'", tt.name, status, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+)
+
+type (
+	// Input port
+	ExpireHoldsUseCase interface {
+		Execute(context.Context, ExpireHoldsInput) (ExpireHoldsOutput, error)
+	}
+
+	// Input data
+	ExpireHoldsInput struct {
+		Now   time.Time
+		Limit int
+	}
+
+	// Output port
+	ExpireHoldsPresenter interface {
+		Output([]entity.Hold) ExpireHoldsOutput
+	}
+
+	// Output data
+	ExpireHoldsOutput struct {
+		Expired int      `json:"expired"`
+		IDs     []string `json:"ids"`
+	}
+
+	expireHoldsInteractor struct {
+		repoHold       entity.HoldRepository
+		repoUserFinder entity.UserRepositoryFinder
+		repoUserHolder entity.UserRepositoryHolder
+		pre            ExpireHoldsPresenter
+	}
+)
+
+// NewExpireHoldsInteractor creates new expireHoldsInteractor with its dependencies
+func NewExpireHoldsInteractor(
+	repoHold entity.HoldRepository,
+	repoUserFinder entity.UserRepositoryFinder,
+	repoUserHolder entity.UserRepositoryHolder,
+	pre ExpireHoldsPresenter,
+) ExpireHoldsUseCase {
+	return expireHoldsInteractor{
+		repoHold:       repoHold,
+		repoUserFinder: repoUserFinder,
+		repoUserHolder: repoUserHolder,
+		pre:            pre,
+	}
+}
+
+// Execute expires the active holds past their expiration, each one in its own transaction releasing its funds.
+// A hold captured or voided while being expired is skipped, a hold that failed to expire is left active to be
+// expired by a later poll and the first failure is returned once the other holds are expired
+func (e expireHoldsInteractor) Execute(ctx context.Context, i ExpireHoldsInput) (ExpireHoldsOutput, error) {
+	stale, err := e.repoHold.FindExpired(ctx, i.Now, i.Limit)
+	if err != nil {
+		return e.pre.Output(nil), err
+	}
+
+	var (
+		expired  []entity.Hold
+		firstErr error
+	)
+	for _, hold := range stale {
+		hold, err := hold.Expire(i.Now)
+		if err != nil {
+			continue
+		}
+
+		err = e.repoHold.WithTransaction(ctx, func(sessCtx context.Context) error {
+			if err := e.repoHold.Close(sessCtx, hold); err != nil {
+				return err
+			}
+
+			return releaseHold(sessCtx, e.repoUserFinder, e.repoUserHolder, hold)
+		})
+		if errors.Is(err, entity.ErrHoldNotActive) {
+			continue
+		}
+		if err != nil {
+			LoggerFromContext(ctx).Errorf("error expiring hold %s: %v", hold.ID().Value(), err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		expired = append(expired, hold)
+	}
+
+	return e.pre.Output(expired), firstErr
+}
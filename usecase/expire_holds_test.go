@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/google/uuid"
+)
+
+type stubExpireHoldsPresenter struct{}
+
+func (s stubExpireHoldsPresenter) Output(holds []entity.Hold) ExpireHoldsOutput {
+	return ExpireHoldsOutput{Expired: len(holds)}
+}
+
+func TestExpireHoldsInteractor_Execute(t *testing.T) {
+	var (
+		now   = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+		holds = func(n int) []entity.Hold {
+			var holds []entity.Hold
+			for i := 0; i < n; i++ {
+				ID, _ := vo.NewUuid(uuid.New().String())
+				hold, _ := entity.NewHold(
+					ID,
+					payerTestID,
+					vo.NewUuidStaticTest(),
+					vo.NewMoneyBRL(vo.NewAmountTest(40)),
+					now.Add(-time.Minute),
+					now.Add(-time.Hour),
+				)
+				holds = append(holds, hold)
+			}
+			return holds
+		}
+		failing = holds(2)
+	)
+
+	tests := []struct {
+		name        string
+		holds       []entity.Hold
+		closeErr    error
+		closeErrs   map[vo.Uuid]error
+		want        int
+		wantHeld    int64
+		wantErr     error
+		wantUpdates int
+		wantLogged  int
+	}{
+		{
+			name:        "Expire stale holds releasing their funds",
+			holds:       holds(2),
+			want:        2,
+			wantHeld:    20,
+			wantUpdates: 1,
+		},
+		{
+			name:     "Skip holds closed meanwhile",
+			holds:    holds(2),
+			closeErr: entity.ErrHoldNotActive,
+		},
+		{
+			name:       "Error closing hold",
+			holds:      holds(1),
+			closeErr:   entity.ErrUpdateHold,
+			wantErr:    entity.ErrUpdateHold,
+			wantLogged: 1,
+		},
+		{
+			name:        "Expire the other holds after an error",
+			holds:       failing,
+			closeErrs:   map[vo.Uuid]error{failing[0].ID(): entity.ErrUpdateHold},
+			want:        1,
+			wantHeld:    60,
+			wantErr:     entity.ErrUpdateHold,
+			wantUpdates: 1,
+			wantLogged:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				repo = &spyHoldRepo{
+					holds:     map[vo.Uuid]entity.Hold{},
+					expired:   tt.holds,
+					closeErr:  tt.closeErr,
+					closeErrs: tt.closeErrs,
+				}
+				holder = spyUserRepoHolder{}
+				uc     = NewExpireHoldsInteractor(
+					repo,
+					newTestUsers(
+						newHeldTestUser(newCommonTestUser(payerTestID, 100), 100),
+						newMerchantTestUser(vo.NewUuidStaticTest(), 0),
+					),
+					holder,
+					stubExpireHoldsPresenter{},
+				)
+			)
+
+			var log = spyLogger{}
+			got, err := uc.Execute(ContextWithLogger(context.Background(), log), ExpireHoldsInput{Now: now, Limit: 10})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if got.Expired != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Expired, tt.want)
+			}
+
+			if len(holder) != tt.wantUpdates || holder[payerTestID] != tt.wantHeld {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, holder, tt.wantHeld)
+			}
+
+			if len(log["error"]) != tt.wantLogged {
+				t.Errorf("[TestCase '%s'] Logged Got: '%v' | Want: '%v'", tt.name, log["error"], tt.wantLogged)
+			}
+
+			for _, hold := range repo.closed {
+				if hold.Status() != entity.HoldExpired {
+					t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, hold.Status(), entity.HoldExpired)
+				}
+			}
+		})
+	}
+}
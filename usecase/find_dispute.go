@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	FindDisputeUseCase interface {
+		Execute(context.Context, FindDisputeInput) (DisputeOutput, error)
+	}
+
+	// Input data
+	FindDisputeInput struct {
+		ID vo.Uuid
+	}
+
+	findDisputeInteractor struct {
+		repo entity.DisputeRepositoryFinder
+		pre  DisputePresenter
+	}
+)
+
+// NewFindDisputeInteractor creates new findDisputeInteractor with its dependencies
+func NewFindDisputeInteractor(repo entity.DisputeRepositoryFinder, pre DisputePresenter) FindDisputeUseCase {
+	return findDisputeInteractor{
+		repo: repo,
+		pre:  pre,
+	}
+}
+
+// Execute orchestrates the use case
+func (f findDisputeInteractor) Execute(ctx context.Context, i FindDisputeInput) (DisputeOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	dispute, err := f.repo.FindByID(ctx, i.ID)
+	if err != nil {
+		return f.pre.Output(entity.Dispute{}), err
+	}
+
+	return f.pre.Output(dispute), nil
+}
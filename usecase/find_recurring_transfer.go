@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	FindRecurringTransferUseCase interface {
+		Execute(context.Context, FindRecurringTransferInput) (RecurringTransferOutput, error)
+	}
+
+	// Input data
+	FindRecurringTransferInput struct {
+		ID vo.Uuid
+	}
+
+	findRecurringTransferInteractor struct {
+		repo entity.RecurringTransferRepositoryFinder
+		pre  RecurringTransferPresenter
+	}
+)
+
+// NewFindRecurringTransferInteractor creates new findRecurringTransferInteractor with its dependencies
+func NewFindRecurringTransferInteractor(
+	repo entity.RecurringTransferRepositoryFinder,
+	pre RecurringTransferPresenter,
+) FindRecurringTransferUseCase {
+	return findRecurringTransferInteractor{
+		repo: repo,
+		pre:  pre,
+	}
+}
+
+// Execute orchestrates the use case
+func (f findRecurringTransferInteractor) Execute(ctx context.Context, i FindRecurringTransferInput) (RecurringTransferOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	recurring, err := f.repo.FindByID(ctx, i.ID)
+	if err != nil {
+		return f.pre.Output(entity.RecurringTransfer{}), err
+	}
+
+	return f.pre.Output(recurring), nil
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func TestFindRecurringTransferInteractor_Execute(t *testing.T) {
+	var recurring = entity.RestoreRecurringTransfer(
+		vo.NewUuidStaticTest(),
+		vo.NewUuidStaticTest(),
+		vo.NewUuidStaticTest(),
+		vo.NewMoneyBRL(vo.NewAmountTest(100)),
+		entity.Daily,
+		0,
+		time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC),
+		time.Time{},
+		0,
+		0,
+		0,
+		3,
+		"",
+		entity.RecurrenceActive,
+		time.Time{},
+		time.Time{},
+	)
+
+	tests := []struct {
+		name    string
+		repo    stubRecurringTransferRepo
+		want    RecurringTransferOutput
+		wantErr error
+	}{
+		{
+			name: "Find recurring transfer success",
+			repo: stubRecurringTransferRepo{result: recurring},
+			want: RecurringTransferOutput{
+				ID:     "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:  100,
+				Status: "ACTIVE",
+			},
+		},
+		{
+			name:    "Find recurring transfer not found",
+			repo:    stubRecurringTransferRepo{findErr: entity.ErrNotFoundRecurringTransfer},
+			wantErr: entity.ErrNotFoundRecurringTransfer,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFindRecurringTransferInteractor(
+				tt.repo,
+				stubRecurringTransferPresenter{},
+			).Execute(context.TODO(), FindRecurringTransferInput{ID: vo.NewUuidStaticTest()})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr == nil && got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	FindScheduledTransferUseCase interface {
+		Execute(context.Context, FindScheduledTransferInput) (ScheduledTransferOutput, error)
+	}
+
+	// Input data
+	FindScheduledTransferInput struct {
+		ID vo.Uuid
+	}
+
+	findScheduledTransferInteractor struct {
+		repo entity.ScheduledTransferRepositoryFinder
+		pre  ScheduledTransferPresenter
+	}
+)
+
+// NewFindScheduledTransferInteractor creates new findScheduledTransferInteractor with its dependencies
+func NewFindScheduledTransferInteractor(
+	repo entity.ScheduledTransferRepositoryFinder,
+	pre ScheduledTransferPresenter,
+) FindScheduledTransferUseCase {
+	return findScheduledTransferInteractor{
+		repo: repo,
+		pre:  pre,
+	}
+}
+
+// Execute orchestrates the use case
+func (f findScheduledTransferInteractor) Execute(ctx context.Context, i FindScheduledTransferInput) (ScheduledTransferOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	scheduled, err := f.repo.FindByID(ctx, i.ID)
+	if err != nil {
+		return f.pre.Output(entity.ScheduledTransfer{}), err
+	}
+
+	return f.pre.Output(scheduled), nil
+}
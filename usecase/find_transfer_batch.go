@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	FindTransferBatchUseCase interface {
+		Execute(context.Context, FindTransferBatchInput) (TransferBatchOutput, error)
+	}
+
+	// Input data
+	FindTransferBatchInput struct {
+		ID vo.Uuid
+	}
+
+	findTransferBatchInteractor struct {
+		repo entity.TransferBatchRepositoryFinder
+		pre  TransferBatchPresenter
+	}
+)
+
+// NewFindTransferBatchInteractor creates new findTransferBatchInteractor with its dependencies
+func NewFindTransferBatchInteractor(repo entity.TransferBatchRepositoryFinder, pre TransferBatchPresenter) FindTransferBatchUseCase {
+	return findTransferBatchInteractor{
+		repo: repo,
+		pre:  pre,
+	}
+}
+
+// Execute orchestrates the use case
+func (f findTransferBatchInteractor) Execute(ctx context.Context, i FindTransferBatchInput) (TransferBatchOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	batch, err := f.repo.FindByID(ctx, i.ID)
+	if err != nil {
+		return f.pre.Output(entity.TransferBatch{}), err
+	}
+
+	return f.pre.Output(batch), nil
+}
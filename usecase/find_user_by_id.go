@@ -33,6 +33,7 @@ type (
 		Wallet    FindUserByIDWalletOutput   `json:"wallet"`
 		Roles     FindUserByIDRolesOutput    `json:"roles"`
 		Type      string                     `json:"type"`
+		KYCLevel  string                     `json:"kyc_level"`
 		CreatedAt string                     `json:"created_at"`
 	}
 
@@ -44,8 +45,10 @@ type (
 
 	// Output data
 	FindUserByIDWalletOutput struct {
-		Currency string `json:"currency"`
-		Amount   int64  `json:"amount"`
+		Currency  string `json:"currency"`
+		Amount    int64  `json:"amount"`
+		Held      int64  `json:"held"`
+		Available int64  `json:"available"`
 	}
 
 	// Output data
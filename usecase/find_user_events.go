@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	FindUserEventsUseCase interface {
+		Execute(context.Context, FindUserEventsInput) (FindUserEventsOutput, error)
+	}
+
+	// Input data
+	FindUserEventsInput struct {
+		ID vo.Uuid
+	}
+
+	// Output port
+	FindUserEventsPresenter interface {
+		Output(vo.Uuid, []entity.Event) FindUserEventsOutput
+	}
+
+	// Output data
+	FindUserEventsOutput struct {
+		ID     string                     `json:"id"`
+		Events []FindUserEventsItemOutput `json:"events"`
+	}
+
+	// Output data
+	FindUserEventsItemOutput struct {
+		Sequence   int64           `json:"sequence"`
+		Type       string          `json:"type"`
+		Data       json.RawMessage `json:"data"`
+		OccurredAt string          `json:"occurred_at"`
+	}
+
+	findUserEventsInteractor struct {
+		repoUserFinder entity.UserRepositoryFinder
+		repoEvents     entity.EventRepositoryFinder
+		pre            FindUserEventsPresenter
+	}
+)
+
+// NewFindUserEventsInteractor creates new findUserEventsInteractor with its dependencies
+func NewFindUserEventsInteractor(
+	repoUserFinder entity.UserRepositoryFinder,
+	repoEvents entity.EventRepositoryFinder,
+	pre FindUserEventsPresenter,
+) FindUserEventsUseCase {
+	return findUserEventsInteractor{
+		repoUserFinder: repoUserFinder,
+		repoEvents:     repoEvents,
+		pre:            pre,
+	}
+}
+
+// Execute orchestrates the use case
+func (f findUserEventsInteractor) Execute(ctx context.Context, i FindUserEventsInput) (FindUserEventsOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if _, err := f.repoUserFinder.FindByID(ctx, i.ID); err != nil {
+		return f.pre.Output(vo.Uuid{}, nil), err
+	}
+
+	events, err := f.repoEvents.FindByAggregateID(ctx, i.ID, 0)
+	if err != nil {
+		return f.pre.Output(vo.Uuid{}, nil), err
+	}
+
+	return f.pre.Output(i.ID, events), nil
+}
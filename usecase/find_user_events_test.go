@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type stubEventRepoFinder struct {
+	result []entity.Event
+	err    error
+}
+
+func (s stubEventRepoFinder) FindByAggregateID(_ context.Context, _ vo.Uuid, _ int64) ([]entity.Event, error) {
+	return s.result, s.err
+}
+
+type stubFindUserEventsPresenter struct {
+	result FindUserEventsOutput
+}
+
+func (s stubFindUserEventsPresenter) Output(_ vo.Uuid, _ []entity.Event) FindUserEventsOutput {
+	return s.result
+}
+
+func TestFindUserEventsInteractor_Execute(t *testing.T) {
+	type fields struct {
+		repoUserFinder entity.UserRepositoryFinder
+		repoEvents     entity.EventRepositoryFinder
+		pre            FindUserEventsPresenter
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    FindUserEventsOutput
+		wantErr error
+	}{
+		{
+			name: "Find user events success",
+			fields: fields{
+				repoUserFinder: stubUserRepoFinder{
+					result: entity.NewCommonUser(
+						vo.NewUuidStaticTest(),
+						vo.NewFullName("Test testing"),
+						vo.NewEmailTest("test@testing.com"),
+						vo.NewPassword("passw"),
+						vo.NewDocumentTest(vo.CPF, "07091054954"),
+						vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(100))),
+						time.Time{},
+					),
+				},
+				repoEvents: stubEventRepoFinder{},
+				pre: stubFindUserEventsPresenter{
+					result: FindUserEventsOutput{
+						ID:     vo.NewUuidStaticTest().Value(),
+						Events: []FindUserEventsItemOutput{{Sequence: 1, Type: "UserCreated"}},
+					},
+				},
+			},
+			want: FindUserEventsOutput{
+				ID:     vo.NewUuidStaticTest().Value(),
+				Events: []FindUserEventsItemOutput{{Sequence: 1, Type: "UserCreated"}},
+			},
+		},
+		{
+			name: "Find user events user not found",
+			fields: fields{
+				repoUserFinder: stubUserRepoFinder{
+					err: entity.ErrNotFoundUser,
+				},
+				repoEvents: stubEventRepoFinder{},
+				pre:        stubFindUserEventsPresenter{},
+			},
+			want:    FindUserEventsOutput{},
+			wantErr: entity.ErrNotFoundUser,
+		},
+		{
+			name: "Find user events database error",
+			fields: fields{
+				repoUserFinder: stubUserRepoFinder{},
+				repoEvents: stubEventRepoFinder{
+					err: entity.ErrFindEvents,
+				},
+				pre: stubFindUserEventsPresenter{},
+			},
+			want:    FindUserEventsOutput{},
+			wantErr: entity.ErrFindEvents,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewFindUserEventsInteractor(tt.fields.repoUserFinder, tt.fields.repoEvents, tt.fields.pre)
+
+			got, err := uc.Execute(context.TODO(), FindUserEventsInput{ID: vo.NewUuidStaticTest()})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	GetStatementUseCase interface {
+		Execute(context.Context, GetStatementInput) (GetStatementOutput, error)
+	}
+
+	// Input data
+	GetStatementInput struct {
+		UserID vo.Uuid
+		From   time.Time
+		To     time.Time
+	}
+
+	// Output port
+	GetStatementPresenter interface {
+		Output(entity.Statement) GetStatementOutput
+	}
+
+	// Output data
+	GetStatementOutput struct {
+		UserID         string                    `json:"user_id"`
+		Currency       string                    `json:"currency"`
+		From           string                    `json:"from"`
+		To             string                    `json:"to"`
+		OpeningBalance int64                     `json:"opening_balance"`
+		ClosingBalance int64                     `json:"closing_balance"`
+		Entries        []GetStatementEntryOutput `json:"entries"`
+	}
+
+	// Output data
+	GetStatementEntryOutput struct {
+		ReferenceID  string `json:"reference_id"`
+		Type         string `json:"type"`
+		Counterparty string `json:"counterparty"`
+		Amount       int64  `json:"amount"`
+		Balance      int64  `json:"balance"`
+		CreatedAt    string `json:"created_at"`
+	}
+
+	getStatementInteractor struct {
+		repoUserFinder     entity.UserRepositoryFinder
+		repoTransferFinder entity.TransferRepositoryFinder
+		repoMovementFinder entity.MovementRepositoryFinder
+		pre                GetStatementPresenter
+	}
+)
+
+// NewGetStatementInteractor creates new getStatementInteractor with its dependencies
+func NewGetStatementInteractor(
+	repoUserFinder entity.UserRepositoryFinder,
+	repoTransferFinder entity.TransferRepositoryFinder,
+	repoMovementFinder entity.MovementRepositoryFinder,
+	pre GetStatementPresenter,
+) GetStatementUseCase {
+	return getStatementInteractor{
+		repoUserFinder:     repoUserFinder,
+		repoTransferFinder: repoTransferFinder,
+		repoMovementFinder: repoMovementFinder,
+		pre:                pre,
+	}
+}
+
+// Execute orchestrates the use case
+func (g getStatementInteractor) Execute(ctx context.Context, i GetStatementInput) (GetStatementOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if i.To.Before(i.From) {
+		return g.pre.Output(entity.Statement{}), entity.ErrInvalidStatementPeriod
+	}
+
+	user, err := g.repoUserFinder.FindByID(ctx, i.UserID)
+	if err != nil {
+		return g.pre.Output(entity.Statement{}), err
+	}
+
+	transfers, err := g.repoTransferFinder.FindByUserID(ctx, i.UserID, i.From)
+	if err != nil {
+		return g.pre.Output(entity.Statement{}), err
+	}
+
+	movements, err := g.repoMovementFinder.FindByUserID(ctx, i.UserID, i.From)
+	if err != nil {
+		return g.pre.Output(entity.Statement{}), err
+	}
+
+	var entries []entity.StatementEntry
+	for _, transfer := range transfers {
+		entries = append(entries, entity.NewTransferStatementEntries(i.UserID, transfer)...)
+	}
+
+	for _, movement := range movements {
+		entries = append(entries, entity.NewMovementStatementEntries(movement)...)
+	}
+
+	statement, err := entity.NewStatement(i.UserID, user.Wallet().Money(), i.From, i.To, entries)
+	if err != nil {
+		return g.pre.Output(entity.Statement{}), err
+	}
+
+	return g.pre.Output(statement), nil
+}
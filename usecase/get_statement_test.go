@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type stubTransferRepoFinder struct {
+	result []entity.Transfer
+	err    error
+}
+
+func (s stubTransferRepoFinder) FindByUserID(_ context.Context, _ vo.Uuid, _ time.Time) ([]entity.Transfer, error) {
+	return s.result, s.err
+}
+
+type stubMovementRepoFinder struct {
+	result entity.Movement
+	list   []entity.Movement
+	err    error
+}
+
+func (s stubMovementRepoFinder) FindByID(_ context.Context, _ vo.Uuid) (entity.Movement, error) {
+	return s.result, s.err
+}
+
+func (s stubMovementRepoFinder) FindByUserID(_ context.Context, _ vo.Uuid, _ time.Time) ([]entity.Movement, error) {
+	return s.list, s.err
+}
+
+type stubGetStatementPresenter struct {
+	result GetStatementOutput
+}
+
+func (s stubGetStatementPresenter) Output(_ entity.Statement) GetStatementOutput {
+	return s.result
+}
+
+func TestGetStatementInteractor_Execute(t *testing.T) {
+	type fields struct {
+		repoUserFinder     entity.UserRepositoryFinder
+		repoTransferFinder entity.TransferRepositoryFinder
+		repoMovementFinder entity.MovementRepositoryFinder
+		pre                GetStatementPresenter
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    GetStatementInput
+		want    GetStatementOutput
+		wantErr error
+	}{
+		{
+			name: "Get statement success",
+			fields: fields{
+				repoUserFinder: stubUserRepoFinder{
+					result: entity.NewCommonUser(
+						vo.NewUuidStaticTest(),
+						vo.NewFullName("Test testing"),
+						vo.NewEmailTest("test@testing.com"),
+						vo.NewPassword("passw"),
+						vo.NewDocumentTest(vo.CPF, "07091054954"),
+						vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(100))),
+						time.Time{},
+					),
+				},
+				repoTransferFinder: stubTransferRepoFinder{},
+				repoMovementFinder: stubMovementRepoFinder{},
+				pre: stubGetStatementPresenter{
+					result: GetStatementOutput{OpeningBalance: 100, ClosingBalance: 100},
+				},
+			},
+			args: GetStatementInput{
+				UserID: vo.NewUuidStaticTest(),
+				From:   time.Time{},
+				To:     time.Now(),
+			},
+			want: GetStatementOutput{OpeningBalance: 100, ClosingBalance: 100},
+		},
+		{
+			name: "Get statement invalid period",
+			fields: fields{
+				repoUserFinder:     stubUserRepoFinder{},
+				repoTransferFinder: stubTransferRepoFinder{},
+				repoMovementFinder: stubMovementRepoFinder{},
+				pre:                stubGetStatementPresenter{},
+			},
+			args: GetStatementInput{
+				UserID: vo.NewUuidStaticTest(),
+				From:   time.Now(),
+				To:     time.Time{},
+			},
+			wantErr: entity.ErrInvalidStatementPeriod,
+		},
+		{
+			name: "Get statement user not found",
+			fields: fields{
+				repoUserFinder: stubUserRepoFinder{
+					err: entity.ErrNotFoundUser,
+				},
+				repoTransferFinder: stubTransferRepoFinder{},
+				repoMovementFinder: stubMovementRepoFinder{},
+				pre:                stubGetStatementPresenter{},
+			},
+			args: GetStatementInput{
+				UserID: vo.NewUuidStaticTest(),
+				To:     time.Now(),
+			},
+			wantErr: entity.ErrNotFoundUser,
+		},
+		{
+			name: "Get statement transfers error",
+			fields: fields{
+				repoUserFinder: stubUserRepoFinder{
+					result: entity.NewCommonUser(
+						vo.NewUuidStaticTest(),
+						vo.NewFullName("Test testing"),
+						vo.NewEmailTest("test@testing.com"),
+						vo.NewPassword("passw"),
+						vo.NewDocumentTest(vo.CPF, "07091054954"),
+						vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(100))),
+						time.Time{},
+					),
+				},
+				repoTransferFinder: stubTransferRepoFinder{
+					err: entity.ErrFindTransfers,
+				},
+				repoMovementFinder: stubMovementRepoFinder{},
+				pre:                stubGetStatementPresenter{},
+			},
+			args: GetStatementInput{
+				UserID: vo.NewUuidStaticTest(),
+				To:     time.Now(),
+			},
+			wantErr: entity.ErrFindTransfers,
+		},
+		{
+			name: "Get statement movements error",
+			fields: fields{
+				repoUserFinder: stubUserRepoFinder{
+					result: entity.NewCommonUser(
+						vo.NewUuidStaticTest(),
+						vo.NewFullName("Test testing"),
+						vo.NewEmailTest("test@testing.com"),
+						vo.NewPassword("passw"),
+						vo.NewDocumentTest(vo.CPF, "07091054954"),
+						vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(100))),
+						time.Time{},
+					),
+				},
+				repoTransferFinder: stubTransferRepoFinder{},
+				repoMovementFinder: stubMovementRepoFinder{
+					err: entity.ErrFindMovement,
+				},
+				pre: stubGetStatementPresenter{},
+			},
+			args: GetStatementInput{
+				UserID: vo.NewUuidStaticTest(),
+				To:     time.Now(),
+			},
+			wantErr: entity.ErrFindMovement,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewGetStatementInteractor(
+				tt.fields.repoUserFinder,
+				tt.fields.repoTransferFinder,
+				tt.fields.repoMovementFinder,
+				tt.fields.pre,
+			)
+
+			got, err := uc.Execute(context.TODO(), tt.args)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import "context"
+
+type (
+	// Logger port writes the logs of the use cases, implemented outside of the use cases. The logger of the
+	// request, with its correlation id and trace id, is retrieved from the context with LoggerFromContext
+	Logger interface {
+		Infof(format string, args ...interface{})
+		Warnf(format string, args ...interface{})
+		Errorf(format string, args ...interface{})
+	}
+
+	// loggerKey is the key of the logger kept in the context, unexported so that it is only read and written
+	// by the functions below
+	loggerKey struct{}
+
+	nopLogger struct{}
+)
+
+// ContextWithLogger returns a copy of ctx carrying the logger of the request for the use cases
+func ContextWithLogger(ctx context.Context, log Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, log)
+}
+
+// LoggerFromContext returns the logger of the request, or a logger discarding the logs when ctx doesn't carry one
+func LoggerFromContext(ctx context.Context) Logger {
+	if log, ok := ctx.Value(loggerKey{}).(Logger); ok {
+		return log
+	}
+
+	return nopLogger{}
+}
+
+func (nopLogger) Infof(string, ...interface{})  {}
+func (nopLogger) Warnf(string, ...interface{})  {}
+func (nopLogger) Errorf(string, ...interface{}) {}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+// spyLogger keeps the messages logged by level
+type spyLogger map[string][]string
+
+func (s spyLogger) Infof(format string, args ...interface{}) {
+	s["info"] = append(s["info"], fmt.Sprintf(format, args...))
+}
+
+func (s spyLogger) Warnf(format string, args ...interface{}) {
+	s["warn"] = append(s["warn"], fmt.Sprintf(format, args...))
+}
+
+func (s spyLogger) Errorf(format string, args ...interface{}) {
+	s["error"] = append(s["error"], fmt.Sprintf(format, args...))
+}
+
+func TestLoggerFromContext(t *testing.T) {
+	var spy = spyLogger{}
+
+	LoggerFromContext(ContextWithLogger(context.Background(), spy)).Errorf("error %s", "logged")
+	if got := spy["error"]; len(got) != 1 || got[0] != "error logged" {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Logger of the request", got, []string{"error logged"})
+	}
+
+	if got := LoggerFromContext(context.Background()); got != (nopLogger{}) {
+		t.Errorf("[TestCase '%s'] Got: '%T' | Want: '%T'", "Context without logger", got, nopLogger{})
+	}
+}
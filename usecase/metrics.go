@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+)
+
+const (
+	// Outcomes of the transfers recorded by TransferMetrics, the failures are recorded by the code of their error
+	TransferSucceeded = "succeeded"
+
+	// Outcomes of the authorizations recorded by AuthorizerMetrics
+	AuthorizationGranted     = "authorized"
+	AuthorizationDenied      = "denied"
+	AuthorizationUnavailable = "unavailable"
+
+	// Outcomes of the notifications recorded by NotifierMetrics
+	NotificationSent   = "sent"
+	NotificationFailed = "failed"
+	NotificationQueued = "queued"
+)
+
+type (
+	// TransferMetrics port records the transfers by outcome, implemented outside of the use cases
+	TransferMetrics interface {
+		TransferCreated(outcome string, currency string, value int64)
+	}
+
+	// AuthorizerMetrics port records the authorizations by outcome with the latency of the authorizer
+	AuthorizerMetrics interface {
+		AuthorizationCompleted(outcome string, latency time.Duration)
+	}
+
+	// NotifierMetrics port records the notifications by outcome
+	NotifierMetrics interface {
+		NotificationCompleted(outcome string)
+	}
+
+	measuredCreateTransfer struct {
+		uc      CreateTransferUseCase
+		metrics TransferMetrics
+	}
+)
+
+// NewMeasuredCreateTransfer creates new CreateTransferUseCase recording the outcome of each transfer of uc
+func NewMeasuredCreateTransfer(uc CreateTransferUseCase, metrics TransferMetrics) CreateTransferUseCase {
+	return measuredCreateTransfer{
+		uc:      uc,
+		metrics: metrics,
+	}
+}
+
+// Execute executes the use case and records its outcome with the value of the transfer
+func (m measuredCreateTransfer) Execute(ctx context.Context, i CreateTransferInput) (CreateTransferOutput, error) {
+	output, err := m.uc.Execute(ctx, i)
+
+	var outcome = TransferSucceeded
+	if err != nil {
+		outcome = entity.ErrorOf(err).Code()
+	}
+
+	m.metrics.TransferCreated(outcome, i.Value.Currency().String(), i.Value.Amount().Value())
+
+	return output, err
+}
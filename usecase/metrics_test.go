@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type spyTransferMetrics struct {
+	outcome  string
+	currency string
+	value    int64
+}
+
+func (s *spyTransferMetrics) TransferCreated(outcome string, currency string, value int64) {
+	s.outcome, s.currency, s.value = outcome, currency, value
+}
+
+func TestMeasuredCreateTransfer_Execute(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		expectedOutcome string
+	}{
+		{
+			name:            "Record transfer succeeded",
+			expectedOutcome: TransferSucceeded,
+		},
+		{
+			name:            "Record transfer failed by error code",
+			err:             entity.ErrUserInsufficientBalance,
+			expectedOutcome: "user_insufficient_balance",
+		},
+		{
+			name:            "Record transfer failed by unknown error",
+			err:             context.DeadlineExceeded,
+			expectedOutcome: "internal_error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				metrics = &spyTransferMetrics{}
+				ID      = vo.NewUuidStaticTest()
+				uc      = NewMeasuredCreateTransfer(stubCreateTransferUseCase{errs: map[string]error{ID.Value(): tt.err}}, metrics)
+			)
+
+			_, err := uc.Execute(context.Background(), CreateTransferInput{
+				ID:        ID,
+				Value:     vo.NewMoneyBRL(vo.NewAmountTest(100)),
+				CreatedAt: time.Now(),
+			})
+			if err != tt.err {
+				t.Errorf("[TestCase '%s'] Got error: '%v' | Want error: '%v'", tt.name, err, tt.err)
+			}
+
+			if metrics.outcome != tt.expectedOutcome || metrics.currency != "BRL" || metrics.value != 100 {
+				t.Errorf("[TestCase '%s'] Got: '%s %s %d' | Want: '%s BRL 100'", tt.name, metrics.outcome, metrics.currency, metrics.value, tt.expectedOutcome)
+			}
+		})
+	}
+}
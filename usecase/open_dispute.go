@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	OpenDisputeUseCase interface {
+		Execute(context.Context, OpenDisputeInput) (DisputeOutput, error)
+	}
+
+	// Input data
+	OpenDisputeInput struct {
+		ID         vo.Uuid
+		TransferID vo.Uuid
+		Reason     string
+		CreatedAt  time.Time
+	}
+
+	// DisputeNotifier defines the notification of the changes of a dispute to its parties
+	DisputeNotifier interface {
+		NotifyDispute(context.Context, entity.DisputeNotice)
+	}
+
+	// Output port
+	DisputePresenter interface {
+		Output(entity.Dispute) DisputeOutput
+	}
+
+	// Output data
+	DisputeOutput struct {
+		ID         string                  `json:"id"`
+		TransferID string                  `json:"transfer_id"`
+		PayerID    string                  `json:"payer"`
+		PayeeID    string                  `json:"payee"`
+		Value      int64                   `json:"value"`
+		Reason     string                  `json:"reason"`
+		Status     string                  `json:"status"`
+		Evidence   []DisputeEvidenceOutput `json:"evidence"`
+		CreatedAt  string                  `json:"created_at"`
+		UpdatedAt  string                  `json:"updated_at"`
+	}
+
+	// Output data
+	DisputeEvidenceOutput struct {
+		SubmittedBy string            `json:"submitted_by"`
+		Metadata    map[string]string `json:"metadata"`
+		SubmittedAt string            `json:"submitted_at"`
+	}
+
+	openDisputeInteractor struct {
+		repoDispute    entity.DisputeRepository
+		repoTransfer   entity.TransferRepositoryFinderByID
+		repoUserFinder entity.UserRepositoryFinder
+		repoUserHolder entity.UserRepositoryHolder
+		notifier       DisputeNotifier
+		pre            DisputePresenter
+	}
+)
+
+// NewOpenDisputeInteractor creates new openDisputeInteractor with its dependencies
+func NewOpenDisputeInteractor(
+	repoDispute entity.DisputeRepository,
+	repoTransfer entity.TransferRepositoryFinderByID,
+	repoUserFinder entity.UserRepositoryFinder,
+	repoUserHolder entity.UserRepositoryHolder,
+	notifier DisputeNotifier,
+	pre DisputePresenter,
+) OpenDisputeUseCase {
+	return openDisputeInteractor{
+		repoDispute:    repoDispute,
+		repoTransfer:   repoTransfer,
+		repoUserFinder: repoUserFinder,
+		repoUserHolder: repoUserHolder,
+		notifier:       notifier,
+		pre:            pre,
+	}
+}
+
+// Execute orchestrates the use case, the disputed value is provisionally held from the balance of the payee in
+// the same transaction that opens the dispute and both parties are notified. A payee whose available balance
+// does not cover the value is left with a negative available balance, the money it receives stays unavailable
+// until what is held is covered
+func (o openDisputeInteractor) Execute(ctx context.Context, i OpenDisputeInput) (DisputeOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var dispute entity.Dispute
+
+	err := o.repoDispute.WithTransaction(ctx, func(sessCtx context.Context) error {
+		transfer, err := o.repoTransfer.FindByID(sessCtx, i.TransferID)
+		if err != nil {
+			return err
+		}
+
+		dispute = entity.NewDispute(i.ID, transfer, i.Reason, i.CreatedAt)
+
+		payee, err := o.repoUserFinder.FindByID(sessCtx, dispute.Payee())
+		if err != nil {
+			return err
+		}
+
+		payee.HoldDebt(dispute.Value())
+
+		if err := o.repoUserHolder.UpdateHeld(sessCtx, dispute.Payee(), payee.Wallet().Held()); err != nil {
+			return err
+		}
+
+		dispute, err = o.repoDispute.Create(sessCtx, dispute)
+
+		return err
+	})
+	if err != nil {
+		return o.pre.Output(entity.Dispute{}), err
+	}
+
+	notifyDisputeParties(ctx, o.notifier, dispute, entity.DisputeOpenedNotice)
+
+	return o.pre.Output(dispute), nil
+}
+
+func notifyDisputeParties(ctx context.Context, notifier DisputeNotifier, dispute entity.Dispute, kind entity.DisputeNoticeKind) {
+	for _, notice := range dispute.Notices(kind) {
+		notifier.NotifyDispute(ctx, notice)
+	}
+}
@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type spyDisputeRepo struct {
+	disputes  map[vo.Uuid]entity.Dispute
+	updateErr error
+}
+
+func (s *spyDisputeRepo) Create(_ context.Context, d entity.Dispute) (entity.Dispute, error) {
+	for _, dispute := range s.disputes {
+		if dispute.Transfer() == d.Transfer() {
+			return entity.Dispute{}, entity.ErrTransferAlreadyDisputed
+		}
+	}
+
+	s.disputes[d.ID()] = d
+	return d, nil
+}
+
+func (s *spyDisputeRepo) FindByID(_ context.Context, ID vo.Uuid) (entity.Dispute, error) {
+	dispute, ok := s.disputes[ID]
+	if !ok {
+		return entity.Dispute{}, entity.ErrNotFoundDispute
+	}
+
+	return dispute, nil
+}
+
+func (s *spyDisputeRepo) Update(_ context.Context, _ entity.DisputeStatus, d entity.Dispute) error {
+	if s.updateErr != nil {
+		return s.updateErr
+	}
+
+	s.disputes[d.ID()] = d
+	return nil
+}
+
+func (s *spyDisputeRepo) AddEvidence(_ context.Context, d entity.Dispute, _ entity.Evidence) error {
+	return s.Update(context.Background(), d.Status(), d)
+}
+
+func (s *spyDisputeRepo) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+type stubTransferRepoFinderByID map[vo.Uuid]entity.Transfer
+
+func (s stubTransferRepoFinderByID) FindByID(_ context.Context, ID vo.Uuid) (entity.Transfer, error) {
+	transfer, ok := s[ID]
+	if !ok {
+		return entity.Transfer{}, entity.ErrNotFoundTransfer
+	}
+
+	return transfer, nil
+}
+
+type spyDisputeNotifier struct {
+	notices []entity.DisputeNotice
+}
+
+func (s *spyDisputeNotifier) NotifyDispute(_ context.Context, n entity.DisputeNotice) {
+	s.notices = append(s.notices, n)
+}
+
+type stubDisputePresenter struct{}
+
+func (s stubDisputePresenter) Output(d entity.Dispute) DisputeOutput {
+	return DisputeOutput{ID: d.ID().Value(), Status: string(d.Status()), Evidence: make([]DisputeEvidenceOutput, len(d.Evidence()))}
+}
+
+func TestOpenDisputeInteractor_Execute(t *testing.T) {
+	var (
+		createdAt = time.Date(2020, 11, 10, 0, 0, 0, 0, time.UTC)
+		transfer  = newTestTransfer(70)
+		disputeID = vo.NewUuidStaticTest()
+		unknown   = vo.NewUuidStaticTest()
+	)
+
+	tests := []struct {
+		name         string
+		transferID   vo.Uuid
+		payeeBalance int64
+		disputes     map[vo.Uuid]entity.Dispute
+		wantHeld     spyUserRepoHolder
+		wantErr      error
+	}{
+		{
+			name:         "Open dispute success",
+			transferID:   transfer.ID(),
+			payeeBalance: 100,
+			disputes:     map[vo.Uuid]entity.Dispute{},
+			wantHeld:     spyUserRepoHolder{vo.NewUuidStaticTest(): 70},
+		},
+		{
+			name:         "Open dispute above the available balance of the payee",
+			transferID:   transfer.ID(),
+			payeeBalance: 50,
+			disputes:     map[vo.Uuid]entity.Dispute{},
+			wantHeld:     spyUserRepoHolder{vo.NewUuidStaticTest(): 70},
+		},
+		{
+			name:         "Open dispute of an unknown transfer",
+			transferID:   unknown,
+			payeeBalance: 100,
+			disputes:     map[vo.Uuid]entity.Dispute{},
+			wantHeld:     spyUserRepoHolder{},
+			wantErr:      entity.ErrNotFoundTransfer,
+		},
+		{
+			name:         "Open dispute of a transfer already disputed",
+			transferID:   transfer.ID(),
+			payeeBalance: 100,
+			disputes: map[vo.Uuid]entity.Dispute{
+				disputeID: entity.NewDispute(disputeID, transfer, "duplicated charge", createdAt),
+			},
+			wantHeld: spyUserRepoHolder{vo.NewUuidStaticTest(): 70},
+			wantErr:  entity.ErrTransferAlreadyDisputed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				repo     = &spyDisputeRepo{disputes: tt.disputes}
+				held     = spyUserRepoHolder{}
+				notifier = &spyDisputeNotifier{}
+				ID, _    = vo.NewUuid("8e3a1c5b-2d4f-4a6e-9b7c-1f0d3e5a7c92")
+			)
+
+			uc := NewOpenDisputeInteractor(
+				repo,
+				stubTransferRepoFinderByID{transfer.ID(): transfer},
+				newTestUsers(
+					newCommonTestUser(payerTestID, 0),
+					newMerchantTestUser(vo.NewUuidStaticTest(), tt.payeeBalance),
+				),
+				held,
+				notifier,
+				stubDisputePresenter{},
+			)
+
+			got, err := uc.Execute(context.Background(), OpenDisputeInput{
+				ID:         ID,
+				TransferID: tt.transferID,
+				Reason:     "item not delivered",
+				CreatedAt:  createdAt,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if len(held) != len(tt.wantHeld) || held[vo.NewUuidStaticTest()] != tt.wantHeld[vo.NewUuidStaticTest()] {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, held, tt.wantHeld)
+			}
+
+			if err != nil {
+				if len(notifier.notices) != 0 {
+					t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, len(notifier.notices), 0)
+				}
+				return
+			}
+
+			if got.Status != string(entity.DisputeOpen) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Status, entity.DisputeOpen)
+			}
+
+			assertDisputeNotices(t, tt.name, notifier.notices, entity.DisputeOpenedNotice, entity.DisputeOpen)
+		})
+	}
+}
+
+// assertDisputeNotices checks that the payer and then the payee were notified of the change of the dispute
+func assertDisputeNotices(
+	t *testing.T,
+	name string,
+	notices []entity.DisputeNotice,
+	kind entity.DisputeNoticeKind,
+	status entity.DisputeStatus,
+) {
+	t.Helper()
+
+	var recipients = []vo.Uuid{payerTestID, vo.NewUuidStaticTest()}
+	if len(notices) != len(recipients) {
+		t.Errorf("[TestCase '%s'] Got: '%v notices' | Want: '%v notices'", name, len(notices), len(recipients))
+		return
+	}
+
+	for idx, notice := range notices {
+		if notice.Kind() != kind || notice.Status() != status || notice.Recipient() != recipients[idx] {
+			t.Errorf(
+				"[TestCase '%s'] Got: '%v, %v, %v' | Want: '%v, %v, %v'",
+				name,
+				notice.Kind(),
+				notice.Status(),
+				notice.Recipient().Value(),
+				kind,
+				status,
+				recipients[idx].Value(),
+			)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	ProcessTransferBatchUseCase interface {
+		Execute(context.Context, ProcessTransferBatchInput) (TransferBatchOutput, error)
+	}
+
+	// Input data
+	ProcessTransferBatchInput struct {
+		ID vo.Uuid
+	}
+
+	processTransferBatchInteractor struct {
+		repoFinder   entity.TransferBatchRepositoryFinder
+		repoUpdater  entity.TransferBatchRepositoryUpdater
+		repoTransfer entity.TransferRepositoryFinderByID
+		ucTransfer   CreateTransferUseCase
+		notifier     Notifier
+		pre          TransferBatchPresenter
+		concurrency  int
+	}
+)
+
+// NewProcessTransferBatchInteractor creates new processTransferBatchInteractor with its dependencies. The
+// transfer use case must not notify, the payees are notified by the interactor once their transfer is durable
+func NewProcessTransferBatchInteractor(
+	repoFinder entity.TransferBatchRepositoryFinder,
+	repoUpdater entity.TransferBatchRepositoryUpdater,
+	repoTransfer entity.TransferRepositoryFinderByID,
+	ucTransfer CreateTransferUseCase,
+	notifier Notifier,
+	pre TransferBatchPresenter,
+	concurrency int,
+) ProcessTransferBatchUseCase {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	return processTransferBatchInteractor{
+		repoFinder:   repoFinder,
+		repoUpdater:  repoUpdater,
+		repoTransfer: repoTransfer,
+		ucTransfer:   ucTransfer,
+		notifier:     notifier,
+		pre:          pre,
+		concurrency:  concurrency,
+	}
+}
+
+// Execute runs every item of a pending batch as an ordinary transfer. A best-effort batch runs the items
+// concurrently and keeps the successful ones, an all-or-nothing batch runs them in order in a single
+// transaction rolled back by the first failure
+func (p processTransferBatchInteractor) Execute(ctx context.Context, i ProcessTransferBatchInput) (TransferBatchOutput, error) {
+	batch, err := p.repoFinder.FindByID(ctx, i.ID)
+	if err != nil {
+		return p.pre.Output(entity.TransferBatch{}), err
+	}
+
+	batch, err = batch.Start(time.Now())
+	if err != nil {
+		return p.pre.Output(entity.TransferBatch{}), err
+	}
+
+	if err := p.repoUpdater.Start(ctx, batch); err != nil {
+		return p.pre.Output(entity.TransferBatch{}), err
+	}
+
+	batch, err = p.run(ctx, batch)
+	if err != nil {
+		return p.pre.Output(batch), err
+	}
+
+	return p.pre.Output(batch), nil
+}
+
+// run transfers the items of a processing batch and finishes it. A batch interrupted by the context is left
+// processing, to be resumed once its progress is stale
+func (p processTransferBatchInteractor) run(ctx context.Context, batch entity.TransferBatch) (entity.TransferBatch, error) {
+	if batch.Mode() == entity.BatchAllOrNothing {
+		return p.allOrNothing(ctx, batch)
+	}
+
+	items := p.bestEffort(ctx, batch)
+	if err := ctx.Err(); err != nil {
+		return batch, err
+	}
+
+	batch = batch.Finish(items, time.Now())
+	if err := p.repoUpdater.Finish(ctx, batch); err != nil {
+		return batch, err
+	}
+
+	return batch, nil
+}
+
+func (p processTransferBatchInteractor) bestEffort(ctx context.Context, batch entity.TransferBatch) []entity.TransferBatchItem {
+	var (
+		items     = make([]entity.TransferBatchItem, len(batch.Items()))
+		semaphore = make(chan struct{}, p.concurrency)
+		wg        sync.WaitGroup
+	)
+
+	for idx, item := range batch.Items() {
+		// The items processed before the batch was interrupted keep their status
+		if item.Status() != entity.BatchItemPending || ctx.Err() != nil {
+			items[idx] = item
+			continue
+		}
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func(idx int, item entity.TransferBatchItem) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			// A duplicate found by the transfer itself is rolled back by its own transaction
+			if err := p.execute(ctx, batch, item); err != nil && !errors.Is(err, entity.ErrDuplicateTransfer) {
+				items[idx] = item.Failed(err)
+			} else {
+				items[idx] = item.Succeeded()
+				p.notify(ctx, batch, item)
+			}
+
+			p.progress(ctx, batch, items[idx])
+		}(idx, item)
+	}
+
+	wg.Wait()
+
+	return items
+}
+
+// allOrNothing runs the items in a single transaction which also stores the finished batch, so a batch is never
+// left processing once its transfers are committed
+func (p processTransferBatchInteractor) allOrNothing(ctx context.Context, batch entity.TransferBatch) (entity.TransferBatch, error) {
+	var (
+		items    = make([]entity.TransferBatchItem, len(batch.Items()))
+		finished entity.TransferBatch
+	)
+
+	err := p.repoUpdater.WithTransaction(ctx, func(sessCtx context.Context) error {
+		// The transaction is run again on transient errors
+		copy(items, batch.Items())
+
+		for idx, item := range items {
+			if err := p.execute(sessCtx, batch, item); err != nil {
+				items[idx] = item.Failed(err)
+				return err
+			}
+
+			items[idx] = item.Succeeded()
+		}
+
+		finished = batch.Finish(items, time.Now())
+
+		return p.repoUpdater.Finish(sessCtx, finished)
+	})
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return batch, ctxErr
+		}
+
+		for idx, item := range items {
+			if item.Status() != entity.BatchItemFailed {
+				items[idx] = item.RolledBack()
+			}
+		}
+
+		batch = batch.Finish(items, time.Now())
+		if err := p.repoUpdater.Finish(ctx, batch); err != nil {
+			return batch, err
+		}
+
+		return batch, nil
+	}
+
+	for _, item := range items {
+		p.notify(ctx, batch, item)
+	}
+
+	return finished, nil
+}
+
+// execute transfers an item unless its transfer already exists because the batch was interrupted after executing
+// it. The transfer is looked up first since a duplicate found while creating it aborts an enclosing transaction
+func (p processTransferBatchInteractor) execute(ctx context.Context, batch entity.TransferBatch, item entity.TransferBatchItem) error {
+	_, err := p.repoTransfer.FindByID(ctx, item.ID())
+	switch {
+	case err == nil:
+		return nil
+	case !errors.Is(err, entity.ErrNotFoundTransfer):
+		return err
+	}
+
+	return p.transfer(ctx, batch, item)
+}
+
+func (p processTransferBatchInteractor) transfer(ctx context.Context, batch entity.TransferBatch, item entity.TransferBatchItem) error {
+	_, err := p.ucTransfer.Execute(ctx, CreateTransferInput{
+		ID:        item.ID(),
+		PayerID:   batch.Payer(),
+		PayeeID:   item.Payee(),
+		Value:     item.Value(),
+		CreatedAt: time.Now(),
+	})
+
+	return err
+}
+
+// progress stores the status of an item while the batch runs, a failure is only logged because
+// every item is stored again when the batch finishes
+func (p processTransferBatchInteractor) progress(ctx context.Context, batch entity.TransferBatch, item entity.TransferBatchItem) {
+	if err := p.repoUpdater.UpdateItem(ctx, batch.ID(), item); err != nil {
+		LoggerFromContext(ctx).Warnf("error storing the progress of transfer batch %s: %v", batch.ID().Value(), err)
+	}
+}
+
+func (p processTransferBatchInteractor) notify(ctx context.Context, batch entity.TransferBatch, item entity.TransferBatchItem) {
+	p.notifier.Notify(ctx, entity.NewTransfer(item.ID(), batch.Payer(), item.Payee(), item.Value(), time.Now()))
+}
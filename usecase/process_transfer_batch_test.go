@@ -0,0 +1,342 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+	"github.com/google/uuid"
+)
+
+type spyTransferBatchRepo struct {
+	result   entity.TransferBatch
+	findErr  error
+	startErr error
+	txErr    error
+
+	mu           sync.Mutex
+	updated      int
+	finished     entity.TransferBatch
+	tx           bool
+	finishedInTx bool
+}
+
+func (s *spyTransferBatchRepo) FindByID(_ context.Context, _ vo.Uuid) (entity.TransferBatch, error) {
+	return s.result, s.findErr
+}
+
+func (s *spyTransferBatchRepo) Start(_ context.Context, _ entity.TransferBatch) error {
+	return s.startErr
+}
+
+func (s *spyTransferBatchRepo) UpdateItem(_ context.Context, _ vo.Uuid, _ entity.TransferBatchItem) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.updated++
+
+	return nil
+}
+
+func (s *spyTransferBatchRepo) Finish(_ context.Context, b entity.TransferBatch) error {
+	s.finished = b
+	s.finishedInTx = s.tx
+	return nil
+}
+
+func (s *spyTransferBatchRepo) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	s.tx = true
+	defer func() { s.tx = false }()
+
+	if err := fn(ctx); err != nil {
+		return err
+	}
+
+	return s.txErr
+}
+
+type spyNotifier struct {
+	mu       sync.Mutex
+	notified int
+}
+
+func (s *spyNotifier) Notify(_ context.Context, _ entity.Transfer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.notified++
+}
+
+type spyConcurrentCreateTransferUseCase struct {
+	mu      sync.Mutex
+	running int
+	max     int
+}
+
+func (s *spyConcurrentCreateTransferUseCase) Execute(_ context.Context, i CreateTransferInput) (CreateTransferOutput, error) {
+	s.mu.Lock()
+	s.running++
+	if s.running > s.max {
+		s.max = s.running
+	}
+	s.mu.Unlock()
+
+	time.Sleep(5 * time.Millisecond)
+
+	s.mu.Lock()
+	s.running--
+	s.mu.Unlock()
+
+	return CreateTransferOutput{ID: i.ID.Value()}, nil
+}
+
+type stubTransferBatchPresenter struct{}
+
+func (s stubTransferBatchPresenter) Output(b entity.TransferBatch) TransferBatchOutput {
+	return TransferBatchOutput{
+		Status:         string(b.Status()),
+		TotalItems:     len(b.Items()),
+		SucceededItems: b.Succeeded(),
+		FailedItems:    b.Failed(),
+	}
+}
+
+func newTransferBatchTest(mode entity.BatchMode, size int) entity.TransferBatch {
+	var items []entity.TransferBatchItem
+	for i := 0; i < size; i++ {
+		ID, _ := vo.NewUuid(uuid.New().String())
+		items = append(items, entity.NewTransferBatchItem(ID, vo.NewUuidStaticTest(), vo.NewMoneyBRL(vo.NewAmountTest(100))))
+	}
+
+	batch, _ := entity.NewTransferBatch(vo.NewUuidStaticTest(), vo.NewUuidStaticTest(), mode, items, time.Time{})
+
+	return batch
+}
+
+// newTransferBatchTestTransfers returns the transfers of the items executed before the batch was interrupted
+func newTransferBatchTestTransfers(batch entity.TransferBatch, idxs ...int) stubTransferRepoFinderByID {
+	var transfers = stubTransferRepoFinderByID{}
+	for _, idx := range idxs {
+		item := batch.Items()[idx]
+		transfers[item.ID()] = entity.NewTransfer(item.ID(), batch.Payer(), item.Payee(), item.Value(), time.Time{})
+	}
+
+	return transfers
+}
+
+func TestProcessTransferBatchInteractor_Execute(t *testing.T) {
+	var (
+		bestEffort   = newTransferBatchTest(entity.BatchBestEffort, 3)
+		allOrNothing = newTransferBatchTest(entity.BatchAllOrNothing, 3)
+		started, _   = bestEffort.Start(time.Now())
+	)
+
+	tests := []struct {
+		name          string
+		repo          *spyTransferBatchRepo
+		transfers     stubTransferRepoFinderByID
+		transferErrs  map[string]error
+		want          TransferBatchOutput
+		wantStatuses  []entity.BatchItemStatus
+		wantTransfers int
+		wantNotified  int
+		wantFinishTx  bool
+		wantErr       error
+	}{
+		{
+			name:          "Best effort batch completed",
+			repo:          &spyTransferBatchRepo{result: bestEffort},
+			want:          TransferBatchOutput{Status: string(entity.BatchCompleted), TotalItems: 3, SucceededItems: 3},
+			wantStatuses:  []entity.BatchItemStatus{entity.BatchItemSucceeded, entity.BatchItemSucceeded, entity.BatchItemSucceeded},
+			wantTransfers: 3,
+			wantNotified:  3,
+		},
+		{
+			name: "Best effort batch keeps the successful items",
+			repo: &spyTransferBatchRepo{result: bestEffort},
+			transferErrs: map[string]error{
+				bestEffort.Items()[1].ID().Value(): entity.ErrUserInsufficientBalance,
+			},
+			want:          TransferBatchOutput{Status: string(entity.BatchPartiallyCompleted), TotalItems: 3, SucceededItems: 2, FailedItems: 1},
+			wantStatuses:  []entity.BatchItemStatus{entity.BatchItemSucceeded, entity.BatchItemFailed, entity.BatchItemSucceeded},
+			wantTransfers: 3,
+			wantNotified:  2,
+		},
+		{
+			name:          "Best effort item transferred before an interruption",
+			repo:          &spyTransferBatchRepo{result: bestEffort},
+			transfers:     newTransferBatchTestTransfers(bestEffort, 0),
+			want:          TransferBatchOutput{Status: string(entity.BatchCompleted), TotalItems: 3, SucceededItems: 3},
+			wantStatuses:  []entity.BatchItemStatus{entity.BatchItemSucceeded, entity.BatchItemSucceeded, entity.BatchItemSucceeded},
+			wantTransfers: 2,
+			wantNotified:  3,
+		},
+		{
+			name: "Best effort item transferred concurrently",
+			repo: &spyTransferBatchRepo{result: bestEffort},
+			transferErrs: map[string]error{
+				bestEffort.Items()[0].ID().Value(): entity.ErrDuplicateTransfer,
+			},
+			want:          TransferBatchOutput{Status: string(entity.BatchCompleted), TotalItems: 3, SucceededItems: 3},
+			wantStatuses:  []entity.BatchItemStatus{entity.BatchItemSucceeded, entity.BatchItemSucceeded, entity.BatchItemSucceeded},
+			wantTransfers: 3,
+			wantNotified:  3,
+		},
+		{
+			name:          "All or nothing batch completed",
+			repo:          &spyTransferBatchRepo{result: allOrNothing},
+			want:          TransferBatchOutput{Status: string(entity.BatchCompleted), TotalItems: 3, SucceededItems: 3},
+			wantStatuses:  []entity.BatchItemStatus{entity.BatchItemSucceeded, entity.BatchItemSucceeded, entity.BatchItemSucceeded},
+			wantTransfers: 3,
+			wantNotified:  3,
+			wantFinishTx:  true,
+		},
+		{
+			name:          "All or nothing item transferred before an interruption",
+			repo:          &spyTransferBatchRepo{result: allOrNothing},
+			transfers:     newTransferBatchTestTransfers(allOrNothing, 0),
+			want:          TransferBatchOutput{Status: string(entity.BatchCompleted), TotalItems: 3, SucceededItems: 3},
+			wantStatuses:  []entity.BatchItemStatus{entity.BatchItemSucceeded, entity.BatchItemSucceeded, entity.BatchItemSucceeded},
+			wantTransfers: 2,
+			wantNotified:  3,
+			wantFinishTx:  true,
+		},
+		{
+			name: "All or nothing batch rolled back by a duplicate transfer",
+			repo: &spyTransferBatchRepo{result: allOrNothing},
+			transferErrs: map[string]error{
+				allOrNothing.Items()[0].ID().Value(): entity.ErrDuplicateTransfer,
+			},
+			want:          TransferBatchOutput{Status: string(entity.BatchFailed), TotalItems: 3, FailedItems: 3},
+			wantStatuses:  []entity.BatchItemStatus{entity.BatchItemFailed, entity.BatchItemRolledBack, entity.BatchItemRolledBack},
+			wantTransfers: 1,
+		},
+		{
+			name: "All or nothing batch rolled back by a failed item",
+			repo: &spyTransferBatchRepo{result: allOrNothing},
+			transferErrs: map[string]error{
+				allOrNothing.Items()[1].ID().Value(): entity.ErrUserInsufficientBalance,
+			},
+			want:          TransferBatchOutput{Status: string(entity.BatchFailed), TotalItems: 3, FailedItems: 3},
+			wantStatuses:  []entity.BatchItemStatus{entity.BatchItemRolledBack, entity.BatchItemFailed, entity.BatchItemRolledBack},
+			wantTransfers: 2,
+		},
+		{
+			name:          "All or nothing batch rolled back by the commit",
+			repo:          &spyTransferBatchRepo{result: allOrNothing, txErr: errors.New("commit failed")},
+			want:          TransferBatchOutput{Status: string(entity.BatchFailed), TotalItems: 3, FailedItems: 3},
+			wantStatuses:  []entity.BatchItemStatus{entity.BatchItemRolledBack, entity.BatchItemRolledBack, entity.BatchItemRolledBack},
+			wantTransfers: 3,
+		},
+		{
+			name:    "Batch already processed",
+			repo:    &spyTransferBatchRepo{result: started},
+			want:    TransferBatchOutput{},
+			wantErr: entity.ErrTransferBatchNotPending,
+		},
+		{
+			name:    "Batch started by another process",
+			repo:    &spyTransferBatchRepo{result: bestEffort, startErr: entity.ErrTransferBatchNotPending},
+			want:    TransferBatchOutput{},
+			wantErr: entity.ErrTransferBatchNotPending,
+		},
+		{
+			name:    "Batch not found",
+			repo:    &spyTransferBatchRepo{findErr: entity.ErrNotFoundTransferBatch},
+			want:    TransferBatchOutput{},
+			wantErr: entity.ErrNotFoundTransferBatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				notifier   = &spyNotifier{}
+				ucTransfer = &spyCountingCreateTransferUseCase{errs: tt.transferErrs}
+			)
+			uc := NewProcessTransferBatchInteractor(
+				tt.repo,
+				tt.repo,
+				tt.transfers,
+				ucTransfer,
+				notifier,
+				stubTransferBatchPresenter{},
+				2,
+			)
+
+			got, err := uc.Execute(context.Background(), ProcessTransferBatchInput{ID: vo.NewUuidStaticTest()})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+
+			for idx, status := range tt.wantStatuses {
+				if got := tt.repo.finished.Items()[idx].Status(); got != status {
+					t.Errorf("[TestCase '%s'] Item %d Got: '%v' | Want: '%v'", tt.name, idx, got, status)
+				}
+			}
+
+			if ucTransfer.executed != tt.wantTransfers {
+				t.Errorf("[TestCase '%s'] Transfers Got: '%v' | Want: '%v'", tt.name, ucTransfer.executed, tt.wantTransfers)
+			}
+
+			if notifier.notified != tt.wantNotified {
+				t.Errorf("[TestCase '%s'] Notified Got: '%v' | Want: '%v'", tt.name, notifier.notified, tt.wantNotified)
+			}
+
+			if tt.repo.finishedInTx != tt.wantFinishTx {
+				t.Errorf("[TestCase '%s'] Finished in transaction Got: '%v' | Want: '%v'", tt.name, tt.repo.finishedInTx, tt.wantFinishTx)
+			}
+		})
+	}
+}
+
+func TestProcessTransferBatchInteractor_Execute_Concurrency(t *testing.T) {
+	var (
+		repo       = &spyTransferBatchRepo{result: newTransferBatchTest(entity.BatchBestEffort, 20)}
+		ucTransfer = &spyConcurrentCreateTransferUseCase{}
+	)
+
+	uc := NewProcessTransferBatchInteractor(repo, repo, stubTransferRepoFinderByID{}, ucTransfer, &spyNotifier{}, stubTransferBatchPresenter{}, 4)
+
+	got, err := uc.Execute(context.Background(), ProcessTransferBatchInput{ID: vo.NewUuidStaticTest()})
+	if err != nil {
+		t.Fatalf("[TestCase '%s'] Err: '%v'", "Bounded concurrency", err)
+	}
+
+	if got.SucceededItems != 20 {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", "Bounded concurrency", got.SucceededItems, 20)
+	}
+
+	if ucTransfer.max > 4 {
+		t.Errorf("[TestCase '%s'] Got: '%v' concurrent transfers | Want at most: '%v'", "Bounded concurrency", ucTransfer.max, 4)
+	}
+
+	if repo.updated != 20 {
+		t.Errorf("[TestCase '%s'] Got: '%v' progress updates | Want: '%v'", "Bounded concurrency", repo.updated, 20)
+	}
+}
+
+func TestProcessTransferBatchInteractor_Execute_Interrupted(t *testing.T) {
+	var repo = &spyTransferBatchRepo{result: newTransferBatchTest(entity.BatchBestEffort, 3)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	uc := NewProcessTransferBatchInteractor(repo, repo, stubTransferRepoFinderByID{}, stubCreateTransferUseCase{}, &spyNotifier{}, stubTransferBatchPresenter{}, 2)
+
+	_, err := uc.Execute(ctx, ProcessTransferBatchInput{ID: vo.NewUuidStaticTest()})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", "Interrupted batch", err, context.Canceled)
+	}
+
+	if status := repo.finished.Status(); status != "" {
+		t.Errorf("[TestCase '%s'] Got: '%v' | Want the batch left processing", "Interrupted batch", status)
+	}
+}
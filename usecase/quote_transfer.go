@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	QuoteTransferUseCase interface {
+		Execute(context.Context, QuoteTransferInput) (QuoteTransferOutput, error)
+	}
+
+	// Input data
+	QuoteTransferInput struct {
+		PayerID vo.Uuid
+		PayeeID vo.Uuid
+		Value   vo.Money
+	}
+
+	// Output port
+	QuoteTransferPresenter interface {
+		Output(entity.Transfer) QuoteTransferOutput
+	}
+
+	// Output data
+	QuoteTransferOutput struct {
+		PayerID string            `json:"payer"`
+		PayeeID string            `json:"payee"`
+		Value   int64             `json:"value"`
+		Fee     TransferFeeOutput `json:"fee"`
+	}
+
+	quoteTransferInteractor struct {
+		repoUserFinder entity.UserRepositoryFinder
+		fees           entity.FeeSchedule
+		pre            QuoteTransferPresenter
+	}
+)
+
+// NewQuoteTransferInteractor creates new quoteTransferInteractor with its dependencies
+func NewQuoteTransferInteractor(
+	repoUserFinder entity.UserRepositoryFinder,
+	fees entity.FeeSchedule,
+	pre QuoteTransferPresenter,
+) QuoteTransferUseCase {
+	return quoteTransferInteractor{
+		repoUserFinder: repoUserFinder,
+		fees:           fees,
+		pre:            pre,
+	}
+}
+
+// Execute returns the fees a transfer would be charged, without moving any money
+func (q quoteTransferInteractor) Execute(ctx context.Context, i QuoteTransferInput) (QuoteTransferOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	payer, err := q.repoUserFinder.FindByID(ctx, i.PayerID)
+	if err != nil {
+		return q.pre.Output(entity.Transfer{}), err
+	}
+
+	payee, err := q.repoUserFinder.FindByID(ctx, i.PayeeID)
+	if err != nil {
+		return q.pre.Output(entity.Transfer{}), err
+	}
+
+	fee, err := q.fees.Quote(payer.TypeUser(), payee.TypeUser(), i.Value)
+	if err != nil {
+		return q.pre.Output(entity.Transfer{}), err
+	}
+
+	return q.pre.Output(entity.NewTransfer(vo.Uuid{}, i.PayerID, i.PayeeID, i.Value, time.Time{}).WithFee(fee)), nil
+}
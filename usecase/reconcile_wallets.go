@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	ReconcileWalletsUseCase interface {
+		Execute(context.Context, ReconcileWalletsInput) (ReconcileWalletsOutput, error)
+	}
+
+	// Input data
+	ReconcileWalletsInput struct {
+		Incremental bool
+		RunAt       time.Time
+	}
+
+	// Output port
+	ReconcileWalletsPresenter interface {
+		Output(ReconcileWalletsResult) ReconcileWalletsOutput
+	}
+
+	// ReconcileWalletsResult defines the result of a reconciliation run
+	ReconcileWalletsResult struct {
+		From            time.Time
+		RunAt           time.Time
+		Incremental     bool
+		Reconciliations []entity.Reconciliation
+	}
+
+	// Output data
+	ReconcileWalletsOutput struct {
+		From        string                       `json:"from"`
+		RunAt       string                       `json:"run_at"`
+		Incremental bool                         `json:"incremental"`
+		Checked     int                          `json:"checked"`
+		Mismatches  []ReconcileWalletsItemOutput `json:"mismatches"`
+	}
+
+	// Output data
+	ReconcileWalletsItemOutput struct {
+		UserID     string `json:"user_id"`
+		Currency   string `json:"currency"`
+		Expected   int64  `json:"expected"`
+		Actual     int64  `json:"actual"`
+		Difference int64  `json:"difference"`
+		Status     string `json:"status"`
+	}
+
+	reconcileWalletsInteractor struct {
+		repoWalletLister       entity.WalletRepositoryLister
+		repoTransferSummarizer entity.TransferRepositorySummarizer
+		repoMovementSummarizer entity.MovementRepositorySummarizer
+		repoCheckpoint         entity.ReconciliationCheckpointRepository
+		pre                    ReconcileWalletsPresenter
+	}
+)
+
+// NewReconcileWalletsInteractor creates new reconcileWalletsInteractor with its dependencies
+func NewReconcileWalletsInteractor(
+	repoWalletLister entity.WalletRepositoryLister,
+	repoTransferSummarizer entity.TransferRepositorySummarizer,
+	repoMovementSummarizer entity.MovementRepositorySummarizer,
+	repoCheckpoint entity.ReconciliationCheckpointRepository,
+	pre ReconcileWalletsPresenter,
+) ReconcileWalletsUseCase {
+	return reconcileWalletsInteractor{
+		repoWalletLister:       repoWalletLister,
+		repoTransferSummarizer: repoTransferSummarizer,
+		repoMovementSummarizer: repoMovementSummarizer,
+		repoCheckpoint:         repoCheckpoint,
+		pre:                    pre,
+	}
+}
+
+// Execute orchestrates the use case, the expected balance of a wallet is its baseline plus its transfers, deposits
+// and withdrawals. An incremental run starts from the expected balances of the latest checkpoint
+// and falls back to a full run from the initial amounts when there is no checkpoint
+func (r reconcileWalletsInteractor) Execute(ctx context.Context, i ReconcileWalletsInput) (ReconcileWalletsOutput, error) {
+	var (
+		checkpoint  entity.ReconciliationCheckpoint
+		incremental bool
+	)
+
+	if i.Incremental {
+		latest, err := r.repoCheckpoint.FindLatest(ctx)
+		switch err {
+		case nil:
+			checkpoint = latest
+			incremental = true
+		case entity.ErrNotFoundReconciliationCheckpoint:
+		default:
+			return r.pre.Output(ReconcileWalletsResult{}), err
+		}
+	}
+
+	wallets, err := r.repoWalletLister.FindAll(ctx)
+	if err != nil {
+		return r.pre.Output(ReconcileWalletsResult{}), err
+	}
+
+	totals, err := r.repoTransferSummarizer.SumByUser(ctx, checkpoint.At(), i.RunAt)
+	if err != nil {
+		return r.pre.Output(ReconcileWalletsResult{}), err
+	}
+
+	movements, err := r.repoMovementSummarizer.SumByUser(ctx, checkpoint.At(), i.RunAt)
+	if err != nil {
+		return r.pre.Output(ReconcileWalletsResult{}), err
+	}
+
+	if totals == nil {
+		totals = make(map[vo.Uuid]entity.MovementTotals)
+	}
+
+	for ID, movement := range movements {
+		totals[ID] = totals[ID].Add(movement)
+	}
+
+	var (
+		reconciliations []entity.Reconciliation
+		expected        = make(map[vo.Uuid]int64)
+	)
+	for _, wallet := range wallets {
+		baseline, hasBaseline := r.baseline(wallet, checkpoint, incremental)
+
+		reconciliation := entity.ReconcileWallet(wallet, baseline, hasBaseline, totals[wallet.UserID()])
+		if reconciliation.Status() != entity.ReconciliationMissingBaseline {
+			expected[wallet.UserID()] = reconciliation.Expected()
+		}
+
+		reconciliations = append(reconciliations, reconciliation)
+	}
+
+	if err := r.repoCheckpoint.Save(ctx, entity.NewReconciliationCheckpoint(i.RunAt, expected)); err != nil {
+		return r.pre.Output(ReconcileWalletsResult{}), err
+	}
+
+	return r.pre.Output(ReconcileWalletsResult{
+		From:            checkpoint.At(),
+		RunAt:           i.RunAt,
+		Incremental:     incremental,
+		Reconciliations: reconciliations,
+	}), nil
+}
+
+// baseline returns the expected balance of the wallet at the start of the run
+func (r reconcileWalletsInteractor) baseline(
+	wallet entity.WalletBalance,
+	checkpoint entity.ReconciliationCheckpoint,
+	incremental bool,
+) (int64, bool) {
+	if incremental {
+		if balance, ok := checkpoint.Balances()[wallet.UserID()]; ok {
+			return balance, true
+		}
+	}
+
+	// Wallets missing from the checkpoint were created after it, so all of their movements are in the run
+	initial, ok := wallet.Initial()
+	if !ok {
+		return 0, false
+	}
+
+	return initial.Amount().Value(), true
+}
@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type stubWalletRepoLister struct {
+	result []entity.WalletBalance
+	err    error
+}
+
+func (s stubWalletRepoLister) FindAll(_ context.Context) ([]entity.WalletBalance, error) {
+	return s.result, s.err
+}
+
+type spyTransferRepoSummarizer struct {
+	result map[vo.Uuid]entity.MovementTotals
+	err    error
+	from   time.Time
+}
+
+func (s *spyTransferRepoSummarizer) SumByUser(_ context.Context, from time.Time, _ time.Time) (map[vo.Uuid]entity.MovementTotals, error) {
+	s.from = from
+	return s.result, s.err
+}
+
+type stubMovementRepoSummarizer struct {
+	result map[vo.Uuid]entity.MovementTotals
+	err    error
+}
+
+func (s stubMovementRepoSummarizer) SumByUser(_ context.Context, _ time.Time, _ time.Time) (map[vo.Uuid]entity.MovementTotals, error) {
+	return s.result, s.err
+}
+
+type spyReconciliationCheckpointRepo struct {
+	latest    entity.ReconciliationCheckpoint
+	latestErr error
+	saveErr   error
+	saved     entity.ReconciliationCheckpoint
+}
+
+func (s *spyReconciliationCheckpointRepo) FindLatest(_ context.Context) (entity.ReconciliationCheckpoint, error) {
+	return s.latest, s.latestErr
+}
+
+func (s *spyReconciliationCheckpointRepo) Save(_ context.Context, c entity.ReconciliationCheckpoint) error {
+	s.saved = c
+	return s.saveErr
+}
+
+type spyReconcileWalletsPresenter struct {
+	result ReconcileWalletsResult
+}
+
+func (s *spyReconcileWalletsPresenter) Output(r ReconcileWalletsResult) ReconcileWalletsOutput {
+	s.result = r
+	return ReconcileWalletsOutput{Checked: len(r.Reconciliations)}
+}
+
+func TestReconcileWalletsInteractor_Execute(t *testing.T) {
+	var (
+		userID, _    = vo.NewUuid("0db298eb-c8e7-4829-84b7-c1036b4f0791")
+		otherID, _   = vo.NewUuid("0db298eb-c8e7-4829-84b7-c1036b4f0792")
+		legacyID, _  = vo.NewUuid("0db298eb-c8e7-4829-84b7-c1036b4f0793")
+		initial      = vo.NewMoneyBRL(vo.NewAmountTest(100))
+		checkpointAt = time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC)
+		runAt        = time.Date(2020, 11, 2, 0, 0, 0, 0, time.UTC)
+		wallets      = []entity.WalletBalance{
+			entity.NewWalletBalance(userID, vo.NewMoneyBRL(vo.NewAmountTest(70)), &initial),
+			entity.NewWalletBalance(otherID, vo.NewMoneyBRL(vo.NewAmountTest(200)), &initial),
+			entity.NewWalletBalance(legacyID, vo.NewMoneyBRL(vo.NewAmountTest(10)), nil),
+		}
+		totals = map[vo.Uuid]entity.MovementTotals{
+			userID:  {Sent: 30},
+			otherID: {Received: 30},
+		}
+		movements = map[vo.Uuid]entity.MovementTotals{
+			userID:  {Received: 20, Sent: 20},
+			otherID: {Received: 50, Sent: 50},
+		}
+	)
+
+	tests := []struct {
+		name           string
+		input          ReconcileWalletsInput
+		latest         entity.ReconciliationCheckpoint
+		latestErr      error
+		walletErr      error
+		wantFrom       time.Time
+		wantStatuses   []entity.ReconciliationStatus
+		wantCheckpoint map[vo.Uuid]int64
+		wantErr        error
+	}{
+		{
+			name:  "Full reconciliation from the initial amounts",
+			input: ReconcileWalletsInput{RunAt: runAt},
+			wantStatuses: []entity.ReconciliationStatus{
+				entity.ReconciliationMatched,
+				entity.ReconciliationMismatched,
+				entity.ReconciliationMissingBaseline,
+			},
+			wantCheckpoint: map[vo.Uuid]int64{userID: 70, otherID: 130},
+		},
+		{
+			name:  "Incremental reconciliation from the checkpoint",
+			input: ReconcileWalletsInput{Incremental: true, RunAt: runAt},
+			latest: entity.NewReconciliationCheckpoint(checkpointAt, map[vo.Uuid]int64{
+				userID:   100,
+				otherID:  170,
+				legacyID: 10,
+			}),
+			wantFrom: checkpointAt,
+			wantStatuses: []entity.ReconciliationStatus{
+				entity.ReconciliationMatched,
+				entity.ReconciliationMatched,
+				entity.ReconciliationMatched,
+			},
+			wantCheckpoint: map[vo.Uuid]int64{userID: 70, otherID: 200, legacyID: 10},
+		},
+		{
+			name:      "Incremental reconciliation without checkpoint",
+			input:     ReconcileWalletsInput{Incremental: true, RunAt: runAt},
+			latestErr: entity.ErrNotFoundReconciliationCheckpoint,
+			wantStatuses: []entity.ReconciliationStatus{
+				entity.ReconciliationMatched,
+				entity.ReconciliationMismatched,
+				entity.ReconciliationMissingBaseline,
+			},
+			wantCheckpoint: map[vo.Uuid]int64{userID: 70, otherID: 130},
+		},
+		{
+			name:      "Reconciliation wallets error",
+			input:     ReconcileWalletsInput{RunAt: runAt},
+			walletErr: entity.ErrFindWallets,
+			wantErr:   entity.ErrFindWallets,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				summarizer = &spyTransferRepoSummarizer{result: totals}
+				checkpoint = &spyReconciliationCheckpointRepo{latest: tt.latest, latestErr: tt.latestErr}
+				pre        = &spyReconcileWalletsPresenter{}
+			)
+
+			uc := NewReconcileWalletsInteractor(
+				stubWalletRepoLister{result: wallets, err: tt.walletErr},
+				summarizer,
+				stubMovementRepoSummarizer{result: movements},
+				checkpoint,
+				pre,
+			)
+
+			_, err := uc.Execute(context.TODO(), tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if !summarizer.from.Equal(tt.wantFrom) {
+				t.Errorf("[TestCase '%s'] Got from: '%v' | Want: '%v'", tt.name, summarizer.from, tt.wantFrom)
+			}
+
+			var statuses []entity.ReconciliationStatus
+			for _, reconciliation := range pre.result.Reconciliations {
+				statuses = append(statuses, reconciliation.Status())
+			}
+
+			if !reflect.DeepEqual(statuses, tt.wantStatuses) {
+				t.Errorf("[TestCase '%s'] Got statuses: '%v' | Want: '%v'", tt.name, statuses, tt.wantStatuses)
+			}
+
+			if !reflect.DeepEqual(checkpoint.saved.Balances(), tt.wantCheckpoint) {
+				t.Errorf("[TestCase '%s'] Got checkpoint: '%v' | Want: '%v'", tt.name, checkpoint.saved.Balances(), tt.wantCheckpoint)
+			}
+
+			if !checkpoint.saved.At().Equal(runAt) {
+				t.Errorf("[TestCase '%s'] Got checkpoint at: '%v' | Want: '%v'", tt.name, checkpoint.saved.At(), runAt)
+			}
+		})
+	}
+}
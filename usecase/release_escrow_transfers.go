@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+)
+
+type (
+	// Input port
+	ReleaseEscrowTransfersUseCase interface {
+		Execute(context.Context, ReleaseEscrowTransfersInput) (ReleaseEscrowTransfersOutput, error)
+	}
+
+	// Input data
+	ReleaseEscrowTransfersInput struct {
+		Now   time.Time
+		Limit int
+	}
+
+	// Output port
+	ReleaseEscrowTransfersPresenter interface {
+		Output([]entity.EscrowTransfer) ReleaseEscrowTransfersOutput
+	}
+
+	// Output data
+	ReleaseEscrowTransfersOutput struct {
+		Released int      `json:"released"`
+		IDs      []string `json:"ids"`
+	}
+
+	releaseEscrowTransfersInteractor struct {
+		repoEscrow          entity.EscrowTransferRepository
+		repoTransferCreator entity.TransferRepositoryCreator
+		repoUserUpdater     entity.UserRepositoryUpdater
+		repoUserFinder      entity.UserRepositoryFinder
+		notifier            Notifier
+		pre                 ReleaseEscrowTransfersPresenter
+	}
+)
+
+// NewReleaseEscrowTransfersInteractor creates new releaseEscrowTransfersInteractor with its dependencies
+func NewReleaseEscrowTransfersInteractor(
+	repoEscrow entity.EscrowTransferRepository,
+	repoTransferCreator entity.TransferRepositoryCreator,
+	repoUserUpdater entity.UserRepositoryUpdater,
+	repoUserFinder entity.UserRepositoryFinder,
+	notifier Notifier,
+	pre ReleaseEscrowTransfersPresenter,
+) ReleaseEscrowTransfersUseCase {
+	return releaseEscrowTransfersInteractor{
+		repoEscrow:          repoEscrow,
+		repoTransferCreator: repoTransferCreator,
+		repoUserUpdater:     repoUserUpdater,
+		repoUserFinder:      repoUserFinder,
+		notifier:            notifier,
+		pre:                 pre,
+	}
+}
+
+// Execute releases to the payees the escrow transfers past their release date, each one in its own transaction.
+// An escrow transfer confirmed or disputed while being released is skipped, one that failed to be released, such
+// as for a payee at its wallet balance limit, is left in escrow to be released by a later poll and the first
+// failure is returned once the other escrow transfers are released
+func (r releaseEscrowTransfersInteractor) Execute(ctx context.Context, i ReleaseEscrowTransfersInput) (ReleaseEscrowTransfersOutput, error) {
+	due, err := r.repoEscrow.FindDue(ctx, i.Now, i.Limit)
+	if err != nil {
+		return r.pre.Output(nil), err
+	}
+
+	var (
+		released []entity.EscrowTransfer
+		firstErr error
+	)
+	for _, escrow := range due {
+		escrow, err := escrow.Timeout(i.Now)
+		if err != nil {
+			continue
+		}
+
+		err = r.repoEscrow.WithTransaction(ctx, func(sessCtx context.Context) error {
+			return settleEscrowTransfer(
+				sessCtx,
+				r.repoEscrow,
+				r.repoTransferCreator,
+				r.repoUserFinder,
+				r.repoUserUpdater,
+				entity.EscrowHeld,
+				escrow,
+			)
+		})
+		if errors.Is(err, entity.ErrEscrowTransferNotInEscrow) {
+			continue
+		}
+		if err != nil {
+			LoggerFromContext(ctx).Errorf("error releasing escrow transfer %s: %v", escrow.ID().Value(), err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		r.notifier.Notify(ctx, escrow.Settlement())
+		released = append(released, escrow)
+	}
+
+	return r.pre.Output(released), firstErr
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type stubReleaseEscrowTransfersPresenter struct{}
+
+func (s stubReleaseEscrowTransfersPresenter) Output(escrows []entity.EscrowTransfer) ReleaseEscrowTransfersOutput {
+	return ReleaseEscrowTransfersOutput{Released: len(escrows)}
+}
+
+func TestReleaseEscrowTransfersInteractor_Execute(t *testing.T) {
+	var (
+		createdAt = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+		releaseAt = createdAt.Add(time.Hour)
+		escrow    = newEscrowTestTransfer(t, createdAt)
+		newID     = func(ID string) vo.Uuid {
+			uuid, _ := vo.NewUuid(ID)
+			return uuid
+		}
+		otherPayee = newID("7a1f5e0c-2c6b-4f7a-9d3e-0c2b9a4c8e11")
+		other, _   = entity.NewEscrowTransfer(
+			newID("2e7b4c9d-5f1a-4b3c-8d6e-9a0b1c2d3e4f"),
+			payerTestID,
+			otherPayee,
+			escrowTestAccountID,
+			vo.NewMoneyBRL(vo.NewAmountTest(70)),
+			releaseAt,
+			createdAt,
+		)
+	)
+
+	tests := []struct {
+		name         string
+		now          time.Time
+		due          []entity.EscrowTransfer
+		payeeBalance int64
+		updateErr    error
+		wantReleased int
+		wantLogged   int
+		wantErr      bool
+	}{
+		{
+			name:         "Release escrow transfer past the release date",
+			now:          releaseAt,
+			wantReleased: 1,
+		},
+		{
+			name:         "Skip escrow transfer before the release date",
+			now:          createdAt,
+			wantReleased: 0,
+		},
+		{
+			name:         "Skip escrow transfer disputed while being released",
+			now:          releaseAt,
+			updateErr:    entity.ErrEscrowTransferNotInEscrow,
+			wantReleased: 0,
+		},
+		{
+			name:         "Release the other escrow transfers after a payee at its wallet balance limit",
+			now:          releaseAt,
+			due:          []entity.EscrowTransfer{escrow, other},
+			payeeBalance: entity.KYCBasic.Limits().WalletBalance() - 50,
+			wantReleased: 1,
+			wantLogged:   1,
+			wantErr:      true,
+		},
+		{
+			name:         "Leave escrow transfer in escrow on update error",
+			now:          releaseAt,
+			updateErr:    entity.ErrUpdateEscrowTransfer,
+			wantReleased: 0,
+			wantLogged:   1,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var repo = &spyEscrowTransferRepo{
+				escrows:   map[vo.Uuid]entity.EscrowTransfer{},
+				due:       []entity.EscrowTransfer{escrow},
+				updateErr: tt.updateErr,
+			}
+			if tt.due != nil {
+				repo.due = tt.due
+			}
+
+			uc := NewReleaseEscrowTransfersInteractor(
+				repo,
+				&spyTransferRepoCreator{},
+				spyWalletsUpdater{},
+				newTestUsers(
+					newCommonTestUser(payerTestID, 0),
+					newMerchantTestUser(vo.NewUuidStaticTest(), tt.payeeBalance),
+					newMerchantTestUser(otherPayee, 0),
+					newCommonTestUser(escrowTestAccountID, 140),
+				),
+				stubNotifier{},
+				stubReleaseEscrowTransfersPresenter{},
+			)
+
+			var log = spyLogger{}
+			got, err := uc.Execute(ContextWithLogger(context.Background(), log), ReleaseEscrowTransfersInput{Now: tt.now, Limit: 10})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if got.Released != tt.wantReleased {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Released, tt.wantReleased)
+			}
+
+			if len(log["error"]) != tt.wantLogged {
+				t.Errorf("[TestCase '%s'] Logged Got: '%v' | Want: '%v'", tt.name, log["error"], tt.wantLogged)
+			}
+		})
+	}
+}
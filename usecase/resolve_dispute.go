@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	ResolveDisputeUseCase interface {
+		Execute(context.Context, ResolveDisputeInput) (DisputeOutput, error)
+	}
+
+	// Input data
+	ResolveDisputeInput struct {
+		ID         vo.Uuid
+		Outcome    entity.DisputeStatus
+		ResolvedAt time.Time
+	}
+
+	resolveDisputeInteractor struct {
+		repoDispute         entity.DisputeRepository
+		repoTransferCreator entity.TransferRepositoryCreator
+		repoUserFinder      entity.UserRepositoryFinder
+		repoUserUpdater     entity.UserRepositoryUpdater
+		repoUserHolder      entity.UserRepositoryHolder
+		notifier            DisputeNotifier
+		pre                 DisputePresenter
+	}
+)
+
+// NewResolveDisputeInteractor creates new resolveDisputeInteractor with its dependencies
+func NewResolveDisputeInteractor(
+	repoDispute entity.DisputeRepository,
+	repoTransferCreator entity.TransferRepositoryCreator,
+	repoUserFinder entity.UserRepositoryFinder,
+	repoUserUpdater entity.UserRepositoryUpdater,
+	repoUserHolder entity.UserRepositoryHolder,
+	notifier DisputeNotifier,
+	pre DisputePresenter,
+) ResolveDisputeUseCase {
+	return resolveDisputeInteractor{
+		repoDispute:         repoDispute,
+		repoTransferCreator: repoTransferCreator,
+		repoUserFinder:      repoUserFinder,
+		repoUserUpdater:     repoUserUpdater,
+		repoUserHolder:      repoUserHolder,
+		notifier:            notifier,
+		pre:                 pre,
+	}
+}
+
+// Execute orchestrates the use case, the value taken from the available balance of the payee is given back
+// and, when the payee loses, charged back to the payer in the same transaction. A dispute is not lost while the
+// balance of the payee does not cover the chargeback. Both parties are notified of the outcome
+func (r resolveDisputeInteractor) Execute(ctx context.Context, i ResolveDisputeInput) (DisputeOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var dispute entity.Dispute
+
+	err := r.repoDispute.WithTransaction(ctx, func(sessCtx context.Context) error {
+		found, err := r.repoDispute.FindByID(sessCtx, i.ID)
+		if err != nil {
+			return err
+		}
+
+		dispute, err = found.Resolve(i.Outcome, i.ResolvedAt)
+		if err != nil {
+			return err
+		}
+
+		if err := r.repoDispute.Update(sessCtx, found.Status(), dispute); err != nil {
+			return err
+		}
+
+		payee, err := r.repoUserFinder.FindByID(sessCtx, dispute.Payee())
+		if err != nil {
+			return err
+		}
+
+		payee.Release(dispute.Value())
+
+		if err := r.repoUserHolder.UpdateHeld(sessCtx, dispute.Payee(), payee.Wallet().Held()); err != nil {
+			return err
+		}
+
+		if dispute.Status() != entity.DisputeLost {
+			return nil
+		}
+
+		var chargeback = dispute.Chargeback()
+		if err := moveFunds(sessCtx, r.repoUserFinder, r.repoUserUpdater, chargeback); err != nil {
+			return err
+		}
+
+		_, err = r.repoTransferCreator.Create(sessCtx, chargeback)
+
+		return err
+	})
+	if err != nil {
+		return r.pre.Output(entity.Dispute{}), err
+	}
+
+	notifyDisputeParties(ctx, r.notifier, dispute, entity.DisputeResolvedNotice)
+
+	return r.pre.Output(dispute), nil
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func TestResolveDisputeInteractor_Execute(t *testing.T) {
+	var (
+		createdAt   = time.Date(2020, 11, 10, 0, 0, 0, 0, time.UTC)
+		dispute     = entity.NewDispute(vo.NewUuidStaticTest(), newTestTransfer(70), "item not delivered", createdAt)
+		reviewed, _ = dispute.Review(createdAt)
+		won, _      = dispute.Resolve(entity.DisputeWon, createdAt)
+		payerID     = dispute.Payer()
+		payeeID     = dispute.Payee()
+	)
+
+	tests := []struct {
+		name        string
+		dispute     entity.Dispute
+		outcome     entity.DisputeStatus
+		balance     int64
+		updateErr   error
+		wantHeld    spyUserRepoHolder
+		wantWallets spyWalletsUpdater
+		wantErr     error
+	}{
+		{
+			name:        "Resolve dispute won by the payee",
+			dispute:     dispute,
+			balance:     100,
+			outcome:     entity.DisputeWon,
+			wantHeld:    spyUserRepoHolder{payeeID: 0},
+			wantWallets: spyWalletsUpdater{},
+		},
+		{
+			name:        "Resolve dispute under review lost by the payee",
+			dispute:     reviewed,
+			balance:     100,
+			outcome:     entity.DisputeLost,
+			wantHeld:    spyUserRepoHolder{payeeID: 0},
+			wantWallets: spyWalletsUpdater{payerID: 70, payeeID: 30},
+		},
+		{
+			name:        "Resolve dispute lost by a payee whose balance does not cover the chargeback",
+			dispute:     reviewed,
+			balance:     50,
+			outcome:     entity.DisputeLost,
+			wantHeld:    spyUserRepoHolder{payeeID: 0},
+			wantWallets: spyWalletsUpdater{},
+			wantErr:     entity.ErrUserInsufficientBalance,
+		},
+		{
+			name:        "Resolve dispute with invalid outcome",
+			dispute:     dispute,
+			balance:     100,
+			outcome:     entity.DisputeUnderReview,
+			wantHeld:    spyUserRepoHolder{},
+			wantWallets: spyWalletsUpdater{},
+			wantErr:     entity.ErrInvalidDisputeOutcome,
+		},
+		{
+			name:        "Resolve dispute already resolved",
+			dispute:     won,
+			balance:     100,
+			outcome:     entity.DisputeLost,
+			wantHeld:    spyUserRepoHolder{},
+			wantWallets: spyWalletsUpdater{},
+			wantErr:     entity.ErrDisputeResolved,
+		},
+		{
+			name:        "Resolve dispute resolved meanwhile",
+			dispute:     dispute,
+			balance:     100,
+			outcome:     entity.DisputeLost,
+			updateErr:   entity.ErrDisputeChanged,
+			wantHeld:    spyUserRepoHolder{},
+			wantWallets: spyWalletsUpdater{},
+			wantErr:     entity.ErrDisputeChanged,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				repo = &spyDisputeRepo{
+					disputes:  map[vo.Uuid]entity.Dispute{tt.dispute.ID(): tt.dispute},
+					updateErr: tt.updateErr,
+				}
+				transfers = &spyTransferRepoCreator{}
+				wallets   = spyWalletsUpdater{}
+				held      = spyUserRepoHolder{}
+				notifier  = &spyDisputeNotifier{}
+			)
+
+			uc := NewResolveDisputeInteractor(
+				repo,
+				transfers,
+				newTestUsers(
+					newCommonTestUser(payerTestID, 0),
+					newHeldTestUser(newMerchantTestUser(vo.NewUuidStaticTest(), tt.balance), 70),
+				),
+				wallets,
+				held,
+				notifier,
+				stubDisputePresenter{},
+			)
+
+			got, err := uc.Execute(context.Background(), ResolveDisputeInput{
+				ID:         tt.dispute.ID(),
+				Outcome:    tt.outcome,
+				ResolvedAt: createdAt.Add(time.Hour),
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+			}
+
+			if len(held) != len(tt.wantHeld) || held[payeeID] != tt.wantHeld[payeeID] {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, held, tt.wantHeld)
+			}
+
+			if len(wallets) != len(tt.wantWallets) || wallets[payerID] != tt.wantWallets[payerID] || wallets[payeeID] != tt.wantWallets[payeeID] {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, wallets, tt.wantWallets)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.Status != string(tt.outcome) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Status, tt.outcome)
+			}
+
+			assertDisputeNotices(t, tt.name, notifier.notices, entity.DisputeResolvedNotice, tt.outcome)
+
+			if tt.outcome == entity.DisputeLost && (len(transfers.transfers) != 1 || transfers.transfers[0].Payee() != payerID) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, transfers.transfers, "chargeback to the payer")
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	ResolveEscrowTransferUseCase interface {
+		Execute(context.Context, ResolveEscrowTransferInput) (EscrowTransferOutput, error)
+	}
+
+	// Input data
+	ResolveEscrowTransferInput struct {
+		ID         vo.Uuid
+		Resolution entity.EscrowResolution
+		ResolvedAt time.Time
+	}
+
+	resolveEscrowTransferInteractor struct {
+		repoEscrow          entity.EscrowTransferRepository
+		repoTransferCreator entity.TransferRepositoryCreator
+		repoUserUpdater     entity.UserRepositoryUpdater
+		repoUserFinder      entity.UserRepositoryFinder
+		notifier            Notifier
+		pre                 EscrowTransferPresenter
+	}
+)
+
+// NewResolveEscrowTransferInteractor creates new resolveEscrowTransferInteractor with its dependencies
+func NewResolveEscrowTransferInteractor(
+	repoEscrow entity.EscrowTransferRepository,
+	repoTransferCreator entity.TransferRepositoryCreator,
+	repoUserUpdater entity.UserRepositoryUpdater,
+	repoUserFinder entity.UserRepositoryFinder,
+	notifier Notifier,
+	pre EscrowTransferPresenter,
+) ResolveEscrowTransferUseCase {
+	return resolveEscrowTransferInteractor{
+		repoEscrow:          repoEscrow,
+		repoTransferCreator: repoTransferCreator,
+		repoUserUpdater:     repoUserUpdater,
+		repoUserFinder:      repoUserFinder,
+		notifier:            notifier,
+		pre:                 pre,
+	}
+}
+
+// Execute orchestrates the use case, the funds of the disputed escrow transfer go to the payee or back
+// to the payer according to the resolution
+func (r resolveEscrowTransferInteractor) Execute(ctx context.Context, i ResolveEscrowTransferInput) (EscrowTransferOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var escrow entity.EscrowTransfer
+
+	err := r.repoEscrow.WithTransaction(ctx, func(sessCtx context.Context) error {
+		found, err := r.repoEscrow.FindByID(sessCtx, i.ID)
+		if err != nil {
+			return err
+		}
+
+		escrow, err = found.Resolve(i.Resolution, i.ResolvedAt)
+		if err != nil {
+			return err
+		}
+
+		return settleEscrowTransfer(
+			sessCtx,
+			r.repoEscrow,
+			r.repoTransferCreator,
+			r.repoUserFinder,
+			r.repoUserUpdater,
+			entity.EscrowDisputed,
+			escrow,
+		)
+	})
+	if err != nil {
+		return r.pre.Output(entity.EscrowTransfer{}), err
+	}
+
+	r.notifier.Notify(ctx, escrow.Settlement())
+
+	return r.pre.Output(escrow), nil
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+)
+
+type (
+	// Input port
+	ResumeTransferBatchesUseCase interface {
+		Execute(context.Context, ResumeTransferBatchesInput) (ResumeTransferBatchesOutput, error)
+	}
+
+	// Input data
+	ResumeTransferBatchesInput struct {
+		Now        time.Time
+		StaleAfter time.Duration
+		Limit      int
+	}
+
+	// Output port
+	ResumeTransferBatchesPresenter interface {
+		Output([]entity.TransferBatch) ResumeTransferBatchesOutput
+	}
+
+	// Output data
+	ResumeTransferBatchesOutput struct {
+		Resumed int      `json:"resumed"`
+		IDs     []string `json:"ids"`
+	}
+
+	resumeTransferBatchesInteractor struct {
+		repo    entity.TransferBatchRepositoryLeaser
+		process processTransferBatchInteractor
+		pre     ResumeTransferBatchesPresenter
+	}
+)
+
+// NewResumeTransferBatchesInteractor creates new resumeTransferBatchesInteractor with its dependencies. As for
+// NewProcessTransferBatchInteractor, the transfer use case must not notify
+func NewResumeTransferBatchesInteractor(
+	repo entity.TransferBatchRepository,
+	repoTransfer entity.TransferRepositoryFinderByID,
+	ucTransfer CreateTransferUseCase,
+	notifier Notifier,
+	pre ResumeTransferBatchesPresenter,
+	concurrency int,
+) ResumeTransferBatchesUseCase {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	return resumeTransferBatchesInteractor{
+		repo: repo,
+		process: processTransferBatchInteractor{
+			repoFinder:   repo,
+			repoUpdater:  repo,
+			repoTransfer: repoTransfer,
+			ucTransfer:   ucTransfer,
+			notifier:     notifier,
+			concurrency:  concurrency,
+		},
+		pre: pre,
+	}
+}
+
+// Execute leases the batches left unfinished by a process stopped while running them and runs their remaining
+// items. A best-effort batch keeps the items already processed, an all-or-nothing batch runs its transaction
+// again. The transfers executed before the interruption are found by their ID and not executed again
+func (r resumeTransferBatchesInteractor) Execute(ctx context.Context, i ResumeTransferBatchesInput) (ResumeTransferBatchesOutput, error) {
+	stale, err := r.repo.LeaseStale(ctx, i.Now, i.StaleAfter, i.Limit)
+	if err != nil {
+		return r.pre.Output(nil), err
+	}
+
+	var (
+		resumed  []entity.TransferBatch
+		firstErr error
+	)
+	for _, batch := range stale {
+		batch, err := r.process.run(ctx, batch)
+		if err != nil {
+			LoggerFromContext(ctx).Errorf("error resuming transfer batch %s: %v", batch.ID().Value(), err)
+			// The batch is leased again once its progress is stale
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		resumed = append(resumed, batch)
+	}
+
+	return r.pre.Output(resumed), firstErr
+}
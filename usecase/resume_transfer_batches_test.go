@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type spyTransferBatchLeaser struct {
+	*spyTransferBatchRepo
+	stale    []entity.TransferBatch
+	leaseErr error
+}
+
+func (s spyTransferBatchLeaser) Create(_ context.Context, b entity.TransferBatch) (entity.TransferBatch, error) {
+	return b, nil
+}
+
+func (s spyTransferBatchLeaser) LeaseStale(_ context.Context, _ time.Time, _ time.Duration, _ int) ([]entity.TransferBatch, error) {
+	return s.stale, s.leaseErr
+}
+
+type spyCountingCreateTransferUseCase struct {
+	errs map[string]error
+
+	mu       sync.Mutex
+	executed int
+}
+
+func (s *spyCountingCreateTransferUseCase) Execute(_ context.Context, i CreateTransferInput) (CreateTransferOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.executed++
+
+	return CreateTransferOutput{ID: i.ID.Value()}, s.errs[i.ID.Value()]
+}
+
+type stubResumeTransferBatchesPresenter struct{}
+
+func (s stubResumeTransferBatchesPresenter) Output(batches []entity.TransferBatch) ResumeTransferBatchesOutput {
+	var IDs []string
+	for _, batch := range batches {
+		IDs = append(IDs, batch.ID().Value())
+	}
+
+	return ResumeTransferBatchesOutput{Resumed: len(batches), IDs: IDs}
+}
+
+func TestResumeTransferBatchesInteractor_Execute(t *testing.T) {
+	var newProcessingBatch = func(mode entity.BatchMode, statuses ...entity.BatchItemStatus) entity.TransferBatch {
+		var items []entity.TransferBatchItem
+		for idx, item := range newTransferBatchTest(mode, len(statuses)).Items() {
+			switch statuses[idx] {
+			case entity.BatchItemSucceeded:
+				item = item.Succeeded()
+			case entity.BatchItemFailed:
+				item = item.Failed(entity.ErrUserInsufficientBalance)
+			}
+			items = append(items, item)
+		}
+
+		return entity.RestoreTransferBatch(
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			mode,
+			items,
+			entity.BatchProcessing,
+			time.Time{},
+			time.Time{},
+		)
+	}
+
+	var (
+		bestEffort = newProcessingBatch(
+			entity.BatchBestEffort,
+			entity.BatchItemSucceeded,
+			entity.BatchItemFailed,
+			entity.BatchItemPending,
+		)
+		allOrNothing = newProcessingBatch(
+			entity.BatchAllOrNothing,
+			entity.BatchItemSucceeded,
+			entity.BatchItemPending,
+		)
+	)
+
+	tests := []struct {
+		name          string
+		stale         []entity.TransferBatch
+		leaseErr      error
+		transfers     stubTransferRepoFinderByID
+		want          ResumeTransferBatchesOutput
+		wantStatus    entity.BatchStatus
+		wantTransfers int
+		wantNotified  int
+		wantErr       error
+	}{
+		{
+			name:          "Resume best effort batch keeps the items processed",
+			stale:         []entity.TransferBatch{bestEffort},
+			want:          ResumeTransferBatchesOutput{Resumed: 1, IDs: []string{"0db298eb-c8e7-4829-84b7-c1036b4f0791"}},
+			wantStatus:    entity.BatchPartiallyCompleted,
+			wantTransfers: 1,
+			wantNotified:  1,
+		},
+		{
+			name:          "Resume all or nothing batch committed before the interruption",
+			stale:         []entity.TransferBatch{allOrNothing},
+			transfers:     newTransferBatchTestTransfers(allOrNothing, 0, 1),
+			want:          ResumeTransferBatchesOutput{Resumed: 1, IDs: []string{"0db298eb-c8e7-4829-84b7-c1036b4f0791"}},
+			wantStatus:    entity.BatchCompleted,
+			wantTransfers: 0,
+			wantNotified:  2,
+		},
+		{
+			name:          "Resume all or nothing batch runs the transaction again",
+			stale:         []entity.TransferBatch{allOrNothing},
+			want:          ResumeTransferBatchesOutput{Resumed: 1, IDs: []string{"0db298eb-c8e7-4829-84b7-c1036b4f0791"}},
+			wantStatus:    entity.BatchCompleted,
+			wantTransfers: 2,
+			wantNotified:  2,
+		},
+		{
+			name: "No stale batches",
+		},
+		{
+			name:     "Lease stale batches error",
+			leaseErr: entity.ErrLeaseTransferBatches,
+			wantErr:  entity.ErrLeaseTransferBatches,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				notifier   = &spyNotifier{}
+				ucTransfer = &spyCountingCreateTransferUseCase{}
+				repo       = spyTransferBatchLeaser{
+					spyTransferBatchRepo: &spyTransferBatchRepo{},
+					stale:                tt.stale,
+					leaseErr:             tt.leaseErr,
+				}
+			)
+
+			got, err := NewResumeTransferBatchesInteractor(
+				repo,
+				tt.transfers,
+				ucTransfer,
+				notifier,
+				stubResumeTransferBatchesPresenter{},
+				2,
+			).Execute(context.Background(), ResumeTransferBatchesInput{
+				Now:        time.Now(),
+				StaleAfter: time.Minute,
+				Limit:      10,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+
+			if got := repo.finished.Status(); got != tt.wantStatus {
+				t.Errorf("[TestCase '%s'] Status Got: '%v' | Want: '%v'", tt.name, got, tt.wantStatus)
+			}
+
+			if ucTransfer.executed != tt.wantTransfers {
+				t.Errorf("[TestCase '%s'] Transfers Got: '%v' | Want: '%v'", tt.name, ucTransfer.executed, tt.wantTransfers)
+			}
+
+			if notifier.notified != tt.wantNotified {
+				t.Errorf("[TestCase '%s'] Notified Got: '%v' | Want: '%v'", tt.name, notifier.notified, tt.wantNotified)
+			}
+		})
+	}
+}
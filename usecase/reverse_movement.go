@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	ReverseMovementUseCase interface {
+		Execute(context.Context, ReverseMovementInput) (MovementOutput, error)
+	}
+
+	// Input data
+	ReverseMovementInput struct {
+		ID         vo.Uuid
+		ReversedAt time.Time
+	}
+
+	reverseMovementInteractor struct {
+		repoMovementFinder  entity.MovementRepositoryFinder
+		repoMovementUpdater entity.MovementRepositoryUpdater
+		repoUserUpdater     entity.UserRepositoryUpdater
+		repoUserFinder      entity.UserRepositoryFinder
+		pre                 MovementPresenter
+	}
+)
+
+// NewReverseMovementInteractor creates new reverseMovementInteractor with its dependencies
+func NewReverseMovementInteractor(
+	repoMovementFinder entity.MovementRepositoryFinder,
+	repoMovementUpdater entity.MovementRepositoryUpdater,
+	repoUserUpdater entity.UserRepositoryUpdater,
+	repoUserFinder entity.UserRepositoryFinder,
+	pre MovementPresenter,
+) ReverseMovementUseCase {
+	return reverseMovementInteractor{
+		repoMovementFinder:  repoMovementFinder,
+		repoMovementUpdater: repoMovementUpdater,
+		repoUserUpdater:     repoUserUpdater,
+		repoUserFinder:      repoUserFinder,
+		pre:                 pre,
+	}
+}
+
+// Execute orchestrates the use case, a reversed deposit takes the money back out of the wallet
+// and a reversed withdrawal puts it back in
+func (r reverseMovementInteractor) Execute(ctx context.Context, i ReverseMovementInput) (MovementOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var reversed entity.Movement
+
+	err := r.repoMovementUpdater.WithTransaction(ctx, func(sessCtx context.Context) error {
+		movement, err := r.repoMovementFinder.FindByID(sessCtx, i.ID)
+		if err != nil {
+			return err
+		}
+
+		user, err := r.repoUserFinder.FindByID(sessCtx, movement.UserID())
+		if err != nil {
+			return err
+		}
+
+		reversed, err = movement.Reverse(user, i.ReversedAt)
+		if err != nil {
+			return err
+		}
+
+		if err := r.repoUserUpdater.UpdateWallet(sessCtx, user.ID(), user.Wallet().Money()); err != nil {
+			return err
+		}
+
+		return r.repoMovementUpdater.Reverse(sessCtx, reversed)
+	})
+	if err != nil {
+		return r.pre.Output(entity.Movement{}), err
+	}
+
+	return r.pre.Output(reversed), nil
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type spyMovementRepoUpdater struct {
+	err      error
+	reversed entity.Movement
+}
+
+func (s *spyMovementRepoUpdater) Reverse(_ context.Context, m entity.Movement) error {
+	s.reversed = m
+	return s.err
+}
+
+func (s *spyMovementRepoUpdater) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestReverseMovementInteractor_Execute(t *testing.T) {
+	var newMovement = func(movementType entity.MovementType, status entity.MovementStatus) entity.Movement {
+		return entity.RestoreMovement(
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			movementType,
+			vo.NewMoneyBRL(vo.NewAmountTest(100)),
+			"bank-slip-0001",
+			status,
+			time.Time{},
+			time.Time{},
+		)
+	}
+
+	tests := []struct {
+		name        string
+		movement    entity.Movement
+		findErr     error
+		balance     int64
+		updateErr   error
+		wantBalance int64
+		wantErr     error
+	}{
+		{
+			name:        "Reverse deposit success",
+			movement:    newMovement(entity.Deposit, entity.MovementCompleted),
+			balance:     150,
+			wantBalance: 50,
+		},
+		{
+			name:        "Reverse withdrawal success",
+			movement:    newMovement(entity.Withdrawal, entity.MovementCompleted),
+			balance:     50,
+			wantBalance: 150,
+		},
+		{
+			name:     "Reverse deposit already spent",
+			movement: newMovement(entity.Deposit, entity.MovementCompleted),
+			balance:  50,
+			wantErr:  entity.ErrUserInsufficientBalance,
+		},
+		{
+			name:     "Reverse movement already reversed",
+			movement: newMovement(entity.Deposit, entity.MovementReversed),
+			balance:  150,
+			wantErr:  entity.ErrMovementAlreadyReversed,
+		},
+		{
+			name:        "Reverse movement reversed concurrently",
+			movement:    newMovement(entity.Deposit, entity.MovementCompleted),
+			balance:     150,
+			updateErr:   entity.ErrMovementAlreadyReversed,
+			wantBalance: 50,
+			wantErr:     entity.ErrMovementAlreadyReversed,
+		},
+		{
+			name:    "Reverse movement not found",
+			findErr: entity.ErrNotFoundMovement,
+			wantErr: entity.ErrNotFoundMovement,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				updater     = &spyWalletUpdater{}
+				repoUpdater = &spyMovementRepoUpdater{err: tt.updateErr}
+				reversedAt  = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+			)
+
+			uc := NewReverseMovementInteractor(
+				stubMovementRepoFinder{result: tt.movement, err: tt.findErr},
+				repoUpdater,
+				updater,
+				stubUserRepoFinder{result: newCommonTestUser(vo.NewUuidStaticTest(), tt.balance)},
+				stubMovementPresenter{},
+			)
+
+			got, err := uc.Execute(context.TODO(), ReverseMovementInput{
+				ID:         vo.NewUuidStaticTest(),
+				ReversedAt: reversedAt,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if updater.money.Amount().Value() != tt.wantBalance {
+				t.Errorf("[TestCase '%s'] Got balance: '%v' | Want: '%v'", tt.name, updater.money.Amount().Value(), tt.wantBalance)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.Status != string(entity.MovementReversed) {
+				t.Errorf("[TestCase '%s'] Got status: '%v' | Want: '%v'", tt.name, got.Status, entity.MovementReversed)
+			}
+
+			if !repoUpdater.reversed.ReversedAt().Equal(reversedAt) {
+				t.Errorf("[TestCase '%s'] Got reversed at: '%v' | Want: '%v'", tt.name, repoUpdater.reversed.ReversedAt(), reversedAt)
+			}
+		})
+	}
+}
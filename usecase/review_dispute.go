@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	ReviewDisputeUseCase interface {
+		Execute(context.Context, ReviewDisputeInput) (DisputeOutput, error)
+	}
+
+	// Input data
+	ReviewDisputeInput struct {
+		ID         vo.Uuid
+		ReviewedAt time.Time
+	}
+
+	reviewDisputeInteractor struct {
+		repoDispute entity.DisputeRepository
+		pre         DisputePresenter
+	}
+)
+
+// NewReviewDisputeInteractor creates new reviewDisputeInteractor with its dependencies
+func NewReviewDisputeInteractor(repoDispute entity.DisputeRepository, pre DisputePresenter) ReviewDisputeUseCase {
+	return reviewDisputeInteractor{
+		repoDispute: repoDispute,
+		pre:         pre,
+	}
+}
+
+// Execute orchestrates the use case
+func (r reviewDisputeInteractor) Execute(ctx context.Context, i ReviewDisputeInput) (DisputeOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	found, err := r.repoDispute.FindByID(ctx, i.ID)
+	if err != nil {
+		return r.pre.Output(entity.Dispute{}), err
+	}
+
+	dispute, err := found.Review(i.ReviewedAt)
+	if err != nil {
+		return r.pre.Output(entity.Dispute{}), err
+	}
+
+	if err := r.repoDispute.Update(ctx, found.Status(), dispute); err != nil {
+		return r.pre.Output(entity.Dispute{}), err
+	}
+
+	return r.pre.Output(dispute), nil
+}
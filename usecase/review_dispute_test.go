@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func TestReviewDisputeInteractor_Execute(t *testing.T) {
+	var (
+		createdAt   = time.Date(2020, 11, 10, 0, 0, 0, 0, time.UTC)
+		dispute     = entity.NewDispute(vo.NewUuidStaticTest(), newTestTransfer(70), "item not delivered", createdAt)
+		reviewed, _ = dispute.Review(createdAt)
+		won, _      = dispute.Resolve(entity.DisputeWon, createdAt)
+		unknown, _  = vo.NewUuid("2b4d6f8a-1c3e-4a5b-9d7f-0e2c4a6b8d10")
+	)
+
+	tests := []struct {
+		name      string
+		dispute   entity.Dispute
+		ID        vo.Uuid
+		updateErr error
+		want      DisputeOutput
+		wantErr   error
+	}{
+		{
+			name:    "Review open dispute",
+			dispute: dispute,
+			ID:      dispute.ID(),
+			want: DisputeOutput{
+				ID:       "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Status:   string(entity.DisputeUnderReview),
+				Evidence: []DisputeEvidenceOutput{},
+			},
+		},
+		{
+			name:    "Review dispute under review",
+			dispute: reviewed,
+			ID:      reviewed.ID(),
+			wantErr: entity.ErrDisputeNotOpen,
+		},
+		{
+			name:    "Review resolved dispute",
+			dispute: won,
+			ID:      won.ID(),
+			wantErr: entity.ErrDisputeNotOpen,
+		},
+		{
+			name:      "Review dispute changed meanwhile",
+			dispute:   dispute,
+			ID:        dispute.ID(),
+			updateErr: entity.ErrDisputeChanged,
+			wantErr:   entity.ErrDisputeChanged,
+		},
+		{
+			name:    "Review unknown dispute",
+			dispute: dispute,
+			ID:      unknown,
+			wantErr: entity.ErrNotFoundDispute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var repo = &spyDisputeRepo{
+				disputes:  map[vo.Uuid]entity.Dispute{tt.dispute.ID(): tt.dispute},
+				updateErr: tt.updateErr,
+			}
+
+			got, err := NewReviewDisputeInteractor(repo, stubDisputePresenter{}).Execute(
+				context.Background(),
+				ReviewDisputeInput{ID: tt.ID, ReviewedAt: createdAt},
+			)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.ID != tt.want.ID || got.Status != tt.want.Status || len(got.Evidence) != len(tt.want.Evidence) {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+
+			if stored := repo.disputes[tt.ID]; stored.Status() != entity.DisputeUnderReview {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, stored.Status(), entity.DisputeUnderReview)
+			}
+		})
+	}
+}
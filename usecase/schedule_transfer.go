@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	ScheduleTransferUseCase interface {
+		Execute(context.Context, ScheduleTransferInput) (ScheduledTransferOutput, error)
+	}
+
+	// Input data
+	ScheduleTransferInput struct {
+		ID        vo.Uuid
+		PayerID   vo.Uuid
+		PayeeID   vo.Uuid
+		Value     vo.Money
+		Details   entity.TransferDetails
+		ExecuteAt time.Time
+		CreatedAt time.Time
+	}
+
+	// Output port
+	ScheduledTransferPresenter interface {
+		Output(entity.ScheduledTransfer) ScheduledTransferOutput
+	}
+
+	// Output data
+	ScheduledTransferOutput struct {
+		ID                string            `json:"id"`
+		PayerID           string            `json:"payer"`
+		PayeeID           string            `json:"payee"`
+		Value             int64             `json:"value"`
+		Description       string            `json:"description,omitempty"`
+		ExternalReference string            `json:"external_reference,omitempty"`
+		Metadata          map[string]string `json:"metadata,omitempty"`
+		ExecuteAt         string            `json:"execute_at"`
+		Status            string            `json:"status"`
+		FailureReason     string            `json:"failure_reason,omitempty"`
+		CreatedAt         string            `json:"created_at"`
+	}
+
+	scheduleTransferInteractor struct {
+		repo           entity.ScheduledTransferRepositoryCreator
+		repoUserFinder entity.UserRepositoryFinder
+		pre            ScheduledTransferPresenter
+	}
+)
+
+// NewScheduleTransferInteractor creates new scheduleTransferInteractor with its dependencies
+func NewScheduleTransferInteractor(
+	repo entity.ScheduledTransferRepositoryCreator,
+	repoUserFinder entity.UserRepositoryFinder,
+	pre ScheduledTransferPresenter,
+) ScheduleTransferUseCase {
+	return scheduleTransferInteractor{
+		repo:           repo,
+		repoUserFinder: repoUserFinder,
+		pre:            pre,
+	}
+}
+
+// Execute orchestrates the use case, the users are validated now but the balance only at the execution
+func (s scheduleTransferInteractor) Execute(ctx context.Context, i ScheduleTransferInput) (ScheduledTransferOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	scheduled, err := entity.NewScheduledTransfer(i.ID, i.PayerID, i.PayeeID, i.Value, i.ExecuteAt, i.CreatedAt)
+	if err != nil {
+		return s.pre.Output(entity.ScheduledTransfer{}), err
+	}
+
+	payer, err := s.repoUserFinder.FindByID(ctx, i.PayerID)
+	if err != nil {
+		return s.pre.Output(entity.ScheduledTransfer{}), err
+	}
+
+	if err := payer.CanTransfer(); err != nil {
+		return s.pre.Output(entity.ScheduledTransfer{}), entity.WrapError(entity.ErrUnauthorizedTransfer, err)
+	}
+
+	if _, err := s.repoUserFinder.FindByID(ctx, i.PayeeID); err != nil {
+		return s.pre.Output(entity.ScheduledTransfer{}), err
+	}
+
+	scheduled, err = s.repo.Create(ctx, scheduled.WithDetails(i.Details))
+	if err != nil {
+		return s.pre.Output(entity.ScheduledTransfer{}), err
+	}
+
+	return s.pre.Output(scheduled), nil
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type stubScheduledTransferRepo struct {
+	result entity.ScheduledTransfer
+	err    error
+}
+
+func (s stubScheduledTransferRepo) Create(_ context.Context, t entity.ScheduledTransfer) (entity.ScheduledTransfer, error) {
+	return t, s.err
+}
+
+func (s stubScheduledTransferRepo) FindByID(_ context.Context, _ vo.Uuid) (entity.ScheduledTransfer, error) {
+	return s.result, s.err
+}
+
+type spyScheduledTransferRepoCanceler struct {
+	err      error
+	canceled entity.ScheduledTransfer
+}
+
+func (s *spyScheduledTransferRepoCanceler) Cancel(_ context.Context, t entity.ScheduledTransfer) error {
+	s.canceled = t
+	return s.err
+}
+
+type stubScheduledTransferPresenter struct{}
+
+func (s stubScheduledTransferPresenter) Output(t entity.ScheduledTransfer) ScheduledTransferOutput {
+	return ScheduledTransferOutput{
+		ID:     t.ID().Value(),
+		Status: string(t.Status()),
+	}
+}
+
+func TestScheduleTransferInteractor_Execute(t *testing.T) {
+	var (
+		createdAt = time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC)
+		merchant  = newMerchantTestUser(vo.NewUuidStaticTest(), 100)
+	)
+
+	tests := []struct {
+		name      string
+		executeAt time.Time
+		findPayer func() (entity.User, error)
+		findPayee func() (entity.User, error)
+		createErr error
+		want      string
+		wantErr   error
+	}{
+		{
+			name:      "Schedule transfer success",
+			executeAt: createdAt.Add(time.Hour),
+			findPayer: func() (entity.User, error) {
+				return newCommonTestUser(vo.NewUuidStaticTest(), 0), nil
+			},
+			findPayee: func() (entity.User, error) {
+				return merchant, nil
+			},
+			want: string(entity.TransferScheduled),
+		},
+		{
+			name:      "Schedule transfer in the past",
+			executeAt: createdAt.Add(-time.Hour),
+			wantErr:   entity.ErrInvalidExecutionDate,
+		},
+		{
+			name:      "Schedule transfer from merchant",
+			executeAt: createdAt.Add(time.Hour),
+			findPayer: func() (entity.User, error) {
+				return merchant, nil
+			},
+			wantErr: vo.ErrNotAllowedTypeUser,
+		},
+		{
+			name:      "Schedule transfer to not found payee",
+			executeAt: createdAt.Add(time.Hour),
+			findPayer: func() (entity.User, error) {
+				return newCommonTestUser(vo.NewUuidStaticTest(), 0), nil
+			},
+			findPayee: func() (entity.User, error) {
+				return entity.User{}, entity.ErrNotFoundUser
+			},
+			wantErr: entity.ErrNotFoundUser,
+		},
+		{
+			name:      "Schedule transfer create error",
+			executeAt: createdAt.Add(time.Hour),
+			findPayer: func() (entity.User, error) {
+				return newCommonTestUser(vo.NewUuidStaticTest(), 0), nil
+			},
+			findPayee: func() (entity.User, error) {
+				return merchant, nil
+			},
+			createErr: entity.ErrCreateScheduledTransfer,
+			wantErr:   entity.ErrCreateScheduledTransfer,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewScheduleTransferInteractor(
+				stubScheduledTransferRepo{err: tt.createErr},
+				&spyUserRepoFinder{findPayer: tt.findPayer, findPayee: tt.findPayee},
+				stubScheduledTransferPresenter{},
+			)
+
+			got, err := uc.Execute(context.TODO(), ScheduleTransferInput{
+				ID:        vo.NewUuidStaticTest(),
+				PayerID:   vo.NewUuidStaticTest(),
+				PayeeID:   vo.NewUuidStaticTest(),
+				Value:     vo.NewMoneyBRL(vo.NewAmountTest(100)),
+				ExecuteAt: tt.executeAt,
+				CreatedAt: createdAt,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if got.Status != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Status, tt.want)
+			}
+		})
+	}
+}
+
+func TestCancelScheduledTransferInteractor_Execute(t *testing.T) {
+	var newScheduledTransfer = func(status entity.TransferStatus) entity.ScheduledTransfer {
+		return entity.RestoreScheduledTransfer(
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewMoneyBRL(vo.NewAmountTest(100)),
+			time.Time{},
+			status,
+			"",
+			time.Time{},
+			time.Time{},
+		)
+	}
+
+	tests := []struct {
+		name      string
+		scheduled entity.ScheduledTransfer
+		findErr   error
+		cancelErr error
+		want      string
+		wantErr   error
+	}{
+		{
+			name:      "Cancel scheduled transfer success",
+			scheduled: newScheduledTransfer(entity.TransferScheduled),
+			want:      string(entity.TransferCanceled),
+		},
+		{
+			name:      "Cancel executed transfer",
+			scheduled: newScheduledTransfer(entity.TransferExecuted),
+			wantErr:   entity.ErrScheduledTransferNotCancelable,
+		},
+		{
+			name:      "Cancel transfer leased by a worker",
+			scheduled: newScheduledTransfer(entity.TransferScheduled),
+			cancelErr: entity.ErrScheduledTransferNotCancelable,
+			wantErr:   entity.ErrScheduledTransferNotCancelable,
+		},
+		{
+			name:    "Cancel not found transfer",
+			findErr: entity.ErrNotFoundScheduledTransfer,
+			wantErr: entity.ErrNotFoundScheduledTransfer,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewCancelScheduledTransferInteractor(
+				stubScheduledTransferRepo{result: tt.scheduled, err: tt.findErr},
+				&spyScheduledTransferRepoCanceler{err: tt.cancelErr},
+				stubScheduledTransferPresenter{},
+			)
+
+			got, err := uc.Execute(context.TODO(), CancelScheduledTransferInput{
+				ID:         vo.NewUuidStaticTest(),
+				CanceledAt: time.Now(),
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if got.Status != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, got.Status, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	SearchTransfersUseCase interface {
+		Execute(context.Context, SearchTransfersInput) (SearchTransfersOutput, error)
+	}
+
+	// Input data
+	SearchTransfersInput struct {
+		UserID            vo.Uuid
+		ExternalReference string
+		Text              string
+		Metadata          map[string]string
+		Limit             int
+	}
+
+	// Output port
+	SearchTransfersPresenter interface {
+		Output([]entity.Transfer) SearchTransfersOutput
+	}
+
+	// Output data
+	SearchTransfersOutput struct {
+		Transfers []CreateTransferOutput `json:"transfers"`
+	}
+
+	searchTransfersInteractor struct {
+		repoTransfer   entity.TransferRepositorySearcher
+		repoUserFinder entity.UserRepositoryFinder
+		pre            SearchTransfersPresenter
+	}
+)
+
+// NewSearchTransfersInteractor creates new searchTransfersInteractor with its dependencies
+func NewSearchTransfersInteractor(
+	repoTransfer entity.TransferRepositorySearcher,
+	repoUserFinder entity.UserRepositoryFinder,
+	pre SearchTransfersPresenter,
+) SearchTransfersUseCase {
+	return searchTransfersInteractor{
+		repoTransfer:   repoTransfer,
+		repoUserFinder: repoUserFinder,
+		pre:            pre,
+	}
+}
+
+// Execute orchestrates the use case
+func (s searchTransfersInteractor) Execute(ctx context.Context, i SearchTransfersInput) (SearchTransfersOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	search, err := entity.NewTransferSearch(i.UserID, i.ExternalReference, i.Text, i.Metadata, i.Limit)
+	if err != nil {
+		return s.pre.Output(nil), err
+	}
+
+	if _, err := s.repoUserFinder.FindByID(ctx, i.UserID); err != nil {
+		return s.pre.Output(nil), err
+	}
+
+	transfers, err := s.repoTransfer.Search(ctx, search)
+	if err != nil {
+		return s.pre.Output(nil), err
+	}
+
+	return s.pre.Output(transfers), nil
+}
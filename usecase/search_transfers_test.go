@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type spyTransferRepoSearcher struct {
+	result []entity.Transfer
+	search entity.TransferSearch
+}
+
+func (s *spyTransferRepoSearcher) Search(_ context.Context, search entity.TransferSearch) ([]entity.Transfer, error) {
+	s.search = search
+	return s.result, nil
+}
+
+type stubSearchTransfersPresenter struct{}
+
+func (s stubSearchTransfersPresenter) Output(transfers []entity.Transfer) SearchTransfersOutput {
+	return SearchTransfersOutput{Transfers: make([]CreateTransferOutput, len(transfers))}
+}
+
+func TestSearchTransfersInteractor_Execute(t *testing.T) {
+	var (
+		payer    = newCommonTestUser(payerTestID, 0)
+		unknown  = escrowTestAccountID
+		transfer = newTestTransfer(70)
+	)
+
+	tests := []struct {
+		name     string
+		userID   vo.Uuid
+		metadata map[string]string
+		limit    int
+		want     int
+		wantErr  error
+	}{
+		{
+			name:     "Search transfers of the user",
+			userID:   payer.ID(),
+			metadata: map[string]string{"order_id": "1234"},
+			limit:    entity.DefaultTransferSearchLimit,
+			want:     1,
+		},
+		{
+			name:    "Search transfers of an unknown user",
+			userID:  unknown,
+			limit:   entity.DefaultTransferSearchLimit,
+			wantErr: entity.ErrNotFoundUser,
+		},
+		{
+			name:     "Search transfers with an invalid metadata key",
+			userID:   payer.ID(),
+			metadata: map[string]string{"order.id": "1234"},
+			limit:    entity.DefaultTransferSearchLimit,
+			wantErr:  entity.ErrInvalidTransferMetadata,
+		},
+		{
+			name:    "Search transfers above the limit",
+			userID:  payer.ID(),
+			limit:   entity.MaxTransferSearchLimit + 1,
+			wantErr: entity.ErrInvalidTransferSearch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var repo = &spyTransferRepoSearcher{result: []entity.Transfer{transfer}}
+
+			users := newTestUsers(payer, newMerchantTestUser(vo.NewUuidStaticTest(), 0))
+			uc := NewSearchTransfersInteractor(repo, users, stubSearchTransfersPresenter{})
+
+			got, err := uc.Execute(context.Background(), SearchTransfersInput{
+				UserID:            tt.userID,
+				ExternalReference: " order-1234 ",
+				Metadata:          tt.metadata,
+				Limit:             tt.limit,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if len(got.Transfers) != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%v' | Want: '%v'", tt.name, len(got.Transfers), tt.want)
+			}
+
+			if err == nil && (repo.search.User() != tt.userID || repo.search.ExternalReference() != "order-1234") {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%v'", tt.name, repo.search, "search of the user by the trimmed reference")
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package usecase
+
+import "context"
+
+type (
+	// Tracer port starts the spans showing where the use cases spend their time, implemented outside of the use cases
+	Tracer interface {
+		Start(ctx context.Context, name string) (context.Context, Span)
+	}
+
+	// Span port defines an operation traced, child of the span of the context it was started with
+	Span interface {
+		// RecordError marks the span as failed with err
+		RecordError(err error)
+
+		// End completes the span
+		End()
+	}
+)
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+// checkSendingLimits returns whether the payer may send the money debited from its wallet, the role of the payer
+// and the limits of its KYC level are checked against the money already sent since the start of the day at
+func checkSendingLimits(
+	ctx context.Context,
+	repoTransferSummarizer entity.TransferRepositorySentSummarizer,
+	payer entity.User,
+	debited vo.Money,
+	at time.Time,
+) error {
+	if err := payer.CanTransfer(); err != nil {
+		return entity.WrapError(entity.ErrUnauthorizedTransfer, err)
+	}
+
+	sentToday, err := repoTransferSummarizer.SumSent(ctx, payer.ID(), entity.StartOfDay(at))
+	if err != nil {
+		return err
+	}
+
+	return payer.CanSend(debited, sentToday)
+}
+
+// checkTransferLimits returns whether the transfer keeps the payer and the payee within their limits, the same
+// checks for every use case moving money between users
+func checkTransferLimits(
+	ctx context.Context,
+	repoTransferSummarizer entity.TransferRepositorySentSummarizer,
+	payer entity.User,
+	payee entity.User,
+	transfer entity.Transfer,
+) error {
+	if err := checkSendingLimits(ctx, repoTransferSummarizer, payer, transfer.Debited(), transfer.CreatedAt()); err != nil {
+		return err
+	}
+
+	return payee.CanReceive(transfer.Credited())
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	UpdateKYCLevelUseCase interface {
+		Execute(context.Context, UpdateKYCLevelInput) (KYCLevelOutput, error)
+	}
+
+	// Input data
+	UpdateKYCLevelInput struct {
+		UserID vo.Uuid
+		Level  entity.KYCLevel
+	}
+
+	// Output port
+	KYCLevelPresenter interface {
+		Output(entity.User, entity.KYCLevel) KYCLevelOutput
+	}
+
+	// Output data
+	KYCLevelOutput struct {
+		UserID        string          `json:"user_id"`
+		PreviousLevel string          `json:"previous_level"`
+		Level         string          `json:"level"`
+		Limits        KYCLimitsOutput `json:"limits"`
+	}
+
+	// Output data
+	KYCLimitsOutput struct {
+		SingleTransfer int64 `json:"single_transfer"`
+		DailyOutgoing  int64 `json:"daily_outgoing"`
+		WalletBalance  int64 `json:"wallet_balance"`
+	}
+
+	updateKYCLevelInteractor struct {
+		repoUserFinder  entity.UserRepositoryFinder
+		repoUserUpdater entity.UserRepositoryKYCUpdater
+		pre             KYCLevelPresenter
+	}
+)
+
+// NewUpdateKYCLevelInteractor creates new updateKYCLevelInteractor with its dependencies
+func NewUpdateKYCLevelInteractor(
+	repoUserFinder entity.UserRepositoryFinder,
+	repoUserUpdater entity.UserRepositoryKYCUpdater,
+	pre KYCLevelPresenter,
+) UpdateKYCLevelUseCase {
+	return updateKYCLevelInteractor{
+		repoUserFinder:  repoUserFinder,
+		repoUserUpdater: repoUserUpdater,
+		pre:             pre,
+	}
+}
+
+// Execute orchestrates the use case, upgrading or downgrading the KYC level of the user, a balance above the limits
+// of a downgraded level is kept and only the next movements are limited
+func (u updateKYCLevelInteractor) Execute(ctx context.Context, i UpdateKYCLevelInput) (KYCLevelOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	user, err := u.repoUserFinder.FindByID(ctx, i.UserID)
+	if err != nil {
+		return u.pre.Output(entity.User{}, ""), err
+	}
+
+	if err := u.repoUserUpdater.UpdateKYCLevel(ctx, i.UserID, i.Level); err != nil {
+		return u.pre.Output(entity.User{}, ""), err
+	}
+
+	return u.pre.Output(user.WithKYCLevel(i.Level), user.KYCLevel()), nil
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	UpdateRecurringTransferUseCase interface {
+		Execute(context.Context, UpdateRecurringTransferInput) (RecurringTransferOutput, error)
+	}
+
+	// Input data
+	UpdateRecurringTransferInput struct {
+		ID        vo.Uuid
+		Value     vo.Money
+		EndAt     time.Time
+		Count     int
+		Status    entity.RecurrenceStatus
+		UpdatedAt time.Time
+	}
+
+	updateRecurringTransferInteractor struct {
+		repoFinder  entity.RecurringTransferRepositoryFinder
+		repoUpdater entity.RecurringTransferRepositoryUpdater
+		pre         RecurringTransferPresenter
+	}
+)
+
+// NewUpdateRecurringTransferInteractor creates new updateRecurringTransferInteractor with its dependencies
+func NewUpdateRecurringTransferInteractor(
+	repoFinder entity.RecurringTransferRepositoryFinder,
+	repoUpdater entity.RecurringTransferRepositoryUpdater,
+	pre RecurringTransferPresenter,
+) UpdateRecurringTransferUseCase {
+	return updateRecurringTransferInteractor{
+		repoFinder:  repoFinder,
+		repoUpdater: repoUpdater,
+		pre:         pre,
+	}
+}
+
+// Execute orchestrates the use case
+func (u updateRecurringTransferInteractor) Execute(ctx context.Context, i UpdateRecurringTransferInput) (RecurringTransferOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	recurring, err := u.repoFinder.FindByID(ctx, i.ID)
+	if err != nil {
+		return u.pre.Output(entity.RecurringTransfer{}), err
+	}
+
+	recurring, err = recurring.Update(i.Value, i.EndAt, i.Count, i.Status, i.UpdatedAt)
+	if err != nil {
+		return u.pre.Output(entity.RecurringTransfer{}), err
+	}
+
+	if err := u.repoUpdater.Update(ctx, recurring); err != nil {
+		return u.pre.Output(entity.RecurringTransfer{}), err
+	}
+
+	return u.pre.Output(recurring), nil
+}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type stubRecurringTransferRepo struct {
+	result    entity.RecurringTransfer
+	findErr   error
+	updateErr error
+}
+
+func (s stubRecurringTransferRepo) FindByID(_ context.Context, _ vo.Uuid) (entity.RecurringTransfer, error) {
+	return s.result, s.findErr
+}
+
+func (s stubRecurringTransferRepo) Update(_ context.Context, _ entity.RecurringTransfer) error {
+	return s.updateErr
+}
+
+type stubRecurringTransferPresenter struct{}
+
+func (s stubRecurringTransferPresenter) Output(r entity.RecurringTransfer) RecurringTransferOutput {
+	return RecurringTransferOutput{
+		ID:     r.ID().Value(),
+		Value:  r.Value().Amount().Value(),
+		Status: string(r.Status()),
+	}
+}
+
+func TestUpdateRecurringTransferInteractor_Execute(t *testing.T) {
+	var newRecurringTransfer = func(status entity.RecurrenceStatus) entity.RecurringTransfer {
+		return entity.RestoreRecurringTransfer(
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewUuidStaticTest(),
+			vo.NewMoneyBRL(vo.NewAmountTest(100)),
+			entity.Weekly,
+			0,
+			time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC),
+			time.Time{},
+			0,
+			0,
+			0,
+			3,
+			"",
+			status,
+			time.Time{},
+			time.Time{},
+		)
+	}
+
+	tests := []struct {
+		name    string
+		repo    stubRecurringTransferRepo
+		status  entity.RecurrenceStatus
+		want    RecurringTransferOutput
+		wantErr error
+	}{
+		{
+			name:   "Update value",
+			repo:   stubRecurringTransferRepo{result: newRecurringTransfer(entity.RecurrenceActive)},
+			status: entity.RecurrenceActive,
+			want: RecurringTransferOutput{
+				ID:     "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:  250,
+				Status: "ACTIVE",
+			},
+		},
+		{
+			name:   "Pause",
+			repo:   stubRecurringTransferRepo{result: newRecurringTransfer(entity.RecurrenceActive)},
+			status: entity.RecurrencePaused,
+			want: RecurringTransferOutput{
+				ID:     "0db298eb-c8e7-4829-84b7-c1036b4f0791",
+				Value:  250,
+				Status: "PAUSED",
+			},
+		},
+		{
+			name:    "Invalid status",
+			repo:    stubRecurringTransferRepo{result: newRecurringTransfer(entity.RecurrenceActive)},
+			status:  entity.RecurrenceFinished,
+			wantErr: entity.ErrInvalidRecurrenceStatus,
+		},
+		{
+			name:    "Update canceled",
+			repo:    stubRecurringTransferRepo{result: newRecurringTransfer(entity.RecurrenceCanceled)},
+			status:  entity.RecurrenceActive,
+			wantErr: entity.ErrRecurringTransferClosed,
+		},
+		{
+			name: "Update while executed by a worker",
+			repo: stubRecurringTransferRepo{
+				result:    newRecurringTransfer(entity.RecurrenceActive),
+				updateErr: entity.ErrRecurringTransferBusy,
+			},
+			status:  entity.RecurrenceActive,
+			wantErr: entity.ErrRecurringTransferBusy,
+		},
+		{
+			name:    "Update not found",
+			repo:    stubRecurringTransferRepo{findErr: entity.ErrNotFoundRecurringTransfer},
+			status:  entity.RecurrenceActive,
+			wantErr: entity.ErrNotFoundRecurringTransfer,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewUpdateRecurringTransferInteractor(
+				tt.repo,
+				tt.repo,
+				stubRecurringTransferPresenter{},
+			).Execute(context.TODO(), UpdateRecurringTransferInput{
+				ID:        vo.NewUuidStaticTest(),
+				Value:     vo.NewMoneyBRL(vo.NewAmountTest(250)),
+				Status:    tt.status,
+				UpdatedAt: time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC),
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr == nil && got != tt.want {
+				t.Errorf("[TestCase '%s'] Got: '%+v' | Want: '%+v'", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+// newCommonTestUser returns a common user with the balance in its wallet, the user fixture shared by the tests
+func newCommonTestUser(ID vo.Uuid, balance int64) entity.User {
+	return entity.NewCommonUser(
+		ID,
+		vo.NewFullName("Test testing"),
+		vo.NewEmailTest("test@testing.com"),
+		vo.NewPassword("passw"),
+		vo.NewDocumentTest(vo.CPF, "07091054954"),
+		vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(balance))),
+		time.Time{},
+	)
+}
+
+// newMerchantTestUser returns a merchant user with the balance in its wallet
+func newMerchantTestUser(ID vo.Uuid, balance int64) entity.User {
+	return entity.NewMerchantUser(
+		ID,
+		vo.NewFullName("Merchant user"),
+		vo.NewEmailTest("test@testing.com"),
+		vo.NewPassword("passw"),
+		vo.NewDocumentTest(vo.CNPJ, "20.770.438/0001-66"),
+		vo.NewWallet(vo.NewMoneyBRL(vo.NewAmountTest(balance))),
+		time.Time{},
+	)
+}
+
+// payerTestID is the ID of the common user paying the merchant at vo.NewUuidStaticTest() in the tests
+var payerTestID, _ = vo.NewUuid("3f6c1d2a-8b4e-4c7f-9a1d-5e2b7c9f0a13")
+
+// escrowTestAccountID is the ID of the common user holding the escrow transfers in the tests
+var escrowTestAccountID, _ = vo.NewUuid("9d4b2c1e-6a8f-4e3d-b5c7-1f2a3e4d5c60")
+
+// newHeldTestUser returns the user with part of its balance held
+func newHeldTestUser(user entity.User, held int64) entity.User {
+	user.HoldDebt(vo.NewMoneyBRL(vo.NewAmountTest(held)))
+	return user
+}
+
+// newTestUsers returns the user repository finding the users by their ID
+func newTestUsers(users ...entity.User) stubUserRepoByID {
+	var repo = stubUserRepoByID{}
+	for _, user := range users {
+		repo[user.ID()] = user
+	}
+
+	return repo
+}
+
+// newTestTransfer returns a transfer of the value from the payer of the tests to the merchant at vo.NewUuidStaticTest()
+func newTestTransfer(value int64) entity.Transfer {
+	transferID, _ := vo.NewUuid("5c8e2a1f-4b7d-4e9a-8c3f-6d1b2e7a9f04")
+
+	return entity.NewTransfer(
+		transferID,
+		payerTestID,
+		vo.NewUuidStaticTest(),
+		vo.NewMoneyBRL(vo.NewAmountTest(value)),
+		time.Date(2020, 11, 9, 0, 0, 0, 0, time.UTC),
+	)
+}
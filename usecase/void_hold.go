@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	VoidHoldUseCase interface {
+		Execute(context.Context, VoidHoldInput) (HoldOutput, error)
+	}
+
+	// Input data
+	VoidHoldInput struct {
+		ID       vo.Uuid
+		VoidedAt time.Time
+	}
+
+	voidHoldInteractor struct {
+		repoHold       entity.HoldRepository
+		repoUserFinder entity.UserRepositoryFinder
+		repoUserHolder entity.UserRepositoryHolder
+		pre            HoldPresenter
+	}
+)
+
+// NewVoidHoldInteractor creates new voidHoldInteractor with its dependencies
+func NewVoidHoldInteractor(
+	repoHold entity.HoldRepository,
+	repoUserFinder entity.UserRepositoryFinder,
+	repoUserHolder entity.UserRepositoryHolder,
+	pre HoldPresenter,
+) VoidHoldUseCase {
+	return voidHoldInteractor{
+		repoHold:       repoHold,
+		repoUserFinder: repoUserFinder,
+		repoUserHolder: repoUserHolder,
+		pre:            pre,
+	}
+}
+
+// Execute orchestrates the use case
+func (v voidHoldInteractor) Execute(ctx context.Context, i VoidHoldInput) (HoldOutput, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var hold entity.Hold
+
+	err := v.repoHold.WithTransaction(ctx, func(sessCtx context.Context) error {
+		found, err := v.repoHold.FindByID(sessCtx, i.ID)
+		if err != nil {
+			return err
+		}
+
+		hold, err = found.Void(i.VoidedAt)
+		if err != nil {
+			return err
+		}
+
+		if err := v.repoHold.Close(sessCtx, hold); err != nil {
+			return err
+		}
+
+		return releaseHold(sessCtx, v.repoUserFinder, v.repoUserHolder, hold)
+	})
+	if err != nil {
+		return v.pre.Output(entity.Hold{}), err
+	}
+
+	return v.pre.Output(hold), nil
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+type (
+	// Input port
+	WithdrawUseCase interface {
+		Execute(context.Context, WithdrawInput) (MovementOutput, error)
+	}
+
+	// Input data
+	WithdrawInput struct {
+		ID                vo.Uuid
+		UserID            vo.Uuid
+		Value             vo.Money
+		ExternalReference string
+		CreatedAt         time.Time
+	}
+
+	withdrawInteractor struct {
+		movementInteractor
+	}
+)
+
+// NewWithdrawInteractor creates new withdrawInteractor with its dependencies
+func NewWithdrawInteractor(
+	repoMovementCreator entity.MovementRepositoryCreator,
+	repoUserUpdater entity.UserRepositoryUpdater,
+	repoUserFinder entity.UserRepositoryFinder,
+	authorizer Authorizer,
+	pre MovementPresenter,
+) WithdrawUseCase {
+	return withdrawInteractor{
+		movementInteractor: movementInteractor{
+			repoMovementCreator: repoMovementCreator,
+			repoUserUpdater:     repoUserUpdater,
+			repoUserFinder:      repoUserFinder,
+			authorizer:          authorizer,
+			pre:                 pre,
+		},
+	}
+}
+
+// Execute orchestrates the use case
+func (w withdrawInteractor) Execute(ctx context.Context, i WithdrawInput) (MovementOutput, error) {
+	return w.create(ctx, i.ID, i.UserID, entity.Withdrawal, i.Value, i.ExternalReference, i.CreatedAt)
+}
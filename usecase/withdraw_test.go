@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GSabadini/golang-clean-architecture/domain/entity"
+	"github.com/GSabadini/golang-clean-architecture/domain/vo"
+)
+
+func TestWithdrawInteractor_Execute(t *testing.T) {
+	var errAuthorizer = errors.New("authorization denied")
+
+	tests := []struct {
+		name           string
+		repoUserFinder entity.UserRepositoryFinder
+		authorizer     Authorizer
+		wantBalance    int64
+		wantUpdated    bool
+		wantErr        error
+	}{
+		{
+			name:           "Withdraw success",
+			repoUserFinder: stubUserRepoFinder{result: newCommonTestUser(vo.NewUuidStaticTest(), 150)},
+			authorizer:     stubAuthorizer{result: true},
+			wantBalance:    50,
+			wantUpdated:    true,
+		},
+		{
+			name:           "Withdraw insufficient balance",
+			repoUserFinder: stubUserRepoFinder{result: newCommonTestUser(vo.NewUuidStaticTest(), 50)},
+			authorizer:     stubAuthorizer{result: true},
+			wantErr:        entity.ErrUserInsufficientBalance,
+		},
+		{
+			name:           "Withdraw authorizer error",
+			repoUserFinder: stubUserRepoFinder{result: newCommonTestUser(vo.NewUuidStaticTest(), 150)},
+			authorizer:     stubAuthorizer{err: errAuthorizer},
+			wantBalance:    50,
+			wantUpdated:    true,
+			wantErr:        errAuthorizer,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updater = &spyWalletUpdater{}
+
+			uc := NewWithdrawInteractor(stubMovementRepoCreator{}, updater, tt.repoUserFinder, tt.authorizer, stubMovementPresenter{})
+
+			got, err := uc.Execute(context.TODO(), WithdrawInput{
+				ID:                vo.NewUuidStaticTest(),
+				UserID:            vo.NewUuidStaticTest(),
+				Value:             vo.NewMoneyBRL(vo.NewAmountTest(100)),
+				ExternalReference: "bank-transfer-0001",
+				CreatedAt:         time.Now(),
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("[TestCase '%s'] Err: '%v' | WantErr: '%v'", tt.name, err, tt.wantErr)
+				return
+			}
+
+			if updater.invoked != tt.wantUpdated {
+				t.Errorf("[TestCase '%s'] Got updated: '%v' | Want: '%v'", tt.name, updater.invoked, tt.wantUpdated)
+			}
+
+			if updater.money.Amount().Value() != tt.wantBalance {
+				t.Errorf("[TestCase '%s'] Got balance: '%v' | Want: '%v'", tt.name, updater.money.Amount().Value(), tt.wantBalance)
+			}
+
+			if tt.wantErr == nil && got.Type != string(entity.Withdrawal) {
+				t.Errorf("[TestCase '%s'] Got type: '%v' | Want: '%v'", tt.name, got.Type, entity.Withdrawal)
+			}
+		})
+	}
+}